@@ -0,0 +1,77 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+// errorBodyTransport keeps the body of failed API responses around after it
+// has been read, so details godo does not decode, such as the error id, are
+// still available when the error is reported.
+type errorBodyTransport struct {
+	wrap http.RoundTripper
+}
+
+func (t *errorBodyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.wrap.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusBadRequest {
+		return resp, err
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = &errorBody{Reader: bytes.NewReader(data), data: data}
+	return resp, nil
+}
+
+// errorBody is a re-readable response body.
+type errorBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+func (b *errorBody) Close() error {
+	return nil
+}
+
+// APIErrorID returns the id the API gave the error described by resp, such
+// as "not_found" or "unprocessable_entity". It returns an empty string when
+// the id is unknown.
+func APIErrorID(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+
+	body, ok := resp.Body.(*errorBody)
+	if !ok {
+		return ""
+	}
+
+	var e struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body.data, &e); err != nil {
+		return ""
+	}
+
+	return e.ID
+}
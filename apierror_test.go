@@ -0,0 +1,56 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ok" {
+			w.Write([]byte(`{"id":"not-an-error"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"id":"not_found","message":"The resource you were accessing could not be found."}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &errorBodyTransport{wrap: http.DefaultTransport}}
+
+	resp, err := client.Get(server.URL + "/missing")
+	require.NoError(t, err)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Contains(t, string(body), "could not be found")
+	assert.Equal(t, "not_found", APIErrorID(resp))
+
+	resp, err = client.Get(server.URL + "/ok")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, "", APIErrorID(resp))
+	assert.Equal(t, "", APIErrorID(nil))
+}
@@ -60,6 +60,10 @@ const (
 	ArgAutoUpgrade = "auto-upgrade"
 	// ArgCommandWait is a wait for a resource to be created argument.
 	ArgCommandWait = "wait"
+	// ArgWaitTimeout is the maximum duration waiting for a resource may take.
+	ArgWaitTimeout = "wait-timeout"
+	// ArgQuiet silences the progress shown while waiting.
+	ArgQuiet = "quiet"
 	// ArgSetCurrentContext is a flag to set the new kubeconfig context as current.
 	ArgSetCurrentContext = "set-current-context"
 	// ArgDropletID is a droplet id argument.
@@ -158,6 +162,14 @@ const (
 	ArgFormat = "format"
 	// ArgNoHeader hides the output header.
 	ArgNoHeader = "no-header"
+	// ArgLimit is the maximum number of items a list command fetches.
+	ArgLimit = "limit"
+	// ArgPerPage is how many items a list command fetches per API request.
+	ArgPerPage = "per-page"
+	// ArgFilter is the conditions list output is filtered by.
+	ArgFilter = "filter"
+	// ArgSortBy is the keys list output is sorted by.
+	ArgSortBy = "sort-by"
 	// ArgPollTime is how long before the next poll argument.
 	ArgPollTime = "poll-timeout"
 	// ArgTagName is a tag name
@@ -170,12 +182,32 @@ const (
 	ArgTagNames = "tag-names"
 	// ArgTag specifies tag.  --tag can be repeated or multiple tags can be , separated.
 	ArgTag = "tag"
+	// ArgQuery is a JMESPath expression evaluated against JSON output.
+	ArgQuery = "query"
 	//ArgTemplate is template format
 	ArgTemplate = "template"
 	// ArgVersion is the version of the command to use
 	ArgVersion = "version"
 	// ArgVerbose enables verbose output
 	ArgVerbose = "verbose"
+	// ArgTimeout is the maximum duration a command may run for
+	ArgTimeout = "timeout"
+	// ArgTraceFile is the path of a HAR file API requests are traced to.
+	ArgTraceFile = "trace-file"
+	// ArgRecord is the path of a cassette API interactions are recorded to.
+	ArgRecord = "record"
+	// ArgReplay is the path of a cassette API interactions are replayed from.
+	ArgReplay = "replay"
+	// ArgDryRun prints mutating API requests instead of sending them.
+	ArgDryRun = "dry-run"
+	// ArgNoCache bypasses the cache of API responses.
+	ArgNoCache = "no-cache"
+	// ArgListen is the address a local server listens on.
+	ArgListen = "listen"
+	// ArgActionDuration is how long the fake API's actions stay in progress.
+	ArgActionDuration = "action-duration"
+	// ArgHTTPRetryMax is the number of times a failed API request is retried
+	ArgHTTPRetryMax = "http-retry-max"
 
 	// ArgOutput is an output type argument.
 	ArgOutput = "output"
@@ -272,6 +304,25 @@ const (
 	// ArgPrivateNetworkUUID is the flag for VPC UUID
 	ArgPrivateNetworkUUID = "private-network-uuid"
 
+	// ArgFile is the path of a file a command reads, - for stdin.
+	ArgFile = "file"
+	// ArgProject is a project name or ID argument.
+	ArgProject = "project"
+	// ArgImportScript is the path of a script of terraform import commands.
+	ArgImportScript = "import-script"
+	// ArgPrune deletes managed resources missing from a stack spec.
+	ArgPrune = "prune"
+	// ArgContinueOnError keeps running a batch after a command failed.
+	ArgContinueOnError = "continue-on-error"
+	// ArgParallel is the number of commands of a batch run at the same time.
+	ArgParallel = "parallel"
+	// ArgPluginIndex is the URL or path of the plugin index.
+	ArgPluginIndex = "index"
+	// ArgSHA256 is the SHA-256 checksum a download must match.
+	ArgSHA256 = "sha256"
+	// ArgInsecureSkipVerify installs a plugin downloaded without a checksum.
+	ArgInsecureSkipVerify = "insecure-skip-verify"
+
 	// ArgForce forces confirmation on actions
 	ArgForce = "force"
 
@@ -16,4 +16,6 @@ package doctl
 const (
 	// ArgShortForce forces confirmation on actions
 	ArgShortForce = "f"
+	// ArgShortFile is the short flag for a file a command reads
+	ArgShortFile = "f"
 )
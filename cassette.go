@@ -0,0 +1,371 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// cassette is a recording of API interactions that can be replayed in place
+// of the API.
+type cassette struct {
+	Interactions []cassetteInteraction `yaml:"interactions"`
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `yaml:"request"`
+	Response cassetteResponse `yaml:"response"`
+}
+
+type cassetteRequest struct {
+	Method string `yaml:"method"`
+	Path   string `yaml:"path"`
+	Query  string `yaml:"query,omitempty"`
+	Body   string `yaml:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	Status  int         `yaml:"status"`
+	Headers http.Header `yaml:"headers,omitempty"`
+	Body    string      `yaml:"body,omitempty"`
+}
+
+// cassetteRecorder records the API interactions made through it to a
+// cassette, with tokens and secrets scrubbed.
+type cassetteRecorder struct {
+	wrap     http.RoundTripper
+	path     string
+	redactor *redactor
+
+	mu       sync.Mutex
+	cassette cassette
+}
+
+func newCassetteRecorder(transport http.RoundTripper, path string, secrets ...string) *cassetteRecorder {
+	return &cassetteRecorder{
+		wrap:     transport,
+		path:     path,
+		redactor: newRedactor(secrets...),
+	}
+}
+
+func (r *cassetteRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readAndRestore(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("recording request: %v", err)
+	}
+
+	resp, err := r.wrap.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readAndRestore(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("recording response: %v", err)
+	}
+
+	headers := r.redactor.headers(resp.Header)
+	headers.Del("Date")
+
+	interaction := cassetteInteraction{
+		Request: newCassetteRequest(r.redactor, req, reqBody),
+		Response: cassetteResponse{
+			Status:  resp.StatusCode,
+			Headers: headers,
+			Body:    indentJSON(r.redactor.body(req.URL.Path, respBody)),
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	// Rewrite the cassette after every interaction, so it is complete even
+	// if the command exits abruptly.
+	b, err := yaml.Marshal(r.cassette)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(r.path, b, 0600); err != nil {
+		return nil, fmt.Errorf("writing cassette: %v", err)
+	}
+
+	return resp, nil
+}
+
+// cassettePlayer serves API responses from a cassette. Requests are matched
+// on method, path, query and body. Interactions are replayed in the order
+// they were recorded; once every match for a request has been used, the last
+// one is served again.
+type cassettePlayer struct {
+	path     string
+	redactor *redactor
+
+	mu       sync.Mutex
+	cassette cassette
+	used     []bool
+}
+
+func newCassettePlayer(path string) (*cassettePlayer, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %v", err)
+	}
+
+	var c cassette
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("reading cassette %s: %v", path, err)
+	}
+
+	return &cassettePlayer{
+		path:     path,
+		redactor: newRedactor(),
+		cassette: c,
+		used:     make([]bool, len(c.Interactions)),
+	}, nil
+}
+
+func (p *cassettePlayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readAndRestore(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("replaying request: %v", err)
+	}
+	got := newCassetteRequest(p.redactor, req, reqBody)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	match := -1
+	for i, interaction := range p.cassette.Interactions {
+		if !interaction.Request.matches(got) {
+			continue
+		}
+
+		match = i
+		if !p.used[i] {
+			break
+		}
+	}
+
+	if match < 0 {
+		return nil, p.miss(got)
+	}
+	p.used[match] = true
+
+	recorded := p.cassette.Interactions[match].Response
+	header := recorded.Headers
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// miss describes a request that is not in the cassette, with a diff against
+// the closest recorded request.
+func (p *cassettePlayer) miss(got cassetteRequest) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "no interaction in cassette %s matches %s %s", p.path, got.Method, got.Path)
+
+	closest, bestScore := -1, 0
+	for i, interaction := range p.cassette.Interactions {
+		if score := interaction.Request.similarity(got); score > bestScore {
+			closest, bestScore = i, score
+		}
+	}
+
+	if closest < 0 {
+		return &cassetteMissError{msg: b.String() + "; no similar request was recorded"}
+	}
+
+	fmt.Fprintf(&b, "\n--- recorded (interaction %d)\n+++ requested\n", closest+1)
+	for _, line := range diffLines(p.cassette.Interactions[closest].Request.lines(), got.lines()) {
+		fmt.Fprintln(&b, line)
+	}
+
+	return &cassetteMissError{msg: strings.TrimRight(b.String(), "\n")}
+}
+
+// cassetteMissError reports a request that is not in the cassette. Retrying
+// the request cannot fix it.
+type cassetteMissError struct {
+	msg string
+}
+
+func (e *cassetteMissError) Error() string {
+	return e.msg
+}
+
+func newCassetteRequest(r *redactor, req *http.Request, body []byte) cassetteRequest {
+	return cassetteRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+		Body:   indentJSON(r.body(req.URL.Path, body)),
+	}
+}
+
+func (r cassetteRequest) matches(o cassetteRequest) bool {
+	return r.Method == o.Method &&
+		r.Path == o.Path &&
+		sameQuery(r.Query, o.Query) &&
+		normalizeBody(r.Body) == normalizeBody(o.Body)
+}
+
+// similarity scores how closely r resembles o, weighting the parts of a
+// request that best identify it highest.
+func (r cassetteRequest) similarity(o cassetteRequest) int {
+	score := 0
+	if r.Path == o.Path {
+		score += 8
+	}
+	if r.Method == o.Method {
+		score += 4
+	}
+	if sameQuery(r.Query, o.Query) {
+		score += 2
+	}
+	if normalizeBody(r.Body) == normalizeBody(o.Body) {
+		score++
+	}
+
+	return score
+}
+
+// lines renders a request for diffing.
+func (r cassetteRequest) lines() []string {
+	lines := []string{"method: " + r.Method, "path: " + r.Path}
+
+	if values, err := url.ParseQuery(r.Query); err == nil && len(values) > 0 {
+		lines = append(lines, "query:")
+		for _, kv := range strings.Split(values.Encode(), "&") {
+			lines = append(lines, "  "+kv)
+		}
+	} else if r.Query != "" {
+		lines = append(lines, "query: "+r.Query)
+	}
+
+	if r.Body != "" {
+		lines = append(lines, "body:")
+		for _, line := range strings.Split(indentJSON([]byte(r.Body)), "\n") {
+			lines = append(lines, "  "+line)
+		}
+	}
+
+	return lines
+}
+
+func sameQuery(a, b string) bool {
+	va, errA := url.ParseQuery(a)
+	vb, errB := url.ParseQuery(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+
+	return va.Encode() == vb.Encode()
+}
+
+// normalizeBody compacts JSON bodies so formatting and key order do not
+// affect matching.
+func normalizeBody(body string) string {
+	var v interface{}
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err == nil {
+		if b, err := json.Marshal(v); err == nil {
+			return string(b)
+		}
+	}
+
+	return strings.TrimSpace(body)
+}
+
+// indentJSON pretty prints a JSON body so cassettes are easy to read and
+// edit. Other bodies are returned unchanged.
+func indentJSON(body []byte) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, body, "", "  "); err != nil {
+		return string(body)
+	}
+
+	return buf.String()
+}
+
+// diffLines returns a line diff turning a into b. Removed lines are prefixed
+// with "- ", added lines with "+ " and unchanged lines with "  ".
+func diffLines(a, b []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var out []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, "  "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, "- "+a[i])
+			i++
+		default:
+			out = append(out, "+ "+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, "- "+a[i])
+	}
+	for ; j < len(b); j++ {
+		out = append(out, "+ "+b[j])
+	}
+
+	return out
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"droplet":{"id":1,"status":"new"}}`))
+		default:
+			w.Write([]byte(`{"droplet":{"id":1,"status":"active"},"token":"my-token"}`))
+		}
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "doctl-cassette")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "cassette.yaml")
+
+	do := func(client *http.Client, method, url, body string) (int, string, error) {
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer my-token")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, "", err
+		}
+		defer resp.Body.Close()
+
+		b, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(b), nil
+	}
+
+	recorder := &http.Client{Transport: newCassetteRecorder(http.DefaultTransport, path, "my-token")}
+	_, _, err = do(recorder, http.MethodPost, server.URL+"/v2/droplets", `{"name":"web","size":"s-1vcpu-1gb"}`)
+	require.NoError(t, err)
+	_, _, err = do(recorder, http.MethodGet, server.URL+"/v2/droplets/1?page=1&per_page=20", "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "my-token")
+
+	player, err := newCassettePlayer(path)
+	require.NoError(t, err)
+	client := &http.Client{Transport: player}
+
+	// Key order and formatting of the body do not matter.
+	status, body, err := do(client, http.MethodPost, "https://api.example.com/v2/droplets", `{"size": "s-1vcpu-1gb", "name": "web"}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Contains(t, body, `"status": "new"`)
+
+	// Nor does the order of query parameters.
+	status, body, err = do(client, http.MethodGet, "https://api.example.com/v2/droplets/1?per_page=20&page=1", "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, `"status": "active"`)
+	assert.Equal(t, 2, calls, "replayed requests should not reach the API")
+
+	_, _, err = do(client, http.MethodPost, "https://api.example.com/v2/droplets", `{"name":"db","size":"s-1vcpu-1gb"}`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no interaction in cassette "+path+" matches POST /v2/droplets")
+	assert.Contains(t, err.Error(), "--- recorded (interaction 1)\n+++ requested\n")
+	assert.Contains(t, err.Error(), `-     "name": "web",`)
+	assert.Contains(t, err.Error(), `+     "name": "db",`)
+}
+
+func TestCassettePlayerOrder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "doctl-cassette")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "cassette.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`interactions:
+- request:
+    method: GET
+    path: /v2/actions/1
+  response:
+    status: 200
+    body: in-progress
+- request:
+    method: GET
+    path: /v2/actions/1
+  response:
+    status: 200
+    body: completed
+`), 0600))
+
+	player, err := newCassettePlayer(path)
+	require.NoError(t, err)
+	client := &http.Client{Transport: player}
+
+	for _, want := range []string{"in-progress", "completed", "completed"} {
+		resp, err := client.Get("https://api.example.com/v2/actions/1")
+		require.NoError(t, err)
+		b, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, want, string(b))
+	}
+
+	_, err = client.Get("https://api.example.com/v2/regions")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no interaction in cassette "+path+" matches GET /v2/regions")
+}
+
+func TestDiffLines(t *testing.T) {
+	assert.Equal(t,
+		[]string{"  a", "- b", "+ c", "  d", "+ e"},
+		diffLines([]string{"a", "b", "d"}, []string{"a", "c", "d", "e"}),
+	)
+}
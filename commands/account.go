@@ -39,7 +39,7 @@ func Account() *Command {
 
 // RunAccountGet runs account get.
 func RunAccountGet(c *CmdConfig) error {
-	a, err := c.Account().Get()
+	a, err := c.Account().Get(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -49,7 +49,7 @@ func RunAccountGet(c *CmdConfig) error {
 
 // RunAccountRateLimit retrieves API rate limits for the account.
 func RunAccountRateLimit(c *CmdConfig) error {
-	rl, err := c.Account().RateLimit()
+	rl, err := c.Account().RateLimit(c.Ctx)
 	if err != nil {
 		return err
 	}
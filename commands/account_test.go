@@ -18,6 +18,7 @@ import (
 
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,7 +39,7 @@ func TestAccountCommand(t *testing.T) {
 
 func TestAccountGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.account.EXPECT().Get().Return(testAccount, nil)
+		tm.account.EXPECT().Get(gomock.Any()).Return(testAccount, nil)
 
 		err := RunAccountGet(config)
 		assert.NoError(t, err)
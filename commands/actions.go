@@ -14,13 +14,19 @@ limitations under the License.
 package commands
 
 import (
+	"context"
+	"fmt"
+	"net/url"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
 	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 	"github.com/spf13/cobra"
 )
 
@@ -46,16 +52,22 @@ func Actions() *Command {
 	AddStringFlag(cmdActionList, doctl.ArgActionStatus, "", "", "Action status")
 	AddStringFlag(cmdActionList, doctl.ArgActionType, "", "", "Action type")
 
-	cmdActionWait := CmdBuilder(cmd, RunCmdActionWait, "wait <action-id>", "wait for action to complete", Writer,
+	cmdActionWait := CmdBuilder(cmd, RunCmdActionWait, "wait <action-id|action-href>...", "wait for actions to complete", Writer,
 		aliasOpt("w"), displayerType(&displayers.Action{}))
-	AddIntFlag(cmdActionWait, doctl.ArgPollTime, "", 5, "Re-poll time in seconds")
+	AddIntFlag(cmdActionWait, doctl.ArgPollTime, "", 5, "Longest re-poll time in seconds")
+
+	cmdActionWatch := CmdBuilder(cmd, RunCmdActionWatch, "watch", "stream new and in-progress actions", Writer,
+		displayerType(&displayers.Action{}))
+	AddStringFlag(cmdActionWatch, doctl.ArgActionResourceType, "", "", "Action resource type")
+	AddStringFlag(cmdActionWatch, doctl.ArgActionStatus, "", "", "Action status")
+	AddIntFlag(cmdActionWatch, doctl.ArgPollTime, "", 5, "Re-poll time in seconds")
 
 	return cmd
 }
 
 // RunCmdActionList run action list.
 func RunCmdActionList(c *CmdConfig) error {
-	actions, err := c.Actions().List()
+	actions, err := c.Actions().List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -184,7 +196,7 @@ func RunCmdActionGet(c *CmdConfig) error {
 	}
 
 	as := c.Actions()
-	a, err := as.Get(id)
+	a, err := as.Get(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -192,48 +204,243 @@ func RunCmdActionGet(c *CmdConfig) error {
 	return c.Display(&displayers.Action{Actions: do.Actions{*a}})
 }
 
-// RunCmdActionWait waits for an action to complete or error.
+// RunCmdActionWait waits for actions, given by ID or HREF, to complete or
+// error. It fails when some of them errored.
 func RunCmdActionWait(c *CmdConfig) error {
-	if len(c.Args) != 1 {
+	if len(c.Args) == 0 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id, err := strconv.Atoi(c.Args[0])
+	pollTime, err := c.Doit.GetInt(c.NS, doctl.ArgPollTime)
 	if err != nil {
 		return err
 	}
 
-	pollTime, err := c.Doit.GetInt(c.NS, doctl.ArgPollTime)
+	w := newWaiter("action %s", c.Args[0])
+	if len(c.Args) > 1 {
+		w = newWaiter("%d actions", len(c.Args))
+	}
+	w.maxInterval = time.Duration(pollTime) * time.Second
+
+	actions, err := actionsWait(c, w, c.Args)
 	if err != nil {
 		return err
 	}
 
-	a, err := actionWait(c, id, pollTime)
-	if err != nil {
+	if err := c.Display(&displayers.Action{Actions: actions}); err != nil {
 		return err
 	}
 
-	return c.Display(&displayers.Action{Actions: do.Actions{*a}})
+	return erroredActions(actions)
 }
 
-func actionWait(c *CmdConfig, actionID, pollTime int) (*do.Action, error) {
-	as := c.Actions()
+// actionWait waits with w for an action to complete or error.
+func actionWait(c *CmdConfig, w *waiter, actionID int) (*do.Action, error) {
+	actions, err := actionsWait(c, w, []string{strconv.Itoa(actionID)})
+	if err != nil {
+		return nil, err
+	}
 
-	var a *do.Action
-	var err error
+	return &actions[0], nil
+}
 
-	for {
-		a, err = as.Get(actionID)
+// actionsWait waits with w for actions, given by ID or HREF, to complete or
+// error, polling them concurrently.
+func actionsWait(c *CmdConfig, w *waiter, refs []string) (do.Actions, error) {
+	ids := make([]int, len(refs))
+	for i, ref := range refs {
+		id, err := parseActionRef(ref)
 		if err != nil {
 			return nil, err
 		}
+		ids[i] = id
+	}
+
+	actions := make(do.Actions, len(refs))
+
+	if doctl.DryRunIntercepted(c.Ctx) {
+		// The actions of intercepted requests can't be fetched, they are
+		// shown as complete.
+		for i, id := range ids {
+			actions[i] = do.Action{Action: &godo.Action{ID: id, Status: godo.ActionCompleted}}
+		}
+		return actions, nil
+	}
 
-		if a.Status != "in-progress" {
-			break
+	as, das := c.Actions(), c.DropletActions()
+	get := func(ctx context.Context, ref string) (*do.Action, error) {
+		if id, err := strconv.Atoi(ref); err == nil {
+			return as.Get(ctx, id)
 		}
+		return das.GetByURI(ctx, ref)
+	}
+
+	err := w.wait(c.Ctx, func(ctx context.Context) (string, bool, error) {
+		var wg sync.WaitGroup
+		errs := make([]error, len(refs))
+		for i := range refs {
+			if actions[i].Action != nil && actions[i].Status != godo.ActionInProgress {
+				continue
+			}
+
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				a, err := get(ctx, refs[i])
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				actions[i] = *a
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			if err != nil {
+				return "", false, err
+			}
+		}
+
+		done := 0
+		for _, a := range actions {
+			if a.Status != godo.ActionInProgress {
+				done++
+			}
+		}
+
+		if len(actions) == 1 {
+			return actions[0].Status, done == 1, nil
+		}
+		return fmt.Sprintf("%d of %d done", done, len(actions)), done == len(actions), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
 
-		time.Sleep(time.Duration(pollTime) * time.Second)
+// parseActionRef returns the ID of the action ref refers to, either by ID
+// or by its absolute HREF, such as
+// https://api.digitalocean.com/v2/droplets/3/actions/2.
+func parseActionRef(ref string) (int, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		return id, nil
 	}
 
-	return a, nil
+	u, err := url.Parse(ref)
+	if err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+		segments := strings.Split(strings.TrimSuffix(u.Path, "/"), "/")
+		if n := len(segments); n >= 2 && segments[n-2] == "actions" {
+			if id, err := strconv.Atoi(segments[n-1]); err == nil {
+				return id, nil
+			}
+		}
+	}
+
+	return 0, &errUsage{err: fmt.Errorf("%q is neither an action ID nor an action HREF", ref)}
+}
+
+// erroredActions returns an error listing the actions which errored, if
+// any.
+func erroredActions(actions do.Actions) error {
+	var ids []int
+	for _, a := range actions {
+		if a.Status == "errored" {
+			ids = append(ids, a.ID)
+		}
+	}
+
+	switch {
+	case len(ids) == 0:
+		return nil
+	case len(actions) == 1:
+		return fmt.Errorf("action %d errored", ids[0])
+	default:
+		return fmt.Errorf("%d of %d actions errored: %v", len(ids), len(actions), ids)
+	}
+}
+
+// watchedActions is how many of the most recent actions action watch lists
+// each time.
+var watchedActions = 200
+
+// RunCmdActionWatch streams actions as they start and change status, until
+// interrupted or the global timeout elapses. Actions which were over before
+// it started aren't shown.
+func RunCmdActionWatch(c *CmdConfig) error {
+	resourceType, err := c.Doit.GetString(c.NS, doctl.ArgActionResourceType)
+	if err != nil {
+		return err
+	}
+
+	status, err := c.Doit.GetString(c.NS, doctl.ArgActionStatus)
+	if err != nil {
+		return err
+	}
+
+	pollTime, err := c.Doit.GetInt(c.NS, doctl.ArgPollTime)
+	if err != nil {
+		return err
+	}
+
+	as := c.Actions()
+	// seen holds the status of the actions last listed.
+	var seen map[int]string
+	failures := 0
+	for {
+		actions, err := as.List(do.WithListLimit(c.Ctx, watchedActions))
+		switch {
+		case c.Ctx.Err() != nil:
+			return nil
+		case err != nil && !transientErr(err):
+			return err
+		case err != nil:
+			// Allow for transient API failures.
+			failures++
+			if failures >= maxAPIFailures {
+				return err
+			}
+		default:
+			failures = 0
+
+			var changed do.Actions
+			listed := make(map[int]string, len(actions))
+			// The newest actions are listed first.
+			for i := len(actions) - 1; i >= 0; i-- {
+				a := actions[i]
+				listed[a.ID] = a.Status
+
+				last, ok := seen[a.ID]
+				switch {
+				case ok && last == a.Status:
+					continue
+				case seen == nil && a.Status != godo.ActionInProgress:
+					continue
+				case resourceType != "" && a.ResourceType != resourceType:
+					continue
+				case status != "" && a.Status != status:
+					continue
+				}
+				changed = append(changed, a)
+			}
+			seen = listed
+
+			if len(changed) > 0 {
+				if err := c.Display(&displayers.Action{Actions: changed}); err != nil {
+					return err
+				}
+				// Later actions continue the table.
+				c.Doit.Set(c.NS, doctl.ArgNoHeader, true)
+			}
+		}
+
+		select {
+		case <-c.Ctx.Done():
+			return nil
+		case <-time.After(time.Duration(pollTime) * time.Second):
+		}
+	}
 }
@@ -14,13 +14,17 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
+	"context"
 	"testing"
 	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -33,12 +37,12 @@ var (
 func TestActionsCommand(t *testing.T) {
 	cmd := Actions()
 	assert.NotNil(t, cmd)
-	assertCommandNames(t, cmd, "get", "list", "wait")
+	assertCommandNames(t, cmd, "get", "list", "wait", "watch")
 }
 
 func TestActionList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.actions.EXPECT().List().Return(testActionList, nil)
+		tm.actions.EXPECT().List(gomock.Any()).Return(testActionList, nil)
 
 		err := RunCmdActionList(config)
 		assert.NoError(t, err)
@@ -47,7 +51,7 @@ func TestActionList(t *testing.T) {
 
 func TestActionGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.actions.EXPECT().Get(1).Return(&testAction, nil)
+		tm.actions.EXPECT().Get(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -56,6 +60,113 @@ func TestActionGet(t *testing.T) {
 	})
 }
 
+func TestActionWait(t *testing.T) {
+	defer shortWaits()()
+
+	href := "https://api.digitalocean.com/v2/droplets/3/actions/2"
+	inProgress := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionInProgress}}
+	completed := do.Action{Action: &godo.Action{ID: 1, Status: godo.ActionCompleted}}
+	errored := do.Action{Action: &godo.Action{ID: 2, Status: "errored"}}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		gomock.InOrder(
+			tm.actions.EXPECT().Get(gomock.Any(), 1).Return(&inProgress, nil),
+			tm.actions.EXPECT().Get(gomock.Any(), 1).Return(&completed, nil),
+		)
+		tm.dropletActions.EXPECT().GetByURI(gomock.Any(), href).Return(&errored, nil)
+
+		config.Args = append(config.Args, "1", href)
+
+		err := RunCmdActionWait(config)
+		assert.EqualError(t, err, "1 of 2 actions errored: [2]")
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.actions.EXPECT().Get(gomock.Any(), 1).Return(&completed, nil)
+
+		config.Args = append(config.Args, "1")
+
+		err := RunCmdActionWait(config)
+		assert.NoError(t, err)
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "1", "abc")
+
+		err := RunCmdActionWait(config)
+		assert.EqualError(t, err, `"abc" is neither an action ID nor an action HREF`)
+		assert.IsType(t, &errUsage{}, err)
+	})
+}
+
+func Test_parseActionRef(t *testing.T) {
+	tests := []struct {
+		ref string
+		id  int
+		err bool
+	}{
+		{ref: "12", id: 12},
+		{ref: "https://api.digitalocean.com/v2/actions/12", id: 12},
+		{ref: "https://api.digitalocean.com/v2/droplets/3/actions/12/", id: 12},
+		{ref: "http://127.0.0.1:8080/v2/droplets/3/actions/12", id: 12},
+		{ref: "abc", err: true},
+		{ref: "/v2/actions/12", err: true},
+		{ref: "ftp://example.com/v2/actions/12", err: true},
+		{ref: "https://api.digitalocean.com/v2/droplets/3", err: true},
+		{ref: "https://api.digitalocean.com/v2/actions/abc", err: true},
+	}
+
+	for _, tt := range tests {
+		id, err := parseActionRef(tt.ref)
+		if tt.err {
+			assert.Error(t, err, tt.ref)
+			continue
+		}
+		assert.NoError(t, err, tt.ref)
+		assert.Equal(t, tt.id, id, tt.ref)
+	}
+}
+
+func TestActionWatch(t *testing.T) {
+	action := func(id int, resourceType, status string) do.Action {
+		return do.Action{Action: &godo.Action{ID: id, ResourceType: resourceType, Status: status, Type: "create"}}
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		config.Ctx = ctx
+
+		var out bytes.Buffer
+		config.Out = &out
+		config.Doit.Set(config.NS, doctl.ArgPollTime, 0)
+		config.Doit.Set(config.NS, doctl.ArgActionResourceType, "droplet")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,Status")
+
+		gomock.InOrder(
+			tm.actions.EXPECT().List(gomock.Any()).Return(do.Actions{
+				action(3, "volume", godo.ActionInProgress),
+				action(2, "droplet", godo.ActionInProgress),
+				action(1, "droplet", godo.ActionCompleted),
+			}, nil),
+			tm.actions.EXPECT().List(gomock.Any()).Return(do.Actions{
+				action(4, "droplet", godo.ActionInProgress),
+				action(3, "volume", godo.ActionCompleted),
+				action(2, "droplet", godo.ActionCompleted),
+				action(1, "droplet", godo.ActionCompleted),
+			}, nil),
+			tm.actions.EXPECT().List(gomock.Any()).DoAndReturn(func(context.Context) (do.Actions, error) {
+				cancel()
+				return nil, context.Canceled
+			}),
+		)
+
+		err := RunCmdActionWatch(config)
+		require.NoError(t, err)
+		assert.Equal(t, "ID    Status\n2     in-progress\n2    completed\n4    in-progress\n", out.String())
+	})
+}
+
 func Test_filterActions(t *testing.T) {
 	cases := []struct {
 		resourceType string
@@ -0,0 +1,347 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/commands/displayers"
+	"github.com/fatih/color"
+)
+
+// Statuses of the commands of a batch.
+const (
+	batchOK      = "ok"
+	batchFailed  = "failed"
+	batchSkipped = "skipped"
+)
+
+// Batch creates the batch command.
+func Batch() *Command {
+	cmd := cmdBuilderWithInit(nil, RunBatch, "batch", "run doctl commands read from a file", Writer, true)
+	cmd.Long = `batch runs doctl commands read from a file, or stdin, one per line, in a single
+process sharing one API client and its connections.
+
+Lines are split like shell words, with single and double quotes, and the
+leading doctl is optional. Blank lines and lines starting with # are ignored:
+
+	compute tag create web
+	compute droplet create web-01 --region nyc3 --size s-1vcpu-1gb --image ubuntu-18-04-x64 --tag-name web
+	compute droplet list --tag-name web --format Name,PublicIPv4
+
+Every line is checked before any runs. Global flags, such as --output or
+--access-token, apply to the whole batch and must be passed to batch itself.
+Commands asking for confirmation need their --force flag.
+
+With --output text, the output of each command is printed in order and its
+status is reported on stderr. With --output json or yaml, a single array of
+results is printed, holding the line, status, exit code, output and error of
+each command.
+
+batch stops at the first failing command unless --continue-on-error is set, and
+exits with the exit code of the first failure.`
+	AddStringFlag(cmd, doctl.ArgFile, doctl.ArgShortFile, "-", "file of commands, - for stdin")
+	AddBoolFlag(cmd, doctl.ArgContinueOnError, "", false, "run the remaining commands after one fails")
+	AddIntFlag(cmd, doctl.ArgParallel, "", 1, "number of commands run at the same time")
+
+	return cmd
+}
+
+// errBatch is returned when commands of a batch failed. It carries the exit
+// code of the first failure.
+type errBatch struct {
+	failed, total int
+	code          int
+}
+
+func (e *errBatch) Error() string {
+	return fmt.Sprintf("%d of %d commands failed", e.failed, e.total)
+}
+
+// batchLine is a command of a batch, parsed and ready to run.
+type batchLine struct {
+	number int
+	text   string
+
+	runner CmdRunner
+	config *CmdConfig
+	err    error
+
+	output []byte
+}
+
+// RunBatch runs doctl commands read from a file.
+func RunBatch(c *CmdConfig) error {
+	path, err := c.Doit.GetString(c.NS, doctl.ArgFile)
+	if err != nil {
+		return err
+	}
+
+	continueOnError, err := c.Doit.GetBool(c.NS, doctl.ArgContinueOnError)
+	if err != nil {
+		return err
+	}
+
+	parallel, err := c.Doit.GetInt(c.NS, doctl.ArgParallel)
+	if err != nil {
+		return err
+	}
+	if parallel < 1 {
+		return fmt.Errorf("--%s must be at least 1", doctl.ArgParallel)
+	}
+
+	lines, err := readBatch(path)
+	if err != nil {
+		return err
+	}
+
+	parsed := make([]*batchLine, len(lines))
+	invalid := false
+	for i, l := range lines {
+		parsed[i] = parseBatchLine(c, l.number, l.text)
+		invalid = invalid || parsed[i].err != nil
+	}
+
+	structured := Output == "json" || Output == "yaml"
+	report := func(i int, r displayers.BatchResult) {
+		if !structured {
+			reportBatchResult(c.Out, parsed[i], r)
+		}
+	}
+
+	var results []displayers.BatchResult
+	if invalid && !continueOnError {
+		// Nothing runs when a line is invalid.
+		for i, l := range parsed {
+			r := displayers.BatchResult{Line: l.number, Command: l.text, Status: batchSkipped}
+			if l.err != nil {
+				setBatchError(&r, nil, l.err)
+			}
+			results = append(results, r)
+			report(i, r)
+		}
+	} else {
+		results = runBatch(parsed, parallel, continueOnError, report)
+	}
+
+	var first *displayers.BatchResult
+	failed := 0
+	for i, r := range results {
+		if r.Status == batchFailed {
+			failed++
+			if first == nil {
+				first = &results[i]
+			}
+		}
+	}
+
+	if structured {
+		d := &displayers.Displayer{
+			Item:       &displayers.BatchResults{Results: results},
+			Out:        c.Out,
+			OutputType: Output,
+		}
+		if err := d.Display(); err != nil {
+			return err
+		}
+	}
+
+	if first != nil {
+		return &errBatch{failed: failed, total: len(results), code: first.ExitCode}
+	}
+
+	return nil
+}
+
+// reportBatchResult prints the output of a command of a batch, and its
+// status on stderr.
+func reportBatchResult(out io.Writer, l *batchLine, r displayers.BatchResult) {
+	out.Write(l.output)
+	if len(l.output) > 0 && !bytes.HasSuffix(l.output, []byte("\n")) {
+		fmt.Fprintln(out)
+	}
+
+	switch r.Status {
+	case batchOK:
+		notice("line %d: %s", l.number, l.text)
+	case batchFailed:
+		fmt.Fprintf(color.Output, "%s: line %d: %s: %s\n", colorErr, l.number, l.text, r.Error)
+		if r.Hint != "" {
+			fmt.Fprintf(color.Output, "%s: %s\n", colorHint, r.Hint)
+		}
+	case batchSkipped:
+		warn("line %d skipped: %s", l.number, l.text)
+	}
+}
+
+// runBatch runs the lines, up to parallel at a time, and calls report with
+// the result of each, in order. Once a line failed, the lines not started
+// yet are skipped unless continueOnError is set.
+func runBatch(lines []*batchLine, parallel int, continueOnError bool, report func(int, displayers.BatchResult)) []displayers.BatchResult {
+	results := make([]displayers.BatchResult, len(lines))
+	done := make([]chan struct{}, len(lines))
+	for i, l := range lines {
+		results[i] = displayers.BatchResult{Line: l.number, Command: l.text}
+		done[i] = make(chan struct{})
+	}
+
+	go func() {
+		var (
+			mu     sync.Mutex
+			failed bool
+			slots  = make(chan struct{}, parallel)
+		)
+
+		for i, l := range lines {
+			if l.err != nil {
+				setBatchError(&results[i], nil, l.err)
+				close(done[i])
+				continue
+			}
+
+			slots <- struct{}{}
+
+			mu.Lock()
+			skip := failed && !continueOnError
+			mu.Unlock()
+			if skip {
+				results[i].Status = batchSkipped
+				<-slots
+				close(done[i])
+				continue
+			}
+
+			go func(r *displayers.BatchResult, l *batchLine, done chan struct{}) {
+				defer func() {
+					<-slots
+					close(done)
+				}()
+
+				l.run(r)
+				if r.Status == batchFailed {
+					mu.Lock()
+					failed = true
+					mu.Unlock()
+				}
+			}(&results[i], l, done[i])
+		}
+	}()
+
+	for i := range lines {
+		<-done[i]
+		report(i, results[i])
+	}
+
+	return results
+}
+
+// run runs the line and records its outcome in r.
+func (l *batchLine) run(r *displayers.BatchResult) {
+	var out bytes.Buffer
+	c := *l.config
+	c.Out = &out
+	// Requests intercepted by a dry run are part of the line's output.
+	c.Ctx = doctl.WithDryRunRecord(c.Ctx, &out)
+
+	err := l.runner(&c)
+	l.output = out.Bytes()
+	r.Output = batchOutput(l.output)
+	if err != nil {
+		setBatchError(r, &c, cmdContextErr(c.Ctx, err))
+		return
+	}
+
+	r.Status = batchOK
+}
+
+func setBatchError(r *displayers.BatchResult, c *CmdConfig, err error) {
+	oe := newOutputError(addHint(c, err))
+	r.Status = batchFailed
+	r.ExitCode = exitCode(err)
+	r.Error = oe.Detail
+	r.Hint = oe.Hint
+}
+
+// batchOutput returns the output of a command, as is when it is JSON and
+// as a JSON string otherwise.
+func batchOutput(b []byte) json.RawMessage {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil
+	}
+
+	if json.Valid(b) {
+		return json.RawMessage(b)
+	}
+
+	s, _ := json.Marshal(string(b))
+	return json.RawMessage(s)
+}
+
+type batchText struct {
+	number int
+	text   string
+}
+
+// readBatch reads the commands of a batch from path, - for stdin, skipping
+// blank lines and comments.
+func readBatch(path string) ([]batchText, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var lines []batchText
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		lines = append(lines, batchText{number: n, text: text})
+	}
+
+	return lines, scanner.Err()
+}
+
+// parseBatchLine finds the command of a line and parses its flags.
+func parseBatchLine(c *CmdConfig, number int, text string) *batchLine {
+	l := &batchLine{number: number, text: text}
+
+	words, err := splitCommandLine(text)
+	if err != nil {
+		l.err = &errUsage{err: err}
+		return l
+	}
+
+	l.runner, l.config, err = parseCommandLine(c, words)
+	if flags, ok := err.(*errGlobalFlags); ok {
+		err = &errUsage{err: fmt.Errorf("global flags apply to the whole batch, pass %s to batch itself", strings.Join(flags.flags, ", "))}
+	}
+	l.err = err
+
+	return l
+}
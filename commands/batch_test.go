@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeBatch(t *testing.T, config *CmdConfig, batch string) string {
+	f, err := ioutil.TempFile("", "doctl-batch")
+	require.NoError(t, err)
+	_, err = f.WriteString(batch)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	config.Doit.Set(config.NS, doctl.ArgFile, f.Name())
+	config.Doit.Set(config.NS, doctl.ArgParallel, 1)
+
+	return f.Name()
+}
+
+func TestParseBatchLine(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		l := parseBatchLine(config, 1, "doctl compute droplet list --tag-name web 'web-*'")
+		require.NoError(t, l.err)
+		assert.Equal(t, "droplet.list", l.config.NS)
+		assert.Equal(t, []string{"web-*"}, l.config.Args)
+
+		tag, err := l.config.Doit.GetString(l.config.NS, doctl.ArgTagName)
+		require.NoError(t, err)
+		assert.Equal(t, "web", tag)
+
+		// The flags were reset once the line was parsed.
+		l = parseBatchLine(config, 2, "compute droplet list")
+		require.NoError(t, l.err)
+		tag, err = l.config.Doit.GetString(l.config.NS, doctl.ArgTagName)
+		require.NoError(t, err)
+		assert.Empty(t, tag)
+
+		for line, msg := range map[string]string{
+			"compute droplet list -o json": "global flags apply to the whole batch, pass --output to batch itself",
+			"compute nope":                 `"compute nope" is not a command`,
+			"batch -f other.txt":           "batch cannot be run from a batch or shell",
+			"compute droplet list --nope":  "unknown flag: --nope",
+		} {
+			l := parseBatchLine(config, 1, line)
+			assert.EqualError(t, l.err, msg, line)
+			assert.Equal(t, ExitUsage, exitCode(l.err), line)
+		}
+		assert.Equal(t, "text", Output)
+	})
+}
+
+func TestRunBatch(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		defer os.Remove(writeBatch(t, config, `
+# tags
+compute tag list --format Name --no-header
+compute tag get web --format Name
+`))
+		var out bytes.Buffer
+		config.Out = &out
+
+		tm.tags.EXPECT().List(gomock.Any()).Return(do.Tags{
+			{Tag: &godo.Tag{Name: "web", Resources: &godo.TaggedResources{Droplets: &godo.TaggedDropletsResources{}}}},
+			{Tag: &godo.Tag{Name: "db", Resources: &godo.TaggedResources{Droplets: &godo.TaggedDropletsResources{}}}},
+		}, nil)
+		tm.tags.EXPECT().Get(gomock.Any(), "web").Return(&do.Tag{Tag: &godo.Tag{Name: "web", Resources: &godo.TaggedResources{Droplets: &godo.TaggedDropletsResources{}}}}, nil)
+
+		require.NoError(t, RunBatch(config))
+		assert.Equal(t, "web\ndb\nName\nweb\n", out.String())
+	})
+}
+
+func TestRunBatchFailure(t *testing.T) {
+	notFound := &godo.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusNotFound, Request: &http.Request{}},
+		Message:  "not found",
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		defer os.Remove(writeBatch(t, config, "compute tag get web\ncompute tag get db\n"))
+
+		tm.tags.EXPECT().Get(gomock.Any(), "web").Return(nil, notFound)
+
+		err := RunBatch(config)
+		assert.EqualError(t, err, "1 of 2 commands failed")
+		assert.Equal(t, ExitNotFound, exitCode(err))
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		defer os.Remove(writeBatch(t, config, "compute tag get web\ncompute tag get db\n"))
+		config.Doit.Set(config.NS, doctl.ArgContinueOnError, true)
+
+		tm.tags.EXPECT().Get(gomock.Any(), "web").Return(nil, notFound)
+		tm.tags.EXPECT().Get(gomock.Any(), "db").Return(&do.Tag{Tag: &godo.Tag{Name: "db", Resources: &godo.TaggedResources{Droplets: &godo.TaggedDropletsResources{}}}}, nil)
+
+		assert.EqualError(t, RunBatch(config), "1 of 2 commands failed")
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		defer os.Remove(writeBatch(t, config, "compute tag get web\ncompute tag get --nope\n"))
+
+		err := RunBatch(config)
+		assert.EqualError(t, err, "1 of 2 commands failed")
+		assert.Equal(t, ExitUsage, exitCode(err))
+	})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// cacheTTLKey is the config key holding how long catalogs are cached.
+	cacheTTLKey = "cache-ttl"
+	// defaultCacheTTL is how long catalogs are cached by default.
+	defaultCacheTTL = 24 * time.Hour
+)
+
+// cacheDir returns the directory API responses are cached in. Tests replace
+// it.
+var cacheDir = defaultCacheDir
+
+func defaultCacheDir() string {
+	return filepath.Join(configHome(), "cache")
+}
+
+// Cache creates the cache commands.
+func Cache() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:   "cache",
+			Short: "cache commands",
+			Long: `cache is used to manage the cache of API responses which rarely change.
+
+The regions, sizes, distribution images and Kubernetes options are cached next
+to the default config file for a day, or for the duration set as cache-ttl in
+the config file, per authentication context and API URL. When the API can't be
+reached, stale entries are used with a warning. --no-cache bypasses the cache.`,
+		},
+	}
+
+	cmdBuilderWithInit(cmd, RunCacheClear, "clear", "remove the cached API responses", Writer, false)
+
+	return cmd
+}
+
+// RunCacheClear removes the cached API responses of every context.
+func RunCacheClear(c *CmdConfig) error {
+	return do.NewCache(cacheDir(), currentContext(), apiURL(), 0).Clear()
+}
+
+// newCache returns the cache of the current context and API URL keeping
+// entries for ttl, or nil when caching is off.
+func newCache(ttl time.Duration) *do.Cache {
+	if viper.GetBool(doctl.ArgNoCache) || viper.GetString(doctl.ArgRecord) != "" || viper.GetString(doctl.ArgReplay) != "" {
+		return nil
+	}
+
+	cache := do.NewCache(cacheDir(), currentContext(), apiURL(), ttl)
+	cache.Stale = func(name string, age time.Duration, err error) {
+		warn("using %s cached %s ago, the API couldn't be reached: %v", name, age.Round(time.Second), err)
+	}
+
+	return cache
+}
+
+// catalogCacheTTL returns how long catalogs such as the regions are cached.
+func catalogCacheTTL() time.Duration {
+	if viper.IsSet(cacheTTLKey) {
+		return viper.GetDuration(cacheTTLKey)
+	}
+	return defaultCacheTTL
+}
+
+// apiURL returns the URL of the API requests are sent to.
+func apiURL() string {
+	if u := viper.GetString("api-url"); u != "" {
+		return u
+	}
+	return godo.NewClient(nil).BaseURL.String()
+}
@@ -65,7 +65,7 @@ func CDN() *Command {
 
 // RunCDNList returns a list of CDNs.
 func RunCDNList(c *CmdConfig) error {
-	cdns, err := c.CDNs().List()
+	cdns, err := c.CDNs().List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -79,8 +79,11 @@ func RunCDNGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
-	item, err := c.CDNs().Get(id)
+	id, err := cdnResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+	item, err := c.CDNs().Get(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -116,7 +119,7 @@ func RunCDNCreate(c *CmdConfig) error {
 		CertificateID: certID,
 	}
 
-	item, err := c.CDNs().Create(createCDN)
+	item, err := c.CDNs().Create(c.Ctx, createCDN)
 	if err != nil {
 		return err
 	}
@@ -130,7 +133,10 @@ func RunCDNUpdate(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := cdnResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	cs := c.CDNs()
 
@@ -146,7 +152,7 @@ func RunCDNUpdate(c *CmdConfig) error {
 
 		updateCDN := &godo.CDNUpdateTTLRequest{TTL: uint32(ttl)}
 
-		item, err = cs.UpdateTTL(id, updateCDN)
+		item, err = cs.UpdateTTL(c.Ctx, id, updateCDN)
 		if err != nil {
 			return err
 		}
@@ -163,7 +169,7 @@ func RunCDNUpdate(c *CmdConfig) error {
 			CertificateID: certID,
 		}
 
-		item, err = cs.UpdateCustomDomain(id, updateCDN)
+		item, err = cs.UpdateCustomDomain(c.Ctx, id, updateCDN)
 		if err != nil {
 			return err
 		}
@@ -210,9 +216,13 @@ func RunCDNDelete(c *CmdConfig) error {
 		return err
 	}
 
+	id, err := cdnResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+
 	if force || AskForConfirm("delete cdn") == nil {
-		id := c.Args[0]
-		return c.CDNs().Delete(id)
+		return c.CDNs().Delete(c.Ctx, id)
 	}
 
 	return fmt.Errorf("operation aborted")
@@ -224,7 +234,10 @@ func RunCDNFlushCache(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := cdnResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	files, err := c.Doit.GetStringSlice(c.NS, doctl.ArgCDNFiles)
 	if err != nil {
@@ -233,5 +246,5 @@ func RunCDNFlushCache(c *CmdConfig) error {
 
 	flushCDN := &godo.CDNFlushCacheRequest{Files: files}
 
-	return c.CDNs().FlushCache(id, flushCDN)
+	return c.CDNs().FlushCache(c.Ctx, id, flushCDN)
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -84,7 +85,7 @@ func TestCDNCommand(t *testing.T) {
 
 func TestCDNsGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.cdns.EXPECT().Get(cdnID).Return(&testCDN, nil)
+		tm.cdns.EXPECT().Get(gomock.Any(), cdnID).Return(&testCDN, nil)
 
 		config.Args = append(config.Args, cdnID)
 
@@ -102,7 +103,7 @@ func TestCDNsGet_RequiredArguments(t *testing.T) {
 
 func TestCDNsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.cdns.EXPECT().List().Return(testCDNList, nil)
+		tm.cdns.EXPECT().List(gomock.Any()).Return(testCDNList, nil)
 
 		err := RunCDNList(config)
 		assert.NoError(t, err)
@@ -115,7 +116,7 @@ func TestCDNsCreate(t *testing.T) {
 			Origin: cdnOrigin,
 			TTL:    3600,
 		}
-		tm.cdns.EXPECT().Create(cdncr).Return(&testCDN, nil)
+		tm.cdns.EXPECT().Create(gomock.Any(), cdncr).Return(&testCDN, nil)
 
 		config.Args = append(config.Args, cdnOrigin)
 		config.Doit.Set(config.NS, doctl.ArgCDNTTL, 3600)
@@ -133,7 +134,7 @@ func TestCDNsCreateCustomDomain(t *testing.T) {
 			CustomDomain:  testCDNWithCustomDomain.CustomDomain,
 			CertificateID: testCDNWithCustomDomain.CertificateID,
 		}
-		tm.cdns.EXPECT().Create(cdncr).Return(&testCDNWithCustomDomain, nil)
+		tm.cdns.EXPECT().Create(gomock.Any(), cdncr).Return(&testCDNWithCustomDomain, nil)
 
 		config.Args = append(config.Args, cdnOrigin)
 		config.Doit.Set(config.NS, doctl.ArgCDNTTL, 3600)
@@ -178,7 +179,7 @@ func TestCDNsUpdateTTL(t *testing.T) {
 		cdnur := &godo.CDNUpdateTTLRequest{
 			TTL: 60,
 		}
-		tm.cdns.EXPECT().UpdateTTL(cdnID, cdnur).Return(&updatedCDN, nil)
+		tm.cdns.EXPECT().UpdateTTL(gomock.Any(), cdnID, cdnur).Return(&updatedCDN, nil)
 
 		config.Args = append(config.Args, cdnID)
 		config.Doit.Set(config.NS, doctl.ArgCDNTTL, 60)
@@ -204,7 +205,7 @@ func TestCDNsUpdateCustomDomain(t *testing.T) {
 			CustomDomain:  updatedCDNWithCustomDomain.CustomDomain,
 			CertificateID: updatedCDNWithCustomDomain.CertificateID,
 		}
-		tm.cdns.EXPECT().UpdateCustomDomain(cdnID, cdnur).Return(&updatedCDNWithCustomDomain, nil)
+		tm.cdns.EXPECT().UpdateCustomDomain(gomock.Any(), cdnID, cdnur).Return(&updatedCDNWithCustomDomain, nil)
 
 		config.Args = append(config.Args, cdnID)
 		config.Doit.Set(config.NS, doctl.ArgCDNDomain, updatedCDNWithCustomDomain.CustomDomain)
@@ -230,7 +231,7 @@ func TestCDNsUpdateRemoveCustomDomain(t *testing.T) {
 		cdnur := &godo.CDNUpdateCustomDomainRequest{
 			CustomDomain: "",
 		}
-		tm.cdns.EXPECT().UpdateCustomDomain(cdnID, cdnur).Return(&testCDN, nil)
+		tm.cdns.EXPECT().UpdateCustomDomain(gomock.Any(), cdnID, cdnur).Return(&testCDN, nil)
 
 		config.Args = append(config.Args, cdnID)
 		config.Doit.Set(config.NS, doctl.ArgCDNDomain, "")
@@ -250,7 +251,7 @@ func TestCDNsUpdate_NothingToUpdateFail(t *testing.T) {
 
 func TestCDNsDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.cdns.EXPECT().Delete(cdnID).Return(nil)
+		tm.cdns.EXPECT().Delete(gomock.Any(), cdnID).Return(nil)
 
 		config.Args = append(config.Args, cdnID)
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -270,7 +271,7 @@ func TestCDNsDelete_RequiredArguments(t *testing.T) {
 func TestCDNsFlushCache(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		flushReq := &godo.CDNFlushCacheRequest{Files: []string{"*"}}
-		tm.cdns.EXPECT().FlushCache(cdnID, flushReq).Return(nil)
+		tm.cdns.EXPECT().FlushCache(gomock.Any(), cdnID, flushReq).Return(nil)
 
 		config.Args = append(config.Args, cdnID)
 		config.Doit.Set(config.NS, doctl.ArgCDNFiles, []string{"*"})
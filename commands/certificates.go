@@ -69,10 +69,13 @@ func RunCertificateGet(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	cID := c.Args[0]
+	cID, err := certificateResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	cs := c.Certificates()
-	cer, err := cs.Get(cID)
+	cer, err := cs.Get(c.Ctx, cID)
 	if err != nil {
 		return err
 	}
@@ -147,7 +150,7 @@ func RunCertificateCreate(c *CmdConfig) error {
 	}
 
 	cs := c.Certificates()
-	cer, err := cs.Create(r)
+	cer, err := cs.Create(c.Ctx, r)
 	if err != nil {
 		return err
 	}
@@ -159,7 +162,7 @@ func RunCertificateCreate(c *CmdConfig) error {
 // RunCertificateList lists certificates.
 func RunCertificateList(c *CmdConfig) error {
 	cs := c.Certificates()
-	list, err := cs.List()
+	list, err := cs.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -173,7 +176,10 @@ func RunCertificateDelete(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	cID := c.Args[0]
+	cID, err := certificateResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	force, err := c.Doit.GetBool(c.NS, doctl.ArgForce)
 	if err != nil {
@@ -182,7 +188,7 @@ func RunCertificateDelete(c *CmdConfig) error {
 
 	if force || AskForConfirm("delete this certificate") == nil {
 		cs := c.Certificates()
-		if err := cs.Delete(cID); err != nil {
+		if err := cs.Delete(c.Ctx, cID); err != nil {
 			return err
 		}
 	} else {
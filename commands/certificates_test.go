@@ -10,6 +10,7 @@ import (
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,7 +44,7 @@ func TestCertificateGetNoID(t *testing.T) {
 func TestCertificateGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		cID := "892071a0-bb95-49bc-8021-3afd67a210bf"
-		tm.certificates.EXPECT().Get(cID).Return(&testCertificate, nil)
+		tm.certificates.EXPECT().Get(gomock.Any(), cID).Return(&testCertificate, nil)
 
 		config.Args = append(config.Args, cID)
 
@@ -126,7 +127,7 @@ func TestCertificatesCreate(t *testing.T) {
 					defer os.Remove(test.certChainPath)
 				}
 
-				tm.certificates.EXPECT().Create(&test.certificate).Return(&testCertificate, nil)
+				tm.certificates.EXPECT().Create(gomock.Any(), &test.certificate).Return(&testCertificate, nil)
 
 				config.Doit.Set(config.NS, doctl.ArgCertificateName, test.certName)
 
@@ -159,7 +160,7 @@ func TestCertificatesCreate(t *testing.T) {
 
 func TestCertificateList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.certificates.EXPECT().List().Return(testCertificateList, nil)
+		tm.certificates.EXPECT().List(gomock.Any()).Return(testCertificateList, nil)
 
 		err := RunCertificateList(config)
 		assert.NoError(t, err)
@@ -169,7 +170,7 @@ func TestCertificateList(t *testing.T) {
 func TestCertificateDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		cID := "892071a0-bb95-49bc-8021-3afd67a210bf"
-		tm.certificates.EXPECT().Delete(cID).Return(nil)
+		tm.certificates.EXPECT().Delete(gomock.Any(), cID).Return(nil)
 
 		config.Args = append(config.Args, cID)
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -30,6 +30,9 @@ type Command struct {
 
 	fmtCols []string
 
+	// runner runs the command, it is nil for commands grouping others.
+	runner CmdRunner
+
 	childCommands []*Command
 }
 
@@ -57,7 +60,11 @@ func cmdBuilderWithInit(parent *Command, cr CmdRunner, cliText, desc string, out
 		Short: desc,
 		Long:  desc,
 		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := newCmdContext()
+			defer cancel()
+
 			c, err := NewCmdConfig(
+				ctx,
 				cmdNS(cmd),
 				&doctl.LiveConfig{},
 				out,
@@ -66,12 +73,14 @@ func cmdBuilderWithInit(parent *Command, cr CmdRunner, cliText, desc string, out
 			)
 			checkErr(err)
 
+			c.Ctx = runContext(c)
+
 			err = cr(c)
-			checkErr(err)
+			checkErr(addHint(c, cmdContextErr(ctx, err)))
 		},
 	}
 
-	c := &Command{Command: cc}
+	c := &Command{Command: cc, runner: cr}
 
 	if parent != nil {
 		parent.AddCommand(c)
@@ -88,6 +97,16 @@ func cmdBuilderWithInit(parent *Command, cr CmdRunner, cliText, desc string, out
 		AddBoolFlag(c, doctl.ArgNoHeader, "", false, "hide headers")
 	}
 
+	if c.Name() == "list" && initCmd {
+		AddIntFlag(c, doctl.ArgLimit, "", 0, "maximum number of items to fetch (0 fetches all)")
+		AddIntFlag(c, doctl.ArgPerPage, "", 200, "number of items to fetch per API request, up to 200")
+	}
+
+	if c.fmtCols != nil && strings.HasPrefix(c.Name(), "list") {
+		AddStringFlag(c, doctl.ArgFilter, "", "", "only show items matching a comma separated list of conditions, e.g. status=active,region=nyc1,name~web-*")
+		AddStringFlag(c, doctl.ArgSortBy, "", "", "sort items by a comma separated list of keys, prefix a key with - to sort in descending order, e.g. memory,-created")
+	}
+
 	return c
 
 }
@@ -14,8 +14,12 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
@@ -30,6 +34,10 @@ type CmdConfig struct {
 	Out  io.Writer
 	Args []string
 
+	// Ctx is cancelled when the command times out or is interrupted. It
+	// should be passed to every API call made on behalf of the command.
+	Ctx context.Context
+
 	initServices          func(*CmdConfig) error
 	getContextAccessToken func() string
 	setContextAccessToken func(string)
@@ -62,13 +70,14 @@ type CmdConfig struct {
 }
 
 // NewCmdConfig creates an instance of a CmdConfig.
-func NewCmdConfig(ns string, dc doctl.Config, out io.Writer, args []string, initGodo bool) (*CmdConfig, error) {
+func NewCmdConfig(ctx context.Context, ns string, dc doctl.Config, out io.Writer, args []string, initGodo bool) (*CmdConfig, error) {
 
 	cmdConfig := &CmdConfig{
 		NS:   ns,
 		Doit: dc,
 		Out:  out,
 		Args: args,
+		Ctx:  ctx,
 
 		initServices: func(c *CmdConfig) error {
 			accessToken := c.getContextAccessToken()
@@ -77,10 +86,13 @@ func NewCmdConfig(ns string, dc doctl.Config, out io.Writer, args []string, init
 				return fmt.Errorf("unable to initialize DigitalOcean api client: %s", err)
 			}
 
+			// Catalogs which rarely change are cached.
+			cache := newCache(catalogCacheTTL())
+
 			c.Keys = func() do.KeysService { return do.NewKeysService(godoClient) }
-			c.Sizes = func() do.SizesService { return do.NewSizesService(godoClient) }
-			c.Regions = func() do.RegionsService { return do.NewRegionsService(godoClient) }
-			c.Images = func() do.ImagesService { return do.NewImagesService(godoClient) }
+			c.Sizes = func() do.SizesService { return do.NewCachedSizesService(do.NewSizesService(godoClient), cache) }
+			c.Regions = func() do.RegionsService { return do.NewCachedRegionsService(do.NewRegionsService(godoClient), cache) }
+			c.Images = func() do.ImagesService { return do.NewCachedImagesService(do.NewImagesService(godoClient), cache) }
 			c.ImageActions = func() do.ImageActionsService { return do.NewImageActionsService(godoClient) }
 			c.FloatingIPs = func() do.FloatingIPsService { return do.NewFloatingIPsService(godoClient) }
 			c.FloatingIPActions = func() do.FloatingIPActionsService { return do.NewFloatingIPActionsService(godoClient) }
@@ -98,7 +110,9 @@ func NewCmdConfig(ns string, dc doctl.Config, out io.Writer, args []string, init
 			c.Firewalls = func() do.FirewallsService { return do.NewFirewallsService(godoClient) }
 			c.CDNs = func() do.CDNsService { return do.NewCDNsService(godoClient) }
 			c.Projects = func() do.ProjectsService { return do.NewProjectsService(godoClient) }
-			c.Kubernetes = func() do.KubernetesService { return do.NewKubernetesService(godoClient) }
+			c.Kubernetes = func() do.KubernetesService {
+				return do.NewCachedKubernetesService(do.NewKubernetesService(godoClient), cache)
+			}
 			c.Databases = func() do.DatabasesService { return do.NewDatabasesService(godoClient) }
 			c.Registry = func() do.RegistryService { return do.NewRegistryService(godoClient) }
 
@@ -151,29 +165,170 @@ func NewCmdConfig(ns string, dc doctl.Config, out io.Writer, args []string, init
 	return cmdConfig, nil
 }
 
+// newCmdContext returns the context a command runs under. It is cancelled
+// once the global timeout elapses or the process receives SIGINT or SIGTERM.
+// A second signal is left to the default handler so the process can still be
+// killed if the command does not stop promptly.
+func newCmdContext() (context.Context, context.CancelFunc) {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if timeout := viper.GetDuration(doctl.ArgTimeout); timeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-sigs:
+			signal.Stop(sigs)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(sigs)
+		cancel()
+	}
+}
+
+// runContext returns the context c runs with, recording the requests a dry
+// run intercepts and printing them to c.Out, and set up by the --limit and
+// --per-page flags of list commands.
+func runContext(c *CmdConfig) context.Context {
+	ctx := doctl.WithDryRunRecord(c.Ctx, c.Out)
+	if limit, err := c.Doit.GetInt(c.NS, doctl.ArgLimit); err == nil && limit > 0 {
+		ctx = do.WithListLimit(ctx, limit)
+	}
+	if size, err := c.Doit.GetInt(c.NS, doctl.ArgPerPage); err == nil && size > 0 {
+		ctx = do.WithPageSize(ctx, size)
+	}
+	return ctx
+}
+
+// cmdContextErr replaces err with a descriptive error when it was caused by
+// the command's context timing out or being interrupted.
+func cmdContextErr(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return &errTimeout{timeout: viper.GetDuration(doctl.ArgTimeout)}
+	case context.Canceled:
+		return fmt.Errorf("command was interrupted")
+	}
+
+	return err
+}
+
 // CmdRunner runs a command and passes in a cmdConfig.
 type CmdRunner func(*CmdConfig) error
 
+// dryRun reports whether mutating API requests are printed instead of
+// sent, in which case commands must not change local state either.
+func dryRun() bool {
+	return viper.GetBool(doctl.ArgDryRun)
+}
+
 // Display displays the output from a command.
 func (c *CmdConfig) Display(d displayers.Displayable) error {
-	dc := &displayers.Displayer{
-		Item: d,
-		Out:  c.Out,
+	if doctl.DryRunIntercepted(c.Ctx) {
+		// The items are synthetic, the intercepted requests are the output.
+		return nil
 	}
 
-	columnList, err := c.Doit.GetString(c.NS, doctl.ArgFormat)
+	dc, err := c.displayer(d)
 	if err != nil {
 		return err
 	}
 
+	return dc.Display()
+}
+
+// displayer returns a Displayer of d set up by the output flags.
+func (c *CmdConfig) displayer(d displayers.Displayable) (*displayers.Displayer, error) {
+	columnList, err := c.Doit.GetString(c.NS, doctl.ArgFormat)
+	if err != nil {
+		return nil, err
+	}
+
 	withHeaders, err := c.Doit.GetBool(c.NS, doctl.ArgNoHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := c.Doit.GetString(c.NS, doctl.ArgFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	sortBy, err := c.Doit.GetString(c.NS, doctl.ArgSortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &displayers.Displayer{
+		Item:       d,
+		Out:        c.Out,
+		NoHeaders:  withHeaders,
+		ColumnList: columnList,
+		OutputType: Output,
+		Template:   Template,
+		Query:      Query,
+		Filter:     filter,
+		SortBy:     sortBy,
+	}, nil
+}
+
+// pagedDisplay displays a list page by page as the pages arrive, when the
+// output allows it, and all at once otherwise.
+type pagedDisplay struct {
+	c      *CmdConfig
+	stream bool
+	pages  int
+}
+
+func newPagedDisplay(c *CmdConfig) (*pagedDisplay, error) {
+	dc, err := c.displayer(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagedDisplay{c: c, stream: dc.Streamable()}, nil
+}
+
+// page displays a page of the list, which must only be called when
+// streaming. Pages after the first have no headers.
+func (p *pagedDisplay) page(d displayers.Displayable) error {
+	if doctl.DryRunIntercepted(p.c.Ctx) {
+		return nil
+	}
+
+	dc, err := p.c.displayer(d)
 	if err != nil {
 		return err
 	}
 
-	dc.NoHeaders = withHeaders
-	dc.ColumnList = columnList
-	dc.OutputType = Output
+	if p.pages > 0 {
+		dc.NoHeaders = true
+	}
+	p.pages++
 
 	return dc.Display()
 }
+
+// done displays the whole list d, unless its pages were displayed already.
+func (p *pagedDisplay) done(d displayers.Displayable) error {
+	if p.stream && p.pages > 0 {
+		return nil
+	}
+	return p.c.Display(d)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/doctl"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// errGlobalFlags is returned when a command line run by batch or shell sets
+// global flags.
+type errGlobalFlags struct {
+	flags []string
+}
+
+func (e *errGlobalFlags) Error() string {
+	return fmt.Sprintf("global flags can't be set on a command line: %s", strings.Join(e.flags, ", "))
+}
+
+// parseCommandLine finds the command of a command line, the leading doctl
+// being optional, and parses its flags. It returns the command's runner and
+// a copy of c to run it with.
+//
+// The flags are read from a copy of the configuration taken once they are
+// parsed, and then reset, so the next line starts from their defaults and
+// lines can run concurrently.
+func parseCommandLine(c *CmdConfig, words []string) (CmdRunner, *CmdConfig, error) {
+	if len(words) > 0 && words[0] == DoitCmd.Name() {
+		words = words[1:]
+	}
+
+	cc, args, err := DoitCmd.Find(words)
+	if err != nil {
+		return nil, nil, &errUsage{err: err}
+	}
+
+	cmd := findCommand(DoitCmd, cc)
+	switch {
+	case cmd == nil || cmd.runner == nil:
+		return nil, nil, &errUsage{err: fmt.Errorf("%q is not a command", strings.Join(words, " "))}
+	case cc.Name() == "batch" || cc.Name() == "shell":
+		return nil, nil, &errUsage{err: fmt.Errorf("%s cannot be run from a batch or shell", cc.Name())}
+	}
+
+	var pluginArgs []string
+	if cc.DisableFlagParsing {
+		// Plugins parse their own flags, only the global flags before their
+		// name are doctl's.
+		args, pluginArgs = splitPluginArgs(words, cc.Name())
+	}
+
+	if err := parseLineFlags(cc, args); err != nil {
+		if _, ok := err.(*errGlobalFlags); ok {
+			return nil, nil, err
+		}
+		return nil, nil, &errUsage{err: err}
+	}
+	defer resetFlags(cc.NonInheritedFlags())
+
+	args = cc.Flags().Args()
+	if cc.DisableFlagParsing {
+		args = pluginArgs
+	}
+	if err := cc.ValidateArgs(args); err != nil {
+		return nil, nil, &errUsage{err: err}
+	}
+
+	config := *c
+	config.NS = cmdNS(cc)
+	config.Doit = &doctl.LiveConfig{Viper: snapshotViper(config.NS), Args: words}
+	config.Args = args
+	config.Ctx = runContext(&config)
+
+	return cmd.runner, &config, nil
+}
+
+// parseLineFlags parses the flags of a command line. Global flags are set
+// for the whole batch or shell, so lines setting one are rejected and their
+// values are left untouched.
+func parseLineFlags(cmd *cobra.Command, args []string) error {
+	type value struct {
+		value   string
+		changed bool
+	}
+	global := map[*pflag.Flag]value{}
+	DoitCmd.PersistentFlags().VisitAll(func(f *pflag.Flag) {
+		global[f] = value{value: f.Value.String(), changed: f.Changed}
+		f.Changed = false
+	})
+
+	var err error
+	if cmd.DisableFlagParsing {
+		err = DoitCmd.PersistentFlags().Parse(args)
+	} else {
+		err = cmd.ParseFlags(args)
+	}
+
+	var set []string
+	for f, v := range global {
+		if f.Changed {
+			set = append(set, "--"+f.Name)
+		}
+		f.Value.Set(v.value)
+		f.Changed = v.changed
+	}
+
+	switch {
+	case err != nil:
+		return err
+	case len(set) > 0:
+		return &errGlobalFlags{flags: sortedStrings(set)}
+	}
+
+	return nil
+}
+
+// resetFlags sets flags back to their defaults. Slice flags are given new
+// values since theirs append to what was set once set.
+func resetFlags(flags *pflag.FlagSet) {
+	flags.VisitAll(func(f *pflag.Flag) {
+		f.Changed = false
+
+		if f.Value.Type() == "stringSlice" {
+			var def []string
+			if s := strings.Trim(f.DefValue, "[]"); s != "" {
+				def = strings.Split(s, ",")
+			}
+			fs := pflag.NewFlagSet(f.Name, pflag.ContinueOnError)
+			fs.StringSlice(f.Name, def, "")
+			f.Value = fs.Lookup(f.Name).Value
+			return
+		}
+
+		f.Value.Set(f.DefValue)
+	})
+}
+
+// snapshotViper copies the settings of the commands of namespace ns, flags
+// included, from the global configuration to a new viper instance.
+func snapshotViper(ns string) *viper.Viper {
+	v := viper.New()
+	for _, key := range viper.AllKeys() {
+		if strings.HasPrefix(key, ns+".") || strings.HasPrefix(key, "required."+ns+".") {
+			v.Set(key, viper.Get(key))
+		}
+	}
+	return v
+}
+
+// findCommand returns the Command of cc under parent.
+func findCommand(parent *Command, cc *cobra.Command) *Command {
+	if parent.Command == cc {
+		return parent
+	}
+
+	for _, child := range parent.childCommands {
+		if c := findCommand(child, cc); c != nil {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// splitCommandLine splits a command line in words like a shell would,
+// honoring single and double quotes and backslash escapes.
+func splitCommandLine(line string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", line)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
@@ -0,0 +1,17 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCommandLine(t *testing.T) {
+	words, err := splitCommandLine(`compute droplet create "db 01" --user-data 'echo "hi"' a\ b`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"compute", "droplet", "create", "db 01", "--user-data", `echo "hi"`, "a b"}, words)
+
+	_, err = splitCommandLine(`compute droplet create "db 01`)
+	assert.Error(t, err)
+}
@@ -14,6 +14,7 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"io/ioutil"
 	"sort"
 	"testing"
@@ -207,6 +208,7 @@ func withTestClient(t *testing.T, tFn testFn) {
 		NS:   "test",
 		Doit: doctl.NewTestConfig(),
 		Out:  ioutil.Discard,
+		Ctx:  context.Background(),
 
 		// can stub this out, since the return is dictated by the mocks.
 		initServices: func(c *CmdConfig) error { return nil },
@@ -0,0 +1,433 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/doctl"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Directives telling completion scripts what to do with the completions,
+// as cobra's __complete command does.
+const (
+	compDirectiveDefault    = 0
+	compDirectiveError      = 1
+	compDirectiveNoSpace    = 2
+	compDirectiveNoFileComp = 4
+)
+
+const (
+	// completionTimeout bounds the requests listing resources to complete
+	// their names.
+	completionTimeout = 5 * time.Second
+	// completionCacheTTL is how long the resources listed by __complete are
+	// cached, so pressing tab again doesn't wait for the API.
+	completionCacheTTL = time.Minute
+)
+
+// completion is a word completing a command line.
+type completion struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// argCompletions maps the placeholders of arguments in the usage of
+// commands, such as <volume-id>, to the kind of values completing them.
+var argCompletions = map[string]string{
+	"droplet-id":              "droplet-id",
+	"droplet_id":              "droplet-id",
+	"droplet-id|droplet-name": "droplet",
+	"droplet-id|host":         "droplet",
+	"volume-id":               "volume-id",
+	"database-id":             "database-id",
+	"cluster-id":              "cluster-id",
+	"cluster-id|cluster-name": "cluster",
+	"domain":                  "domain",
+	"image-id|image-slug":     "image",
+}
+
+// flagCompletions maps flags to the kind of values completing them, for
+// the commands under a path. Sizes are only those of droplets.
+var flagCompletions = []struct {
+	flag   string
+	kind   string
+	prefix []string
+}{
+	{doctl.ArgRegionSlug, "region", nil},
+	{doctl.ArgSizeSlug, "size", []string{"doctl compute droplet ", "doctl compute droplet-action ", "doctl kubernetes "}},
+	{doctl.ArgImage, "image", nil},
+	{doctl.ArgContext, "context", nil},
+	{doctl.ArgOutput, "output", nil},
+}
+
+// Complete creates the hidden __complete command completion scripts run.
+func Complete() *Command {
+	cmd := cmdBuilderWithInit(nil, RunComplete, "__complete", "complete a command line", Writer, false, hiddenCmd())
+	// The words to complete are arguments, flags included.
+	cmd.DisableFlagParsing = true
+
+	return cmd
+}
+
+// RunComplete prints the completions of its last argument, following the
+// others, as cobra's __complete does: one per line, followed by a tab and
+// their description if any, and then a line holding the directive.
+func RunComplete(c *CmdConfig) error {
+	words, current := c.Args, ""
+	if len(words) > 0 {
+		words, current = words[:len(words)-1], words[len(words)-1]
+	}
+	// Windows PowerShell drops empty arguments, so "" stands for one.
+	if current == `""` {
+		current = ""
+	}
+
+	// The global flags of the command line, such as --context, apply.
+	if cc, args, err := DoitCmd.Find(words); err == nil {
+		cc.ParseFlags(args)
+		initConfig()
+	}
+
+	list := func(kind string) []completion {
+		var comps []completion
+		if !completionNeedsAPI(kind) {
+			comps, _ := listCompletions(c.Ctx, c, kind)
+			return comps
+		}
+
+		cache := newCache(completionCacheTTL)
+		name := "completion-" + kind
+		if _, ok := cache.Read(name, completionCacheTTL, &comps); ok {
+			return comps
+		}
+
+		if c.Droplets == nil {
+			if err := c.initServices(c); err != nil {
+				return nil
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(c.Ctx, completionTimeout)
+		defer cancel()
+
+		comps, err := listCompletions(ctx, c, kind)
+		if err != nil {
+			return nil
+		}
+		cache.Write(name, comps)
+
+		return comps
+	}
+
+	comps, directive := completeCommandLine(words, current, list)
+	for _, comp := range comps {
+		if comp.Description != "" {
+			fmt.Fprintf(c.Out, "%s\t%s\n", comp.Value, comp.Description)
+		} else {
+			fmt.Fprintln(c.Out, comp.Value)
+		}
+	}
+	fmt.Fprintf(c.Out, ":%d\n", directive)
+
+	return nil
+}
+
+// completeCommandLine completes current following the words of a command
+// line, the leading doctl excluded: with the subcommands or flags of the
+// command, or with the values its arguments and flags take. list lists the
+// values of a kind. It returns the completions starting with current and
+// the directive for completion scripts.
+func completeCommandLine(words []string, current string, list func(kind string) []completion) ([]completion, int) {
+	cc, rest, err := DoitCmd.Find(words)
+	if err != nil {
+		return nil, compDirectiveError
+	}
+	if _, ok := cc.Annotations[pluginAnnotation]; ok {
+		// Plugins complete their own arguments, if at all.
+		return nil, compDirectiveDefault
+	}
+
+	// InheritedFlags adds the flags of the parents to Flags.
+	cc.InheritedFlags()
+	flags := cc.Flags()
+
+	if i := strings.Index(current, "="); strings.HasPrefix(current, "--") && i > 0 {
+		f := flags.Lookup(current[2:i])
+		if f == nil {
+			return nil, compDirectiveNoFileComp
+		}
+
+		kind := flagCompletionKind(cc, f)
+		if kind == "" {
+			return nil, compDirectiveDefault
+		}
+
+		var comps []completion
+		for _, comp := range list(kind) {
+			comp.Value = current[:i+1] + comp.Value
+			comps = append(comps, comp)
+		}
+		return filterCompletions(comps, current), compDirectiveNoFileComp
+	}
+
+	if strings.HasPrefix(current, "-") {
+		var comps []completion
+		flags.VisitAll(func(f *pflag.Flag) {
+			if !f.Hidden {
+				comps = append(comps, completion{Value: "--" + f.Name, Description: f.Usage})
+			}
+		})
+		return filterCompletions(comps, current), compDirectiveNoFileComp
+	}
+
+	args, pending := positionalArgs(flags, rest)
+	if pending != nil {
+		kind := flagCompletionKind(cc, pending)
+		if kind == "" {
+			return nil, compDirectiveDefault
+		}
+		return filterCompletions(list(kind), current), compDirectiveNoFileComp
+	}
+
+	if cc.HasAvailableSubCommands() && len(args) == 0 {
+		var comps []completion
+		for _, sub := range cc.Commands() {
+			if sub.IsAvailableCommand() {
+				comps = append(comps, completion{Value: sub.Name(), Description: sub.Short})
+			}
+		}
+		return filterCompletions(comps, current), compDirectiveNoFileComp
+	}
+
+	kind := argCompletionKind(cc, len(args))
+	if kind == "" {
+		return nil, compDirectiveDefault
+	}
+	return filterCompletions(list(kind), current), compDirectiveNoFileComp
+}
+
+// positionalArgs returns the arguments among the words following a
+// command, and the flag the last word leaves waiting for its value, if any.
+func positionalArgs(flags *pflag.FlagSet, words []string) ([]string, *pflag.Flag) {
+	var args []string
+
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		switch {
+		case w == "--":
+			return append(args, words[i+1:]...), nil
+		case !strings.HasPrefix(w, "-") || w == "-":
+			args = append(args, w)
+			continue
+		case strings.Contains(w, "="):
+			continue
+		}
+
+		f := flags.Lookup(strings.TrimLeft(w, "-"))
+		if !strings.HasPrefix(w, "--") && len(w) == 2 {
+			f = flags.ShorthandLookup(w[1:])
+		}
+		if f == nil || f.Value.Type() == "bool" {
+			continue
+		}
+
+		if i == len(words)-1 {
+			return args, f
+		}
+		// Skip the flag's value.
+		i++
+	}
+
+	return args, nil
+}
+
+// argCompletionKind returns the kind of values completing the argument at
+// index of cmd, as told by its placeholder in the usage of cmd. The last
+// placeholder stands for the following arguments when it ends with ....
+func argCompletionKind(cmd *cobra.Command, index int) string {
+	var placeholders []string
+	repeat := false
+	for _, w := range strings.Fields(cmd.Use)[1:] {
+		if !strings.HasPrefix(w, "<") {
+			break
+		}
+		repeat = strings.HasSuffix(w, "...")
+		placeholders = append(placeholders, strings.Trim(w, "<>."))
+	}
+
+	switch {
+	case index < len(placeholders):
+	case repeat:
+		index = len(placeholders) - 1
+	default:
+		return ""
+	}
+
+	p := placeholders[index]
+	if p == "id|name" && strings.HasPrefix(cmd.CommandPath(), "doctl kubernetes cluster ") {
+		return "cluster"
+	}
+
+	return argCompletions[p]
+}
+
+// flagCompletionKind returns the kind of values completing the flag f of
+// cmd.
+func flagCompletionKind(cmd *cobra.Command, f *pflag.Flag) string {
+	for _, fc := range flagCompletions {
+		if fc.flag != f.Name {
+			continue
+		}
+		if fc.prefix == nil {
+			return fc.kind
+		}
+		for _, p := range fc.prefix {
+			if strings.HasPrefix(cmd.CommandPath()+" ", p) {
+				return fc.kind
+			}
+		}
+	}
+
+	return ""
+}
+
+func filterCompletions(comps []completion, current string) []completion {
+	var filtered []completion
+	for _, comp := range comps {
+		if strings.HasPrefix(comp.Value, current) {
+			filtered = append(filtered, comp)
+		}
+	}
+	return filtered
+}
+
+// completionNeedsAPI reports whether the values of a kind are listed from
+// the API.
+func completionNeedsAPI(kind string) bool {
+	return kind != "context" && kind != "output"
+}
+
+// listCompletions lists the values of a kind.
+func listCompletions(ctx context.Context, c *CmdConfig, kind string) ([]completion, error) {
+	var comps []completion
+
+	switch kind {
+	case "context":
+		comps = append(comps, completion{Value: doctl.ArgDefaultContext})
+		var names []string
+		for name := range viper.GetStringMap("auth-contexts") {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			comps = append(comps, completion{Value: name})
+		}
+	case "output":
+		for _, o := range shellOutputs {
+			comps = append(comps, completion{Value: o})
+		}
+	case "droplet", "droplet-id":
+		list, err := c.Droplets().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range list {
+			id := strconv.Itoa(d.ID)
+			if kind == "droplet" {
+				comps = append(comps, completion{Value: d.Name, Description: id})
+			} else {
+				comps = append(comps, completion{Value: id, Description: d.Name})
+			}
+		}
+	case "cluster", "cluster-id":
+		list, err := c.Kubernetes().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range list {
+			if kind == "cluster" {
+				comps = append(comps, completion{Value: k.Name, Description: k.ID})
+			} else {
+				comps = append(comps, completion{Value: k.ID, Description: k.Name})
+			}
+		}
+	case "volume-id":
+		list, err := c.Volumes().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range list {
+			comps = append(comps, completion{Value: v.ID, Description: v.Name})
+		}
+	case "database-id":
+		list, err := c.Databases().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range list {
+			comps = append(comps, completion{Value: d.ID, Description: d.Name})
+		}
+	case "domain":
+		list, err := c.Domains().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range list {
+			comps = append(comps, completion{Value: d.Name})
+		}
+	case "region":
+		list, err := c.Regions().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range list {
+			if r.Available {
+				comps = append(comps, completion{Value: r.Slug, Description: r.Name})
+			}
+		}
+	case "size":
+		list, err := c.Sizes().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range list {
+			if s.Available {
+				comps = append(comps, completion{Value: s.Slug, Description: fmt.Sprintf("%d vCPUs, %d MB", s.Vcpus, s.Memory)})
+			}
+		}
+	case "image":
+		list, err := c.Images().List(ctx, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range list {
+			if i.Slug != "" {
+				comps = append(comps, completion{Value: i.Slug, Description: strings.TrimSpace(i.Distribution + " " + i.Name)})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown completion kind %q", kind)
+	}
+
+	return comps, nil
+}
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withCacheDir(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "doctl-cache")
+	require.NoError(t, err)
+
+	cacheDir = func() string { return dir }
+	return func() {
+		cacheDir = defaultCacheDir
+		os.RemoveAll(dir)
+	}
+}
+
+func TestCompleteCommandLine(t *testing.T) {
+	var kinds []string
+	list := func(kind string) []completion {
+		kinds = append(kinds, kind)
+		return []completion{{Value: kind + "-1"}, {Value: kind + "-2"}}
+	}
+
+	tests := []struct {
+		words     []string
+		current   string
+		want      []string
+		kind      string
+		directive int
+	}{
+		{words: nil, current: "compu", want: []string{"compute"}, directive: compDirectiveNoFileComp},
+		{words: []string{"compute"}, current: "dro", want: []string{"droplet", "droplet-action"}, directive: compDirectiveNoFileComp},
+		{words: []string{"compute", "droplet", "list"}, current: "--tag", want: []string{"--tag-name"}, directive: compDirectiveNoFileComp},
+		{words: []string{"compute", "droplet", "create", "--region"}, current: "", want: []string{"region-1", "region-2"}, kind: "region", directive: compDirectiveNoFileComp},
+		{words: []string{"compute", "droplet", "create"}, current: "--size=size-2", want: []string{"--size=size-2"}, kind: "size", directive: compDirectiveNoFileComp},
+		{words: []string{"compute", "volume", "get"}, current: "", want: []string{"volume-id-1", "volume-id-2"}, kind: "volume-id", directive: compDirectiveNoFileComp},
+		{words: []string{"compute", "droplet", "delete", "a", "--force"}, current: "droplet-1", want: []string{"droplet-1"}, kind: "droplet", directive: compDirectiveNoFileComp},
+		{words: []string{"kubernetes", "cluster", "get"}, current: "", want: []string{"cluster-1", "cluster-2"}, kind: "cluster", directive: compDirectiveNoFileComp},
+		{words: []string{"compute", "domain", "get"}, current: "", want: []string{"domain-1", "domain-2"}, kind: "domain", directive: compDirectiveNoFileComp},
+		{words: []string{"compute", "droplet", "create"}, current: "", directive: compDirectiveDefault},
+		{words: []string{"compute", "volume", "get", "a"}, current: "", directive: compDirectiveDefault},
+	}
+
+	for _, tt := range tests {
+		kinds = nil
+		comps, directive := completeCommandLine(tt.words, tt.current, list)
+
+		var got []string
+		for _, c := range comps {
+			got = append(got, c.Value)
+		}
+		assert.Equal(t, tt.want, got, "%v %q", tt.words, tt.current)
+		assert.Equal(t, tt.directive, directive, "%v %q", tt.words, tt.current)
+		if tt.kind != "" {
+			assert.Equal(t, []string{tt.kind}, kinds, "%v %q", tt.words, tt.current)
+		} else {
+			assert.Empty(t, kinds, "%v %q", tt.words, tt.current)
+		}
+	}
+}
+
+func TestPositionalArgs(t *testing.T) {
+	cmd, _, err := DoitCmd.Find([]string{"compute", "droplet", "create"})
+	require.NoError(t, err)
+	cmd.InheritedFlags()
+	flags := cmd.Flags()
+
+	args, pending := positionalArgs(flags, []string{"web", "--region", "nyc1", "--wait", "db", "--size=s-1vcpu-1gb"})
+	assert.Equal(t, []string{"web", "db"}, args)
+	assert.Nil(t, pending)
+
+	args, pending = positionalArgs(flags, []string{"web", "--image"})
+	assert.Equal(t, []string{"web"}, args)
+	require.NotNil(t, pending)
+	assert.Equal(t, "image", pending.Name)
+
+	args, pending = positionalArgs(flags, []string{"web", "--", "--image"})
+	assert.Equal(t, []string{"web", "--image"}, args)
+	assert.Nil(t, pending)
+}
+
+func TestRunComplete(t *testing.T) {
+	defer withCacheDir(t)()
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.EXPECT().List(gomock.Any()).Return(do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Name: "web-01"}},
+			{Droplet: &godo.Droplet{ID: 2, Name: "db-01"}},
+		}, nil)
+
+		var out bytes.Buffer
+		config.Out = &out
+		config.Args = []string{"compute", "ssh", "w"}
+		require.NoError(t, RunComplete(config))
+		assert.Equal(t, "web-01\t1\n:4\n", out.String())
+
+		// The droplets are cached, so they're listed once.
+		out.Reset()
+		config.Args = []string{"compute", "ssh", `""`}
+		require.NoError(t, RunComplete(config))
+		assert.Equal(t, "web-01\t1\ndb-01\t2\n:4\n", out.String())
+	})
+}
@@ -19,11 +19,12 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const (
 	completionLong = `
-completion is used to output completion code for bash and zsh shells.
+completion is used to output completion code for bash, zsh, fish and PowerShell.
 
 Before using completion features, you have to source completion code
 from your .profile or .bashrc/.zshrc file. This is done by adding
@@ -35,6 +36,16 @@ Bash users can as well save it to the file and copy it to:
 
 Correct arguments for SHELL are: "bash" and "zsh".
 
+fish users can save the fish completion code to:
+	~/.config/fish/completions/doctl.fish
+
+PowerShell users can load it from their profile by adding:
+	doctl completion powershell | Out-String | Invoke-Expression
+
+Besides commands and flags, the names and IDs of droplets, Kubernetes
+clusters, volumes, domains and databases are completed, as well as regions,
+sizes and image slugs. They are listed from the API and cached for a minute.
+
 Notes:
 1) zsh completions requires zsh 5.2 or newer.
 	
@@ -72,6 +83,8 @@ func Completion() *Command {
 
 	cmdBuilderWithInit(cmd, RunCompletionBash, "bash", "generate bash completion code", Writer, false)
 	cmdBuilderWithInit(cmd, RunCompletionZsh, "zsh", "generate zsh completion code", Writer, false)
+	cmdBuilderWithInit(cmd, RunCompletionFish, "fish", "generate fish completion code", Writer, false)
+	cmdBuilderWithInit(cmd, RunCompletionPowerShell, "powershell", "generate PowerShell completion code", Writer, false)
 
 	return cmd
 }
@@ -85,6 +98,7 @@ func RunCompletionBash(c *CmdConfig) error {
 		return fmt.Errorf("error while generating bash completion: %v", err)
 	}
 
+	addBashDynamicCompletion(DoitCmd)
 	err = DoitCmd.GenBashCompletion(&buf)
 	if err != nil {
 		return fmt.Errorf("error while generating bash completion: %v", err)
@@ -276,6 +290,7 @@ _complete doctl 2>/dev/null
 		return fmt.Errorf("error while generating zsh completion: %v", err)
 	}
 
+	addBashDynamicCompletion(DoitCmd)
 	err = DoitCmd.GenBashCompletion(&buf)
 	if err != nil {
 		return fmt.Errorf("error wheil generating zsh completion: %v", err)
@@ -293,3 +308,129 @@ _complete doctl 2>/dev/null
 	fmt.Print(code)
 	return nil
 }
+
+// bashDynamicCompletion completes the arguments and flag values the bash
+// completion code has no candidates for with doctl __complete.
+const bashDynamicCompletion = `__doctl_complete_dynamic()
+{
+    local args out
+    args=("${words[@]:1:$((cword-1))}")
+    # --flag=value is completed as --flag value
+    if [[ ${words[cword]} == --*=* ]]; then
+        args+=("${words[cword]%%=*}")
+    fi
+    out=$("${words[0]}" __complete "${args[@]}" "${cur}" 2>/dev/null) || return
+    COMPREPLY=( $(compgen -W "$(printf '%s\n' "${out}" | sed '$d' | cut -f1)" -- "${cur}") )
+}
+
+__custom_func()
+{
+    __doctl_complete_dynamic
+}
+`
+
+// addBashDynamicCompletion makes the bash completion code of root complete
+// arguments and flag values with doctl __complete.
+func addBashDynamicCompletion(root *Command) {
+	root.BashCompletionFunction = bashDynamicCompletion
+
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		cmd.Flags().VisitAll(func(f *pflag.Flag) {
+			if flagCompletionKind(cmd, f) != "" {
+				cmd.Flags().SetAnnotation(f.Name, cobra.BashCompCustom, []string{"__doctl_complete_dynamic"})
+			}
+		})
+		for _, sub := range cmd.Commands() {
+			walk(sub)
+		}
+	}
+	walk(root.Command)
+}
+
+// RunCompletionFish outputs completion code for fish.
+func RunCompletionFish(c *CmdConfig) error {
+	_, err := fmt.Fprint(c.Out, doctlLicense+fishCompletion)
+	return err
+}
+
+const fishCompletion = `# fish completion for doctl, asking doctl __complete for completions.
+
+function __doctl_perform_completion
+    set -l args (commandline -opc)
+    set -l doctl $args[1]
+    set -e args[1]
+    set -l current (commandline -ct)
+
+    set -g __doctl_comp_results ($doctl __complete $args "$current" 2>/dev/null)
+    set -g __doctl_comp_directive 0
+    if test (count $__doctl_comp_results) -gt 0
+        set -g __doctl_comp_directive (string replace -r '^:' '' -- $__doctl_comp_results[-1])
+        set -e __doctl_comp_results[-1]
+    end
+end
+
+# __doctl_prepare_completions tells whether doctl offers completions. When it
+# doesn't, fish completes file names unless doctl says not to.
+function __doctl_prepare_completions
+    __doctl_perform_completion
+    if test (count $__doctl_comp_results) -gt 0
+        return 0
+    end
+    test (math "floor($__doctl_comp_directive / 4) % 2") -eq 1
+end
+
+complete -c doctl -e
+complete -c doctl -n '__doctl_prepare_completions' -f -a '$__doctl_comp_results'
+`
+
+// RunCompletionPowerShell outputs completion code for PowerShell.
+func RunCompletionPowerShell(c *CmdConfig) error {
+	_, err := fmt.Fprint(c.Out, doctlLicense+powerShellCompletion)
+	return err
+}
+
+const powerShellCompletion = `# PowerShell completion for doctl, asking doctl __complete for completions.
+
+Register-ArgumentCompleter -Native -CommandName 'doctl' -ScriptBlock {
+    param($WordToComplete, $CommandAst, $CursorPosition)
+
+    $Elements = @($CommandAst.CommandElements |
+        Where-Object { $_.Extent.StartOffset -lt $CursorPosition } |
+        ForEach-Object { $_.ToString() })
+    $Program = $Elements[0]
+    $Words = @($Elements | Select-Object -Skip 1)
+    if ($WordToComplete -ne '' -and $Words.Count -gt 0) {
+        $Words = @($Words | Select-Object -First ($Words.Count - 1))
+    }
+
+    # Windows PowerShell drops empty arguments, doctl reads "" as one.
+    $Current = $WordToComplete
+    if ($Current -eq '') {
+        $Current = '""'
+    }
+
+    $Out = @(& $Program __complete @Words $Current 2>$null)
+    if ($Out.Count -eq 0) {
+        return
+    }
+    $Directive = [int]($Out[-1].TrimStart(':'))
+    $Completions = @($Out | Select-Object -First ($Out.Count - 1))
+
+    if ($Completions.Count -eq 0) {
+        if (($Directive -band 4) -ne 0) {
+            # An empty completion keeps PowerShell from completing file names.
+            return ""
+        }
+        return
+    }
+
+    $Completions | ForEach-Object {
+        $Value, $Description = $_ -split "` + "`" + `t", 2
+        if (-not $Description) {
+            $Description = $Value
+        }
+        [System.Management.Automation.CompletionResult]::new($Value, $Value, 'ParameterValue', $Description)
+    }
+}
+`
@@ -32,6 +32,11 @@ func readUserInput(in io.Reader, message string) (string, error) {
 
 // AskForConfirm parses and verifies user input for confirmation.
 func AskForConfirm(message string) error {
+	if dryRun() {
+		// Nothing is changed in a dry run, so there is nothing to confirm.
+		return nil
+	}
+
 	answer, err := retrieveUserInput(message)
 	if err != nil {
 		return fmt.Errorf("unable to parse users input: %s", err)
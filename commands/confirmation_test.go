@@ -5,6 +5,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/digitalocean/doctl"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -51,6 +53,24 @@ func TestAskForConfirmAny(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestAskForConfirmDryRun(t *testing.T) {
+	rui := retrieveUserInput
+	defer func() {
+		retrieveUserInput = rui
+	}()
+
+	retrieveUserInput = func(string) (string, error) {
+		t.Fatal("a dry run should not ask for confirmation")
+		return "", nil
+	}
+
+	viper.Set(doctl.ArgDryRun, true)
+	defer viper.Set(doctl.ArgDryRun, false)
+
+	err := AskForConfirm("test")
+	assert.NoError(t, err)
+}
+
 func TestAskForConfirmError(t *testing.T) {
 	rui := retrieveUserInput
 	defer func() {
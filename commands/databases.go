@@ -14,6 +14,7 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -59,6 +60,7 @@ type. Use 'pg' for PostgreSQL, 'mysql' for MySQL, or 'redis' for Redis.
 	AddStringFlag(cmdDatabaseCreate, doctl.ArgDatabaseEngine, "", defaultDatabaseEngine, "database engine")
 	AddStringFlag(cmdDatabaseCreate, doctl.ArgVersion, "", "", "database engine version")
 	AddStringFlag(cmdDatabaseCreate, doctl.ArgPrivateNetworkUUID, "", "", "private network uuid")
+	AddBoolFlag(cmdDatabaseCreate, doctl.ArgCommandWait, "", false, "Wait for database cluster to be online")
 
 	cmdDatabaseDelete := CmdBuilder(cmd, RunDatabaseDelete, "delete <database-id>", "delete database cluster", Writer,
 		aliasOpt("rm"))
@@ -94,7 +96,7 @@ type. Use 'pg' for PostgreSQL, 'mysql' for MySQL, or 'redis' for Redis.
 
 // RunDatabaseList returns a list of database clusters.
 func RunDatabaseList(c *CmdConfig) error {
-	dbs, err := c.Databases().List()
+	dbs, err := c.Databases().List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -108,8 +110,11 @@ func RunDatabaseGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
-	db, err := c.Databases().Get(id)
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+	db, err := c.Databases().Get(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -128,11 +133,31 @@ func RunDatabaseCreate(c *CmdConfig) error {
 		return err
 	}
 
-	db, err := c.Databases().Create(r)
+	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	if err != nil {
+		return err
+	}
+
+	dbs := c.Databases()
+	db, err := dbs.Create(c.Ctx, r)
 	if err != nil {
 		return err
 	}
 
+	if wait {
+		err := newWaiter("database cluster %s", db.Name).wait(c.Ctx, func(ctx context.Context) (string, bool, error) {
+			updated, err := dbs.Get(ctx, db.ID)
+			if err != nil {
+				return "", false, err
+			}
+			db = updated
+			return db.Status, db.Status == "online", nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return displayDatabases(c, false, *db)
 }
 
@@ -189,9 +214,13 @@ func RunDatabaseDelete(c *CmdConfig) error {
 		return err
 	}
 
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+
 	if force || AskForConfirm("delete this database cluster") == nil {
-		id := c.Args[0]
-		return c.Databases().Delete(id)
+		return c.Databases().Delete(c.Ctx, id)
 	}
 
 	return fmt.Errorf("operation aborted")
@@ -211,8 +240,11 @@ func RunDatabaseConnectionGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
-	connInfo, err := c.Databases().GetConnection(id)
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+	connInfo, err := c.Databases().GetConnection(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -231,8 +263,11 @@ func RunDatabaseBackupsList(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
-	backups, err := c.Databases().ListBackups(id)
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+	backups, err := c.Databases().ListBackups(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -251,14 +286,17 @@ func RunDatabaseResize(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	r, err := buildDatabaseResizeRequestFromArgs(c)
 	if err != nil {
 		return err
 	}
 
-	return c.Databases().Resize(id, r)
+	return c.Databases().Resize(c.Ctx, id, r)
 }
 
 func buildDatabaseResizeRequestFromArgs(c *CmdConfig) (*godo.DatabaseResizeRequest, error) {
@@ -285,14 +323,17 @@ func RunDatabaseMigrate(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	r, err := buildDatabaseMigrateRequestFromArgs(c)
 	if err != nil {
 		return err
 	}
 
-	return c.Databases().Migrate(id, r)
+	return c.Databases().Migrate(c.Ctx, id, r)
 }
 
 func buildDatabaseMigrateRequestFromArgs(c *CmdConfig) (*godo.DatabaseMigrateRequest, error) {
@@ -345,9 +386,12 @@ func RunDatabaseMaintenanceGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
-	window, err := c.Databases().GetMaintenance(id)
+	window, err := c.Databases().GetMaintenance(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -366,13 +410,16 @@ func RunDatabaseMaintenanceUpdate(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	r, err := buildDatabaseUpdateMaintenanceRequestFromArgs(c)
 	if err != nil {
 		return err
 	}
 
-	return c.Databases().UpdateMaintenance(id, r)
+	return c.Databases().UpdateMaintenance(c.Ctx, id, r)
 }
 
 func buildDatabaseUpdateMaintenanceRequestFromArgs(c *CmdConfig) (*godo.DatabaseUpdateMaintenanceRequest, error) {
@@ -430,9 +477,12 @@ func RunDatabaseUserList(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
-	users, err := c.Databases().ListUsers(id)
+	users, err := c.Databases().ListUsers(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -446,10 +496,13 @@ func RunDatabaseUserGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	userID := c.Args[1]
 
-	user, err := c.Databases().GetUser(databaseID, userID)
+	user, err := c.Databases().GetUser(c.Ctx, databaseID, userID)
 	if err != nil {
 		return err
 	}
@@ -463,10 +516,11 @@ func RunDatabaseUserCreate(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	var (
-		databaseID = c.Args[0]
-		userName   = c.Args[1]
-	)
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+	userName := c.Args[1]
 
 	req := &godo.DatabaseCreateUserRequest{Name: userName}
 
@@ -481,7 +535,7 @@ func RunDatabaseUserCreate(c *CmdConfig) error {
 		}
 	}
 
-	user, err := c.Databases().CreateUser(databaseID, req)
+	user, err := c.Databases().CreateUser(c.Ctx, databaseID, req)
 	if err != nil {
 		return err
 	}
@@ -500,10 +554,14 @@ func RunDatabaseUserDelete(c *CmdConfig) error {
 		return err
 	}
 
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+
 	if force || AskForConfirm("delete this database user") == nil {
-		databaseID := c.Args[0]
 		userID := c.Args[1]
-		return c.Databases().DeleteUser(databaseID, userID)
+		return c.Databases().DeleteUser(c.Ctx, databaseID, userID)
 	}
 
 	return fmt.Errorf("operation aborted")
@@ -558,9 +616,12 @@ func RunDatabasePoolList(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
-	pools, err := c.Databases().ListPools(id)
+	pools, err := c.Databases().ListPools(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -574,10 +635,13 @@ func RunDatabasePoolGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	poolID := c.Args[1]
 
-	pool, err := c.Databases().GetPool(databaseID, poolID)
+	pool, err := c.Databases().GetPool(c.Ctx, databaseID, poolID)
 	if err != nil {
 		return err
 	}
@@ -591,13 +655,16 @@ func RunDatabasePoolCreate(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	r, err := buildDatabaseCreatePoolRequestFromArgs(c)
 	if err != nil {
 		return err
 	}
 
-	pool, err := c.Databases().CreatePool(databaseID, r)
+	pool, err := c.Databases().CreatePool(c.Ctx, databaseID, r)
 	if err != nil {
 		return err
 	}
@@ -646,10 +713,14 @@ func RunDatabasePoolDelete(c *CmdConfig) error {
 		return err
 	}
 
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+
 	if force || AskForConfirm("delete this database pool") == nil {
-		databaseID := c.Args[0]
 		poolID := c.Args[1]
-		return c.Databases().DeletePool(databaseID, poolID)
+		return c.Databases().DeletePool(c.Ctx, databaseID, poolID)
 	}
 
 	return fmt.Errorf("operation aborted")
@@ -692,9 +763,12 @@ func RunDatabaseDBList(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
-	dbs, err := c.Databases().ListDBs(id)
+	dbs, err := c.Databases().ListDBs(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -708,10 +782,13 @@ func RunDatabaseDBGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	dbID := c.Args[1]
 
-	db, err := c.Databases().GetDB(databaseID, dbID)
+	db, err := c.Databases().GetDB(c.Ctx, databaseID, dbID)
 	if err != nil {
 		return err
 	}
@@ -725,10 +802,13 @@ func RunDatabaseDBCreate(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	req := &godo.DatabaseCreateDBRequest{Name: c.Args[1]}
 
-	db, err := c.Databases().CreateDB(databaseID, req)
+	db, err := c.Databases().CreateDB(c.Ctx, databaseID, req)
 	if err != nil {
 		return err
 	}
@@ -747,10 +827,14 @@ func RunDatabaseDBDelete(c *CmdConfig) error {
 		return err
 	}
 
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+
 	if force || AskForConfirm("delete this database db") == nil {
-		databaseID := c.Args[0]
 		dbID := c.Args[1]
-		return c.Databases().DeleteDB(databaseID, dbID)
+		return c.Databases().DeleteDB(c.Ctx, databaseID, dbID)
 	}
 
 	return fmt.Errorf("operation aborted")
@@ -809,9 +893,12 @@ func RunDatabaseReplicaList(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
-	replicas, err := c.Databases().ListReplicas(id)
+	replicas, err := c.Databases().ListReplicas(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -825,10 +912,13 @@ func RunDatabaseReplicaGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	replicaID := c.Args[1]
 
-	replica, err := c.Databases().GetReplica(databaseID, replicaID)
+	replica, err := c.Databases().GetReplica(c.Ctx, databaseID, replicaID)
 	if err != nil {
 		return err
 	}
@@ -842,13 +932,16 @@ func RunDatabaseReplicaCreate(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	r, err := buildDatabaseCreateReplicaRequestFromArgs(c)
 	if err != nil {
 		return err
 	}
 
-	replica, err := c.Databases().CreateReplica(databaseID, r)
+	replica, err := c.Databases().CreateReplica(c.Ctx, databaseID, r)
 	if err != nil {
 		return err
 	}
@@ -891,10 +984,14 @@ func RunDatabaseReplicaDelete(c *CmdConfig) error {
 		return err
 	}
 
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+
 	if force || AskForConfirm("delete this database replica") == nil {
-		databaseID := c.Args[0]
 		replicaID := c.Args[1]
-		return c.Databases().DeleteReplica(databaseID, replicaID)
+		return c.Databases().DeleteReplica(c.Ctx, databaseID, replicaID)
 	}
 
 	return fmt.Errorf("operation aborted")
@@ -914,9 +1011,12 @@ func RunDatabaseReplicaConnectionGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	replicaID := c.Args[1]
-	connInfo, err := c.Databases().GetReplicaConnection(databaseID, replicaID)
+	connInfo, err := c.Databases().GetReplicaConnection(c.Ctx, databaseID, replicaID)
 	if err != nil {
 		return err
 	}
@@ -954,8 +1054,11 @@ func RunDatabaseGetSQLModes(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
-	sqlModes, err := c.Databases().GetSQLMode(databaseID)
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+	sqlModes, err := c.Databases().GetSQLMode(c.Ctx, databaseID)
 	if err != nil {
 		return err
 	}
@@ -974,8 +1077,11 @@ func RunDatabaseSetSQLModes(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	databaseID := c.Args[0]
+	databaseID, err := databaseResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	sqlModes := c.Args[1:]
 
-	return c.Databases().SetSQLMode(databaseID, sqlModes...)
+	return c.Databases().SetSQLMode(c.Ctx, databaseID, sqlModes...)
 }
@@ -226,16 +226,16 @@ func TestDatabaseReplicaCommand(t *testing.T) {
 func TestDatabasesGet(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Get(testDBCluster.ID).Return(&testDBCluster, nil)
+		tm.databases.EXPECT().Get(gomock.Any(), testDBCluster.ID).Return(&testDBCluster, nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 		err := RunDatabaseGet(config)
 		assert.NoError(t, err)
 	})
 
 	// Error
-	notFound := "not-found"
+	notFound := "00000000-0000-4000-8000-000000000000"
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Get(notFound).Return(nil, errTest)
+		tm.databases.EXPECT().Get(gomock.Any(), notFound).Return(nil, errTest)
 		config.Args = append(config.Args, notFound)
 		err := RunDatabaseGet(config)
 		assert.Error(t, err)
@@ -251,14 +251,14 @@ func TestDatabasesGet(t *testing.T) {
 func TestDatabasesList(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().List().Return(testDBClusters, nil)
+		tm.databases.EXPECT().List(gomock.Any()).Return(testDBClusters, nil)
 		err := RunDatabaseList(config)
 		assert.NoError(t, err)
 	})
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().List().Return(nil, errTest)
+		tm.databases.EXPECT().List(gomock.Any()).Return(nil, errTest)
 		err := RunDatabaseList(config)
 		assert.Error(t, err)
 	})
@@ -277,7 +277,7 @@ func TestDatabasesCreate(t *testing.T) {
 
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Create(r).Return(&testDBCluster, nil)
+		tm.databases.EXPECT().Create(gomock.Any(), r).Return(&testDBCluster, nil)
 
 		config.Args = append(config.Args, testDBCluster.Name)
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, testDBCluster.RegionSlug)
@@ -291,9 +291,33 @@ func TestDatabasesCreate(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	// Waiting for the cluster to be online
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		defer shortWaits()()
+
+		db := *testDBCluster.Database
+		db.Status = "creating"
+		creating := do.Database{Database: &db}
+
+		tm.databases.EXPECT().Create(gomock.Any(), r).Return(&creating, nil)
+		tm.databases.EXPECT().Get(gomock.Any(), db.ID).Return(&testDBCluster, nil)
+
+		config.Args = append(config.Args, testDBCluster.Name)
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, testDBCluster.RegionSlug)
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, testDBCluster.SizeSlug)
+		config.Doit.Set(config.NS, doctl.ArgVersion, testDBCluster.VersionSlug)
+		config.Doit.Set(config.NS, doctl.ArgDatabaseEngine, testDBCluster.EngineSlug)
+		config.Doit.Set(config.NS, doctl.ArgDatabaseNumNodes, testDBCluster.NumNodes)
+		config.Doit.Set(config.NS, doctl.ArgPrivateNetworkUUID, testDBCluster.PrivateNetworkUUID)
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+
+		err := RunDatabaseCreate(config)
+		assert.NoError(t, err)
+	})
+
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Create(
+		tm.databases.EXPECT().Create(gomock.Any(),
 			gomock.AssignableToTypeOf(&godo.DatabaseCreateRequest{}),
 		).Return(nil, errTest)
 
@@ -306,7 +330,7 @@ func TestDatabasesCreate(t *testing.T) {
 func TestDatabasesDelete(t *testing.T) {
 	// Successful
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Delete(testDBCluster.ID).Return(nil)
+		tm.databases.EXPECT().Delete(gomock.Any(), testDBCluster.ID).Return(nil)
 
 		config.Args = append(config.Args, testDBCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -317,7 +341,7 @@ func TestDatabasesDelete(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Delete(testDBCluster.ID).Return(errTest)
+		tm.databases.EXPECT().Delete(gomock.Any(), testDBCluster.ID).Return(errTest)
 
 		config.Args = append(config.Args, testDBCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -335,7 +359,7 @@ func TestDatabaseMigrate(t *testing.T) {
 
 	// Success
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Migrate(testDBCluster.ID, r).Return(nil)
+		tm.databases.EXPECT().Migrate(gomock.Any(), testDBCluster.ID, r).Return(nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, testDBCluster.RegionSlug)
 		config.Doit.Set(config.NS, doctl.ArgPrivateNetworkUUID, testDBCluster.PrivateNetworkUUID)
@@ -346,7 +370,7 @@ func TestDatabaseMigrate(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Migrate(testDBCluster.ID, r).Return(errTest)
+		tm.databases.EXPECT().Migrate(gomock.Any(), testDBCluster.ID, r).Return(errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, testDBCluster.RegionSlug)
 		config.Doit.Set(config.NS, doctl.ArgPrivateNetworkUUID, testDBCluster.PrivateNetworkUUID)
@@ -364,7 +388,7 @@ func TestDatabaseResize(t *testing.T) {
 
 	// Success
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Resize(testDBCluster.ID, r).Return(nil)
+		tm.databases.EXPECT().Resize(gomock.Any(), testDBCluster.ID, r).Return(nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgSizeSlug, testDBCluster.SizeSlug)
 		config.Doit.Set(config.NS, doctl.ArgDatabaseNumNodes, testDBCluster.NumNodes)
@@ -375,7 +399,7 @@ func TestDatabaseResize(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().Resize(testDBCluster.ID, r).Return(errTest)
+		tm.databases.EXPECT().Resize(gomock.Any(), testDBCluster.ID, r).Return(errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgSizeSlug, testDBCluster.SizeSlug)
 		config.Doit.Set(config.NS, doctl.ArgDatabaseNumNodes, testDBCluster.NumNodes)
@@ -388,7 +412,7 @@ func TestDatabaseResize(t *testing.T) {
 func TestDatabaseListBackups(t *testing.T) {
 	// Success
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListBackups(testDBCluster.ID).Return(testDBBackups, nil)
+		tm.databases.EXPECT().ListBackups(gomock.Any(), testDBCluster.ID).Return(testDBBackups, nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseBackupsList(config)
@@ -397,7 +421,7 @@ func TestDatabaseListBackups(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListBackups(testDBCluster.ID).Return(nil, errTest)
+		tm.databases.EXPECT().ListBackups(gomock.Any(), testDBCluster.ID).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseBackupsList(config)
@@ -408,7 +432,7 @@ func TestDatabaseListBackups(t *testing.T) {
 func TestDatabaseConnectionGet(t *testing.T) {
 	// Success
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetConnection(testDBCluster.ID).Return(&testDBConnection, nil)
+		tm.databases.EXPECT().GetConnection(gomock.Any(), testDBCluster.ID).Return(&testDBConnection, nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseConnectionGet(config)
@@ -417,7 +441,7 @@ func TestDatabaseConnectionGet(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetConnection(testDBCluster.ID).Return(nil, errTest)
+		tm.databases.EXPECT().GetConnection(gomock.Any(), testDBCluster.ID).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseConnectionGet(config)
@@ -428,7 +452,7 @@ func TestDatabaseConnectionGet(t *testing.T) {
 func TestDatabaseGetMaintenance(t *testing.T) {
 	// Success
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetMaintenance(testDBCluster.ID).Return(&testDBMainWindow, nil)
+		tm.databases.EXPECT().GetMaintenance(gomock.Any(), testDBCluster.ID).Return(&testDBMainWindow, nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseMaintenanceGet(config)
@@ -437,7 +461,7 @@ func TestDatabaseGetMaintenance(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetMaintenance(testDBCluster.ID).Return(nil, errTest)
+		tm.databases.EXPECT().GetMaintenance(gomock.Any(), testDBCluster.ID).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseMaintenanceGet(config)
@@ -453,7 +477,7 @@ func TestDatabaseUpdateMaintenance(t *testing.T) {
 
 	// Success
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().UpdateMaintenance(testDBCluster.ID, r).Return(nil)
+		tm.databases.EXPECT().UpdateMaintenance(gomock.Any(), testDBCluster.ID, r).Return(nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgDatabaseMaintenanceDay, testDBMainWindow.Day)
 		config.Doit.Set(config.NS, doctl.ArgDatabaseMaintenanceHour, testDBMainWindow.Hour)
@@ -464,7 +488,7 @@ func TestDatabaseUpdateMaintenance(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().UpdateMaintenance(testDBCluster.ID, r).Return(errTest)
+		tm.databases.EXPECT().UpdateMaintenance(gomock.Any(), testDBCluster.ID, r).Return(errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgDatabaseMaintenanceDay, testDBMainWindow.Day)
 		config.Doit.Set(config.NS, doctl.ArgDatabaseMaintenanceHour, testDBMainWindow.Hour)
@@ -477,7 +501,7 @@ func TestDatabaseUpdateMaintenance(t *testing.T) {
 func TestDatabasesUserGet(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetUser(testDBCluster.ID, testDBUser.Name).Return(&testDBUser, nil)
+		tm.databases.EXPECT().GetUser(gomock.Any(), testDBCluster.ID, testDBUser.Name).Return(&testDBUser, nil)
 		config.Args = append(config.Args, testDBCluster.ID, testDBUser.Name)
 		err := RunDatabaseUserGet(config)
 		assert.NoError(t, err)
@@ -485,7 +509,7 @@ func TestDatabasesUserGet(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetUser(testDBCluster.ID, testDBUser.Name).Return(nil, errTest)
+		tm.databases.EXPECT().GetUser(gomock.Any(), testDBCluster.ID, testDBUser.Name).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID, testDBUser.Name)
 		err := RunDatabaseUserGet(config)
 		assert.Error(t, err)
@@ -501,7 +525,7 @@ func TestDatabasesUserGet(t *testing.T) {
 func TestDatabasesListUsers(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListUsers(testDBCluster.ID).Return(testDBUsers, nil)
+		tm.databases.EXPECT().ListUsers(gomock.Any(), testDBCluster.ID).Return(testDBUsers, nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseUserList(config)
@@ -510,7 +534,7 @@ func TestDatabasesListUsers(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListUsers(testDBCluster.ID).Return(nil, errTest)
+		tm.databases.EXPECT().ListUsers(gomock.Any(), testDBCluster.ID).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseUserList(config)
@@ -525,7 +549,7 @@ func TestDatabaseUserCreate(t *testing.T) {
 
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().CreateUser(testDBCluster.ID, r).Return(&testDBUser, nil)
+		tm.databases.EXPECT().CreateUser(gomock.Any(), testDBCluster.ID, r).Return(&testDBUser, nil)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBUser.Name)
 
@@ -542,7 +566,7 @@ func TestDatabaseUserCreate(t *testing.T) {
 			},
 		}
 
-		tm.databases.EXPECT().CreateUser(testDBCluster.ID, r).Return(&testDBUser, nil)
+		tm.databases.EXPECT().CreateUser(gomock.Any(), testDBCluster.ID, r).Return(&testDBUser, nil)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBUser.Name)
 		config.Doit.Set(config.NS, doctl.ArgDatabaseUserMySQLAuthPlugin, "mysql_native_password")
@@ -553,7 +577,7 @@ func TestDatabaseUserCreate(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().CreateUser(
+		tm.databases.EXPECT().CreateUser(gomock.Any(),
 			testDBCluster.ID,
 			gomock.AssignableToTypeOf(&godo.DatabaseCreateUserRequest{}),
 		).Return(nil, errTest)
@@ -567,7 +591,7 @@ func TestDatabaseUserCreate(t *testing.T) {
 func TestDatabasesUserDelete(t *testing.T) {
 	// Successful
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().DeleteUser(testDBCluster.ID, testDBUser.Name).Return(nil)
+		tm.databases.EXPECT().DeleteUser(gomock.Any(), testDBCluster.ID, testDBUser.Name).Return(nil)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBUser.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -578,7 +602,7 @@ func TestDatabasesUserDelete(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().DeleteUser(testDBCluster.ID, testDBUser.Name).Return(errTest)
+		tm.databases.EXPECT().DeleteUser(gomock.Any(), testDBCluster.ID, testDBUser.Name).Return(errTest)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBUser.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -591,7 +615,7 @@ func TestDatabasesUserDelete(t *testing.T) {
 func TestDatabasesPoolGet(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetPool(testDBCluster.ID, testDBPool.Name).Return(&testDBPool, nil)
+		tm.databases.EXPECT().GetPool(gomock.Any(), testDBCluster.ID, testDBPool.Name).Return(&testDBPool, nil)
 		config.Args = append(config.Args, testDBCluster.ID, testDBPool.Name)
 		err := RunDatabasePoolGet(config)
 		assert.NoError(t, err)
@@ -599,7 +623,7 @@ func TestDatabasesPoolGet(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetPool(testDBCluster.ID, testDBPool.Name).Return(nil, errTest)
+		tm.databases.EXPECT().GetPool(gomock.Any(), testDBCluster.ID, testDBPool.Name).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID, testDBPool.Name)
 		err := RunDatabasePoolGet(config)
 		assert.Error(t, err)
@@ -615,7 +639,7 @@ func TestDatabasesPoolGet(t *testing.T) {
 func TestDatabasesListPools(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListPools(testDBCluster.ID).Return(testDBPools, nil)
+		tm.databases.EXPECT().ListPools(gomock.Any(), testDBCluster.ID).Return(testDBPools, nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabasePoolList(config)
@@ -624,7 +648,7 @@ func TestDatabasesListPools(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListPools(testDBCluster.ID).Return(nil, errTest)
+		tm.databases.EXPECT().ListPools(gomock.Any(), testDBCluster.ID).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabasePoolList(config)
@@ -646,7 +670,7 @@ func TestDatabasePoolCreate(t *testing.T) {
 
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().CreatePool(testDBCluster.ID, r).Return(&testDBPool, nil)
+		tm.databases.EXPECT().CreatePool(gomock.Any(), testDBCluster.ID, r).Return(&testDBPool, nil)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBPool.Name)
 		config.Doit.Set(config.NS, doctl.ArgDatabasePoolDBName, testDB.Name)
@@ -660,7 +684,7 @@ func TestDatabasePoolCreate(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().CreatePool(
+		tm.databases.EXPECT().CreatePool(gomock.Any(),
 			testDBCluster.ID,
 			gomock.AssignableToTypeOf(&godo.DatabaseCreatePoolRequest{}),
 		).Return(nil, errTest)
@@ -674,7 +698,7 @@ func TestDatabasePoolCreate(t *testing.T) {
 func TestDatabasesPoolDelete(t *testing.T) {
 	// Successful
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().DeletePool(testDBCluster.ID, testDBPool.Name).Return(nil)
+		tm.databases.EXPECT().DeletePool(gomock.Any(), testDBCluster.ID, testDBPool.Name).Return(nil)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBPool.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -685,7 +709,7 @@ func TestDatabasesPoolDelete(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().DeletePool(testDBCluster.ID, testDBPool.Name).Return(errTest)
+		tm.databases.EXPECT().DeletePool(gomock.Any(), testDBCluster.ID, testDBPool.Name).Return(errTest)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBPool.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -698,7 +722,7 @@ func TestDatabasesPoolDelete(t *testing.T) {
 func TestDatabasesDBGet(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetDB(testDBCluster.ID, testDB.Name).Return(&testDB, nil)
+		tm.databases.EXPECT().GetDB(gomock.Any(), testDBCluster.ID, testDB.Name).Return(&testDB, nil)
 		config.Args = append(config.Args, testDBCluster.ID, testDB.Name)
 		err := RunDatabaseDBGet(config)
 		assert.NoError(t, err)
@@ -706,7 +730,7 @@ func TestDatabasesDBGet(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetDB(testDBCluster.ID, testDB.Name).Return(nil, errTest)
+		tm.databases.EXPECT().GetDB(gomock.Any(), testDBCluster.ID, testDB.Name).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID, testDB.Name)
 		err := RunDatabaseDBGet(config)
 		assert.Error(t, err)
@@ -722,7 +746,7 @@ func TestDatabasesDBGet(t *testing.T) {
 func TestDatabasesListDBs(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListDBs(testDBCluster.ID).Return(testDBs, nil)
+		tm.databases.EXPECT().ListDBs(gomock.Any(), testDBCluster.ID).Return(testDBs, nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseDBList(config)
@@ -731,7 +755,7 @@ func TestDatabasesListDBs(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListDBs(testDBCluster.ID).Return(nil, errTest)
+		tm.databases.EXPECT().ListDBs(gomock.Any(), testDBCluster.ID).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseDBList(config)
@@ -746,7 +770,7 @@ func TestDatabaseDBCreate(t *testing.T) {
 
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().CreateDB(testDBCluster.ID, r).Return(&testDB, nil)
+		tm.databases.EXPECT().CreateDB(gomock.Any(), testDBCluster.ID, r).Return(&testDB, nil)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDB.Name)
 
@@ -756,7 +780,7 @@ func TestDatabaseDBCreate(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().CreateDB(
+		tm.databases.EXPECT().CreateDB(gomock.Any(),
 			testDBCluster.ID,
 			gomock.AssignableToTypeOf(&godo.DatabaseCreateDBRequest{}),
 		).Return(nil, errTest)
@@ -770,7 +794,7 @@ func TestDatabaseDBCreate(t *testing.T) {
 func TestDatabasesDBDelete(t *testing.T) {
 	// Successful
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().DeleteDB(testDBCluster.ID, testDB.Name).Return(nil)
+		tm.databases.EXPECT().DeleteDB(gomock.Any(), testDBCluster.ID, testDB.Name).Return(nil)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDB.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -781,7 +805,7 @@ func TestDatabasesDBDelete(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().DeleteDB(testDBCluster.ID, testDB.Name).Return(errTest)
+		tm.databases.EXPECT().DeleteDB(gomock.Any(), testDBCluster.ID, testDB.Name).Return(errTest)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDB.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -794,7 +818,7 @@ func TestDatabasesDBDelete(t *testing.T) {
 func TestDatabasesReplicaGet(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetReplica(testDBCluster.ID, testDBReplica.Name).Return(&testDBReplica, nil)
+		tm.databases.EXPECT().GetReplica(gomock.Any(), testDBCluster.ID, testDBReplica.Name).Return(&testDBReplica, nil)
 		config.Args = append(config.Args, testDBCluster.ID, testDBReplica.Name)
 		err := RunDatabaseReplicaGet(config)
 		assert.NoError(t, err)
@@ -802,7 +826,7 @@ func TestDatabasesReplicaGet(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().GetReplica(testDBCluster.ID, testDBReplica.Name).Return(nil, errTest)
+		tm.databases.EXPECT().GetReplica(gomock.Any(), testDBCluster.ID, testDBReplica.Name).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID, testDBReplica.Name)
 		err := RunDatabaseReplicaGet(config)
 		assert.Error(t, err)
@@ -818,7 +842,7 @@ func TestDatabasesReplicaGet(t *testing.T) {
 func TestDatabasesListReplicas(t *testing.T) {
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListReplicas(testDBCluster.ID).Return(testDBReplicas, nil)
+		tm.databases.EXPECT().ListReplicas(gomock.Any(), testDBCluster.ID).Return(testDBReplicas, nil)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseReplicaList(config)
@@ -827,7 +851,7 @@ func TestDatabasesListReplicas(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().ListReplicas(testDBCluster.ID).Return(nil, errTest)
+		tm.databases.EXPECT().ListReplicas(gomock.Any(), testDBCluster.ID).Return(nil, errTest)
 		config.Args = append(config.Args, testDBCluster.ID)
 
 		err := RunDatabaseReplicaList(config)
@@ -845,7 +869,7 @@ func TestDatabaseReplicaCreate(t *testing.T) {
 
 	// Successful call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().CreateReplica(testDBCluster.ID, r).Return(&testDBReplica, nil)
+		tm.databases.EXPECT().CreateReplica(gomock.Any(), testDBCluster.ID, r).Return(&testDBReplica, nil)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBReplica.Name)
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, testDBReplica.Region)
@@ -858,7 +882,7 @@ func TestDatabaseReplicaCreate(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().CreateReplica(
+		tm.databases.EXPECT().CreateReplica(gomock.Any(),
 			testDBCluster.ID,
 			gomock.AssignableToTypeOf(&godo.DatabaseCreateReplicaRequest{}),
 		).Return(nil, errTest)
@@ -872,7 +896,7 @@ func TestDatabaseReplicaCreate(t *testing.T) {
 func TestDatabasesReplicaDelete(t *testing.T) {
 	// Successful
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().DeleteReplica(testDBCluster.ID, testDBReplica.Name).Return(nil)
+		tm.databases.EXPECT().DeleteReplica(gomock.Any(), testDBCluster.ID, testDBReplica.Name).Return(nil)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBReplica.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -883,7 +907,7 @@ func TestDatabasesReplicaDelete(t *testing.T) {
 
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.databases.EXPECT().DeleteReplica(testDBCluster.ID, testDBReplica.Name).Return(errTest)
+		tm.databases.EXPECT().DeleteReplica(gomock.Any(), testDBCluster.ID, testDBReplica.Name).Return(errTest)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBReplica.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -896,7 +920,7 @@ func TestDatabasesReplicaDelete(t *testing.T) {
 func TestDatabaseGetSQLModes(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-			tm.databases.EXPECT().GetSQLMode(testDBCluster.ID).Return(testSQLModes, nil)
+			tm.databases.EXPECT().GetSQLMode(gomock.Any(), testDBCluster.ID).Return(testSQLModes, nil)
 
 			config.Args = append(config.Args, testDBCluster.ID)
 
@@ -907,7 +931,7 @@ func TestDatabaseGetSQLModes(t *testing.T) {
 
 	t.Run("Error", func(t *testing.T) {
 		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-			tm.databases.EXPECT().GetSQLMode(testDBCluster.ID).Return(nil, errTest)
+			tm.databases.EXPECT().GetSQLMode(gomock.Any(), testDBCluster.ID).Return(nil, errTest)
 
 			config.Args = append(config.Args, testDBCluster.ID)
 
@@ -925,7 +949,7 @@ func TestDatabaseSetSQLModes(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-			tm.databases.EXPECT().SetSQLMode(testDBCluster.ID, testSQLModesInterface...).Return(nil)
+			tm.databases.EXPECT().SetSQLMode(gomock.Any(), testDBCluster.ID, testSQLModesInterface...).Return(nil)
 
 			config.Args = append(config.Args, testDBCluster.ID)
 			config.Args = append(config.Args, testSQLModes...)
@@ -937,7 +961,7 @@ func TestDatabaseSetSQLModes(t *testing.T) {
 
 	t.Run("Error", func(t *testing.T) {
 		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-			tm.databases.EXPECT().SetSQLMode(testDBCluster.ID, testSQLModesInterface...).Return(errTest)
+			tm.databases.EXPECT().SetSQLMode(gomock.Any(), testDBCluster.ID, testSQLModesInterface...).Return(errTest)
 
 			config.Args = append(config.Args, testDBCluster.ID)
 			config.Args = append(config.Args, testSQLModes...)
@@ -0,0 +1,94 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/pkg/fakeapi"
+	"github.com/spf13/cobra"
+)
+
+// Dev creates the dev command.
+func Dev() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:   "dev",
+			Short: "development tools",
+			Long:  "dev provides tools for developing and testing scripts and tooling that use doctl",
+		},
+	}
+
+	cmdFakeAPI := cmdBuilderWithInit(cmd, RunDevFakeAPI, "fake-api", "run a fake DigitalOcean API locally", Writer, false)
+	cmdFakeAPI.Long = `fake-api serves an in-memory fake of the DigitalOcean API, so doctl and other
+tools can be used without an account:
+
+	doctl dev fake-api --listen 127.0.0.1:8080 &
+	doctl --api-url http://127.0.0.1:8080 -t fake compute droplet list
+
+It supports droplets, actions, volumes, tags, domains, firewalls, load
+balancers, Kubernetes clusters, databases, the container registry,
+certificates and projects. Actions stay in progress for --action-duration, and
+state is lost when it stops.`
+	AddStringFlag(cmdFakeAPI, doctl.ArgListen, "", "127.0.0.1:8080", "address to listen on")
+	AddStringFlag(cmdFakeAPI, doctl.ArgActionDuration, "", "2s", "how long actions stay in progress, e.g. 0s or 10s")
+
+	return cmd
+}
+
+// RunDevFakeAPI serves a fake API until the command is interrupted.
+func RunDevFakeAPI(c *CmdConfig) error {
+	addr, err := c.Doit.GetString(c.NS, doctl.ArgListen)
+	if err != nil {
+		return err
+	}
+
+	d, err := c.Doit.GetString(c.NS, doctl.ArgActionDuration)
+	if err != nil {
+		return err
+	}
+	actionDuration, err := time.ParseDuration(d)
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %v", doctl.ArgActionDuration, d, err)
+	}
+
+	api := fakeapi.New()
+	api.ActionDuration = actionDuration
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{Handler: api}
+	go func() {
+		<-c.Ctx.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(ctx)
+	}()
+
+	fmt.Fprintf(c.Out, "fake API listening on http://%s; use --api-url http://%s\n", l.Addr(), l.Addr())
+
+	if err := server.Serve(l); err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
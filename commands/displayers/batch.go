@@ -0,0 +1,73 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package displayers
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// BatchResult is the outcome of a command of a batch.
+type BatchResult struct {
+	Line     int    `json:"line"`
+	Command  string `json:"command"`
+	Status   string `json:"status"`
+	ExitCode int    `json:"exit_code"`
+	// Output is embedded as is when the command printed JSON, and as a
+	// string otherwise.
+	Output json.RawMessage `json:"output,omitempty"`
+	Error  string          `json:"error,omitempty"`
+	Hint   string          `json:"hint,omitempty"`
+}
+
+type BatchResults struct {
+	Results []BatchResult
+}
+
+var _ Displayable = &BatchResults{}
+
+func (b *BatchResults) JSON(out io.Writer) error {
+	return writeJSON(b.Results, out)
+}
+
+func (b *BatchResults) Cols() []string {
+	return []string{"Line", "Command", "Status", "ExitCode", "Error"}
+}
+
+func (b *BatchResults) ColMap() map[string]string {
+	return map[string]string{
+		"Line":     "Line",
+		"Command":  "Command",
+		"Status":   "Status",
+		"ExitCode": "Exit Code",
+		"Error":    "Error",
+	}
+}
+
+func (b *BatchResults) KV() []map[string]interface{} {
+	out := []map[string]interface{}{}
+
+	for _, r := range b.Results {
+		o := map[string]interface{}{
+			"Line":     r.Line,
+			"Command":  r.Command,
+			"Status":   r.Status,
+			"ExitCode": r.ExitCode,
+			"Error":    r.Error,
+		}
+		out = append(out, o)
+	}
+
+	return out
+}
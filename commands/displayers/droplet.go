@@ -44,7 +44,7 @@ func (d *Droplet) ColMap() map[string]string {
 		"Memory": "Memory", "VCPUs": "VCPUs", "Disk": "Disk",
 		"Region": "Region", "Image": "Image", "Status": "Status",
 		"Tags": "Tags", "Features": "Features", "Volumes": "Volumes",
-		"SizeSlug": "Size Slug",
+		"SizeSlug": "Size Slug", "Created": "Created At",
 	}
 }
 
@@ -63,7 +63,7 @@ func (d *Droplet) KV() []map[string]interface{} {
 			"Memory": d.Memory, "VCPUs": d.Vcpus, "Disk": d.Disk,
 			"Region": d.Region.Slug, "Image": image, "Status": d.Status,
 			"Tags": tags, "Features": features, "Volumes": volumes,
-			"SizeSlug": d.SizeSlug,
+			"SizeSlug": d.SizeSlug, "Created": d.Created,
 		}
 		out = append(out, m)
 	}
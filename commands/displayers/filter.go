@@ -0,0 +1,361 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package displayers
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/gobwas/glob"
+)
+
+// filterOps are the operators understood by --filter. Two character
+// operators come first so that "!=" is not read as "!" followed by "=".
+var filterOps = []string{"!=", "!~", ">=", "<=", "=", "~", ">", "<"}
+
+// filterTerm is a single key, operator and value from --filter.
+type filterTerm struct {
+	key   string
+	op    string
+	value string
+}
+
+// sortKey is a single key from --sort-by.
+type sortKey struct {
+	key  string
+	desc bool
+}
+
+// FilterAndSort narrows down and reorders the list wrapped by item using the
+// KV keys of its rows. filter is a comma separated list of conditions such as
+// "status=active,name~web-*" and sortBy a comma separated list of keys, each
+// optionally prefixed with "-" to sort in descending order. Keys are matched
+// case insensitively, ignoring dashes and underscores.
+func FilterAndSort(item Displayable, filter, sortBy string) error {
+	terms, err := parseFilter(filter)
+	if err != nil {
+		return err
+	}
+
+	keys, err := parseSortBy(sortBy)
+	if err != nil {
+		return err
+	}
+
+	if len(terms) == 0 && len(keys) == 0 {
+		return nil
+	}
+
+	list, err := itemList(item)
+	if err != nil {
+		return err
+	}
+
+	rows := item.KV()
+	if len(rows) != list.Len() {
+		return fmt.Errorf("--filter and --sort-by are not supported by this command")
+	}
+
+	for i := range terms {
+		if terms[i].key, err = resolveKey(item, terms[i].key); err != nil {
+			return err
+		}
+	}
+	for i := range keys {
+		if keys[i].key, err = resolveKey(item, keys[i].key); err != nil {
+			return err
+		}
+	}
+
+	indexes := make([]int, 0, len(rows))
+	for i, row := range rows {
+		ok, err := matchRow(row, terms)
+		if err != nil {
+			return err
+		}
+		if ok {
+			indexes = append(indexes, i)
+		}
+	}
+
+	sort.SliceStable(indexes, func(a, b int) bool {
+		ra, rb := rows[indexes[a]], rows[indexes[b]]
+		for _, k := range keys {
+			c := compareValues(ra[k.key], rb[k.key])
+			if c == 0 {
+				continue
+			}
+			if k.desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+
+	out := reflect.MakeSlice(list.Type(), 0, len(indexes))
+	for _, i := range indexes {
+		out = reflect.Append(out, list.Index(i))
+	}
+	list.Set(out)
+
+	return nil
+}
+
+// itemList returns the settable list wrapped by a displayable.
+func itemList(item Displayable) (reflect.Value, error) {
+	v := reflect.ValueOf(item)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct || v.NumField() == 0 || v.Field(0).Kind() != reflect.Slice || !v.Field(0).CanSet() {
+		return reflect.Value{}, fmt.Errorf("--filter and --sort-by are only supported for lists")
+	}
+
+	return v.Field(0), nil
+}
+
+// resolveKey maps a user supplied key to one of the item's KV keys.
+func resolveKey(item Displayable, key string) (string, error) {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.NewReplacer("-", "", "_", "", " ", "").Replace(s))
+	}
+
+	want := normalize(key)
+	available := make([]string, 0, len(item.ColMap()))
+	for k := range item.ColMap() {
+		if normalize(k) == want {
+			return k, nil
+		}
+		available = append(available, k)
+	}
+	sort.Strings(available)
+
+	return "", fmt.Errorf("unknown key %q, expected one of: %s", key, strings.Join(available, ", "))
+}
+
+func parseFilter(filter string) ([]filterTerm, error) {
+	var terms []filterTerm
+	for _, expr := range strings.Split(filter, ",") {
+		expr = strings.TrimSpace(expr)
+		if expr == "" {
+			continue
+		}
+
+		term, err := parseFilterTerm(expr)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+
+	return terms, nil
+}
+
+func parseFilterTerm(expr string) (filterTerm, error) {
+	at, op := -1, ""
+	for _, o := range filterOps {
+		if i := strings.Index(expr, o); i >= 0 && (at < 0 || i < at) {
+			at, op = i, o
+		}
+	}
+
+	if at <= 0 {
+		return filterTerm{}, fmt.Errorf("invalid filter %q, expected key=value, key!=value, key~glob or a comparison such as key>value", expr)
+	}
+
+	return filterTerm{
+		key:   strings.TrimSpace(expr[:at]),
+		op:    op,
+		value: strings.TrimSpace(expr[at+len(op):]),
+	}, nil
+}
+
+func parseSortBy(sortBy string) ([]sortKey, error) {
+	var keys []sortKey
+	for _, k := range strings.Split(sortBy, ",") {
+		k = strings.TrimSpace(k)
+		if k == "" {
+			continue
+		}
+
+		sk := sortKey{key: k}
+		if strings.HasPrefix(k, "-") {
+			sk = sortKey{key: k[1:], desc: true}
+		}
+		if sk.key == "" {
+			return nil, fmt.Errorf("invalid sort key %q", k)
+		}
+		keys = append(keys, sk)
+	}
+
+	return keys, nil
+}
+
+func matchRow(row map[string]interface{}, terms []filterTerm) (bool, error) {
+	for _, t := range terms {
+		ok, err := matchTerm(row[t.key], t)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func matchTerm(v interface{}, t filterTerm) (bool, error) {
+	switch t.op {
+	case "~", "!~":
+		g, err := glob.Compile(t.value)
+		if err != nil {
+			return false, fmt.Errorf("invalid pattern %q: %v", t.value, err)
+		}
+		return g.Match(formatValue(v)) == (t.op == "~"), nil
+	}
+
+	want, err := parseLike(v, t.value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for %s: %v", t.key, err)
+	}
+
+	c := compareValues(v, want)
+	switch t.op {
+	case "=":
+		return c == 0, nil
+	case "!=":
+		return c != 0, nil
+	case ">":
+		return c > 0, nil
+	case ">=":
+		return c >= 0, nil
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	}
+
+	return false, fmt.Errorf("unknown operator %q", t.op)
+}
+
+// parseLike parses s into the same kind of value as v so the two can be
+// compared.
+func parseLike(v interface{}, s string) (interface{}, error) {
+	if _, ok := toTime(v); ok {
+		if t, ok := toTime(s); ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("%q is not a timestamp", s)
+	}
+
+	if _, ok := toFloat(v); ok {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", s)
+		}
+		return f, nil
+	}
+
+	if _, ok := v.(bool); ok {
+		return strconv.ParseBool(s)
+	}
+
+	return s, nil
+}
+
+// compareValues orders two KV values, comparing numbers numerically and
+// timestamps chronologically. Other values are compared as strings.
+func compareValues(a, b interface{}) int {
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	if ta, ok := toTime(a); ok {
+		if tb, ok := toTime(b); ok {
+			switch {
+			case ta.Before(tb):
+				return -1
+			case ta.After(tb):
+				return 1
+			}
+			return 0
+		}
+	}
+
+	if ba, ok := a.(bool); ok {
+		if bb, ok := b.(bool); ok {
+			switch {
+			case ba == bb:
+				return 0
+			case bb:
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return strings.Compare(formatValue(a), formatValue(b))
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+
+	return 0, false
+}
+
+// toTime converts times and the timestamp strings returned by the API.
+func toTime(v interface{}) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case *time.Time:
+		if t != nil {
+			return *t, true
+		}
+	case godo.Timestamp:
+		return t.Time, true
+	case string:
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+			if pt, err := time.Parse(layout, t); err == nil {
+				return pt, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
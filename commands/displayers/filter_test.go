@@ -0,0 +1,111 @@
+package displayers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func filterTestDroplets() do.Droplets {
+	droplet := func(id int, name, status, region string, memory int, created string) do.Droplet {
+		return do.Droplet{Droplet: &godo.Droplet{
+			ID:      id,
+			Name:    name,
+			Status:  status,
+			Region:  &godo.Region{Slug: region},
+			Memory:  memory,
+			Created: created,
+			Image:   &godo.Image{},
+		}}
+	}
+
+	return do.Droplets{
+		droplet(1, "web-01", "active", "nyc1", 2048, "2019-10-01T10:00:00Z"),
+		droplet(2, "web-02", "off", "nyc1", 1024, "2019-10-03T10:00:00Z"),
+		droplet(3, "db-01", "active", "sfo2", 8192, "2019-10-02T10:00:00Z"),
+		droplet(4, "web-03", "active", "nyc1", 1024, "2019-10-04T10:00:00Z"),
+		droplet(10, "web-10", "active", "sfo2", 512, "2019-09-30T10:00:00Z"),
+	}
+}
+
+func dropletIDs(d *Droplet) []int {
+	ids := make([]int, 0, len(d.Droplets))
+	for _, droplet := range d.Droplets {
+		ids = append(ids, droplet.ID)
+	}
+	return ids
+}
+
+func TestFilterAndSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		sortBy   string
+		expected []int
+	}{
+		{name: "no filter or sort", expected: []int{1, 2, 3, 4, 10}},
+		{name: "equality", filter: "status=active,region=nyc1", expected: []int{1, 4}},
+		{name: "inequality", filter: "status!=active", expected: []int{2}},
+		{name: "glob", filter: "name~web-*", expected: []int{1, 2, 4, 10}},
+		{name: "negated glob", filter: "name!~web-*", expected: []int{3}},
+		{name: "keys ignore case and dashes", filter: "Size-Slug=,STATUS=off", expected: []int{2}},
+		{name: "numeric comparison", filter: "memory>=2048", expected: []int{1, 3}},
+		{name: "numeric ids", filter: "id>3", expected: []int{4, 10}},
+		{name: "timestamp comparison", filter: "created<2019-10-02", expected: []int{1, 10}},
+		{name: "numeric sort", sortBy: "memory", expected: []int{10, 2, 4, 1, 3}},
+		{name: "descending timestamp sort", sortBy: "-created", expected: []int{4, 2, 3, 1, 10}},
+		{name: "multiple sort keys", sortBy: "memory,-created", expected: []int{10, 4, 2, 1, 3}},
+		{name: "numeric ids sort numerically", sortBy: "-id", expected: []int{10, 4, 3, 2, 1}},
+		{name: "filter and sort", filter: "region=nyc1", sortBy: "-memory,name", expected: []int{1, 2, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &Droplet{Droplets: filterTestDroplets()}
+
+			err := FilterAndSort(item, tt.filter, tt.sortBy)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, dropletIDs(item))
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		errTests := []struct {
+			filter string
+			sortBy string
+			err    string
+		}{
+			{filter: "colour=red", err: `unknown key "colour"`},
+			{sortBy: "-", err: `invalid sort key "-"`},
+			{filter: "status", err: `invalid filter "status"`},
+			{filter: "memory>lots", err: `invalid value for Memory: "lots" is not a number`},
+			{filter: "name~[", err: `invalid pattern "["`},
+		}
+
+		for _, et := range errTests {
+			err := FilterAndSort(&Droplet{Droplets: filterTestDroplets()}, et.filter, et.sortBy)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), et.err)
+		}
+	})
+
+	t.Run("applies to every output type", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		displayer := Displayer{
+			OutputType: "json",
+			Filter:     "name~db-*",
+			Item:       &Droplet{Droplets: filterTestDroplets()},
+			Out:        out,
+		}
+
+		require.NoError(t, displayer.Display())
+		assert.Contains(t, out.String(), `"name": "db-01"`)
+		assert.NotContains(t, out.String(), `"name": "web-01"`)
+	})
+}
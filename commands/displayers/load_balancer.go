@@ -86,7 +86,7 @@ func (lb *LoadBalancer) KV() []map[string]interface{} {
 			"Algorithm":           l.Algorithm,
 			"Region":              l.Region.Slug,
 			"Tag":                 l.Tag,
-			"DropletIDs":          strings.Trim(strings.Replace(fmt.Sprint(l.DropletIDs), " ", ",", -1), "[]"),
+			"DropletIDs":          dropletListHelper(l.DropletIDs),
 			"RedirectHttpToHttps": l.RedirectHttpToHttps,
 			"StickySessions":      prettyPrintStruct(l.StickySessions),
 			"HealthCheck":         prettyPrintStruct(l.HealthCheck),
@@ -15,12 +15,18 @@ package displayers
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
+
+	"gopkg.in/yaml.v2"
 )
 
 // Displayable is a displable entity. These are used for printing results.
@@ -36,13 +42,34 @@ type Displayer struct {
 	OutputType string
 	ColumnList string
 	NoHeaders  bool
+	Template   string
+	Query      string
+	Filter     string
+	SortBy     string
 
 	Item Displayable
 	Out  io.Writer
 }
 
-// Display ends up rendering the content in one of two formats (text|json)
+// Display ends up rendering the content in one of the supported formats
+// (text|json|yaml|csv|tsv), or through Template or Query when one is set.
 func (d *Displayer) Display() error {
+	if err := FilterAndSort(d.Item, d.Filter, d.SortBy); err != nil {
+		return err
+	}
+
+	if d.Template != "" && d.Query != "" {
+		return fmt.Errorf("--template and --query cannot be used together")
+	}
+
+	if d.Template != "" {
+		return DisplayTemplate(d.Item, d.Out, d.Template)
+	}
+
+	if d.Query != "" {
+		return DisplayQuery(d.Item, d.Out, d.OutputType, d.Query)
+	}
+
 	switch d.OutputType {
 	case "json":
 		if containsOnlyNilSlice(d.Item) {
@@ -50,20 +77,52 @@ func (d *Displayer) Display() error {
 			return err
 		}
 		return d.Item.JSON(d.Out)
-	case "text":
-		var cols []string
-		for _, c := range strings.Split(strings.Join(strings.Fields(d.ColumnList), ""), ",") {
-			if c != "" {
-				cols = append(cols, c)
-			}
+	case "yaml":
+		if containsOnlyNilSlice(d.Item) {
+			_, err := d.Out.Write([]byte("[]\n"))
+			return err
 		}
-
-		return DisplayText(d.Item, d.Out, d.NoHeaders, cols)
+		return DisplayYAML(d.Item, d.Out)
+	case "text":
+		return DisplayText(d.Item, d.Out, d.NoHeaders, d.columns())
+	case "csv":
+		return DisplayCSV(d.Item, d.Out, d.NoHeaders, d.columns(), ',')
+	case "tsv":
+		return DisplayCSV(d.Item, d.Out, d.NoHeaders, d.columns(), '\t')
 	default:
 		return fmt.Errorf("unknown output type")
 	}
 }
 
+// Streamable reports whether a list may be displayed in parts, one after the
+// other, rather than all at once: csv and tsv output which isn't sorted,
+// templated or queried. Text output is aligned across the whole list, so it
+// isn't streamable.
+func (d *Displayer) Streamable() bool {
+	switch {
+	case d.SortBy != "", d.Template != "", d.Query != "":
+		return false
+	}
+
+	switch d.OutputType {
+	case "csv", "tsv":
+		return true
+	}
+	return false
+}
+
+// columns returns the columns requested with --format.
+func (d *Displayer) columns() []string {
+	var cols []string
+	for _, c := range strings.Split(strings.Join(strings.Fields(d.ColumnList), ""), ",") {
+		if c != "" {
+			cols = append(cols, c)
+		}
+	}
+
+	return cols
+}
+
 // DisplayText writes tabbed content to the passed in io.Writer
 // while potentially adding or removing headers.
 func DisplayText(item Displayable, out io.Writer, noHeaders bool, includeCols []string) error {
@@ -117,6 +176,119 @@ func DisplayText(item Displayable, out io.Writer, noHeaders bool, includeCols []
 	return w.Flush()
 }
 
+// DisplayCSV writes the item's columns as delimiter separated values to the
+// passed in io.Writer, quoting values where required.
+func DisplayCSV(item Displayable, out io.Writer, noHeaders bool, includeCols []string, delimiter rune) error {
+	w := csv.NewWriter(out)
+	w.Comma = delimiter
+
+	cols := item.Cols()
+	if len(includeCols) > 0 && includeCols[0] != "" {
+		cols = includeCols
+	}
+
+	colMap := item.ColMap()
+	headers := make([]string, 0, len(cols))
+	for _, k := range cols {
+		col := colMap[k]
+		if col == "" {
+			return fmt.Errorf("unknown column %q", k)
+		}
+
+		headers = append(headers, col)
+	}
+
+	if !noHeaders {
+		if err := w.Write(headers); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range item.KV() {
+		record := make([]string, 0, len(cols))
+		for _, col := range cols {
+			record = append(record, formatValue(r[col]))
+		}
+
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// formatValue renders a single KV value as a string. Slices are joined with
+// commas and maps are rendered as comma separated key=value pairs sorted by
+// key, so that the output does not depend on Go's %v formatting.
+func formatValue(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case int:
+		return strconv.Itoa(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case time.Time:
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	case fmt.Stringer:
+		return t.String()
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return ""
+		}
+		return formatValue(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			return string(rv.Bytes())
+		}
+		items := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			items = append(items, formatValue(rv.Index(i).Interface()))
+		}
+		return strings.Join(items, ",")
+	case reflect.Map:
+		keys := make([]string, 0, rv.Len())
+		values := make(map[string]string, rv.Len())
+		for _, k := range rv.MapKeys() {
+			key := formatValue(k.Interface())
+			keys = append(keys, key)
+			values[key] = formatValue(rv.MapIndex(k).Interface())
+		}
+		sort.Strings(keys)
+
+		pairs := make([]string, 0, len(keys))
+		for _, k := range keys {
+			pairs = append(pairs, k+"="+values[k])
+		}
+		return strings.Join(pairs, ",")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 32)
+	case reflect.Struct:
+		if b, err := json.Marshal(v); err == nil {
+			return string(b)
+		}
+	}
+
+	return fmt.Sprint(v)
+}
+
 func writeJSON(item interface{}, w io.Writer) error {
 	b, err := json.Marshal(item)
 	if err != nil {
@@ -133,6 +305,89 @@ func writeJSON(item interface{}, w io.Writer) error {
 	return err
 }
 
+// DisplayYAML writes the item as YAML to the passed in io.Writer. The YAML is
+// derived from the item's JSON so both formats share the same field names and
+// ordering.
+func DisplayYAML(item Displayable, out io.Writer) error {
+	var buf bytes.Buffer
+	if err := item.JSON(&buf); err != nil {
+		return err
+	}
+
+	b, err := jsonToYAML(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = out.Write(b)
+	return err
+}
+
+// jsonToYAML converts a JSON document to YAML, keeping object keys in the
+// order they appear in the JSON.
+func jsonToYAML(j []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.UseNumber()
+
+	v, err := decodeOrdered(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(v)
+}
+
+// decodeOrdered decodes the next JSON value from dec, using yaml.MapSlice for
+// objects so that key order survives the conversion.
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			m := yaml.MapSlice{}
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+
+				val, err := decodeOrdered(dec)
+				if err != nil {
+					return nil, err
+				}
+
+				m = append(m, yaml.MapItem{Key: key, Value: val})
+			}
+			_, err := dec.Token()
+			return m, err
+		case '[':
+			l := []interface{}{}
+			for dec.More() {
+				val, err := decodeOrdered(dec)
+				if err != nil {
+					return nil, err
+				}
+
+				l = append(l, val)
+			}
+			_, err := dec.Token()
+			return l, err
+		}
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i, nil
+		}
+		return t.Float64()
+	}
+
+	return tok, nil
+}
+
 // containsOnlyNiSlice returns true if the given interface's concrete type is
 // a pointer to a struct that contains a single nil slice field.
 func containsOnlyNilSlice(i interface{}) bool {
@@ -2,9 +2,12 @@ package displayers
 
 import (
 	"bytes"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -46,3 +49,195 @@ func TestDisplayerDisplay(t *testing.T) {
 		})
 	}
 }
+
+func TestDisplayerDisplayYAML(t *testing.T) {
+	emptyVolumes := make([]do.Volume, 0)
+	var nilVolumes []do.Volume
+
+	volumes := []do.Volume{
+		{Volume: &godo.Volume{
+			ID:            "vol-1",
+			Name:          "data",
+			SizeGigaBytes: 100,
+			Region:        &godo.Region{Slug: "nyc1"},
+			DropletIDs:    []int{1, 2},
+			Tags:          []string{"yes", "web"},
+		}},
+	}
+
+	tests := []struct {
+		name         string
+		item         Displayable
+		expectedYAML string
+	}{
+		{
+			name:         "displaying a non-nil slice of Volumes should return an empty YAML list",
+			item:         &Volume{Volumes: emptyVolumes},
+			expectedYAML: "[]\n",
+		},
+		{
+			name:         "displaying a nil slice of Volumes should return an empty YAML list",
+			item:         &Volume{Volumes: nilVolumes},
+			expectedYAML: "[]\n",
+		},
+		{
+			name: "displaying Volumes uses the JSON field names in order",
+			item: &Volume{Volumes: volumes},
+			expectedYAML: `- id: vol-1
+  region:
+    slug: nyc1
+  name: data
+  size_gigabytes: 100
+  description: ""
+  droplet_ids:
+  - 1
+  - 2
+  created_at: "0001-01-01T00:00:00Z"
+  filesystem_type: ""
+  filesystem_label: ""
+  tags:
+  - "yes"
+  - web
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+
+			displayer := Displayer{
+				OutputType: "yaml",
+				Item:       tt.item,
+				Out:        out,
+			}
+
+			err := displayer.Display()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedYAML, out.String())
+		})
+	}
+}
+
+// rows is a Displayable of arbitrary rows with the columns Name and Value.
+type rows []map[string]interface{}
+
+func (r rows) Cols() []string { return []string{"Name", "Value"} }
+
+func (r rows) ColMap() map[string]string {
+	return map[string]string{"Name": "Name", "Value": "Value"}
+}
+
+func (r rows) KV() []map[string]interface{} { return r }
+
+func (r rows) JSON(out io.Writer) error { return writeJSON(r, out) }
+
+func TestDisplayerDisplayCSV(t *testing.T) {
+	item := rows{
+		{"Name": "plain", "Value": "value"},
+		{"Name": "comma", "Value": "a,b"},
+		{"Name": "quote", "Value": `say "hi"`},
+		{"Name": "newline", "Value": "line 1\nline 2"},
+		{"Name": "tab", "Value": "a\tb"},
+		{"Name": "space", "Value": " padded"},
+		{"Name": "tags", "Value": []string{"web", "db"}},
+	}
+
+	tests := []struct {
+		name       string
+		item       rows
+		outputType string
+		noHeaders  bool
+		columns    string
+		expected   string
+	}{
+		{
+			name:       "csv quotes values containing commas, quotes, newlines and leading spaces",
+			item:       item,
+			outputType: "csv",
+			expected: `Name,Value
+plain,value
+comma,"a,b"
+quote,"say ""hi"""
+newline,"line 1
+line 2"
+tab,a	b
+space," padded"
+tags,"web,db"
+`,
+		},
+		{
+			name:       "tsv quotes values containing tabs, quotes, newlines and leading spaces",
+			item:       item,
+			outputType: "tsv",
+			noHeaders:  true,
+			expected: "plain\tvalue\n" +
+				"comma\ta,b\n" +
+				"quote\t\"say \"\"hi\"\"\"\n" +
+				"newline\t\"line 1\nline 2\"\n" +
+				"tab\t\"a\tb\"\n" +
+				"space\t\" padded\"\n" +
+				"tags\tweb,db\n",
+		},
+		{
+			name:       "csv shows the selected columns in order",
+			item:       item[:2],
+			outputType: "csv",
+			columns:    "Value, Name",
+			expected:   "Value,Name\nvalue,plain\n\"a,b\",comma\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+
+			displayer := Displayer{
+				OutputType: tt.outputType,
+				NoHeaders:  tt.noHeaders,
+				ColumnList: tt.columns,
+				Item:       tt.item,
+				Out:        out,
+			}
+
+			err := displayer.Display()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+
+	err := (&Displayer{OutputType: "csv", ColumnList: "Size", Item: item, Out: &bytes.Buffer{}}).Display()
+	assert.EqualError(t, err, `unknown column "Size"`)
+}
+
+func TestFormatValue(t *testing.T) {
+	created := time.Date(2019, 3, 1, 12, 30, 0, 0, time.UTC)
+	name := "web"
+	var noName *string
+
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{nil, ""},
+		{"web", "web"},
+		{true, "true"},
+		{42, "42"},
+		{int64(-7), "-7"},
+		{uint8(8), "8"},
+		{1.5, "1.5"},
+		{float32(0.25), "0.25"},
+		{created, "2019-03-01T12:30:00Z"},
+		{time.Time{}, ""},
+		{&name, "web"},
+		{noName, ""},
+		{[]string{"a", "b,c"}, "a,b,c"},
+		{[]int{1, 2}, "1,2"},
+		{[]byte("raw"), "raw"},
+		{map[string]int{"b": 2, "a": 1}, "a=1,b=2"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, formatValue(tt.value), "%#v", tt.value)
+	}
+}
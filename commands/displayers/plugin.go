@@ -15,9 +15,18 @@ package displayers
 
 import "io"
 
+// PlugDesc describes a doctl-<name> plugin executable.
 type PlugDesc struct {
-	Path string `json:"path"`
 	Name string `json:"name"`
+	// Version is known for the plugins installed from an index.
+	Version string `json:"version,omitempty"`
+	Path    string `json:"path"`
+	// Source is where the plugin was found: PATH, or the index, url or
+	// file it was installed from.
+	Source string `json:"source"`
+	// ShadowedBy is the built-in command or plugin run in place of this
+	// one, if any.
+	ShadowedBy string `json:"shadowed_by,omitempty"`
 }
 
 type Plugin struct {
@@ -32,13 +41,17 @@ func (p *Plugin) JSON(out io.Writer) error {
 
 func (p *Plugin) Cols() []string {
 	return []string{
-		"Name",
+		"Name", "Version", "Path", "Source", "ShadowedBy",
 	}
 }
 
 func (p *Plugin) ColMap() map[string]string {
 	return map[string]string{
-		"Name": "Name",
+		"Name":       "Name",
+		"Version":    "Version",
+		"Path":       "Path",
+		"Source":     "Source",
+		"ShadowedBy": "Shadowed By",
 	}
 }
 
@@ -47,7 +60,11 @@ func (p *Plugin) KV() []map[string]interface{} {
 
 	for _, plug := range p.Plugins {
 		o := map[string]interface{}{
-			"Name": plug.Name,
+			"Name":       plug.Name,
+			"Version":    plug.Version,
+			"Path":       plug.Path,
+			"Source":     plug.Source,
+			"ShadowedBy": plug.ShadowedBy,
 		}
 
 		out = append(out, o)
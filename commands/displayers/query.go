@@ -0,0 +1,134 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package displayers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+// DisplayQuery evaluates the JMESPath expression against the item's JSON and
+// writes the result. Text output prints strings and numbers raw, one per line
+// for lists, while the json and yaml output types keep their encoding.
+func DisplayQuery(item Displayable, out io.Writer, outputType, expr string) error {
+	q, err := jmespath.Compile(expr)
+	if err != nil {
+		if serr, ok := err.(jmespath.SyntaxError); ok {
+			return fmt.Errorf("invalid query: %v\n%s", serr, serr.HighlightLocation())
+		}
+		return fmt.Errorf("invalid query: %v", err)
+	}
+
+	var data interface{} = []interface{}{}
+	if !containsOnlyNilSlice(item) {
+		var buf bytes.Buffer
+		if err := item.JSON(&buf); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(buf.Bytes(), &data); err != nil {
+			return err
+		}
+	}
+
+	result, err := q.Search(data)
+	if err != nil {
+		return fmt.Errorf("unable to evaluate query: %v", err)
+	}
+
+	switch outputType {
+	case "json":
+		if err := writeJSON(result, out); err != nil {
+			return err
+		}
+		_, err := io.WriteString(out, "\n")
+		return err
+	case "yaml":
+		b, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		if b, err = jsonToYAML(b); err != nil {
+			return err
+		}
+		_, err = out.Write(b)
+		return err
+	case "text":
+		return writeRaw(result, out)
+	}
+
+	return fmt.Errorf("--query is not supported with %s output", outputType)
+}
+
+// writeRaw writes scalars without JSON quoting. Lists of scalars are written
+// one per line and anything else falls back to JSON.
+func writeRaw(v interface{}, out io.Writer) error {
+	if l, ok := v.([]interface{}); ok && allScalars(l) {
+		for _, item := range l {
+			if _, err := fmt.Fprintln(out, rawScalar(item)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !isScalar(v) {
+		if err := writeJSON(v, out); err != nil {
+			return err
+		}
+		_, err := io.WriteString(out, "\n")
+		return err
+	}
+
+	_, err := fmt.Fprintln(out, rawScalar(v))
+	return err
+}
+
+func allScalars(l []interface{}) bool {
+	for _, v := range l {
+		if !isScalar(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case nil, string, float64, bool:
+		return true
+	}
+
+	return false
+}
+
+func rawScalar(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	}
+
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,114 @@
+package displayers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisplayQuery(t *testing.T) {
+	droplets := do.Droplets{
+		{Droplet: &godo.Droplet{
+			ID:     1,
+			Name:   "web-01",
+			Status: "active",
+			Networks: &godo.Networks{
+				V4: []godo.NetworkV4{{IPAddress: "203.0.113.1", Type: "public"}},
+			},
+		}},
+		{Droplet: &godo.Droplet{
+			ID:     2,
+			Name:   "web-02",
+			Status: "off",
+			Networks: &godo.Networks{
+				V4: []godo.NetworkV4{{IPAddress: "203.0.113.2", Type: "public"}},
+			},
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		outputType string
+		query      string
+		expected   string
+	}{
+		{
+			name:       "text prints lists of scalars raw",
+			outputType: "text",
+			query:      "[].networks.v4[0].ip_address",
+			expected:   "203.0.113.1\n203.0.113.2\n",
+		},
+		{
+			name:       "text prints a single scalar raw",
+			outputType: "text",
+			query:      "[?status=='off'] | [0].id",
+			expected:   "2\n",
+		},
+		{
+			name:       "text falls back to json for objects",
+			outputType: "text",
+			query:      "[0].{name: name, id: id}",
+			expected:   "{\n  \"id\": 1,\n  \"name\": \"web-01\"\n}\n",
+		},
+		{
+			name:       "json keeps the encoding",
+			outputType: "json",
+			query:      "[].name",
+			expected:   "[\n  \"web-01\",\n  \"web-02\"\n]\n",
+		},
+		{
+			name:       "yaml keeps the encoding",
+			outputType: "yaml",
+			query:      "[].name",
+			expected:   "- web-01\n- web-02\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+
+			displayer := Displayer{
+				OutputType: tt.outputType,
+				Query:      tt.query,
+				Item:       &Droplet{Droplets: droplets},
+				Out:        out,
+			}
+
+			require.NoError(t, displayer.Display())
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+
+	t.Run("queries an empty list", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		err := DisplayQuery(&Droplet{}, out, "json", "length(@)")
+		require.NoError(t, err)
+		assert.Equal(t, "0\n", out.String())
+	})
+
+	t.Run("reports invalid expressions", func(t *testing.T) {
+		err := DisplayQuery(&Droplet{Droplets: droplets}, &bytes.Buffer{}, "text", "[].name[")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid query: SyntaxError")
+		assert.Contains(t, err.Error(), "[].name[\n")
+	})
+
+	t.Run("rejects combining a query with a template", func(t *testing.T) {
+		displayer := Displayer{
+			OutputType: "text",
+			Query:      "[].name",
+			Template:   "{{.Name}}",
+			Item:       &Droplet{Droplets: droplets},
+			Out:        &bytes.Buffer{},
+		}
+
+		assert.EqualError(t, displayer.Display(), "--template and --query cannot be used together")
+	})
+}
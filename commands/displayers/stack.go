@@ -0,0 +1,66 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package displayers
+
+import (
+	"io"
+	"strings"
+)
+
+// StackChange is a step of a stack plan.
+type StackChange struct {
+	Action  string   `json:"action"`
+	Type    string   `json:"type"`
+	Name    string   `json:"name"`
+	Details []string `json:"details,omitempty"`
+}
+
+type StackPlan struct {
+	Changes []StackChange
+}
+
+var _ Displayable = &StackPlan{}
+
+func (p *StackPlan) JSON(out io.Writer) error {
+	return writeJSON(p.Changes, out)
+}
+
+func (p *StackPlan) Cols() []string {
+	return []string{"Action", "Type", "Name", "Details"}
+}
+
+func (p *StackPlan) ColMap() map[string]string {
+	return map[string]string{
+		"Action":  "Action",
+		"Type":    "Type",
+		"Name":    "Name",
+		"Details": "Details",
+	}
+}
+
+func (p *StackPlan) KV() []map[string]interface{} {
+	out := []map[string]interface{}{}
+
+	for _, c := range p.Changes {
+		o := map[string]interface{}{
+			"Action":  c.Action,
+			"Type":    c.Type,
+			"Name":    c.Name,
+			"Details": strings.Join(c.Details, "; "),
+		}
+		out = append(out, o)
+	}
+
+	return out
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package displayers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/dustin/go-humanize"
+)
+
+// now is used by the since template function and is replaced in tests.
+var now = time.Now
+
+// TemplateFuncs are the helper functions available to --template.
+var TemplateFuncs = template.FuncMap{
+	"join":          templateJoin,
+	"json":          templateJSON,
+	"upper":         templateUpper,
+	"default":       templateDefault,
+	"humanizeBytes": humanizeBytes,
+	"since":         since,
+	"first":         firstIPv4,
+}
+
+// DisplayTemplate renders the Go template text once for every item in the
+// displayable's list, writing each result on its own line.
+func DisplayTemplate(item Displayable, out io.Writer, text string) error {
+	t, err := template.New("output").Funcs(TemplateFuncs).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %v", err)
+	}
+
+	for _, v := range templateItems(item) {
+		if err := t.Execute(out, v); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(out, "\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// templateItems returns the values a template is executed against. Displayers
+// wrap the API objects in their first field, which is either a list of
+// objects or a single object.
+func templateItems(item Displayable) []interface{} {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct || v.NumField() == 0 {
+		return []interface{}{item}
+	}
+
+	field := v.Field(0)
+	switch field.Kind() {
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, 0, field.Len())
+		for i := 0; i < field.Len(); i++ {
+			items = append(items, field.Index(i).Interface())
+		}
+		return items
+	case reflect.Ptr:
+		if field.IsNil() {
+			return nil
+		}
+	}
+
+	return []interface{}{field.Interface()}
+}
+
+// templateJoin joins the elements of a list with sep, e.g.
+// {{join "," .Tags}}.
+func templateJoin(sep string, v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return formatValue(v)
+	}
+
+	items := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		items = append(items, formatValue(rv.Index(i).Interface()))
+	}
+
+	return strings.Join(items, sep)
+}
+
+func templateJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func templateUpper(v interface{}) string {
+	return strings.ToUpper(formatValue(v))
+}
+
+// templateDefault returns v unless it is empty, in which case def is
+// returned, e.g. {{.Description | default "none"}}.
+func templateDefault(def, v interface{}) interface{} {
+	if v == nil {
+		return def
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		if rv.Len() == 0 {
+			return def
+		}
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return def
+		}
+	default:
+		if rv.IsZero() {
+			return def
+		}
+	}
+
+	return v
+}
+
+// humanizeBytes formats a number of bytes using binary units, e.g. 1.5 GiB.
+func humanizeBytes(v interface{}) (string, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() >= 0 {
+			return humanize.IBytes(uint64(rv.Int())), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return humanize.IBytes(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		if rv.Float() >= 0 {
+			return humanize.IBytes(uint64(rv.Float())), nil
+		}
+	}
+
+	return "", fmt.Errorf("humanizeBytes: expected a non-negative number, got %v", v)
+}
+
+// since returns how long ago a time was, e.g. 3h. It accepts times and
+// RFC 3339 timestamps as returned by the API.
+func since(v interface{}) (string, error) {
+	var t time.Time
+
+	switch tv := v.(type) {
+	case time.Time:
+		t = tv
+	case *time.Time:
+		if tv == nil {
+			return "", nil
+		}
+		t = *tv
+	case godo.Timestamp:
+		t = tv.Time
+	case string:
+		if tv == "" {
+			return "", nil
+		}
+		var err error
+		if t, err = time.Parse(time.RFC3339, tv); err != nil {
+			return "", fmt.Errorf("since: %v", err)
+		}
+	default:
+		return "", fmt.Errorf("since: expected a time, got %T", v)
+	}
+
+	if t.IsZero() {
+		return "", nil
+	}
+
+	d := now().Sub(t)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds())), nil
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes())), nil
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours())), nil
+	}
+
+	return fmt.Sprintf("%dd", int(d.Hours()/24)), nil
+}
+
+// firstIPv4 returns the first public or private IPv4 address of a droplet
+// or its networks, e.g. {{first "public" .}}.
+func firstIPv4(kind string, v interface{}) (string, error) {
+	if kind != "public" && kind != "private" {
+		return "", fmt.Errorf("first: unknown network type %q, expected public or private", kind)
+	}
+
+	var networks *godo.Networks
+	switch tv := v.(type) {
+	case do.Droplet:
+		if tv.Droplet != nil {
+			networks = tv.Networks
+		}
+	case *do.Droplet:
+		if tv != nil && tv.Droplet != nil {
+			networks = tv.Networks
+		}
+	case godo.Droplet:
+		networks = tv.Networks
+	case *godo.Droplet:
+		if tv != nil {
+			networks = tv.Networks
+		}
+	case godo.Networks:
+		networks = &tv
+	case *godo.Networks:
+		networks = tv
+	default:
+		return "", fmt.Errorf("first: expected a droplet or its networks, got %T", v)
+	}
+
+	if networks == nil {
+		return "", nil
+	}
+
+	for _, n := range networks.V4 {
+		if n.Type == kind {
+			return n.IPAddress, nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,154 @@
+package displayers
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisplayTemplate(t *testing.T) {
+	droplets := do.Droplets{
+		{Droplet: &godo.Droplet{
+			ID:   1,
+			Name: "web-01",
+			Tags: []string{"web", "prod"},
+			Networks: &godo.Networks{
+				V4: []godo.NetworkV4{
+					{IPAddress: "10.0.0.1", Type: "private"},
+					{IPAddress: "203.0.113.1", Type: "public"},
+				},
+			},
+		}},
+		{Droplet: &godo.Droplet{
+			ID:   2,
+			Name: "db-01",
+		}},
+	}
+
+	tests := []struct {
+		name     string
+		item     Displayable
+		template string
+		expected string
+	}{
+		{
+			name:     "renders every item in the list",
+			item:     &Droplet{Droplets: droplets},
+			template: `{{.ID}} {{upper .Name}} {{join "," .Tags | default "-"}} {{first "public" .}} {{first "private" .}}`,
+			expected: "1 WEB-01 web,prod 203.0.113.1 10.0.0.1\n2 DB-01 -  \n",
+		},
+		{
+			name:     "renders single objects once",
+			item:     &Account{Account: &do.Account{Account: &godo.Account{Email: "user@example.com", DropletLimit: 10}}},
+			template: `{{.Email}} {{.DropletLimit}}`,
+			expected: "user@example.com 10\n",
+		},
+		{
+			name:     "renders values as json",
+			item:     &Droplet{Droplets: droplets[:1]},
+			template: `{{json .Tags}}`,
+			expected: "[\"web\",\"prod\"]\n",
+		},
+		{
+			name:     "renders nothing for an empty list",
+			item:     &Droplet{},
+			template: `{{.ID}}`,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+
+			displayer := Displayer{
+				OutputType: "text",
+				Template:   tt.template,
+				Item:       tt.item,
+				Out:        out,
+			}
+
+			err := displayer.Display()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+
+	t.Run("reports invalid templates", func(t *testing.T) {
+		err := DisplayTemplate(&Droplet{Droplets: droplets}, &bytes.Buffer{}, "{{.ID")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid template")
+	})
+}
+
+func TestHumanizeBytes(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{value: 512, expected: "512 B"},
+		{value: int64(1536), expected: "1.5 KiB"},
+		{value: uint64(5 * 1024 * 1024 * 1024), expected: "5.0 GiB"},
+		{value: 1024.0 * 1024, expected: "1.0 MiB"},
+	}
+
+	for _, tt := range tests {
+		got, err := humanizeBytes(tt.value)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, got)
+	}
+
+	_, err := humanizeBytes("lots")
+	assert.Error(t, err)
+}
+
+func TestSince(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2019, 10, 10, 12, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{value: "2019-10-10T11:59:30Z", expected: "30s"},
+		{value: "2019-10-10T11:15:00Z", expected: "45m"},
+		{value: time.Date(2019, 10, 10, 9, 0, 0, 0, time.UTC), expected: "3h"},
+		{value: godo.Timestamp{Time: time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)}, expected: "9d"},
+		{value: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		got, err := since(tt.value)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, got)
+	}
+
+	_, err := since("yesterday")
+	assert.Error(t, err)
+}
+
+func TestFirstIPv4(t *testing.T) {
+	networks := &godo.Networks{
+		V4: []godo.NetworkV4{
+			{IPAddress: "203.0.113.1", Type: "public"},
+			{IPAddress: "203.0.113.2", Type: "public"},
+		},
+	}
+
+	got, err := firstIPv4("public", networks)
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.1", got)
+
+	got, err = firstIPv4("private", networks)
+	require.NoError(t, err)
+	assert.Equal(t, "", got)
+
+	_, err = firstIPv4("floating", networks)
+	assert.Error(t, err)
+}
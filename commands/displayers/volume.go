@@ -14,7 +14,6 @@ limitations under the License.
 package displayers
 
 import (
-	"fmt"
 	"io"
 	"strconv"
 	"strings"
@@ -70,7 +69,7 @@ func (a *Volume) KV() []map[string]interface{} {
 		}
 		m["DropletIDs"] = ""
 		if len(volume.DropletIDs) != 0 {
-			m["DropletIDs"] = fmt.Sprintf("%v", volume.DropletIDs)
+			m["DropletIDs"] = volume.DropletIDs
 		}
 		out = append(out, m)
 
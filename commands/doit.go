@@ -19,6 +19,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/fatih/color"
@@ -45,12 +46,32 @@ var (
 	APIURL string
 	//Context current auth context
 	Context string
+	//DryRun prints mutating API requests instead of sending them
+	DryRun bool
+	//NoCache bypasses the cache of API responses
+	NoCache bool
+	//Quiet silences the progress shown while waiting
+	Quiet bool
 	//Output global output format
 	Output string
+	//Query global JMESPath expression evaluated against the JSON output
+	Query string
+	//Record path of a cassette API interactions are recorded to
+	Record string
+	//Replay path of a cassette API interactions are replayed from
+	Replay string
+	//Template global Go template applied to each item of the output
+	Template string
+	//Timeout global limit on how long a command may run
+	Timeout time.Duration
 	//Token global authorization token
 	Token string
 	//Trace toggles http tracing output
 	Trace bool
+	//TraceFile path of a HAR file API requests are traced to
+	TraceFile string
+	//WaitTimeout global limit on how long waiting for a resource may take
+	WaitTimeout time.Duration
 	//Verbose toggle verbose output on and off
 	Verbose bool
 
@@ -73,11 +94,33 @@ func init() {
 	rootPFlagSet.StringVarP(&Token, doctl.ArgAccessToken, "t", "", "API V2 Access Token")
 	viper.BindPFlag(doctl.ArgAccessToken, rootPFlagSet.Lookup(doctl.ArgAccessToken))
 
-	rootPFlagSet.StringVarP(&Output, doctl.ArgOutput, "o", "text", "output format [text|json]")
+	rootPFlagSet.StringVarP(&Output, doctl.ArgOutput, "o", "text", "output format [text|json|yaml|csv|tsv]")
 	viper.BindPFlag("output", rootPFlagSet.Lookup(doctl.ArgOutput))
 
+	rootPFlagSet.StringVarP(&Template, doctl.ArgTemplate, "", "", "Go template applied to each item of the output, e.g. {{.ID}} {{.Name}}; overrides --output")
+	viper.BindPFlag(doctl.ArgTemplate, rootPFlagSet.Lookup(doctl.ArgTemplate))
+
+	rootPFlagSet.StringVarP(&Query, doctl.ArgQuery, "", "", "JMESPath expression evaluated against the JSON output, e.g. [].networks.v4[0].ip_address; text output prints scalars raw")
+	viper.BindPFlag(doctl.ArgQuery, rootPFlagSet.Lookup(doctl.ArgQuery))
+
 	rootPFlagSet.StringVarP(&Context, doctl.ArgContext, "", "", "authentication context")
 	rootPFlagSet.BoolVarP(&Trace, "trace", "", false, "trace api access")
+	rootPFlagSet.StringVarP(&TraceFile, doctl.ArgTraceFile, "", "", "write a HAR 1.2 trace of API requests, with secrets redacted, to a file")
+	viper.BindPFlag(doctl.ArgTraceFile, rootPFlagSet.Lookup(doctl.ArgTraceFile))
+	rootPFlagSet.StringVarP(&Record, doctl.ArgRecord, "", "", "record API requests and responses, with tokens scrubbed, to a cassette file")
+	viper.BindPFlag(doctl.ArgRecord, rootPFlagSet.Lookup(doctl.ArgRecord))
+	rootPFlagSet.StringVarP(&Replay, doctl.ArgReplay, "", "", "serve API responses from a cassette file instead of the API")
+	viper.BindPFlag(doctl.ArgReplay, rootPFlagSet.Lookup(doctl.ArgReplay))
+	rootPFlagSet.BoolVarP(&DryRun, doctl.ArgDryRun, "", false, "print the API requests that would change resources instead of sending them; read-only requests are still sent")
+	viper.BindPFlag(doctl.ArgDryRun, rootPFlagSet.Lookup(doctl.ArgDryRun))
+	rootPFlagSet.BoolVarP(&NoCache, doctl.ArgNoCache, "", false, "don't use or update the cache of regions, sizes, images and Kubernetes options")
+	viper.BindPFlag(doctl.ArgNoCache, rootPFlagSet.Lookup(doctl.ArgNoCache))
+	rootPFlagSet.DurationVarP(&Timeout, doctl.ArgTimeout, "", 0, "maximum time a command may run, e.g. 30s or 5m (0 means no limit)")
+	viper.BindPFlag(doctl.ArgTimeout, rootPFlagSet.Lookup(doctl.ArgTimeout))
+	rootPFlagSet.DurationVarP(&WaitTimeout, doctl.ArgWaitTimeout, "", 0, "maximum time --wait may wait for a resource, e.g. 10m (0 means no limit)")
+	viper.BindPFlag(doctl.ArgWaitTimeout, rootPFlagSet.Lookup(doctl.ArgWaitTimeout))
+	rootPFlagSet.BoolVarP(&Quiet, doctl.ArgQuiet, "q", false, "don't show progress while waiting")
+	viper.BindPFlag(doctl.ArgQuiet, rootPFlagSet.Lookup(doctl.ArgQuiet))
 	rootPFlagSet.BoolVarP(&Verbose, doctl.ArgVerbose, "v", false, "verbose output")
 
 	addCommands()
@@ -118,9 +161,14 @@ func configHome() string {
 
 // Execute executes the current command using DoitCmd.
 func Execute() {
+	// Plugins come last, so built-in commands shadow them.
+	if pluginsNeeded(DoitCmd, os.Args[1:]) {
+		addPlugins(DoitCmd)
+	}
+
 	if err := DoitCmd.Execute(); err != nil {
 		fmt.Println(err)
-		os.Exit(-1)
+		os.Exit(ExitUsage)
 	}
 }
 
@@ -128,13 +176,22 @@ func Execute() {
 func addCommands() {
 	DoitCmd.AddCommand(Account())
 	DoitCmd.AddCommand(Auth())
+	DoitCmd.AddCommand(Batch())
+	DoitCmd.AddCommand(Cache())
 	DoitCmd.AddCommand(Completion())
+	DoitCmd.AddCommand(Complete())
 	DoitCmd.AddCommand(computeCmd())
 	DoitCmd.AddCommand(Kubernetes())
 	DoitCmd.AddCommand(Databases())
+	DoitCmd.AddCommand(Dev())
+	DoitCmd.AddCommand(Export())
+	DoitCmd.AddCommand(Plan())
+	DoitCmd.AddCommand(Apply())
+	DoitCmd.AddCommand(Plugin())
 	DoitCmd.AddCommand(Projects())
 	DoitCmd.AddCommand(Version())
 	DoitCmd.AddCommand(Registry())
+	DoitCmd.AddCommand(Shell())
 }
 
 func computeCmd() *Command {
@@ -158,7 +215,6 @@ func computeCmd() *Command {
 	cmd.AddCommand(Images())
 	cmd.AddCommand(ImageAction())
 	cmd.AddCommand(LoadBalancer())
-	cmd.AddCommand(Plugin())
 	cmd.AddCommand(Region())
 	cmd.AddCommand(Size())
 	cmd.AddCommand(Snapshot())
@@ -237,7 +293,8 @@ func flagName(cmd *Command, name string) string {
 	if cmd.Parent() != nil {
 		return fmt.Sprintf("%s.%s.%s", cmd.Parent().Name(), cmd.Name(), name)
 	}
-	return fmt.Sprintf("%s.%s", cmd.Name(), name)
+	// Commands built without a parent are added to the root command.
+	return fmt.Sprintf("%s.%s.%s", DoitCmd.Name(), cmd.Name(), name)
 }
 
 func cmdNS(cmd *cobra.Command) string {
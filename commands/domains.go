@@ -111,7 +111,7 @@ func RunDomainCreate(c *CmdConfig) error {
 		IPAddress: ipAddress,
 	}
 
-	d, err := ds.Create(req)
+	d, err := ds.Create(c.Ctx, req)
 	if err != nil {
 		return err
 	}
@@ -124,7 +124,7 @@ func RunDomainList(c *CmdConfig) error {
 
 	ds := c.Domains()
 
-	domains, err := ds.List()
+	domains, err := ds.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -146,7 +146,7 @@ func RunDomainGet(c *CmdConfig) error {
 		return errors.New("invalid domain name")
 	}
 
-	d, err := ds.Get(id)
+	d, err := ds.Get(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -174,7 +174,7 @@ func RunDomainDelete(c *CmdConfig) error {
 			return errors.New("invalid domain name")
 		}
 
-		err := ds.Delete(name)
+		err := ds.Delete(c.Ctx, name)
 		return err
 	}
 
@@ -194,7 +194,7 @@ func RunRecordList(c *CmdConfig) error {
 		return errors.New("domain name is missing")
 	}
 
-	list, err := ds.Records(name)
+	list, err := ds.Records(c.Ctx, name)
 	if err != nil {
 		return err
 	}
@@ -274,7 +274,7 @@ func RunRecordCreate(c *CmdConfig) error {
 		return errors.New("record request is missing type")
 	}
 
-	r, err := ds.CreateRecord(name, drcr)
+	r, err := ds.CreateRecord(c.Ctx, name, drcr)
 	if err != nil {
 		return err
 	}
@@ -309,7 +309,7 @@ func RunRecordDelete(c *CmdConfig) error {
 				return fmt.Errorf("invalid record id %q", i)
 			}
 
-			err = ds.DeleteRecord(domainName, id)
+			err = ds.DeleteRecord(c.Ctx, domainName, id)
 			if err != nil {
 				return err
 			}
@@ -393,7 +393,7 @@ func RunRecordUpdate(c *CmdConfig) error {
 		Tag:      rTag,
 	}
 
-	r, err := ds.EditRecord(domainName, recordID, drcr)
+	r, err := ds.EditRecord(c.Ctx, domainName, recordID, drcr)
 	if err != nil {
 		return err
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,7 +41,7 @@ func TestDomainsCommand(t *testing.T) {
 func TestDomainsCreate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		dcr := &godo.DomainCreateRequest{Name: "example.com", IPAddress: "127.0.0.1"}
-		tm.domains.EXPECT().Create(dcr).Return(&testDomain, nil)
+		tm.domains.EXPECT().Create(gomock.Any(), dcr).Return(&testDomain, nil)
 
 		config.Args = append(config.Args, testDomain.Name)
 		config.Doit.Set(config.NS, doctl.ArgIPAddress, "127.0.0.1")
@@ -51,7 +52,7 @@ func TestDomainsCreate(t *testing.T) {
 
 func TestDomainsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.domains.EXPECT().List().Return(testDomainList, nil)
+		tm.domains.EXPECT().List(gomock.Any()).Return(testDomainList, nil)
 
 		err := RunDomainList(config)
 		assert.NoError(t, err)
@@ -60,7 +61,7 @@ func TestDomainsList(t *testing.T) {
 
 func TestDomainsGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.domains.EXPECT().Get("example.com").Return(&testDomain, nil)
+		tm.domains.EXPECT().Get(gomock.Any(), "example.com").Return(&testDomain, nil)
 
 		config.Args = append(config.Args, testDomain.Name)
 		err := RunDomainGet(config)
@@ -77,7 +78,7 @@ func TestDomainsGet_DomainRequired(t *testing.T) {
 
 func TestDomainsDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.domains.EXPECT().Delete("example.com").Return(nil)
+		tm.domains.EXPECT().Delete(gomock.Any(), "example.com").Return(nil)
 
 		config.Args = append(config.Args, testDomain.Name)
 
@@ -97,7 +98,7 @@ func TestDomainsGet_RequiredArguments(t *testing.T) {
 
 func TestRecordsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.domains.EXPECT().Records("example.com").Return(testRecordList, nil)
+		tm.domains.EXPECT().Records(gomock.Any(), "example.com").Return(testRecordList, nil)
 
 		config.Args = append(config.Args, "example.com")
 
@@ -117,7 +118,7 @@ func TestRecordsCreate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		port := 0
 		dcer := &do.DomainRecordEditRequest{Type: "A", Name: "foo.example.com.", Data: "192.168.1.1", Priority: 0, Port: &port, TTL: 0, Weight: 0}
-		tm.domains.EXPECT().CreateRecord("example.com", dcer).Return(&testRecord, nil)
+		tm.domains.EXPECT().CreateRecord(gomock.Any(), "example.com", dcer).Return(&testRecord, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgRecordType, "A")
 		config.Doit.Set(config.NS, doctl.ArgRecordName, "foo.example.com.")
@@ -140,7 +141,7 @@ func TestRecordCreate_RequiredArguments(t *testing.T) {
 
 func TestRecordsDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.domains.EXPECT().DeleteRecord("example.com", 1).Return(nil)
+		tm.domains.EXPECT().DeleteRecord(gomock.Any(), "example.com", 1).Return(nil)
 
 		config.Args = append(config.Args, "example.com", "1")
 
@@ -155,7 +156,7 @@ func TestRecordsUpdate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		port := 0
 		dcer := &do.DomainRecordEditRequest{Type: "A", Name: "foo.example.com.", Data: "192.168.1.1", Priority: 0, Port: &port, TTL: 0, Weight: 0}
-		tm.domains.EXPECT().EditRecord("example.com", 1, dcer).Return(&testRecord, nil)
+		tm.domains.EXPECT().EditRecord(gomock.Any(), "example.com", 1, dcer).Return(&testRecord, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgRecordID, 1)
 		config.Doit.Set(config.NS, doctl.ArgRecordType, "A")
@@ -39,7 +39,7 @@ func performAction(c *CmdConfig, fn actionFn) error {
 	}
 
 	if wait {
-		a, err = actionWait(c, a.ID, 5)
+		a, err = actionWait(c, newWaiter("%s action %d", a.Type, a.ID), a.ID)
 		if err != nil {
 			return err
 		}
@@ -161,7 +161,7 @@ func RunDropletActionGet(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		dropletID, err := strconv.Atoi(c.Args[0])
+		dropletID, err := dropletResolver(c).resolveInt(c.Args[0])
 		if err != nil {
 			return nil, err
 		}
@@ -171,7 +171,7 @@ func RunDropletActionGet(c *CmdConfig) error {
 			return nil, err
 		}
 
-		a, err := das.Get(dropletID, actionID)
+		a, err := das.Get(c.Ctx, dropletID, actionID)
 		return a, err
 	}
 
@@ -184,12 +184,12 @@ func RunDropletActionEnableBackups(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 		if err != nil {
 			return nil, err
 		}
 
-		a, err := das.EnableBackups(id)
+		a, err := das.EnableBackups(c.Ctx, id)
 		return a, err
 	}
 
@@ -202,12 +202,12 @@ func RunDropletActionDisableBackups(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 		if err != nil {
 			return nil, err
 		}
 
-		a, err := das.DisableBackups(id)
+		a, err := das.DisableBackups(c.Ctx, id)
 		return a, err
 	}
 
@@ -220,12 +220,12 @@ func RunDropletActionReboot(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 		if err != nil {
 			return nil, err
 		}
 
-		a, err := das.Reboot(id)
+		a, err := das.Reboot(c.Ctx, id)
 		return a, err
 	}
 
@@ -238,13 +238,13 @@ func RunDropletActionPowerCycle(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
 		}
 
-		a, err := das.PowerCycle(id)
+		a, err := das.PowerCycle(c.Ctx, id)
 		return a, err
 	}
 
@@ -257,12 +257,12 @@ func RunDropletActionShutdown(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 		if err != nil {
 			return nil, fmt.Errorf("Could not convert args into integer")
 		}
 
-		a, err := das.Shutdown(id)
+		a, err := das.Shutdown(c.Ctx, id)
 		return a, err
 	}
 
@@ -275,13 +275,13 @@ func RunDropletActionPowerOff(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
 		}
 
-		a, err := das.PowerOff(id)
+		a, err := das.PowerOff(c.Ctx, id)
 		return a, err
 	}
 
@@ -294,13 +294,13 @@ func RunDropletActionPowerOn(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
 		}
 
-		a, err := das.PowerOn(id)
+		a, err := das.PowerOn(c.Ctx, id)
 		return a, err
 	}
 
@@ -313,13 +313,13 @@ func RunDropletActionPasswordReset(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
 		}
 
-		a, err := das.PasswordReset(id)
+		a, err := das.PasswordReset(c.Ctx, id)
 		return a, err
 	}
 
@@ -332,13 +332,13 @@ func RunDropletActionEnableIPv6(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
 		}
 
-		a, err := das.EnableIPv6(id)
+		a, err := das.EnableIPv6(c.Ctx, id)
 		return a, err
 	}
 
@@ -351,13 +351,13 @@ func RunDropletActionEnablePrivateNetworking(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
 		}
 
-		a, err := das.EnablePrivateNetworking(id)
+		a, err := das.EnablePrivateNetworking(c.Ctx, id)
 		return a, err
 	}
 
@@ -370,7 +370,7 @@ func RunDropletActionRestore(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
@@ -381,7 +381,7 @@ func RunDropletActionRestore(c *CmdConfig) error {
 			return nil, err
 		}
 
-		a, err := das.Restore(id, image)
+		a, err := das.Restore(c.Ctx, id, image)
 		return a, err
 	}
 
@@ -395,7 +395,7 @@ func RunDropletActionResize(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
@@ -411,7 +411,7 @@ func RunDropletActionResize(c *CmdConfig) error {
 			return nil, err
 		}
 
-		a, err := das.Resize(id, size, disk)
+		a, err := das.Resize(c.Ctx, id, size, disk)
 		return a, err
 	}
 
@@ -424,7 +424,7 @@ func RunDropletActionRebuild(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
@@ -437,9 +437,9 @@ func RunDropletActionRebuild(c *CmdConfig) error {
 
 		var a *do.Action
 		if i, aerr := strconv.Atoi(image); aerr == nil {
-			a, err = das.RebuildByImageID(id, i)
+			a, err = das.RebuildByImageID(c.Ctx, id, i)
 		} else {
-			a, err = das.RebuildByImageSlug(id, image)
+			a, err = das.RebuildByImageSlug(c.Ctx, id, image)
 		}
 		return a, err
 	}
@@ -453,7 +453,7 @@ func RunDropletActionRename(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
@@ -464,7 +464,7 @@ func RunDropletActionRename(c *CmdConfig) error {
 			return nil, err
 		}
 
-		a, err := das.Rename(id, name)
+		a, err := das.Rename(c.Ctx, id, name)
 		return a, err
 	}
 
@@ -477,7 +477,7 @@ func RunDropletActionChangeKernel(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
@@ -488,7 +488,7 @@ func RunDropletActionChangeKernel(c *CmdConfig) error {
 			return nil, err
 		}
 
-		a, err := das.ChangeKernel(id, kernel)
+		a, err := das.ChangeKernel(c.Ctx, id, kernel)
 		return a, err
 	}
 
@@ -501,7 +501,7 @@ func RunDropletActionSnapshot(c *CmdConfig) error {
 		if len(c.Args) != 1 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		id, err := strconv.Atoi(c.Args[0])
+		id, err := dropletResolver(c).resolveInt(c.Args[0])
 
 		if err != nil {
 			return nil, err
@@ -512,7 +512,7 @@ func RunDropletActionSnapshot(c *CmdConfig) error {
 			return nil, err
 		}
 
-		a, err := das.Snapshot(id, name)
+		a, err := das.Snapshot(c.Ctx, id, name)
 		return a, err
 	}
 
@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,7 +29,7 @@ func TestDropletActionCommand(t *testing.T) {
 
 func TestDropletActionsChangeKernel(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().ChangeKernel(1, 2).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().ChangeKernel(gomock.Any(), 1, 2).Return(&testAction, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgKernelID, 2)
 		config.Args = append(config.Args, "1")
@@ -39,7 +40,7 @@ func TestDropletActionsChangeKernel(t *testing.T) {
 }
 func TestDropletActionsEnableBackups(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().EnableBackups(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().EnableBackups(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -50,7 +51,7 @@ func TestDropletActionsEnableBackups(t *testing.T) {
 }
 func TestDropletActionsDisableBackups(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().DisableBackups(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().DisableBackups(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -61,7 +62,7 @@ func TestDropletActionsDisableBackups(t *testing.T) {
 }
 func TestDropletActionsEnableIPv6(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().EnableIPv6(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().EnableIPv6(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -72,7 +73,7 @@ func TestDropletActionsEnableIPv6(t *testing.T) {
 
 func TestDropletActionsEnablePrivateNetworking(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().EnablePrivateNetworking(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().EnablePrivateNetworking(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -82,7 +83,7 @@ func TestDropletActionsEnablePrivateNetworking(t *testing.T) {
 }
 func TestDropletActionsGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().Get(1, 2).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().Get(gomock.Any(), 1, 2).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -95,7 +96,7 @@ func TestDropletActionsGet(t *testing.T) {
 
 func TestDropletActionsPasswordReset(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().PasswordReset(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().PasswordReset(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -106,7 +107,7 @@ func TestDropletActionsPasswordReset(t *testing.T) {
 
 func TestDropletActionsPowerCycle(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().PowerCycle(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().PowerCycle(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -117,7 +118,7 @@ func TestDropletActionsPowerCycle(t *testing.T) {
 }
 func TestDropletActionsPowerOff(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().PowerOff(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().PowerOff(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -127,7 +128,7 @@ func TestDropletActionsPowerOff(t *testing.T) {
 }
 func TestDropletActionsPowerOn(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().PowerOn(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().PowerOn(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -138,7 +139,7 @@ func TestDropletActionsPowerOn(t *testing.T) {
 }
 func TestDropletActionsReboot(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().Reboot(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().Reboot(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -149,7 +150,7 @@ func TestDropletActionsReboot(t *testing.T) {
 
 func TestDropletActionsRebuildByImageID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().RebuildByImageID(1, 2).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().RebuildByImageID(gomock.Any(), 1, 2).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -162,7 +163,7 @@ func TestDropletActionsRebuildByImageID(t *testing.T) {
 
 func TestDropletActionsRebuildByImageSlug(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().RebuildByImageSlug(1, "slug").Return(&testAction, nil)
+		tm.dropletActions.EXPECT().RebuildByImageSlug(gomock.Any(), 1, "slug").Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -175,7 +176,7 @@ func TestDropletActionsRebuildByImageSlug(t *testing.T) {
 }
 func TestDropletActionsRename(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().Rename(1, "name").Return(&testAction, nil)
+		tm.dropletActions.EXPECT().Rename(gomock.Any(), 1, "name").Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -188,7 +189,7 @@ func TestDropletActionsRename(t *testing.T) {
 
 func TestDropletActionsResize(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().Resize(1, "1gb", true).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().Resize(gomock.Any(), 1, "1gb", true).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -202,7 +203,7 @@ func TestDropletActionsResize(t *testing.T) {
 
 func TestDropletActionsRestore(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().Restore(1, 2).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().Restore(gomock.Any(), 1, 2).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -215,7 +216,7 @@ func TestDropletActionsRestore(t *testing.T) {
 
 func TestDropletActionsShutdown(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().Shutdown(1).Return(&testAction, nil)
+		tm.dropletActions.EXPECT().Shutdown(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -226,7 +227,7 @@ func TestDropletActionsShutdown(t *testing.T) {
 
 func TestDropletActionsSnapshot(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.dropletActions.EXPECT().Snapshot(1, "name").Return(&testAction, nil)
+		tm.dropletActions.EXPECT().Snapshot(gomock.Any(), 1, "name").Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -14,13 +14,13 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
-	"text/template"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
@@ -73,9 +73,8 @@ func Droplet() *Command {
 	AddBoolFlag(cmdRunDropletDelete, doctl.ArgForce, doctl.ArgShortForce, false, "Force droplet delete")
 	AddStringFlag(cmdRunDropletDelete, doctl.ArgTagName, "", "", "Tag name")
 
-	cmdRunDropletGet := CmdBuilder(cmd, RunDropletGet, "get <droplet-id>", "get droplet", Writer,
+	CmdBuilder(cmd, RunDropletGet, "get <droplet-id>", "get droplet", Writer,
 		aliasOpt("g"), displayerType(&displayers.Droplet{}))
-	AddStringFlag(cmdRunDropletGet, doctl.ArgTemplate, "", "", "Go template format. Few sample values:{{.ID}} {{.Name}} {{.Memory}} {{.Region.Name}} {{.Image}} {{.Tags}}")
 
 	CmdBuilder(cmd, RunDropletKernels, "kernels <droplet-id>", "droplet kernels", Writer,
 		aliasOpt("k"), displayerType(&displayers.Kernel{}))
@@ -106,12 +105,12 @@ func RunDropletActions(c *CmdConfig) error {
 
 	ds := c.Droplets()
 
-	id, err := getDropletIDArg(c.NS, c.Args)
+	id, err := getDropletIDArg(c)
 	if err != nil {
 		return err
 	}
 
-	list, err := ds.Actions(id)
+	list, err := ds.Actions(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -124,12 +123,12 @@ func RunDropletBackups(c *CmdConfig) error {
 
 	ds := c.Droplets()
 
-	id, err := getDropletIDArg(c.NS, c.Args)
+	id, err := getDropletIDArg(c)
 	if err != nil {
 		return err
 	}
 
-	list, err := ds.Backups(id)
+	list, err := ds.Backups(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -257,7 +256,7 @@ func RunDropletCreate(c *CmdConfig) error {
 			defer wg.Done()
 
 			if tagName != "" {
-				tag, err := ts.Get(tagName)
+				tag, err := ts.Get(c.Ctx, tagName)
 				if err != nil {
 					errs <- err
 					return
@@ -268,7 +267,7 @@ func RunDropletCreate(c *CmdConfig) error {
 				}
 			}
 
-			d, err := ds.Create(dcr, wait)
+			d, err := ds.Create(c.Ctx, dcr, false)
 			if err != nil {
 				errs <- err
 				return
@@ -281,7 +280,7 @@ func RunDropletCreate(c *CmdConfig) error {
 					},
 				}
 
-				err := ts.TagResources(tagName, trr)
+				err := ts.TagResources(c.Ctx, tagName, trr)
 				if err != nil {
 					errs <- err
 				}
@@ -295,21 +294,72 @@ func RunDropletCreate(c *CmdConfig) error {
 	wg.Wait()
 	close(errs)
 
-	item := &displayers.Droplet{Droplets: createdList}
-
 	for err := range errs {
 		if err != nil {
 			return err
 		}
 	}
+
+	if wait {
+		createdList, err = waitForDroplets(c, createdList)
+		if err != nil {
+			return err
+		}
+	}
+
+	item := &displayers.Droplet{Droplets: createdList}
 	c.Display(item)
 
 	return nil
 }
 
+// waitForDroplets waits for new droplets to be active, returning them as
+// they are then.
+func waitForDroplets(c *CmdConfig, droplets do.Droplets) (do.Droplets, error) {
+	if len(droplets) == 0 {
+		return droplets, nil
+	}
+
+	names := make([]string, len(droplets))
+	for i, d := range droplets {
+		names[i] = d.Name
+	}
+
+	what := "droplet " + names[0]
+	if len(names) > 1 {
+		what = "droplets " + strings.Join(names, ", ")
+	}
+
+	// Droplets are fetched at least once, as created droplets lack most
+	// of their attributes.
+	fetched := make([]bool, len(droplets))
+	ds := c.Droplets()
+	err := newWaiter("%s", what).wait(c.Ctx, func(ctx context.Context) (string, bool, error) {
+		active := 0
+		for i, d := range droplets {
+			if !fetched[i] || d.Status == "new" {
+				updated, err := ds.Get(ctx, d.ID)
+				if err != nil {
+					return "", false, err
+				}
+				droplets[i], fetched[i] = *updated, true
+			}
+			if droplets[i].Status != "new" {
+				active++
+			}
+		}
+
+		if len(droplets) == 1 {
+			return droplets[0].Status, active == 1, nil
+		}
+		return fmt.Sprintf("%d of %d active", active, len(droplets)), active == len(droplets), nil
+	})
+
+	return droplets, err
+}
+
 // RunDropletTag adds a tag to a droplet.
 func RunDropletTag(c *CmdConfig) error {
-	ds := c.Droplets()
 	ts := c.Tags()
 
 	if len(c.Args) < 1 {
@@ -331,15 +381,14 @@ func RunDropletTag(c *CmdConfig) error {
 			trr.Resources = append(trr.Resources, r)
 		}
 
-		return ts.TagResources(tag, trr)
+		return ts.TagResources(c.Ctx, tag, trr)
 	}
 
-	return matchDroplets(c.Args, ds, fn)
+	return matchDroplets(c, c.Args, fn)
 }
 
 // RunDropletUntag untags a droplet.
 func RunDropletUntag(c *CmdConfig) error {
-	ds := c.Droplets()
 	ts := c.Tags()
 
 	if len(c.Args) < 1 {
@@ -365,7 +414,7 @@ func RunDropletUntag(c *CmdConfig) error {
 
 				urr.Resources = append(urr.Resources, r)
 
-				err := ts.UntagResources(tagName, urr)
+				err := ts.UntagResources(c.Ctx, tagName, urr)
 				if err != nil {
 					return err
 				}
@@ -375,7 +424,7 @@ func RunDropletUntag(c *CmdConfig) error {
 		return nil
 	}
 
-	return matchDroplets(dropletIDStrs, ds, fn)
+	return matchDroplets(c, dropletIDStrs, fn)
 }
 
 func extractSSHKeys(keys []string) []godo.DropletCreateSSHKey {
@@ -425,26 +474,6 @@ func extractVolumes(volumeList []string) []godo.DropletCreateVolume {
 	return volumes
 }
 
-func allInt(in []string) ([]int, error) {
-	out := []int{}
-	seen := map[string]bool{}
-
-	for _, i := range in {
-		if seen[i] {
-			continue
-		}
-
-		seen[i] = true
-
-		id, err := strconv.Atoi(i)
-		if err != nil {
-			return nil, fmt.Errorf("%s is not an int", i)
-		}
-		out = append(out, id)
-	}
-	return out, nil
-}
-
 // RunDropletDelete destroy a droplet by id.
 func RunDropletDelete(c *CmdConfig) error {
 	ds := c.Droplets()
@@ -466,7 +495,7 @@ func RunDropletDelete(c *CmdConfig) error {
 	} else if tagName != "" {
 		// Collect affected Droplet IDs to show in confirmation message.
 		var affectedIDs string
-		list, err := ds.ListByTag(tagName)
+		list, err := ds.ListByTag(c.Ctx, tagName)
 		if err != nil {
 			return err
 		}
@@ -481,7 +510,7 @@ func RunDropletDelete(c *CmdConfig) error {
 		affectedIDs = strings.Join(ids, " ")
 
 		if force || AskForConfirm(fmt.Sprintf("delete droplet(s) by \"%s\" tag [affected ID(s): %s]", tagName, affectedIDs)) == nil {
-			return ds.DeleteByTag(tagName)
+			return ds.DeleteByTag(c.Ctx, tagName)
 		}
 		return nil
 	}
@@ -490,48 +519,28 @@ func RunDropletDelete(c *CmdConfig) error {
 
 		fn := func(ids []int) error {
 			for _, id := range ids {
-				if err := ds.Delete(id); err != nil {
+				if err := ds.Delete(c.Ctx, id); err != nil {
 					return fmt.Errorf("unable to delete droplet %d: %v", id, err)
 				}
 			}
 			return nil
 		}
-		return matchDroplets(c.Args, ds, fn)
+		return matchDroplets(c, c.Args, fn)
 	}
 	return fmt.Errorf("operation aborted")
 }
 
 type matchDropletsFn func(ids []int) error
 
-func matchDroplets(ids []string, ds do.DropletsService, fn matchDropletsFn) error {
-	if extractedIDs, err := allInt(ids); err == nil {
-		return fn(extractedIDs)
-	}
-
-	sum, err := buildDropletSummary(ds)
-	if err != nil {
-		return err
-	}
+func matchDroplets(c *CmdConfig, args []string, fn matchDropletsFn) error {
+	r := dropletResolver(c)
 
 	matchedMap := map[int]bool{}
-	for _, idStr := range ids {
-		count := sum.count[idStr]
-		if count > 1 {
-			return fmt.Errorf("there are %d Droplets with the name %q, please delete by id. [%s]",
-				count, idStr, strings.Join(sum.byName[idStr], ", "))
-		}
-
-		id, err := strconv.Atoi(idStr)
+	for _, arg := range args {
+		id, err := r.resolveInt(arg)
 		if err != nil {
-			id, ok := sum.byID[idStr]
-			if !ok {
-				return fmt.Errorf("droplet with name %q could not be found", idStr)
-			}
-
-			matchedMap[id] = true
-			continue
+			return err
 		}
-
 		matchedMap[id] = true
 	}
 
@@ -546,32 +555,19 @@ func matchDroplets(ids []string, ds do.DropletsService, fn matchDropletsFn) erro
 
 // RunDropletGet returns a droplet.
 func RunDropletGet(c *CmdConfig) error {
-	id, err := getDropletIDArg(c.NS, c.Args)
-	if err != nil {
-		return err
-	}
-
-	getTemplate, err := c.Doit.GetString(c.NS, doctl.ArgTemplate)
+	id, err := getDropletIDArg(c)
 	if err != nil {
 		return err
 	}
 
 	ds := c.Droplets()
 
-	d, err := ds.Get(id)
+	d, err := ds.Get(c.Ctx, id)
 	if err != nil {
 		return err
 	}
 
 	item := &displayers.Droplet{Droplets: do.Droplets{*d}}
-	if getTemplate != "" {
-		t := template.New("get template")
-		t, err = t.Parse(getTemplate)
-		if err != nil {
-			return err
-		}
-		return t.Execute(c.Out, d)
-	}
 	return c.Display(item)
 }
 
@@ -579,12 +575,12 @@ func RunDropletGet(c *CmdConfig) error {
 func RunDropletKernels(c *CmdConfig) error {
 
 	ds := c.Droplets()
-	id, err := getDropletIDArg(c.NS, c.Args)
+	id, err := getDropletIDArg(c)
 	if err != nil {
 		return err
 	}
 
-	list, err := ds.Kernels(id)
+	list, err := ds.Kernels(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -618,43 +614,59 @@ func RunDropletList(c *CmdConfig) error {
 		matches = append(matches, g)
 	}
 
-	var matchedList do.Droplets
-
-	var list do.Droplets
-	if tagName == "" {
-		list, err = ds.List()
-	} else {
-		list, err = ds.ListByTag(tagName)
-	}
+	pd, err := newPagedDisplay(c)
 	if err != nil {
 		return err
 	}
 
-	for _, droplet := range list {
-		var skip = true
-		if len(matches) == 0 {
-			skip = false
-		} else {
-			for _, m := range matches {
-				if m.Match(droplet.Name) {
-					skip = false
+	var matchedList do.Droplets
+	show := func(list do.Droplets) error {
+		var matched do.Droplets
+		for _, droplet := range list {
+			var skip = true
+			if len(matches) == 0 {
+				skip = false
+			} else {
+				for _, m := range matches {
+					if m.Match(droplet.Name) {
+						skip = false
+					}
 				}
 			}
-		}
 
-		if !skip && region != "" {
-			if region != droplet.Region.Slug {
-				skip = true
+			if !skip && region != "" {
+				if region != droplet.Region.Slug {
+					skip = true
+				}
+			}
+
+			if !skip {
+				matched = append(matched, droplet)
 			}
 		}
 
-		if !skip {
-			matchedList = append(matchedList, droplet)
+		if pd.stream {
+			return pd.page(&displayers.Droplet{Droplets: matched})
 		}
+		matchedList = append(matchedList, matched...)
+		return nil
+	}
+
+	if tagName == "" {
+		err = ds.ListPages(c.Ctx, show)
+	} else {
+		var list do.Droplets
+		list, err = ds.ListByTag(c.Ctx, tagName)
+		if err == nil {
+			err = show(list)
+		}
+	}
+	if err != nil {
+		return err
 	}
 
 	item := &displayers.Droplet{Droplets: matchedList}
-	return c.Display(item)
+	return pd.done(item)
 }
 
 // RunDropletNeighbors returns a list of droplet neighbors.
@@ -662,12 +674,12 @@ func RunDropletNeighbors(c *CmdConfig) error {
 
 	ds := c.Droplets()
 
-	id, err := getDropletIDArg(c.NS, c.Args)
+	id, err := getDropletIDArg(c)
 	if err != nil {
 		return err
 	}
 
-	list, err := ds.Neighbors(id)
+	list, err := ds.Neighbors(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -680,12 +692,12 @@ func RunDropletNeighbors(c *CmdConfig) error {
 func RunDropletSnapshots(c *CmdConfig) error {
 
 	ds := c.Droplets()
-	id, err := getDropletIDArg(c.NS, c.Args)
+	id, err := getDropletIDArg(c)
 	if err != nil {
 		return err
 	}
 
-	list, err := ds.Snapshots(id)
+	list, err := ds.Snapshots(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -694,36 +706,10 @@ func RunDropletSnapshots(c *CmdConfig) error {
 	return c.Display(item)
 }
 
-func getDropletIDArg(ns string, args []string) (int, error) {
-	if len(args) != 1 {
-		return 0, doctl.NewMissingArgsErr(ns)
-	}
-
-	return strconv.Atoi(args[0])
-}
-
-type dropletSummary struct {
-	count  map[string]int
-	byID   map[string]int
-	byName map[string][]string
-}
-
-func buildDropletSummary(ds do.DropletsService) (*dropletSummary, error) {
-	list, err := ds.List()
-	if err != nil {
-		return nil, err
-	}
-
-	var sum dropletSummary
-
-	sum.count = map[string]int{}
-	sum.byID = map[string]int{}
-	sum.byName = map[string][]string{}
-	for _, d := range list {
-		sum.count[d.Name]++
-		sum.byID[d.Name] = d.ID
-		sum.byName[d.Name] = append(sum.byName[d.Name], strconv.Itoa(d.ID))
+func getDropletIDArg(c *CmdConfig) (int, error) {
+	if len(c.Args) != 1 {
+		return 0, doctl.NewMissingArgsErr(c.NS)
 	}
 
-	return &sum, nil
+	return dropletResolver(c).resolveInt(c.Args[0])
 }
@@ -15,6 +15,7 @@ package commands
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"os"
 	"strconv"
@@ -23,7 +24,9 @@ import (
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -48,7 +51,7 @@ func TestDropletCommand(t *testing.T) {
 
 func TestDropletActionList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Actions(1).Return(testActionList, nil)
+		tm.droplets.EXPECT().Actions(gomock.Any(), 1).Return(testActionList, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -59,7 +62,7 @@ func TestDropletActionList(t *testing.T) {
 
 func TestDropletBackupList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Backups(1).Return(testImageList, nil)
+		tm.droplets.EXPECT().Backups(gomock.Any(), 1).Return(testImageList, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -88,7 +91,7 @@ func TestDropletCreate(t *testing.T) {
 			UserData:          "#cloud-config",
 			Tags:              []string{"one", "two"},
 		}
-		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+		tm.droplets.EXPECT().Create(gomock.Any(), dcr, false).Return(&testDroplet, nil)
 
 		config.Args = append(config.Args, "droplet")
 
@@ -104,18 +107,48 @@ func TestDropletCreate(t *testing.T) {
 	})
 }
 
+func TestDropletCreateWait(t *testing.T) {
+	defer shortWaits()()
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		d := *testDroplet.Droplet
+		d.Status = "new"
+		created := do.Droplet{Droplet: &d}
+
+		activeDroplet := d
+		activeDroplet.Status = "active"
+		active := do.Droplet{Droplet: &activeDroplet}
+
+		tm.droplets.EXPECT().Create(gomock.Any(), gomock.Any(), false).Return(&created, nil)
+		gomock.InOrder(
+			tm.droplets.EXPECT().Get(gomock.Any(), d.ID).Return(&created, nil),
+			tm.droplets.EXPECT().Get(gomock.Any(), d.ID).Return(&active, nil),
+		)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
 func TestDropletCreateWithTag(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		dcr := &godo.DropletCreateRequest{Name: "droplet", Region: "dev0", Size: "1gb", Image: godo.DropletCreateImage{ID: 0, Slug: "image"}, SSHKeys: []godo.DropletCreateSSHKey{}, Backups: false, IPv6: false, PrivateNetworking: false, UserData: "#cloud-config"}
-		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
-		tm.tags.EXPECT().Get("my-tag").Return(&testTag, nil)
+		tm.droplets.EXPECT().Create(gomock.Any(), dcr, false).Return(&testDroplet, nil)
+		tm.tags.EXPECT().Get(gomock.Any(), "my-tag").Return(&testTag, nil)
 
 		trr := &godo.TagResourcesRequest{
 			Resources: []godo.Resource{
 				{ID: "1", Type: godo.DropletResourceType},
 			},
 		}
-		tm.tags.EXPECT().TagResources("my-tag", trr).Return(nil)
+		tm.tags.EXPECT().TagResources(gomock.Any(), "my-tag", trr).Return(nil)
 
 		config.Args = append(config.Args, "droplet")
 
@@ -171,7 +204,7 @@ coreos:
 			PrivateNetworking: false,
 			UserData:          userData,
 		}
-		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+		tm.droplets.EXPECT().Create(gomock.Any(), dcr, false).Return(&testDroplet, nil)
 
 		config.Args = append(config.Args, "droplet")
 
@@ -187,7 +220,7 @@ coreos:
 
 func TestDropletDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Delete(1).Return(nil)
+		tm.droplets.EXPECT().Delete(gomock.Any(), 1).Return(nil)
 
 		config.Args = append(config.Args, strconv.Itoa(testDroplet.ID))
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -200,8 +233,8 @@ func TestDropletDelete(t *testing.T) {
 
 func TestDropletDeleteByTag_DropletsExist(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().ListByTag("my-tag").Return(testDropletList, nil)
-		tm.droplets.EXPECT().DeleteByTag("my-tag").Return(nil)
+		tm.droplets.EXPECT().ListByTag(gomock.Any(), "my-tag").Return(testDropletList, nil)
+		tm.droplets.EXPECT().DeleteByTag(gomock.Any(), "my-tag").Return(nil)
 
 		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -213,7 +246,7 @@ func TestDropletDeleteByTag_DropletsExist(t *testing.T) {
 
 func TestDropletDeleteByTag_DropletsMissing(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().ListByTag("my-tag").Return(do.Droplets{}, nil)
+		tm.droplets.EXPECT().ListByTag(gomock.Any(), "my-tag").Return(do.Droplets{}, nil)
 
 		var buf bytes.Buffer
 		config.Out = &buf
@@ -228,7 +261,7 @@ func TestDropletDeleteByTag_DropletsMissing(t *testing.T) {
 
 func TestDropletDeleteRepeatedID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Delete(1).Return(nil).Times(1)
+		tm.droplets.EXPECT().Delete(gomock.Any(), 1).Return(nil).Times(1)
 
 		id := strconv.Itoa(testDroplet.ID)
 		config.Args = append(config.Args, id, id)
@@ -241,8 +274,8 @@ func TestDropletDeleteRepeatedID(t *testing.T) {
 
 func TestDropletDeleteByName(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
-		tm.droplets.EXPECT().Delete(1).Return(nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
+		tm.droplets.EXPECT().Delete(gomock.Any(), 1).Return(nil)
 
 		config.Args = append(config.Args, testDroplet.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -255,7 +288,7 @@ func TestDropletDeleteByName(t *testing.T) {
 func TestDropletDeleteByName_Ambiguous(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		list := do.Droplets{testDroplet, testDroplet}
-		tm.droplets.EXPECT().List().Return(list, nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(list, nil)
 
 		config.Args = append(config.Args, testDroplet.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -268,8 +301,8 @@ func TestDropletDeleteByName_Ambiguous(t *testing.T) {
 
 func TestDropletDelete_MixedNameAndType(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
-		tm.droplets.EXPECT().Delete(1).Return(nil).Times(1)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
+		tm.droplets.EXPECT().Delete(gomock.Any(), 1).Return(nil).Times(1)
 
 		id := strconv.Itoa(testDroplet.ID)
 		config.Args = append(config.Args, id, testDroplet.Name)
@@ -283,7 +316,7 @@ func TestDropletDelete_MixedNameAndType(t *testing.T) {
 
 func TestDropletGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Get(testDroplet.ID).Return(&testDroplet, nil)
+		tm.droplets.EXPECT().Get(gomock.Any(), testDroplet.ID).Return(&testDroplet, nil)
 
 		config.Args = append(config.Args, strconv.Itoa(testDroplet.ID))
 
@@ -294,19 +327,24 @@ func TestDropletGet(t *testing.T) {
 
 func TestDropletGet_Template(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Get(testDroplet.ID).Return(&testDroplet, nil)
+		tm.droplets.EXPECT().Get(gomock.Any(), testDroplet.ID).Return(&testDroplet, nil)
+
+		defer func(tmpl string) { Template = tmpl }(Template)
+		Template = "{{.Name}} {{.Region.Slug}}"
 
+		var out bytes.Buffer
+		config.Out = &out
 		config.Args = append(config.Args, strconv.Itoa(testDroplet.ID))
-		config.Doit.Set(config.NS, doctl.ArgTemplate, "{{.Name}}")
 
 		err := RunDropletGet(config)
 		assert.NoError(t, err)
+		assert.Equal(t, "a-droplet test0\n", out.String())
 	})
 }
 
 func TestDropletKernelList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Kernels(testDroplet.ID).Return(testKernelList, nil)
+		tm.droplets.EXPECT().Kernels(gomock.Any(), testDroplet.ID).Return(testKernelList, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -317,7 +355,7 @@ func TestDropletKernelList(t *testing.T) {
 
 func TestDropletNeighbors(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Neighbors(testDroplet.ID).Return(testDropletList, nil)
+		tm.droplets.EXPECT().Neighbors(gomock.Any(), testDroplet.ID).Return(testDropletList, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -328,7 +366,7 @@ func TestDropletNeighbors(t *testing.T) {
 
 func TestDropletSnapshotList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Snapshots(testDroplet.ID).Return(testImageList, nil)
+		tm.droplets.EXPECT().Snapshots(gomock.Any(), testDroplet.ID).Return(testImageList, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -339,16 +377,62 @@ func TestDropletSnapshotList(t *testing.T) {
 
 func TestDropletsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+		tm.droplets.EXPECT().ListPages(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(do.Droplets) error) error {
+			return fn(testDropletList)
+		})
 
 		err := RunDropletList(config)
 		assert.NoError(t, err)
 	})
 }
 
+func TestDropletsListPages(t *testing.T) {
+	pages := func(out *bytes.Buffer, streamed bool) func(context.Context, func(do.Droplets) error) error {
+		return func(ctx context.Context, fn func(do.Droplets) error) error {
+			if err := fn(do.Droplets{testDroplet}); err != nil {
+				return err
+			}
+			if streamed {
+				// The first page is shown before the second arrives.
+				assert.Contains(t, out.String(), "a-droplet")
+			} else {
+				assert.Empty(t, out.String())
+			}
+			return fn(do.Droplets{anotherTestDroplet})
+		}
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var out bytes.Buffer
+		config.Out = &out
+
+		tm.droplets.EXPECT().ListPages(gomock.Any(), gomock.Any()).DoAndReturn(pages(&out, false))
+
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,Name")
+		err := RunDropletList(config)
+		require.NoError(t, err)
+		assert.Equal(t, "ID    Name\n1     a-droplet\n3     another-droplet\n", out.String())
+	})
+
+	defer func(output string) { Output = output }(Output)
+	Output = "csv"
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var out bytes.Buffer
+		config.Out = &out
+
+		tm.droplets.EXPECT().ListPages(gomock.Any(), gomock.Any()).DoAndReturn(pages(&out, true))
+
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,Name")
+		err := RunDropletList(config)
+		require.NoError(t, err)
+		assert.Equal(t, "ID,Name\n1,a-droplet\n3,another-droplet\n", out.String())
+	})
+}
+
 func TestDropletsListByTag(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().ListByTag("my-tag").Return(testDropletList, nil)
+		tm.droplets.EXPECT().ListByTag(gomock.Any(), "my-tag").Return(testDropletList, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
 
@@ -364,7 +448,7 @@ func TestDropletsTag(t *testing.T) {
 				{ID: "1", Type: godo.DropletResourceType},
 			},
 		}
-		tm.tags.EXPECT().TagResources("my-tag", trr).Return(nil)
+		tm.tags.EXPECT().TagResources(gomock.Any(), "my-tag", trr).Return(nil)
 
 		config.Args = append(config.Args, "1")
 		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
@@ -382,7 +466,7 @@ func TestDropletsTagMultiple(t *testing.T) {
 				{ID: "2", Type: godo.DropletResourceType},
 			},
 		}
-		tm.tags.EXPECT().TagResources("my-tag", trr).Return(nil)
+		tm.tags.EXPECT().TagResources(gomock.Any(), "my-tag", trr).Return(nil)
 
 		config.Args = append(config.Args, "1")
 		config.Args = append(config.Args, "2")
@@ -400,8 +484,8 @@ func TestDropletsTagByName(t *testing.T) {
 				{ID: "1", Type: godo.DropletResourceType},
 			},
 		}
-		tm.tags.EXPECT().TagResources("my-tag", trr).Return(nil)
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+		tm.tags.EXPECT().TagResources(gomock.Any(), "my-tag", trr).Return(nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
 
 		config.Args = append(config.Args, testDroplet.Name)
 		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
@@ -419,8 +503,8 @@ func TestDropletsTagMultipleNameAndID(t *testing.T) {
 				{ID: "3", Type: godo.DropletResourceType},
 			},
 		}
-		tm.tags.EXPECT().TagResources("my-tag", trr).Return(nil)
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+		tm.tags.EXPECT().TagResources(gomock.Any(), "my-tag", trr).Return(nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
 
 		config.Args = append(config.Args, testDroplet.Name)
 		config.Args = append(config.Args, strconv.Itoa(anotherTestDroplet.ID))
@@ -439,8 +523,8 @@ func TestDropletsUntag(t *testing.T) {
 			},
 		}
 
-		tm.tags.EXPECT().UntagResources("my-tag", urr).Return(nil)
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+		tm.tags.EXPECT().UntagResources(gomock.Any(), "my-tag", urr).Return(nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
 
 		config.Args = []string{testDroplet.Name}
 		config.Doit.Set(config.NS, doctl.ArgTagName, "my-tag")
@@ -16,24 +16,35 @@ package commands
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/godo"
 	"github.com/fatih/color"
 	"github.com/stretchr/testify/assert"
 )
 
 func Test_checkErr(t *testing.T) {
-	defer func(a func()) { errAction = a }(errAction)
+	defer func(a func(int)) { errAction = a }(errAction)
 	defer func(a io.Writer) { color.Output = a }(color.Output)
 
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	color.Output = w
 
-	errAction = func() {
+	var code int
+	errAction = func(c int) {
+		code = c
 	}
 
 	e := errors.New("an error")
@@ -43,4 +54,57 @@ func Test_checkErr(t *testing.T) {
 
 	re := regexp.MustCompile(`an error`)
 	assert.True(t, re.Match(b.Bytes()))
+	assert.Equal(t, ExitError, code)
+}
+
+func apiError(status int, body string) error {
+	req, _ := http.NewRequest(http.MethodGet, "https://api.digitalocean.com/v2/droplets/1", nil)
+	resp := &http.Response{
+		StatusCode: status,
+		Request:    req,
+		Header:     http.Header{"X-Request-Id": []string{"req-1"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+
+	return godo.CheckResponse(resp)
+}
+
+func Test_exitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "generic", err: errors.New("an error"), code: ExitError},
+		{name: "missing arguments", err: doctl.NewMissingArgsErr("droplet get"), code: ExitUsage},
+		{name: "unauthorized", err: apiError(http.StatusUnauthorized, `{"id":"unauthorized"}`), code: ExitAuth},
+		{name: "forbidden", err: apiError(http.StatusForbidden, `{}`), code: ExitAuth},
+		{name: "not found", err: apiError(http.StatusNotFound, `{}`), code: ExitNotFound},
+		{name: "wrapped not found", err: fmt.Errorf("getting droplet: %w", apiError(http.StatusNotFound, `{}`)), code: ExitNotFound},
+		{name: "conflict", err: apiError(http.StatusConflict, `{}`), code: ExitConflict},
+		{name: "validation", err: apiError(http.StatusUnprocessableEntity, `{}`), code: ExitValidation},
+		{name: "rate limited", err: apiError(http.StatusTooManyRequests, `{}`), code: ExitRateLimit},
+		{name: "server error", err: apiError(http.StatusInternalServerError, `{}`), code: ExitError},
+		{name: "timeout", err: &errTimeout{timeout: time.Second}, code: ExitTimeout},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, code: ExitTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.code, exitCode(tt.err))
+		})
+	}
+}
+
+func Test_newOutputError(t *testing.T) {
+	err := apiError(http.StatusNotFound, `{"id":"not_found","message":"The resource you were accessing could not be found.","request_id":"req-1"}`)
+
+	oe := newOutputError(err)
+	assert.Equal(t, http.StatusNotFound, oe.Status)
+	assert.Equal(t, "req-1", oe.RequestID)
+	assert.Equal(t, err.Error(), oe.Detail)
+
+	b, jerr := json.Marshal(outputErrors{Errors: []outputError{newOutputError(errors.New("an error"))}})
+	assert.NoError(t, jerr)
+	assert.Equal(t, `{"errors":[{"detail":"an error"}]}`, string(b))
 }
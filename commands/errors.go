@@ -14,36 +14,153 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"time"
 
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/godo"
 	"github.com/fatih/color"
 	"github.com/shiena/ansicolor"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
 )
 
 var (
 	colorErr    = color.RedString("Error")
 	colorWarn   = color.YellowString("Warning")
 	colorNotice = color.GreenString("Notice")
+	colorHint   = color.CyanString("Hint")
 
 	// errAction specifies what should happen when an error occurs
-	errAction = func() {
-		os.Exit(1)
+	errAction = func(code int) {
+		os.Exit(code)
 	}
 )
 
+// Exit codes returned by doctl. Scripts can rely on them to tell classes of
+// errors apart, so existing values must not change.
+const (
+	// ExitError is returned for errors that do not fall into another class.
+	ExitError = 1
+	// ExitUsage is returned when a command is invoked incorrectly, e.g. with
+	// missing arguments or unknown flags.
+	ExitUsage = 2
+	// ExitAuth is returned when the API rejects the access token.
+	ExitAuth = 3
+	// ExitNotFound is returned when a resource does not exist.
+	ExitNotFound = 4
+	// ExitConflict is returned when a request conflicts with the current
+	// state of a resource.
+	ExitConflict = 5
+	// ExitValidation is returned when the API rejects a request as invalid.
+	ExitValidation = 6
+	// ExitRateLimit is returned when the API rate limit was exceeded.
+	ExitRateLimit = 7
+	// ExitTimeout is returned when a command exceeds --timeout.
+	ExitTimeout = 8
+)
+
+// errTimeout is returned when a command was stopped by --timeout.
+type errTimeout struct {
+	timeout time.Duration
+}
+
+func (e *errTimeout) Error() string {
+	return fmt.Sprintf("command timed out after %s", e.timeout)
+}
+
+// errUsage is returned when a command is invoked incorrectly.
+type errUsage struct {
+	err error
+}
+
+func (e *errUsage) Error() string {
+	return e.err.Error()
+}
+
+// errPluginExit is returned when a plugin exits with a non-zero status. The
+// plugin reported its error itself, doctl exits with the same status.
+type errPluginExit struct {
+	name string
+	code int
+}
+
+func (e *errPluginExit) Error() string {
+	return fmt.Sprintf("plugin %s exited with status %d", e.name, e.code)
+}
+
 func init() {
 	color.Output = ansicolor.NewAnsiColorWriter(os.Stderr)
 }
 
 type outputErrors struct {
-	Errors []outputError `json:"errors"`
+	Errors []outputError `json:"errors" yaml:"errors"`
 }
 
 type outputError struct {
-	Detail string `json:"detail"`
+	Detail    string `json:"detail" yaml:"detail"`
+	Status    int    `json:"status,omitempty" yaml:"status,omitempty"`
+	ID        string `json:"id,omitempty" yaml:"id,omitempty"`
+	RequestID string `json:"request_id,omitempty" yaml:"request_id,omitempty"`
+	Hint      string `json:"hint,omitempty" yaml:"hint,omitempty"`
+}
+
+// newOutputError describes err, including the details of the API response
+// when err was returned by the API.
+func newOutputError(err error) outputError {
+	oe := outputError{Detail: err.Error(), Hint: errorHint(err)}
+
+	var er *godo.ErrorResponse
+	if errors.As(err, &er) && er.Response != nil {
+		oe.Status = er.Response.StatusCode
+		oe.ID = doctl.APIErrorID(er.Response)
+		oe.RequestID = er.RequestID
+	}
+
+	return oe
+}
+
+// exitCode returns the exit code for the class of err.
+func exitCode(err error) int {
+	var (
+		er      *godo.ErrorResponse
+		missing *doctl.MissingArgsErr
+		usage   *errUsage
+		timeout *errTimeout
+		batch   *errBatch
+		plugin  *errPluginExit
+	)
+
+	switch {
+	case errors.As(err, &er) && er.Response != nil:
+		switch er.Response.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ExitAuth
+		case http.StatusNotFound:
+			return ExitNotFound
+		case http.StatusConflict:
+			return ExitConflict
+		case http.StatusUnprocessableEntity:
+			return ExitValidation
+		case http.StatusTooManyRequests:
+			return ExitRateLimit
+		}
+	case errors.As(err, &missing), errors.As(err, &usage):
+		return ExitUsage
+	case errors.As(err, &batch):
+		return batch.code
+	case errors.As(err, &plugin) && plugin.code > 0:
+		return plugin.code
+	case errors.As(err, &timeout), errors.Is(err, context.DeadlineExceeded):
+		return ExitTimeout
+	}
+
+	return ExitError
 }
 
 func checkErr(err error) {
@@ -51,23 +168,51 @@ func checkErr(err error) {
 		return
 	}
 
-	output := viper.GetString("output")
+	// The errors of the commands of a batch are part of its results, and
+	// plugins report their own.
+	var (
+		batch  *errBatch
+		plugin *errPluginExit
+	)
+	switch output := viper.GetString("output"); {
+	case errors.As(err, &batch) && (output == "json" || output == "yaml"):
+	case errors.As(err, &plugin):
+	default:
+		printErr(err)
+	}
+
+	errAction(exitCode(err))
+}
+
+// printErr reports err in the output format.
+func printErr(err error) {
+	oe := newOutputError(err)
 
-	switch output {
+	switch viper.GetString("output") {
 	default:
-		fmt.Fprintf(color.Output, "%s: %v\n", colorErr, err)
+		if oe.ID != "" {
+			fmt.Fprintf(color.Output, "%s: %v (id: %s)\n", colorErr, err, oe.ID)
+		} else {
+			fmt.Fprintf(color.Output, "%s: %v\n", colorErr, err)
+		}
+		if oe.Hint != "" {
+			fmt.Fprintf(color.Output, "%s: %s\n", colorHint, oe.Hint)
+		}
 	case "json":
 		es := outputErrors{
-			Errors: []outputError{
-				{Detail: err.Error()},
-			},
+			Errors: []outputError{oe},
 		}
 
 		b, _ := json.Marshal(&es)
 		fmt.Println(string(b))
-	}
+	case "yaml":
+		es := outputErrors{
+			Errors: []outputError{oe},
+		}
 
-	errAction()
+		b, _ := yaml.Marshal(&es)
+		fmt.Print(string(b))
+	}
 }
 
 func warn(msg string, args ...interface{}) {
@@ -0,0 +1,651 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/godo"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Export creates the export command.
+func Export() *Command {
+	cmd := cmdBuilderWithInit(nil, RunExport, "export", "export resources as a stack spec or Terraform configuration", Writer, true)
+	cmd.Long = `export describes the droplets, volumes, firewalls, load balancers, domains and
+their records, certificates, Kubernetes clusters and databases of the account.
+Resources refer to each other by name, such as a firewall to the droplets it
+applies to, or a DNS record to the droplet or load balancer it points to.
+
+With --format yaml, the default, it writes a stack spec that plan and apply
+read. With --format hcl, it writes Terraform configuration for the
+DigitalOcean provider, followed by the terraform import commands bringing the
+existing resources under its management, or writes those to --import-script.
+
+--tag only exports the resources carrying a tag, and --project those of a
+project, given by name or ID. Firewalls are exported when they apply to an
+exported droplet, certificates when an exported load balancer uses them, and
+domains when one of their records points to an exported droplet or load
+balancer.`
+	AddStringFlag(cmd, doctl.ArgFormat, "", "yaml", "output format: yaml or hcl")
+	AddStringFlag(cmd, doctl.ArgTag, "", "", "only export resources with this tag")
+	AddStringFlag(cmd, doctl.ArgProject, "", "", "only export resources of this project, by name or ID")
+	AddStringFlag(cmd, doctl.ArgImportScript, "", "", "with --format hcl, write the terraform import commands to this shell script")
+
+	return cmd
+}
+
+// RunExport writes the resources of the account as a stack spec or as
+// Terraform configuration.
+func RunExport(c *CmdConfig) error {
+	format, err := c.Doit.GetString(c.NS, doctl.ArgFormat)
+	if err != nil {
+		return err
+	}
+	if format != "yaml" && format != "hcl" {
+		return fmt.Errorf("unknown format %q, use yaml or hcl", format)
+	}
+
+	tag, err := c.Doit.GetString(c.NS, doctl.ArgTag)
+	if err != nil {
+		return err
+	}
+
+	project, err := c.Doit.GetString(c.NS, doctl.ArgProject)
+	if err != nil {
+		return err
+	}
+
+	importScript, err := c.Doit.GetString(c.NS, doctl.ArgImportScript)
+	if err != nil {
+		return err
+	}
+	if importScript != "" && format != "hcl" {
+		return fmt.Errorf("--%s requires --%s hcl", doctl.ArgImportScript, doctl.ArgFormat)
+	}
+
+	filter := exportFilter{tag: tag}
+	if project != "" {
+		if filter.urns, err = projectURNs(c, project); err != nil {
+			return err
+		}
+	}
+
+	export, err := exportStack(c, filter)
+	if err != nil {
+		return err
+	}
+	for _, w := range export.warnings {
+		warn(w)
+	}
+
+	if format == "yaml" {
+		b, err := yaml.Marshal(export.spec)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.Out.Write(b)
+		return err
+	}
+
+	config, imports := export.terraform()
+	if importScript == "" {
+		config += "\n# Import the existing resources into the Terraform state with:\n#\n"
+		for _, cmd := range imports {
+			config += "#   " + cmd + "\n"
+		}
+	} else {
+		script := "#!/bin/sh\nset -e\n\n" + strings.Join(imports, "\n") + "\n"
+		if err := ioutil.WriteFile(importScript, []byte(script), 0755); err != nil {
+			return err
+		}
+	}
+
+	_, err = c.Out.Write([]byte(config))
+	return err
+}
+
+// projectURNs returns the URNs of the resources of a project, given by name
+// or ID.
+func projectURNs(c *CmdConfig, project string) (map[string]bool, error) {
+	projects, err := c.Projects().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, p := range projects {
+		if p.ID == project || p.Name == project {
+			matches = append(matches, p.ID)
+		}
+	}
+	switch {
+	case len(matches) == 0:
+		return nil, fmt.Errorf("project %s not found", project)
+	case len(matches) > 1:
+		return nil, fmt.Errorf("there are %d projects named %s, use its ID instead", len(matches), project)
+	}
+
+	resources, err := c.Projects().ListResources(c.Ctx, matches[0])
+	if err != nil {
+		return nil, err
+	}
+
+	urns := map[string]bool{}
+	for _, r := range resources {
+		urns[r.URN] = true
+	}
+
+	return urns, nil
+}
+
+// exportFilter selects the resources to export by tag and by project. A nil
+// set of URNs doesn't filter by project.
+type exportFilter struct {
+	tag  string
+	urns map[string]bool
+}
+
+func (f exportFilter) match(urn string, tags []string) bool {
+	if f.tag != "" && !containsString(tags, f.tag) {
+		return false
+	}
+	if f.urns != nil && !f.urns[urn] {
+		return false
+	}
+
+	return true
+}
+
+// stackExport is a stack spec describing existing resources, with what
+// writing it as Terraform configuration needs: the IDs of the resources,
+// to import them or to refer to those that are not exported.
+type stackExport struct {
+	spec StackSpec
+	// ids are the IDs of all the resources listed, by kind and name, such as
+	// droplet/web-01. Records are keyed by domain and index, such as
+	// record/example.com/0, and node pools by cluster and name.
+	ids map[string]string
+	// exported has the same keys as ids, for the resources of the spec.
+	exported map[string]bool
+	warnings []string
+}
+
+func (e *stackExport) add(kind, name, id string, exported bool) {
+	key := kind + "/" + name
+	if exported && e.exported[key] {
+		e.warnings = append(e.warnings, fmt.Sprintf("there are several %ss named %s, only the first can be referred to by name", kind, name))
+	}
+
+	if _, ok := e.ids[key]; !ok || exported {
+		e.ids[key] = id
+	}
+	if exported {
+		e.exported[key] = true
+	}
+}
+
+// exportStack lists the resources of the account selected by filter.
+func exportStack(c *CmdConfig, filter exportFilter) (*stackExport, error) {
+	e := &stackExport{ids: map[string]string{}, exported: map[string]bool{}}
+	if filter.tag != "" {
+		e.spec.Tags = []string{filter.tag}
+	}
+
+	droplets, err := c.Droplets().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	volumes, err := c.Volumes().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	firewalls, err := c.Firewalls().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	lbs, err := c.LoadBalancers().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := c.Certificates().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	domains, err := c.Domains().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := c.Kubernetes().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	databases, err := c.Databases().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dropletNames := map[int]string{}
+	exportedDroplets := map[int]bool{}
+	var exportedDropletTags []string
+	ips := map[string][]string{}
+	for _, d := range droplets {
+		dropletNames[d.ID] = d.Name
+		exported := filter.match(d.URN(), d.Tags)
+		e.add("droplet", d.Name, strconv.Itoa(d.ID), exported)
+		if !exported {
+			continue
+		}
+
+		exportedDroplets[d.ID] = true
+		exportedDropletTags = append(exportedDropletTags, d.Tags...)
+		if ip, err := d.PublicIPv4(); err == nil && ip != "" {
+			ips[ip] = append(ips[ip], "droplet/"+d.Name)
+		}
+	}
+
+	volumeNames := map[string]string{}
+	for _, v := range volumes {
+		volumeNames[v.ID] = v.Name
+		exported := filter.match(v.URN(), v.Tags)
+		e.add("volume", v.Name, v.ID, exported)
+		if !exported {
+			continue
+		}
+
+		e.spec.Volumes = append(e.spec.Volumes, StackVolume{
+			Name:            v.Name,
+			Region:          v.Region.Slug,
+			Size:            v.SizeGigaBytes,
+			Description:     v.Description,
+			FilesystemType:  v.FilesystemType,
+			FilesystemLabel: v.FilesystemLabel,
+			Tags:            v.Tags,
+		})
+	}
+
+	for _, d := range droplets {
+		if !exportedDroplets[d.ID] {
+			continue
+		}
+
+		image := ""
+		if d.Image != nil {
+			image = d.Image.Slug
+			if image == "" {
+				image = strconv.Itoa(d.Image.ID)
+			}
+		}
+
+		var attached []string
+		for _, id := range d.VolumeIDs {
+			if name, ok := volumeNames[id]; ok && e.exported["volume/"+name] {
+				attached = append(attached, name)
+			}
+		}
+
+		region := ""
+		if d.Region != nil {
+			region = d.Region.Slug
+		}
+
+		e.spec.Droplets = append(e.spec.Droplets, StackDroplet{
+			Name:              d.Name,
+			Region:            region,
+			Size:              d.SizeSlug,
+			Image:             image,
+			Backups:           containsString(d.Features, "backups"),
+			IPv6:              containsString(d.Features, "ipv6"),
+			PrivateNetworking: containsString(d.Features, "private_networking"),
+			Monitoring:        containsString(d.Features, "monitoring"),
+			Tags:              d.Tags,
+			Volumes:           attached,
+		})
+	}
+
+	certNames := map[string]string{}
+	for _, cert := range certs {
+		certNames[cert.ID] = cert.Name
+		e.add("certificate", cert.Name, cert.ID, false)
+	}
+
+	lbNames := map[string]string{}
+	usedCerts := map[string]bool{}
+	for _, lb := range lbs {
+		lbNames[lb.ID] = lb.Name
+		exported := filter.match(lb.URN(), []string{lb.Tag})
+		e.add("load balancer", lb.Name, lb.ID, exported)
+		if !exported {
+			continue
+		}
+		if lb.IP != "" {
+			ips[lb.IP] = append(ips[lb.IP], "load balancer/"+lb.Name)
+		}
+
+		spec := StackLoadBalancer{
+			Name:                lb.Name,
+			Region:              lb.Region.Slug,
+			Algorithm:           lb.Algorithm,
+			Tag:                 lb.Tag,
+			RedirectHTTPToHTTPS: lb.RedirectHttpToHttps,
+			EnableProxyProtocol: lb.EnableProxyProtocol,
+		}
+		for _, r := range lb.ForwardingRules {
+			rule := StackForwardingRule{
+				EntryProtocol:  r.EntryProtocol,
+				EntryPort:      r.EntryPort,
+				TargetProtocol: r.TargetProtocol,
+				TargetPort:     r.TargetPort,
+				CertificateID:  r.CertificateID,
+				TLSPassthrough: r.TlsPassthrough,
+			}
+			if name, ok := certNames[r.CertificateID]; ok {
+				rule.Certificate, rule.CertificateID = name, ""
+				usedCerts[r.CertificateID] = true
+			}
+			spec.ForwardingRules = append(spec.ForwardingRules, rule)
+		}
+		if hc := lb.HealthCheck; hc != nil {
+			spec.HealthCheck = &StackHealthCheck{
+				Protocol:               hc.Protocol,
+				Port:                   hc.Port,
+				Path:                   hc.Path,
+				CheckIntervalSeconds:   hc.CheckIntervalSeconds,
+				ResponseTimeoutSeconds: hc.ResponseTimeoutSeconds,
+				HealthyThreshold:       hc.HealthyThreshold,
+				UnhealthyThreshold:     hc.UnhealthyThreshold,
+			}
+		}
+		if ss := lb.StickySessions; ss != nil && ss.Type != "" && ss.Type != "none" {
+			spec.StickySessions = &StackStickySessions{
+				Type:             ss.Type,
+				CookieName:       ss.CookieName,
+				CookieTTLSeconds: ss.CookieTtlSeconds,
+			}
+		}
+		if lb.Tag == "" {
+			spec.Droplets = e.dropletRefs(lb.DropletIDs, dropletNames, "load balancer "+lb.Name)
+		}
+
+		e.spec.LoadBalancers = append(e.spec.LoadBalancers, spec)
+	}
+
+	unfiltered := filter.tag == "" && filter.urns == nil
+	for _, cert := range certs {
+		if !unfiltered && !usedCerts[cert.ID] {
+			continue
+		}
+
+		e.exported["certificate/"+cert.Name] = true
+		spec := StackCertificate{Name: cert.Name, Type: cert.Type, DNSNames: cert.DNSNames}
+		if spec.Type == "" {
+			spec.Type = "custom"
+		}
+		if spec.Type == "custom" {
+			e.warnings = append(e.warnings, fmt.Sprintf("the private key and certificates of custom certificate %s can't be exported", cert.Name))
+		}
+		e.spec.Certificates = append(e.spec.Certificates, spec)
+	}
+
+	for _, fw := range firewalls {
+		exported := unfiltered
+		for _, id := range fw.DropletIDs {
+			exported = exported || exportedDroplets[id]
+		}
+		for _, t := range fw.Tags {
+			exported = exported || t == filter.tag || containsString(exportedDropletTags, t)
+		}
+		if filter.urns != nil && filter.urns[fw.URN()] {
+			exported = true
+		}
+		e.add("firewall", fw.Name, fw.ID, exported)
+		if !exported {
+			continue
+		}
+
+		referrer := "firewall " + fw.Name
+		spec := StackFirewall{
+			Name:     fw.Name,
+			Droplets: e.dropletRefs(fw.DropletIDs, dropletNames, referrer),
+			Tags:     fw.Tags,
+		}
+		for _, r := range fw.InboundRules {
+			s := r.Sources
+			if s == nil {
+				s = &godo.Sources{}
+			}
+			spec.InboundRules = append(spec.InboundRules, StackFirewallRule{
+				Protocol:      r.Protocol,
+				Ports:         exportPorts(r.Protocol, r.PortRange),
+				Addresses:     s.Addresses,
+				Droplets:      e.dropletRefs(s.DropletIDs, dropletNames, referrer),
+				LoadBalancers: e.loadBalancerRefs(s.LoadBalancerUIDs, lbNames, referrer),
+				Tags:          s.Tags,
+			})
+		}
+		for _, r := range fw.OutboundRules {
+			d := r.Destinations
+			if d == nil {
+				d = &godo.Destinations{}
+			}
+			spec.OutboundRules = append(spec.OutboundRules, StackFirewallRule{
+				Protocol:      r.Protocol,
+				Ports:         exportPorts(r.Protocol, r.PortRange),
+				Addresses:     d.Addresses,
+				Droplets:      e.dropletRefs(d.DropletIDs, dropletNames, referrer),
+				LoadBalancers: e.loadBalancerRefs(d.LoadBalancerUIDs, lbNames, referrer),
+				Tags:          d.Tags,
+			})
+		}
+
+		e.spec.Firewalls = append(e.spec.Firewalls, spec)
+	}
+
+	for _, d := range domains {
+		if filter.urns != nil && !filter.urns[d.URN()] {
+			continue
+		}
+
+		records, err := c.Domains().Records(c.Ctx, d.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		spec := StackDomain{Name: d.Name}
+		pointsToExport := false
+		for _, r := range records {
+			if r.Type == "SOA" || (r.Type == "NS" && r.Name == "@") {
+				continue
+			}
+
+			record := StackRecord{
+				Type:     r.Type,
+				Name:     r.Name,
+				Data:     r.Data,
+				TTL:      r.TTL,
+				Priority: r.Priority,
+				Port:     r.Port,
+				Weight:   r.Weight,
+				Flags:    r.Flags,
+				Tag:      r.Tag,
+			}
+			if targets := ips[r.Data]; (r.Type == "A") && len(targets) == 1 {
+				pointsToExport = true
+				record.Data = ""
+				if strings.HasPrefix(targets[0], "droplet/") {
+					record.Droplet = strings.TrimPrefix(targets[0], "droplet/")
+				} else {
+					record.LoadBalancer = strings.TrimPrefix(targets[0], "load balancer/")
+				}
+			}
+
+			e.ids[fmt.Sprintf("record/%s/%d", d.Name, len(spec.Records))] = strconv.Itoa(r.ID)
+			spec.Records = append(spec.Records, record)
+		}
+
+		if filter.tag != "" && !pointsToExport {
+			continue
+		}
+
+		e.add("domain", d.Name, d.Name, true)
+		e.spec.Domains = append(e.spec.Domains, spec)
+	}
+
+	for _, k := range clusters {
+		if !filter.match("do:kubernetes:"+k.ID, k.Tags) {
+			continue
+		}
+		e.add("kubernetes cluster", k.Name, k.ID, true)
+
+		spec := StackKubernetesCluster{
+			Name:        k.Name,
+			Region:      k.RegionSlug,
+			Version:     k.VersionSlug,
+			AutoUpgrade: k.AutoUpgrade,
+			Tags:        userTags(k.Tags, k.ID),
+		}
+		for _, p := range k.NodePools {
+			e.ids["node pool/"+k.Name+"/"+p.Name] = p.ID
+			spec.NodePools = append(spec.NodePools, StackNodePool{
+				Name:      p.Name,
+				Size:      p.Size,
+				Count:     p.Count,
+				Tags:      userTags(p.Tags, k.ID),
+				AutoScale: p.AutoScale,
+				MinNodes:  p.MinNodes,
+				MaxNodes:  p.MaxNodes,
+			})
+		}
+
+		e.spec.KubernetesClusters = append(e.spec.KubernetesClusters, spec)
+	}
+
+	for _, db := range databases {
+		if !filter.match(db.URN(), db.Tags) {
+			continue
+		}
+		e.add("database", db.Name, db.ID, true)
+
+		e.spec.Databases = append(e.spec.Databases, StackDatabase{
+			Name:    db.Name,
+			Engine:  db.EngineSlug,
+			Version: db.VersionSlug,
+			Region:  db.RegionSlug,
+			Size:    db.SizeSlug,
+			Nodes:   db.NumNodes,
+			Tags:    db.Tags,
+		})
+	}
+
+	e.sort()
+	return e, nil
+}
+
+func (e *stackExport) dropletRefs(ids []int, names map[int]string, referrer string) []string {
+	var refs []string
+	for _, id := range ids {
+		name, ok := names[id]
+		if !ok {
+			e.warnings = append(e.warnings, fmt.Sprintf("%s refers to droplet %d, which doesn't exist", referrer, id))
+			continue
+		}
+		refs = append(refs, name)
+	}
+	sort.Strings(refs)
+
+	return refs
+}
+
+func (e *stackExport) loadBalancerRefs(ids []string, names map[string]string, referrer string) []string {
+	var refs []string
+	for _, id := range ids {
+		name, ok := names[id]
+		if !ok {
+			e.warnings = append(e.warnings, fmt.Sprintf("%s refers to load balancer %s, which doesn't exist", referrer, id))
+			continue
+		}
+		refs = append(refs, name)
+	}
+	sort.Strings(refs)
+
+	return refs
+}
+
+// sort orders resources by name, and records by type and name, so exports
+// of the same resources are identical.
+func (e *stackExport) sort() {
+	s := &e.spec
+	sort.SliceStable(s.Droplets, func(i, j int) bool { return s.Droplets[i].Name < s.Droplets[j].Name })
+	sort.SliceStable(s.Volumes, func(i, j int) bool { return s.Volumes[i].Name < s.Volumes[j].Name })
+	sort.SliceStable(s.Firewalls, func(i, j int) bool { return s.Firewalls[i].Name < s.Firewalls[j].Name })
+	sort.SliceStable(s.LoadBalancers, func(i, j int) bool { return s.LoadBalancers[i].Name < s.LoadBalancers[j].Name })
+	sort.SliceStable(s.Certificates, func(i, j int) bool { return s.Certificates[i].Name < s.Certificates[j].Name })
+	sort.SliceStable(s.KubernetesClusters, func(i, j int) bool { return s.KubernetesClusters[i].Name < s.KubernetesClusters[j].Name })
+	sort.SliceStable(s.Databases, func(i, j int) bool { return s.Databases[i].Name < s.Databases[j].Name })
+	sort.SliceStable(s.Domains, func(i, j int) bool { return s.Domains[i].Name < s.Domains[j].Name })
+
+	for _, d := range s.Domains {
+		records := d.Records
+		order := make([]int, len(records))
+		for i := range order {
+			order[i] = i
+		}
+		key := func(r StackRecord) string {
+			return r.Type + " " + r.Name + " " + r.Data + r.Droplet + r.LoadBalancer
+		}
+		sort.SliceStable(order, func(i, j int) bool { return key(records[order[i]]) < key(records[order[j]]) })
+
+		sorted := make([]StackRecord, len(records))
+		ids := map[string]string{}
+		for i, o := range order {
+			sorted[i] = records[o]
+			ids[fmt.Sprintf("record/%s/%d", d.Name, i)] = e.ids[fmt.Sprintf("record/%s/%d", d.Name, o)]
+		}
+		copy(records, sorted)
+		for k, v := range ids {
+			e.ids[k] = v
+		}
+	}
+}
+
+// exportPorts writes the ports of a firewall rule the way stack specs do.
+func exportPorts(protocol, ports string) string {
+	if protocol == "icmp" {
+		return ""
+	}
+	if ports == "0" || ports == "" {
+		return "all"
+	}
+
+	return ports
+}
+
+// userTags drops the tags Kubernetes adds to clusters and their droplets.
+func userTags(tags []string, clusterID string) []string {
+	var out []string
+	for _, t := range tags {
+		if t == "k8s" || t == "k8s:worker" || t == "k8s:"+clusterID {
+			continue
+		}
+		out = append(out, t)
+	}
+
+	return out
+}
@@ -0,0 +1,465 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// hclBlock is a block of Terraform configuration: attributes, whose values
+// are HCL expressions, and nested blocks, in order.
+type hclBlock struct {
+	header  string
+	comment string
+	items   []hclItem
+}
+
+type hclItem struct {
+	name  string
+	value string
+	block *hclBlock
+}
+
+func (b *hclBlock) attr(name, value string) {
+	b.items = append(b.items, hclItem{name: name, value: value})
+}
+
+// optional adds an attribute unless its value is the zero value of its
+// type.
+func (b *hclBlock) optional(name, value string) {
+	switch value {
+	case "", `""`, "0", "false", "[]":
+		return
+	}
+	b.attr(name, value)
+}
+
+func (b *hclBlock) block(header string) *hclBlock {
+	nested := &hclBlock{header: header}
+	b.items = append(b.items, hclItem{block: nested})
+	return nested
+}
+
+// write writes the block the way terraform fmt does, aligning the equal
+// signs of consecutive attributes.
+func (b *hclBlock) write(sb *strings.Builder, indent string) {
+	if b.comment != "" {
+		for _, line := range strings.Split(b.comment, "\n") {
+			sb.WriteString(indent + "# " + line + "\n")
+		}
+	}
+	sb.WriteString(indent + b.header + " {\n")
+
+	for i := 0; i < len(b.items); {
+		if b.items[i].block != nil {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			b.items[i].block.write(sb, indent+"  ")
+			i++
+			continue
+		}
+
+		j, width := i, 0
+		for ; j < len(b.items) && b.items[j].block == nil; j++ {
+			if len(b.items[j].name) > width {
+				width = len(b.items[j].name)
+			}
+		}
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		for ; i < j; i++ {
+			fmt.Fprintf(sb, "%s  %-*s = %s\n", indent, width, b.items[i].name, b.items[i].value)
+		}
+	}
+
+	sb.WriteString(indent + "}\n")
+}
+
+// hclString quotes s as an HCL string literal. Only the escapes HCL knows
+// are used, and template sequences are escaped so they are kept literally.
+func hclString(s string) string {
+	s = strings.ToValidUTF8(s, string(utf8.RuneError))
+
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '"':
+			sb.WriteString(`\"`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			sb.WriteRune(r)
+			sb.WriteRune(r)
+		case r <= 0xffff && !unicode.IsPrint(r):
+			fmt.Fprintf(&sb, `\u%04x`, r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+
+	return sb.String()
+}
+
+func hclBool(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+func hclInt(i int) string {
+	return strconv.Itoa(i)
+}
+
+func hclList(values []string) string {
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+func hclStrings(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, hclString(v))
+	}
+
+	return hclList(quoted)
+}
+
+var hclLabelRe = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// terraformWriter turns a stack export into Terraform resources, naming
+// each after the resource it describes.
+type terraformWriter struct {
+	e       *stackExport
+	blocks  []*hclBlock
+	imports []string
+	// labels are the labels given to resources, by type and name.
+	labels map[string]string
+	used   map[string]bool
+}
+
+// label returns a unique label for a resource of a Terraform type.
+func (w *terraformWriter) label(typ, name string) string {
+	l := strings.Trim(hclLabelRe.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if l == "" || (l[0] >= '0' && l[0] <= '9') {
+		l = "_" + l
+	}
+
+	unique := l
+	for i := 2; w.used[typ+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", l, i)
+	}
+	w.used[typ+"."+unique] = true
+
+	return unique
+}
+
+func (w *terraformWriter) resource(typ, name, id string) *hclBlock {
+	l := w.label(typ, name)
+	w.labels[typ+"/"+name] = l
+
+	b := &hclBlock{header: fmt.Sprintf("resource %q %q", typ, l)}
+	w.blocks = append(w.blocks, b)
+	if id != "" {
+		w.imports = append(w.imports, fmt.Sprintf("terraform import %s.%s %s", typ, l, id))
+	}
+
+	return b
+}
+
+// ref refers to an attribute of an exported resource, or to the ID of one
+// that isn't exported. It reports false when the resource is unknown.
+func (w *terraformWriter) ref(typ, kind, name, attr string, quote bool) (string, bool) {
+	if l, ok := w.labels[typ+"/"+name]; ok {
+		return typ + "." + l + "." + attr, true
+	}
+
+	id, ok := w.e.ids[kind+"/"+name]
+	if !ok || id == "" {
+		return "", false
+	}
+	if quote {
+		return hclString(id), true
+	}
+
+	return id, true
+}
+
+// refs refers to the IDs of resources by name, leaving out unknown ones.
+func (w *terraformWriter) refs(typ, kind string, names []string, quote bool) string {
+	refs := make([]string, 0, len(names))
+	for _, n := range names {
+		if ref, ok := w.ref(typ, kind, n, "id", quote); ok {
+			refs = append(refs, ref)
+		}
+	}
+
+	return hclList(refs)
+}
+
+func (w *terraformWriter) tags(tags []string) string {
+	return w.refs("digitalocean_tag", "tag", tags, true)
+}
+
+func (w *terraformWriter) droplets(names []string) string {
+	return w.refs("digitalocean_droplet", "droplet", names, false)
+}
+
+func (w *terraformWriter) loadBalancers(names []string) string {
+	return w.refs("digitalocean_loadbalancer", "load balancer", names, true)
+}
+
+// terraform returns the Terraform configuration of the export, and the
+// terraform import commands for its resources.
+func (e *stackExport) terraform() (string, []string) {
+	w := &terraformWriter{e: e, labels: map[string]string{}, used: map[string]bool{}}
+	s := e.spec
+
+	tags := map[string]bool{}
+	for _, t := range s.Tags {
+		tags[t] = true
+	}
+	for _, d := range s.Droplets {
+		for _, t := range d.Tags {
+			tags[t] = true
+		}
+	}
+	for _, v := range s.Volumes {
+		for _, t := range v.Tags {
+			tags[t] = true
+		}
+	}
+	for _, fw := range s.Firewalls {
+		for _, t := range fw.Tags {
+			tags[t] = true
+		}
+	}
+	for _, lb := range s.LoadBalancers {
+		if lb.Tag != "" {
+			tags[lb.Tag] = true
+		}
+	}
+	for _, k := range s.KubernetesClusters {
+		for _, t := range k.Tags {
+			tags[t] = true
+		}
+	}
+	for _, db := range s.Databases {
+		for _, t := range db.Tags {
+			tags[t] = true
+		}
+	}
+	for _, t := range sortedKeys(tags) {
+		b := w.resource("digitalocean_tag", t, t)
+		b.attr("name", hclString(t))
+	}
+
+	for _, c := range s.Certificates {
+		b := w.resource("digitalocean_certificate", c.Name, e.ids["certificate/"+c.Name])
+		b.attr("name", hclString(c.Name))
+		b.attr("type", hclString(c.Type))
+		if c.Type == "lets_encrypt" {
+			b.attr("domains", hclStrings(c.DNSNames))
+			continue
+		}
+
+		l := w.labels["digitalocean_certificate/"+c.Name]
+		b.comment = "The private key and certificates of custom certificates can't be exported."
+		b.attr("private_key", fmt.Sprintf(`file("%s.key")`, l))
+		b.attr("leaf_certificate", fmt.Sprintf(`file("%s.crt")`, l))
+		b.attr("certificate_chain", fmt.Sprintf(`file("%s-chain.crt")`, l))
+	}
+
+	for _, v := range s.Volumes {
+		b := w.resource("digitalocean_volume", v.Name, e.ids["volume/"+v.Name])
+		b.attr("name", hclString(v.Name))
+		b.attr("region", hclString(v.Region))
+		b.attr("size", strconv.FormatInt(v.Size, 10))
+		b.optional("description", hclString(v.Description))
+		b.optional("initial_filesystem_type", hclString(v.FilesystemType))
+		b.optional("initial_filesystem_label", hclString(v.FilesystemLabel))
+		b.optional("tags", w.tags(v.Tags))
+	}
+
+	for _, d := range s.Droplets {
+		b := w.resource("digitalocean_droplet", d.Name, e.ids["droplet/"+d.Name])
+		b.attr("name", hclString(d.Name))
+		b.attr("region", hclString(d.Region))
+		b.attr("size", hclString(d.Size))
+		b.attr("image", hclString(d.Image))
+		b.optional("backups", hclBool(d.Backups))
+		b.optional("ipv6", hclBool(d.IPv6))
+		b.optional("private_networking", hclBool(d.PrivateNetworking))
+		b.optional("monitoring", hclBool(d.Monitoring))
+		b.optional("tags", w.tags(d.Tags))
+
+		b.optional("volume_ids", w.refs("digitalocean_volume", "volume", d.Volumes, true))
+	}
+
+	for _, lb := range s.LoadBalancers {
+		b := w.resource("digitalocean_loadbalancer", lb.Name, e.ids["load balancer/"+lb.Name])
+		b.attr("name", hclString(lb.Name))
+		b.attr("region", hclString(lb.Region))
+		b.optional("algorithm", hclString(lb.Algorithm))
+		b.optional("redirect_http_to_https", hclBool(lb.RedirectHTTPToHTTPS))
+		b.optional("enable_proxy_protocol", hclBool(lb.EnableProxyProtocol))
+		if lb.Tag != "" {
+			if ref, ok := w.ref("digitalocean_tag", "tag", lb.Tag, "id", true); ok {
+				b.attr("droplet_tag", ref)
+			}
+		} else {
+			b.optional("droplet_ids", w.droplets(lb.Droplets))
+		}
+
+		for _, r := range lb.ForwardingRules {
+			fr := b.block("forwarding_rule")
+			fr.attr("entry_protocol", hclString(r.EntryProtocol))
+			fr.attr("entry_port", hclInt(r.EntryPort))
+			fr.attr("target_protocol", hclString(r.TargetProtocol))
+			fr.attr("target_port", hclInt(r.TargetPort))
+			if ref, ok := w.ref("digitalocean_certificate", "certificate", r.Certificate, "id", true); ok {
+				fr.attr("certificate_id", ref)
+			} else {
+				fr.optional("certificate_id", hclString(r.CertificateID))
+			}
+			fr.optional("tls_passthrough", hclBool(r.TLSPassthrough))
+		}
+
+		if hc := lb.HealthCheck; hc != nil {
+			h := b.block("healthcheck")
+			h.attr("protocol", hclString(hc.Protocol))
+			h.attr("port", hclInt(hc.Port))
+			h.optional("path", hclString(hc.Path))
+			h.optional("check_interval_seconds", hclInt(hc.CheckIntervalSeconds))
+			h.optional("response_timeout_seconds", hclInt(hc.ResponseTimeoutSeconds))
+			h.optional("healthy_threshold", hclInt(hc.HealthyThreshold))
+			h.optional("unhealthy_threshold", hclInt(hc.UnhealthyThreshold))
+		}
+
+		if ss := lb.StickySessions; ss != nil {
+			st := b.block("sticky_sessions")
+			st.attr("type", hclString(ss.Type))
+			st.optional("cookie_name", hclString(ss.CookieName))
+			st.optional("cookie_ttl_seconds", hclInt(ss.CookieTTLSeconds))
+		}
+	}
+
+	for _, fw := range s.Firewalls {
+		b := w.resource("digitalocean_firewall", fw.Name, e.ids["firewall/"+fw.Name])
+		b.attr("name", hclString(fw.Name))
+		b.optional("droplet_ids", w.droplets(fw.Droplets))
+		b.optional("tags", w.tags(fw.Tags))
+
+		rules := func(typ, prefix string, rules []StackFirewallRule) {
+			for _, r := range rules {
+				rb := b.block(typ)
+				rb.attr("protocol", hclString(r.Protocol))
+				rb.optional("port_range", hclString(r.Ports))
+				rb.optional(prefix+"_addresses", hclStrings(r.Addresses))
+				rb.optional(prefix+"_droplet_ids", w.droplets(r.Droplets))
+				rb.optional(prefix+"_load_balancer_uids", w.loadBalancers(r.LoadBalancers))
+				rb.optional(prefix+"_tags", w.tags(r.Tags))
+			}
+		}
+		rules("inbound_rule", "source", fw.InboundRules)
+		rules("outbound_rule", "destination", fw.OutboundRules)
+	}
+
+	for _, d := range s.Domains {
+		b := w.resource("digitalocean_domain", d.Name, d.Name)
+		b.attr("name", hclString(d.Name))
+		domain := "digitalocean_domain." + w.labels["digitalocean_domain/"+d.Name] + ".name"
+
+		for i, r := range d.Records {
+			id := e.ids[fmt.Sprintf("record/%s/%d", d.Name, i)]
+			rb := w.resource("digitalocean_record", d.Name+"_"+r.Type+"_"+r.Name, d.Name+","+id)
+			rb.attr("domain", domain)
+			rb.attr("type", hclString(r.Type))
+			rb.attr("name", hclString(r.Name))
+			value := hclString(r.Data)
+			if ref, ok := w.ref("digitalocean_droplet", "droplet", r.Droplet, "ipv4_address", true); r.Droplet != "" && ok {
+				value = ref
+			} else if ref, ok := w.ref("digitalocean_loadbalancer", "load balancer", r.LoadBalancer, "ip", true); r.LoadBalancer != "" && ok {
+				value = ref
+			}
+			rb.attr("value", value)
+			rb.optional("ttl", hclInt(r.TTL))
+			rb.optional("priority", hclInt(r.Priority))
+			rb.optional("port", hclInt(r.Port))
+			rb.optional("weight", hclInt(r.Weight))
+			rb.optional("flags", hclInt(r.Flags))
+			rb.optional("tag", hclString(r.Tag))
+		}
+	}
+
+	for _, k := range s.KubernetesClusters {
+		b := w.resource("digitalocean_kubernetes_cluster", k.Name, e.ids["kubernetes cluster/"+k.Name])
+		b.attr("name", hclString(k.Name))
+		b.attr("region", hclString(k.Region))
+		b.attr("version", hclString(k.Version))
+		b.optional("tags", w.tags(k.Tags))
+
+		cluster := "digitalocean_kubernetes_cluster." + w.labels["digitalocean_kubernetes_cluster/"+k.Name] + ".id"
+		for i, p := range k.NodePools {
+			var pb *hclBlock
+			if i == 0 {
+				// The first pool is the cluster's default pool, which is
+				// imported along with it.
+				pb = b.block("node_pool")
+			} else {
+				pb = w.resource("digitalocean_kubernetes_node_pool", k.Name+"_"+p.Name, e.ids["node pool/"+k.Name+"/"+p.Name])
+				pb.attr("cluster_id", cluster)
+			}
+			pb.attr("name", hclString(p.Name))
+			pb.attr("size", hclString(p.Size))
+			pb.attr("node_count", hclInt(p.Count))
+			pb.optional("auto_scale", hclBool(p.AutoScale))
+			pb.optional("min_nodes", hclInt(p.MinNodes))
+			pb.optional("max_nodes", hclInt(p.MaxNodes))
+			pb.optional("tags", hclStrings(p.Tags))
+		}
+	}
+
+	for _, db := range s.Databases {
+		b := w.resource("digitalocean_database_cluster", db.Name, e.ids["database/"+db.Name])
+		b.attr("name", hclString(db.Name))
+		b.attr("engine", hclString(db.Engine))
+		b.optional("version", hclString(db.Version))
+		b.attr("size", hclString(db.Size))
+		b.attr("region", hclString(db.Region))
+		b.attr("node_count", hclInt(db.Nodes))
+		b.optional("tags", w.tags(db.Tags))
+	}
+
+	var sb strings.Builder
+	for i, b := range w.blocks {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		b.write(&sb, "")
+	}
+
+	return sb.String(), w.imports
+}
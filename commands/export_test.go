@@ -0,0 +1,223 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func expectExportLists(tm *tcMocks) {
+	tm.droplets.EXPECT().List(gomock.Any()).Return(do.Droplets{
+		{Droplet: &godo.Droplet{
+			ID:        1,
+			Name:      "web-01",
+			Region:    &godo.Region{Slug: "nyc3"},
+			SizeSlug:  "s-1vcpu-1gb",
+			Image:     &godo.Image{Slug: "ubuntu-18-04-x64"},
+			Features:  []string{"monitoring"},
+			Tags:      []string{"web"},
+			VolumeIDs: []string{"vol-1"},
+			Networks: &godo.Networks{
+				V4: []godo.NetworkV4{{IPAddress: "192.0.2.1", Type: "public"}},
+			},
+		}},
+		{Droplet: &godo.Droplet{
+			ID:       2,
+			Name:     "db-01",
+			Region:   &godo.Region{Slug: "nyc3"},
+			SizeSlug: "s-1vcpu-1gb",
+			Image:    &godo.Image{ID: 42},
+		}},
+	}, nil)
+	tm.volumes.EXPECT().List(gomock.Any()).Return([]do.Volume{
+		{Volume: &godo.Volume{ID: "vol-1", Name: "web-data", Region: &godo.Region{Slug: "nyc3"}, SizeGigaBytes: 10, Tags: []string{"web"}}},
+	}, nil)
+	tm.firewalls.EXPECT().List(gomock.Any()).Return(do.Firewalls{
+		{Firewall: &godo.Firewall{
+			ID:         "fw-1",
+			Name:       "ssh",
+			DropletIDs: []int{2},
+			InboundRules: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{DropletIDs: []int{1}}},
+			},
+		}},
+	}, nil)
+	tm.loadBalancers.EXPECT().List(gomock.Any()).Return(do.LoadBalancers{
+		{LoadBalancer: &godo.LoadBalancer{
+			ID:        "lb-1",
+			Name:      "web",
+			IP:        "192.0.2.2",
+			Region:    &godo.Region{Slug: "nyc3"},
+			Algorithm: "round_robin",
+			Tag:       "web",
+			ForwardingRules: []godo.ForwardingRule{
+				{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 80, CertificateID: "cert-1"},
+			},
+			StickySessions: &godo.StickySessions{Type: "none"},
+		}},
+	}, nil)
+	tm.certificates.EXPECT().List(gomock.Any()).Return(do.Certificates{
+		{Certificate: &godo.Certificate{ID: "cert-1", Name: "web", Type: "lets_encrypt", DNSNames: []string{"www.example.com"}}},
+		{Certificate: &godo.Certificate{ID: "cert-2", Name: "old", Type: "custom"}},
+	}, nil)
+	tm.domains.EXPECT().List(gomock.Any()).Return(do.Domains{
+		{Domain: &godo.Domain{Name: "example.com"}},
+		{Domain: &godo.Domain{Name: "example.org"}},
+	}, nil)
+	tm.kubernetes.EXPECT().List(gomock.Any()).Return(do.KubernetesClusters{}, nil)
+	tm.databases.EXPECT().List(gomock.Any()).Return(do.Databases{}, nil)
+}
+
+func expectExportRecords(tm *tcMocks) {
+	tm.domains.EXPECT().Records(gomock.Any(), "example.com").Return(do.DomainRecords{
+		{DomainRecord: &godo.DomainRecord{ID: 10, Type: "SOA", Name: "@", Data: "1800"}},
+		{DomainRecord: &godo.DomainRecord{ID: 11, Type: "NS", Name: "@", Data: "ns1.digitalocean.com"}},
+		{DomainRecord: &godo.DomainRecord{ID: 12, Type: "A", Name: "www", Data: "192.0.2.2", TTL: 1800}},
+		{DomainRecord: &godo.DomainRecord{ID: 13, Type: "A", Name: "@", Data: "192.0.2.1", TTL: 1800}},
+	}, nil)
+	tm.domains.EXPECT().Records(gomock.Any(), "example.org").Return(do.DomainRecords{
+		{DomainRecord: &godo.DomainRecord{ID: 14, Type: "CNAME", Name: "www", Data: "example.com.", TTL: 1800}},
+	}, nil)
+}
+
+func TestExportStack(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		expectExportLists(tm)
+		expectExportRecords(tm)
+
+		e, err := exportStack(config, exportFilter{})
+		require.NoError(t, err)
+
+		s := e.spec
+		require.Len(t, s.Droplets, 2)
+		assert.Equal(t, StackDroplet{Name: "db-01", Region: "nyc3", Size: "s-1vcpu-1gb", Image: "42"}, s.Droplets[0])
+		assert.Equal(t, []string{"web-data"}, s.Droplets[1].Volumes)
+		assert.True(t, s.Droplets[1].Monitoring)
+
+		require.Len(t, s.Firewalls, 1)
+		assert.Equal(t, []string{"db-01"}, s.Firewalls[0].Droplets)
+		assert.Equal(t, []string{"web-01"}, s.Firewalls[0].InboundRules[0].Droplets)
+
+		require.Len(t, s.LoadBalancers, 1)
+		assert.Equal(t, "web", s.LoadBalancers[0].ForwardingRules[0].Certificate)
+		assert.Empty(t, s.LoadBalancers[0].ForwardingRules[0].CertificateID)
+		assert.Nil(t, s.LoadBalancers[0].StickySessions)
+
+		assert.Len(t, s.Certificates, 2)
+		assert.Contains(t, e.warnings, "the private key and certificates of custom certificate old can't be exported")
+
+		require.Len(t, s.Domains, 2)
+		assert.Equal(t, []StackRecord{
+			{Type: "A", Name: "@", Droplet: "web-01", TTL: 1800},
+			{Type: "A", Name: "www", LoadBalancer: "web", TTL: 1800},
+		}, s.Domains[0].Records)
+		assert.Equal(t, "13", e.ids["record/example.com/0"])
+
+		b, err := yaml.Marshal(s)
+		require.NoError(t, err)
+		_, err = parseStackSpec(b)
+		assert.NoError(t, err)
+	})
+}
+
+func TestExportStackByTag(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		expectExportLists(tm)
+		expectExportRecords(tm)
+
+		e, err := exportStack(config, exportFilter{tag: "web"})
+		require.NoError(t, err)
+
+		s := e.spec
+		assert.Equal(t, []string{"web"}, s.Tags)
+		require.Len(t, s.Droplets, 1)
+		assert.Equal(t, "web-01", s.Droplets[0].Name)
+		assert.Len(t, s.Volumes, 1)
+		assert.Len(t, s.LoadBalancers, 1)
+		require.Len(t, s.Certificates, 1)
+		assert.Equal(t, "web", s.Certificates[0].Name)
+		assert.Empty(t, s.Firewalls, "the firewall applies to db-01")
+		require.Len(t, s.Domains, 1)
+		assert.Equal(t, "example.com", s.Domains[0].Name)
+	})
+}
+
+func TestRunExportHCL(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		expectExportLists(tm)
+		expectExportRecords(tm)
+
+		var out bytes.Buffer
+		config.Out = &out
+		config.Doit.Set(config.NS, doctl.ArgFormat, "hcl")
+
+		require.NoError(t, RunExport(config))
+
+		hcl := out.String()
+		assert.Contains(t, hcl, `resource "digitalocean_droplet" "web_01" {
+  name       = "web-01"
+  region     = "nyc3"
+  size       = "s-1vcpu-1gb"
+  image      = "ubuntu-18-04-x64"
+  monitoring = true
+  tags       = [digitalocean_tag.web.id]
+  volume_ids = [digitalocean_volume.web_data.id]
+}`)
+		assert.Contains(t, hcl, `    certificate_id  = digitalocean_certificate.web.id`)
+		assert.Contains(t, hcl, `    source_droplet_ids = [digitalocean_droplet.web_01.id]`)
+		assert.Contains(t, hcl, `  value  = digitalocean_loadbalancer.web.ip`)
+		assert.Contains(t, hcl, `  private_key       = file("old.key")`)
+		assert.Contains(t, hcl, "#   terraform import digitalocean_droplet.db_01 2\n")
+		assert.Contains(t, hcl, "#   terraform import digitalocean_record.example_com_a_www example.com,12\n")
+		assert.Contains(t, hcl, "#   terraform import digitalocean_record.example_org_cname_www example.org,14\n")
+	})
+}
+
+func TestRunExportFormat(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgFormat, "json")
+		assert.EqualError(t, RunExport(config), `unknown format "json", use yaml or hcl`)
+	})
+}
+
+func TestExportTerraformUnknownRefs(t *testing.T) {
+	e := &stackExport{
+		spec: StackSpec{
+			LoadBalancers: []StackLoadBalancer{{Name: "web", Region: "nyc3", Droplets: []string{"gone", "web-02"}}},
+			Firewalls:     []StackFirewall{{Name: "web", Droplets: []string{"gone"}, Tags: []string{"web"}}},
+		},
+		ids:      map[string]string{"droplet/web-02": "123", "tag/web": "web"},
+		exported: map[string]bool{},
+	}
+
+	hcl, _ := e.terraform()
+	assert.Contains(t, hcl, "  droplet_ids = [123]\n")
+	assert.NotContains(t, hcl, "[, ")
+	assert.NotContains(t, hcl, ", ]")
+	assert.Contains(t, hcl, `resource "digitalocean_firewall" "web" {
+  name = "web"
+  tags = [digitalocean_tag.web.id]
+}`)
+}
+
+func TestHCLString(t *testing.T) {
+	for s, want := range map[string]string{
+		"web-01":              `"web-01"`,
+		`say "hi"\now`:        `"say \"hi\"\\now"`,
+		"a\nb\r\tc":           `"a\nb\r\tc"`,
+		"web\x1b[1m\a\v":      `"web\u001b[1m\u0007\u000b"`,
+		"${var.name} %{if x}": `"$${var.name} %%{if x}"`,
+		"$5 and 100%":         `"$5 and 100%"`,
+		"café \u2028 🚀":       "\"café \\u2028 🚀\"",
+		"bad \xff byte":       "\"bad � byte\"",
+	} {
+		assert.Equal(t, want, hclString(s), s)
+	}
+}
@@ -88,10 +88,13 @@ func RunFirewallGet(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	id := c.Args[0]
+	id, err := firewallResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	fs := c.Firewalls()
-	f, err := fs.Get(id)
+	f, err := fs.Get(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -108,7 +111,7 @@ func RunFirewallCreate(c *CmdConfig) error {
 	}
 
 	fs := c.Firewalls()
-	f, err := fs.Create(r)
+	f, err := fs.Create(c.Ctx, r)
 	if err != nil {
 		return err
 	}
@@ -122,7 +125,10 @@ func RunFirewallUpdate(c *CmdConfig) error {
 	if len(c.Args) == 0 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	fID := c.Args[0]
+	fID, err := firewallResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	r := new(godo.FirewallRequest)
 	if err := buildFirewallRequestFromArgs(c, r); err != nil {
@@ -130,7 +136,7 @@ func RunFirewallUpdate(c *CmdConfig) error {
 	}
 
 	fs := c.Firewalls()
-	f, err := fs.Update(fID, r)
+	f, err := fs.Update(c.Ctx, fID, r)
 	if err != nil {
 		return err
 	}
@@ -142,7 +148,7 @@ func RunFirewallUpdate(c *CmdConfig) error {
 // RunFirewallList lists Firewalls.
 func RunFirewallList(c *CmdConfig) error {
 	fs := c.Firewalls()
-	list, err := fs.List()
+	list, err := fs.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -156,13 +162,13 @@ func RunFirewallListByDroplet(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	dID, err := strconv.Atoi(c.Args[0])
+	dID, err := dropletResolver(c).resolveInt(c.Args[0])
 	if err != nil {
-		return fmt.Errorf("invalid droplet id: [%v]", c.Args[0])
+		return err
 	}
 
 	fs := c.Firewalls()
-	list, err := fs.ListByDroplet(dID)
+	list, err := fs.ListByDroplet(c.Ctx, dID)
 	if err != nil {
 		return err
 	}
@@ -182,10 +188,15 @@ func RunFirewallDelete(c *CmdConfig) error {
 		return err
 	}
 
+	ids, err := firewallResolver(c).resolveAll(c.Args)
+	if err != nil {
+		return err
+	}
+
 	fs := c.Firewalls()
-	if force || AskForConfirm(fmt.Sprintf("delete %d firewall(s)", len(c.Args))) == nil {
-		for _, id := range c.Args {
-			if err := fs.Delete(id); err != nil {
+	if force || AskForConfirm(fmt.Sprintf("delete %d firewall(s)", len(ids))) == nil {
+		for _, id := range ids {
+			if err := fs.Delete(c.Ctx, id); err != nil {
 				return err
 			}
 		}
@@ -201,7 +212,10 @@ func RunFirewallAddDroplets(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	fID := c.Args[0]
+	fID, err := firewallResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	dropletIDsList, err := c.Doit.GetStringSlice(c.NS, doctl.ArgDropletIDs)
 	if err != nil {
@@ -213,7 +227,7 @@ func RunFirewallAddDroplets(c *CmdConfig) error {
 		return err
 	}
 
-	return c.Firewalls().AddDroplets(fID, dropletIDs...)
+	return c.Firewalls().AddDroplets(c.Ctx, fID, dropletIDs...)
 }
 
 // RunFirewallRemoveDroplets removes droplets from a Firewall.
@@ -221,7 +235,10 @@ func RunFirewallRemoveDroplets(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	fID := c.Args[0]
+	fID, err := firewallResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	dropletIDsList, err := c.Doit.GetStringSlice(c.NS, doctl.ArgDropletIDs)
 	if err != nil {
@@ -233,7 +250,7 @@ func RunFirewallRemoveDroplets(c *CmdConfig) error {
 		return err
 	}
 
-	return c.Firewalls().RemoveDroplets(fID, dropletIDs...)
+	return c.Firewalls().RemoveDroplets(c.Ctx, fID, dropletIDs...)
 }
 
 // RunFirewallAddTags adds tags to a Firewall.
@@ -241,14 +258,17 @@ func RunFirewallAddTags(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	fID := c.Args[0]
+	fID, err := firewallResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	tagList, err := c.Doit.GetStringSlice(c.NS, doctl.ArgTagNames)
 	if err != nil {
 		return err
 	}
 
-	return c.Firewalls().AddTags(fID, tagList...)
+	return c.Firewalls().AddTags(c.Ctx, fID, tagList...)
 }
 
 // RunFirewallRemoveTags removes tags from a Firewall.
@@ -256,14 +276,17 @@ func RunFirewallRemoveTags(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	fID := c.Args[0]
+	fID, err := firewallResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	tagList, err := c.Doit.GetStringSlice(c.NS, doctl.ArgTagNames)
 	if err != nil {
 		return err
 	}
 
-	return c.Firewalls().RemoveTags(fID, tagList...)
+	return c.Firewalls().RemoveTags(c.Ctx, fID, tagList...)
 }
 
 // RunFirewallAddRules adds rules to a Firewall.
@@ -271,14 +294,17 @@ func RunFirewallAddRules(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	fID := c.Args[0]
+	fID, err := firewallResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	rr := new(godo.FirewallRulesRequest)
 	if err := buildFirewallRulesRequestFromArgs(c, rr); err != nil {
 		return err
 	}
 
-	return c.Firewalls().AddRules(fID, rr)
+	return c.Firewalls().AddRules(c.Ctx, fID, rr)
 }
 
 // RunFirewallRemoveRules removes rules from a Firewall.
@@ -286,14 +312,17 @@ func RunFirewallRemoveRules(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	fID := c.Args[0]
+	fID, err := firewallResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	rr := new(godo.FirewallRulesRequest)
 	if err := buildFirewallRulesRequestFromArgs(c, rr); err != nil {
 		return err
 	}
 
-	return c.Firewalls().RemoveRules(fID, rr)
+	return c.Firewalls().RemoveRules(c.Ctx, fID, rr)
 }
 
 func buildFirewallRequestFromArgs(c *CmdConfig, r *godo.FirewallRequest) error {
@@ -38,13 +38,15 @@ func FloatingIPAction() *Command {
 		"get <floating-ip> <action-id>", "get floating-ip action", Writer,
 		displayerType(&displayers.Action{}))
 
-	CmdBuilder(cmd, RunFloatingIPActionsAssign,
+	cmdFloatingIPActionsAssign := CmdBuilder(cmd, RunFloatingIPActionsAssign,
 		"assign <floating-ip> <droplet-id>", "assign a floating IP to a droplet", Writer,
 		displayerType(&displayers.Action{}))
+	AddBoolFlag(cmdFloatingIPActionsAssign, doctl.ArgCommandWait, "", false, "Wait for action to complete")
 
-	CmdBuilder(cmd, RunFloatingIPActionsUnassign,
+	cmdFloatingIPActionsUnassign := CmdBuilder(cmd, RunFloatingIPActionsUnassign,
 		"unassign <floating-ip>", "unassign a floating IP to a droplet", Writer,
 		displayerType(&displayers.Action{}))
+	AddBoolFlag(cmdFloatingIPActionsUnassign, doctl.ArgCommandWait, "", false, "Wait for action to complete")
 
 	return cmd
 }
@@ -64,7 +66,7 @@ func RunFloatingIPActionsGet(c *CmdConfig) error {
 		return err
 	}
 
-	a, err := fia.Get(ip, actionID)
+	a, err := fia.Get(c.Ctx, ip, actionID)
 	if err != nil {
 		return err
 	}
@@ -83,18 +85,17 @@ func RunFloatingIPActionsAssign(c *CmdConfig) error {
 
 	fia := c.FloatingIPActions()
 
-	dropletID, err := strconv.Atoi(c.Args[1])
+	dropletID, err := dropletResolver(c).resolveInt(c.Args[1])
 	if err != nil {
 		return err
 	}
 
-	a, err := fia.Assign(ip, dropletID)
+	a, err := fia.Assign(c.Ctx, ip, dropletID)
 	if err != nil {
 		checkErr(fmt.Errorf("could not assign IP to droplet: %v", err))
 	}
 
-	item := &displayers.Action{Actions: do.Actions{*a}}
-	return c.Display(item)
+	return displayFloatingIPAction(c, a)
 }
 
 // RunFloatingIPActionsUnassign unassigns a floating IP to a droplet.
@@ -107,11 +108,29 @@ func RunFloatingIPActionsUnassign(c *CmdConfig) error {
 
 	fia := c.FloatingIPActions()
 
-	a, err := fia.Unassign(ip)
+	a, err := fia.Unassign(c.Ctx, ip)
 	if err != nil {
 		checkErr(fmt.Errorf("could not unassign IP to droplet: %v", err))
 	}
 
+	return displayFloatingIPAction(c, a)
+}
+
+// displayFloatingIPAction displays an action, once complete if --wait is
+// passed.
+func displayFloatingIPAction(c *CmdConfig, a *do.Action) error {
+	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	if err != nil {
+		return err
+	}
+
+	if wait {
+		a, err = actionWait(c, newWaiter("%s action %d", a.Type, a.ID), a.ID)
+		if err != nil {
+			return err
+		}
+	}
+
 	item := &displayers.Action{Actions: do.Actions{*a}}
 	return c.Display(item)
 }
@@ -16,6 +16,7 @@ package commands
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,7 +28,7 @@ func TestFloatingIPActionCommand(t *testing.T) {
 
 func TestFloatingIPActionsGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.floatingIPActions.EXPECT().Get("127.0.0.1", 2).Return(&testAction, nil)
+		tm.floatingIPActions.EXPECT().Get(gomock.Any(), "127.0.0.1", 2).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "127.0.0.1", "2")
 
@@ -39,7 +40,7 @@ func TestFloatingIPActionsGet(t *testing.T) {
 
 func TestFloatingIPActionsAssign(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.floatingIPActions.EXPECT().Assign("127.0.0.1", 2).Return(&testAction, nil)
+		tm.floatingIPActions.EXPECT().Assign(gomock.Any(), "127.0.0.1", 2).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "127.0.0.1", "2")
 
@@ -50,7 +51,7 @@ func TestFloatingIPActionsAssign(t *testing.T) {
 
 func TestFloatingIPActionsUnassign(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.floatingIPActions.EXPECT().Unassign("127.0.0.1").Return(&testAction, nil)
+		tm.floatingIPActions.EXPECT().Unassign(gomock.Any(), "127.0.0.1").Return(&testAction, nil)
 
 		config.Args = append(config.Args, "127.0.0.1")
 
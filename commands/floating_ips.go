@@ -78,7 +78,7 @@ func RunFloatingIPCreate(c *CmdConfig) error {
 		DropletID: dropletID,
 	}
 
-	ip, err := fis.Create(req)
+	ip, err := fis.Create(c.Ctx, req)
 	if err != nil {
 		fmt.Println(err)
 		return err
@@ -102,7 +102,7 @@ func RunFloatingIPGet(c *CmdConfig) error {
 		return errors.New("invalid ip address")
 	}
 
-	fip, err := fis.Get(ip)
+	fip, err := fis.Get(c.Ctx, ip)
 	if err != nil {
 		return err
 	}
@@ -126,7 +126,7 @@ func RunFloatingIPDelete(c *CmdConfig) error {
 
 	if force || AskForConfirm("delete floating IP") == nil {
 		ip := c.Args[0]
-		return fis.Delete(ip)
+		return fis.Delete(c.Ctx, ip)
 	}
 
 	return fmt.Errorf("operation aborted")
@@ -141,7 +141,7 @@ func RunFloatingIPList(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := fis.List()
+	list, err := fis.List(c.Ctx)
 	if err != nil {
 		return err
 	}
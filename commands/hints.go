@@ -0,0 +1,278 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// hintTimeout bounds the API calls made to build a hint, so reporting an
+// error never takes much longer than the error itself.
+const hintTimeout = 10 * time.Second
+
+// errWithHint is an error annotated with a suggested next step.
+type errWithHint struct {
+	err  error
+	hint string
+}
+
+func (e *errWithHint) Error() string {
+	return e.err.Error()
+}
+
+func (e *errWithHint) Unwrap() error {
+	return e.err
+}
+
+// hintFn returns a suggested next step for err, or an empty string when it
+// has none.
+type hintFn func(ctx context.Context, c *CmdConfig, err error) string
+
+// hinters are consulted in order; the first hint found is used.
+var hinters = []hintFn{
+	authHint,
+	sizeRegionHint,
+	dropletNameHint,
+}
+
+// addHint annotates err with a suggested next step when one is known. No
+// hint is looked for once the command timed out or was interrupted.
+func addHint(c *CmdConfig, err error) error {
+	if err == nil || c == nil {
+		return err
+	}
+
+	parent := c.Ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	if parent.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(parent, hintTimeout)
+	defer cancel()
+
+	for _, fn := range hinters {
+		if hint := fn(ctx, c, err); hint != "" {
+			return &errWithHint{err: err, hint: hint}
+		}
+	}
+
+	return err
+}
+
+// errorHint returns the hint attached to err, if any.
+func errorHint(err error) string {
+	var eh *errWithHint
+	if errors.As(err, &eh) {
+		return eh.hint
+	}
+
+	return ""
+}
+
+func apiErrorStatus(err error) (*godo.ErrorResponse, int) {
+	var er *godo.ErrorResponse
+	if !errors.As(err, &er) || er.Response == nil {
+		return nil, 0
+	}
+
+	return er, er.Response.StatusCode
+}
+
+// authHint points to doctl auth init when the API rejects the token.
+func authHint(ctx context.Context, c *CmdConfig, err error) string {
+	authContext := getCurrentAuthContextFn()
+
+	switch _, status := apiErrorStatus(err); status {
+	case http.StatusUnauthorized:
+		return fmt.Sprintf("the access token was rejected; run doctl auth init --context %s to set a valid token", authContext)
+	case http.StatusForbidden:
+		return fmt.Sprintf("the access token is not allowed to do this, it may be read only; run doctl auth init --context %s to set a token with write access", authContext)
+	}
+
+	return ""
+}
+
+// sizeRegionHint lists the regions a size is available in when a request
+// for a size and region is rejected.
+func sizeRegionHint(ctx context.Context, c *CmdConfig, err error) string {
+	er, status := apiErrorStatus(err)
+	if status != http.StatusUnprocessableEntity || c.Sizes == nil {
+		return ""
+	}
+
+	req := er.Response.Request
+	if req == nil || req.GetBody == nil {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+
+	var r struct {
+		Size   string `json:"size"`
+		Region string `json:"region"`
+	}
+	if err := json.Unmarshal(b, &r); err != nil || r.Size == "" || r.Region == "" {
+		return ""
+	}
+
+	sizes, err := c.Sizes().List(ctx)
+	if err != nil {
+		return ""
+	}
+
+	for _, s := range sizes {
+		if s.Slug != r.Size {
+			continue
+		}
+
+		regions := append([]string{}, s.Regions...)
+		sort.Strings(regions)
+		for _, region := range regions {
+			if region == r.Region {
+				return ""
+			}
+		}
+
+		if len(regions) == 0 {
+			return fmt.Sprintf("size %s is not available in any region", r.Size)
+		}
+		return fmt.Sprintf("size %s is not available in %s; available regions: %s", r.Size, r.Region, strings.Join(regions, ", "))
+	}
+
+	return fmt.Sprintf("size %s does not exist; run doctl compute size list to see available sizes", r.Size)
+}
+
+// dropletNameHint suggests similarly named droplets when a droplet command
+// could not find the droplet it was given.
+func dropletNameHint(ctx context.Context, c *CmdConfig, err error) string {
+	if !strings.HasPrefix(c.NS, "compute.droplet") && c.NS != "compute.ssh" {
+		return ""
+	}
+
+	_, status := apiErrorStatus(err)
+	msg := err.Error()
+	if status != http.StatusNotFound && !strings.Contains(msg, "could not be found") && !strings.Contains(msg, "could not find droplet") && !strings.Contains(msg, "goes by the name") {
+		return ""
+	}
+
+	var names []string
+	for _, arg := range c.Args {
+		if _, err := strconv.Atoi(arg); err != nil {
+			names = append(names, extractHostInfo(arg).host)
+		}
+	}
+	if len(names) == 0 || c.Droplets == nil {
+		return ""
+	}
+
+	droplets, err := c.Droplets().List(ctx)
+	if err != nil {
+		return ""
+	}
+
+	known := make([]string, 0, len(droplets))
+	for _, d := range droplets {
+		known = append(known, d.Name)
+	}
+
+	for _, name := range names {
+		if suggestion := closestName(name, known); suggestion != "" && suggestion != name {
+			return fmt.Sprintf("did you mean %s?", suggestion)
+		}
+	}
+
+	return ""
+}
+
+// closestName returns the candidate nearest to name, as long as it is close
+// enough to be a plausible typo.
+func closestName(name string, candidates []string) string {
+	best, bestDistance := "", -1
+	for _, candidate := range candidates {
+		d := levenshtein(strings.ToLower(name), strings.ToLower(candidate))
+		if bestDistance < 0 || d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+
+	limit := len(name) / 3
+	if limit < 2 {
+		limit = 2
+	}
+	if bestDistance < 0 || bestDistance > limit {
+		return ""
+	}
+
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func apiErrorWithRequest(status int, method, body string) error {
+	req, _ := http.NewRequest(method, "https://api.digitalocean.com/v2/droplets", bytes.NewBufferString(body))
+	resp := &http.Response{
+		StatusCode: status,
+		Request:    req,
+		Body:       ioutil.NopCloser(strings.NewReader(`{"message":"an error"}`)),
+	}
+
+	return godo.CheckResponse(resp)
+}
+
+func TestAddHint(t *testing.T) {
+	sizes := do.Sizes{
+		{Size: &godo.Size{Slug: "s-1vcpu-1gb", Regions: []string{"nyc3", "ams3", "nyc1"}}},
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		defer func() { getCurrentAuthContextFn = defaultGetCurrentAuthContextFn }()
+		getCurrentAuthContextFn = func() string { return "work" }
+
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			err := addHint(config, apiErrorWithRequest(http.StatusUnauthorized, http.MethodGet, ""))
+			assert.Contains(t, errorHint(err), "run doctl auth init --context work")
+		})
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			err := addHint(config, apiErrorWithRequest(http.StatusForbidden, http.MethodPost, "{}"))
+			assert.Contains(t, errorHint(err), "run doctl auth init --context default")
+		})
+	})
+
+	t.Run("size not available in region", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.sizes.EXPECT().List(gomock.Any()).Return(sizes, nil)
+
+			err := addHint(config, apiErrorWithRequest(http.StatusUnprocessableEntity, http.MethodPost, `{"name":"web","size":"s-1vcpu-1gb","region":"sfo1"}`))
+			assert.Equal(t, "size s-1vcpu-1gb is not available in sfo1; available regions: ams3, nyc1, nyc3", errorHint(err))
+		})
+	})
+
+	t.Run("unknown size", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.sizes.EXPECT().List(gomock.Any()).Return(sizes, nil)
+
+			err := addHint(config, apiErrorWithRequest(http.StatusUnprocessableEntity, http.MethodPost, `{"size":"huge","region":"sfo1"}`))
+			assert.Equal(t, "size huge does not exist; run doctl compute size list to see available sizes", errorHint(err))
+		})
+	})
+
+	t.Run("size available in region", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.sizes.EXPECT().List(gomock.Any()).Return(sizes, nil)
+
+			original := apiErrorWithRequest(http.StatusUnprocessableEntity, http.MethodPost, `{"size":"s-1vcpu-1gb","region":"nyc1"}`)
+			assert.Equal(t, original, addHint(config, original))
+		})
+	})
+
+	t.Run("mistyped droplet name", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.droplets.EXPECT().List(gomock.Any()).Return(do.Droplets{
+				{Droplet: &godo.Droplet{ID: 1, Name: "web-01"}},
+				{Droplet: &godo.Droplet{ID: 2, Name: "db-01"}},
+			}, nil)
+
+			config.NS = "compute.droplet.delete"
+			config.Args = []string{"web-1"}
+
+			err := addHint(config, fmt.Errorf("droplet with name %q could not be found", "web-1"))
+			assert.Equal(t, "did you mean web-01?", errorHint(err))
+			assert.Equal(t, `droplet with name "web-1" could not be found`, err.Error())
+		})
+	})
+
+	t.Run("no similar droplet name", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.droplets.EXPECT().List(gomock.Any()).Return(do.Droplets{
+				{Droplet: &godo.Droplet{ID: 1, Name: "web-01"}},
+			}, nil)
+
+			config.NS = "compute.ssh"
+			config.Args = []string{"root@database"}
+
+			err := addHint(config, errors.New("could not find droplet"))
+			assert.Equal(t, "", errorHint(err))
+		})
+	})
+
+	t.Run("interrupted command", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			config.Ctx = ctx
+			config.NS = "compute.droplet.delete"
+			config.Args = []string{"web-1"}
+
+			original := fmt.Errorf("droplet with name %q could not be found", "web-1")
+			assert.Equal(t, original, addHint(config, original))
+		})
+	})
+
+	t.Run("timed out request", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			original := fmt.Errorf("listing sizes: %w", context.DeadlineExceeded)
+			assert.Equal(t, original, addHint(config, original))
+		})
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			original := errors.New("an error")
+			assert.Equal(t, original, addHint(config, original))
+			assert.Nil(t, addHint(config, nil))
+		})
+	})
+}
+
+func TestClosestName(t *testing.T) {
+	names := []string{"web-01", "web-02", "database"}
+
+	assert.Equal(t, "web-01", closestName("web-1", names))
+	assert.Equal(t, "database", closestName("databse", names))
+	assert.Equal(t, "", closestName("cache", names))
+	assert.Equal(t, "", closestName("web-1", nil))
+}
@@ -66,7 +66,7 @@ func RunImageActionsGet(c *CmdConfig) error {
 		return err
 	}
 
-	a, err := ias.Get(imageID, actionID)
+	a, err := ias.Get(c.Ctx, imageID, actionID)
 	if err != nil {
 		return err
 	}
@@ -98,7 +98,7 @@ func RunImageActionsTransfer(c *CmdConfig) error {
 		"region": region,
 	}
 
-	a, err := ias.Transfer(id, req)
+	a, err := ias.Transfer(c.Ctx, id, req)
 	if err != nil {
 		checkErr(fmt.Errorf("could not transfer image: %v", err))
 	}
@@ -109,7 +109,7 @@ func RunImageActionsTransfer(c *CmdConfig) error {
 	}
 
 	if wait {
-		a, err = actionWait(c, a.ID, 5)
+		a, err = actionWait(c, newWaiter("%s action %d", a.Type, a.ID), a.ID)
 		if err != nil {
 			return err
 		}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,7 +30,7 @@ func TestImageActionCommand(t *testing.T) {
 
 func TestImageActionsGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.imageActions.EXPECT().Get(1, 2).Return(&testAction, nil)
+		tm.imageActions.EXPECT().Get(gomock.Any(), 1, 2).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -44,7 +45,7 @@ func TestImageActionsGet(t *testing.T) {
 func TestImageActionsTransfer(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		ar := &godo.ActionRequest{"type": "transfer", "region": "dev0"}
-		tm.imageActions.EXPECT().Transfer(1, ar).Return(&testAction, nil)
+		tm.imageActions.EXPECT().Transfer(gomock.Any(), 1, ar).Return(&testAction, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -14,6 +14,7 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -69,6 +70,7 @@ func Images() *Command {
 	AddStringFlag(cmdRunImagesCreate, doctl.ArgImageDistro, "", "Unknown", "Custom image distribution")
 	AddStringFlag(cmdRunImagesCreate, doctl.ArgImageDescription, "", "", "Text to describe an image")
 	AddStringSliceFlag(cmdRunImagesCreate, doctl.ArgTagNames, "", []string{}, "List of new or existing tags")
+	AddBoolFlag(cmdRunImagesCreate, doctl.ArgCommandWait, "", false, "Wait for image to be available")
 
 	return cmd
 }
@@ -82,13 +84,25 @@ func RunImagesList(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := is.List(public)
+	pd, err := newPagedDisplay(c)
+	if err != nil {
+		return err
+	}
+
+	var list do.Images
+	err = is.ListPages(c.Ctx, public, func(page do.Images) error {
+		if pd.stream {
+			return pd.page(&displayers.Image{Images: page})
+		}
+		list = append(list, page...)
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
 	item := &displayers.Image{Images: list}
-	return c.Display(item)
+	return pd.done(item)
 }
 
 // RunImagesListDistribution lists distributions that are available.
@@ -100,7 +114,7 @@ func RunImagesListDistribution(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := is.ListDistribution(public)
+	list, err := is.ListDistribution(c.Ctx, public)
 	if err != nil {
 		return err
 	}
@@ -119,7 +133,7 @@ func RunImagesListApplication(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := is.ListApplication(public)
+	list, err := is.ListApplication(c.Ctx, public)
 	if err != nil {
 		return err
 	}
@@ -137,7 +151,7 @@ func RunImagesListUser(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := is.ListUser(public)
+	list, err := is.ListUser(c.Ctx, public)
 	if err != nil {
 		return err
 	}
@@ -160,10 +174,10 @@ func RunImagesGet(c *CmdConfig) error {
 	var err error
 
 	if id, cerr := strconv.Atoi(rawID); cerr == nil {
-		i, err = is.GetByID(id)
+		i, err = is.GetByID(c.Ctx, id)
 	} else {
 		if len(rawID) > 0 {
-			i, err = is.GetBySlug(rawID)
+			i, err = is.GetBySlug(c.Ctx, rawID)
 		} else {
 			err = fmt.Errorf("image identifier is required")
 		}
@@ -199,7 +213,7 @@ func RunImagesUpdate(c *CmdConfig) error {
 		Name: name,
 	}
 
-	i, err := is.Update(id, req)
+	i, err := is.Update(c.Ctx, id, req)
 	if err != nil {
 		return err
 	}
@@ -228,7 +242,7 @@ func RunImagesDelete(c *CmdConfig) error {
 			if err != nil {
 				return err
 			}
-			if err := is.Delete(id); err != nil {
+			if err := is.Delete(c.Ctx, id); err != nil {
 				return err
 			}
 		}
@@ -248,12 +262,38 @@ func RunImagesCreate(c *CmdConfig) error {
 		return err
 	}
 
+	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	if err != nil {
+		return err
+	}
+
 	is := c.Images()
-	i, err := is.Create(r)
+	i, err := is.Create(c.Ctx, r)
 	if err != nil {
 		return err
 	}
 
+	if wait {
+		err := newWaiter("image %s", i.Name).wait(c.Ctx, func(ctx context.Context) (string, bool, error) {
+			updated, err := is.GetByID(ctx, i.ID)
+			if err != nil {
+				return "", false, err
+			}
+			i = updated
+
+			switch {
+			case i.ErrorMessage != "":
+				return i.Status, true, fmt.Errorf("image %d couldn't be created: %s", i.ID, i.ErrorMessage)
+			case i.Status == "deleted":
+				return i.Status, true, fmt.Errorf("image %d was deleted", i.ID)
+			}
+			return i.Status, i.Status == "available", nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	item := &displayers.Image{Images: do.Images{*i}}
 	return c.Display(item)
 }
@@ -14,11 +14,14 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"strconv"
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,7 +33,9 @@ func TestImageCommand(t *testing.T) {
 
 func TestImagesList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.EXPECT().List(false).Return(testImageList, nil)
+		tm.images.EXPECT().ListPages(gomock.Any(), false, gomock.Any()).DoAndReturn(func(ctx context.Context, public bool, fn func(do.Images) error) error {
+			return fn(testImageList)
+		})
 
 		err := RunImagesList(config)
 		assert.NoError(t, err)
@@ -39,7 +44,7 @@ func TestImagesList(t *testing.T) {
 
 func TestImagesListDistribution(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.EXPECT().ListDistribution(false).Return(testImageList, nil)
+		tm.images.EXPECT().ListDistribution(gomock.Any(), false).Return(testImageList, nil)
 
 		err := RunImagesListDistribution(config)
 		assert.NoError(t, err)
@@ -48,7 +53,7 @@ func TestImagesListDistribution(t *testing.T) {
 
 func TestImagesListApplication(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.EXPECT().ListApplication(false).Return(testImageList, nil)
+		tm.images.EXPECT().ListApplication(gomock.Any(), false).Return(testImageList, nil)
 
 		err := RunImagesListApplication(config)
 		assert.NoError(t, err)
@@ -57,7 +62,7 @@ func TestImagesListApplication(t *testing.T) {
 
 func TestImagesListUser(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.EXPECT().ListUser(false).Return(testImageList, nil)
+		tm.images.EXPECT().ListUser(gomock.Any(), false).Return(testImageList, nil)
 
 		err := RunImagesListUser(config)
 		assert.NoError(t, err)
@@ -66,7 +71,7 @@ func TestImagesListUser(t *testing.T) {
 
 func TestImagesGetByID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.EXPECT().GetByID(testImage.ID).Return(&testImage, nil)
+		tm.images.EXPECT().GetByID(gomock.Any(), testImage.ID).Return(&testImage, nil)
 
 		config.Args = append(config.Args, strconv.Itoa(testImage.ID))
 		err := RunImagesGet(config)
@@ -76,7 +81,7 @@ func TestImagesGetByID(t *testing.T) {
 
 func TestImagesGetBySlug(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.EXPECT().GetBySlug(testImage.Slug).Return(&testImage, nil)
+		tm.images.EXPECT().GetBySlug(gomock.Any(), testImage.Slug).Return(&testImage, nil)
 
 		config.Args = append(config.Args, testImage.Slug)
 		err := RunImagesGet(config)
@@ -94,7 +99,7 @@ func TestImagesNoID(t *testing.T) {
 func TestImagesUpdate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		iur := &godo.ImageUpdateRequest{Name: "new-name"}
-		tm.images.EXPECT().Update(testImage.ID, iur).Return(&testImage, nil)
+		tm.images.EXPECT().Update(gomock.Any(), testImage.ID, iur).Return(&testImage, nil)
 
 		config.Args = append(config.Args, strconv.Itoa(testImage.ID))
 		config.Doit.Set(config.NS, doctl.ArgImageName, "new-name")
@@ -105,7 +110,7 @@ func TestImagesUpdate(t *testing.T) {
 
 func TestImagesDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.EXPECT().Delete(testImage.ID).Return(nil)
+		tm.images.EXPECT().Delete(gomock.Any(), testImage.ID).Return(nil)
 
 		config.Args = append(config.Args, strconv.Itoa(testImage.ID))
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -118,8 +123,8 @@ func TestImagesDelete(t *testing.T) {
 
 func TestImagesDeleteMultiple(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.images.EXPECT().Delete(testImage.ID).Return(nil)
-		tm.images.EXPECT().Delete(testImageSecondary.ID).Return(nil)
+		tm.images.EXPECT().Delete(gomock.Any(), testImage.ID).Return(nil)
+		tm.images.EXPECT().Delete(gomock.Any(), testImageSecondary.ID).Return(nil)
 
 		config.Args = append(config.Args, strconv.Itoa(testImage.ID), strconv.Itoa(testImageSecondary.ID))
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -139,7 +144,7 @@ func TestImagesCreate(t *testing.T) {
 			Region: "nyc1",
 		}
 
-		tm.images.EXPECT().Create(&r).Return(&testImage, nil)
+		tm.images.EXPECT().Create(gomock.Any(), &r).Return(&testImage, nil)
 
 		config.Args = append(config.Args, "test-image")
 		config.Doit.Set(config.NS, doctl.ArgImageName, "test-image")
@@ -66,27 +66,27 @@ func defaultGetCurrentAuthContextFn() string {
 }
 
 func errNoClusterByName(name string) error {
-	return fmt.Errorf("no cluster goes by the name %q", name)
+	return errNoResourceByName("cluster", name)
 }
 
 func errAmbigousClusterName(name string, ids []string) error {
-	return fmt.Errorf("many clusters go by the name %q, they have the following IDs: %v", name, ids)
+	return errAmbiguousResourceName("clusters", name, ids)
 }
 
 func errNoPoolByName(name string) error {
-	return fmt.Errorf("no node pool goes by the name %q", name)
+	return errNoResourceByName("node pool", name)
 }
 
 func errAmbigousPoolName(name string, ids []string) error {
-	return fmt.Errorf("many node pools go by the name %q, they have the following IDs: %v", name, ids)
+	return errAmbiguousResourceName("node pools", name, ids)
 }
 
 func errNoClusterNodeByName(name string) error {
-	return fmt.Errorf("no node goes by the name %q", name)
+	return errNoResourceByName("node", name)
 }
 
 func errAmbigousClusterNodeName(name string, ids []string) error {
-	return fmt.Errorf("many nodes go by the name %q, they have the following IDs: %v", name, ids)
+	return errAmbiguousResourceName("nodes", name, ids)
 }
 
 // Kubernetes creates the kubernetes command.
@@ -108,7 +108,7 @@ func Kubernetes() *Command {
 // KubeconfigProvider allows a user to read from a remote and local Kubeconfig, and write to a
 // local Kubeconfig.
 type KubeconfigProvider interface {
-	Remote(kube do.KubernetesService, clusterID string) (*clientcmdapi.Config, error)
+	Remote(ctx context.Context, kube do.KubernetesService, clusterID string) (*clientcmdapi.Config, error)
 	Local() (*clientcmdapi.Config, error)
 	Write(config *clientcmdapi.Config) error
 	ConfigPath() string
@@ -119,8 +119,8 @@ type kubeconfigProvider struct {
 }
 
 // Remote returns the kubeconfig for the cluster with the given ID from DOKS.
-func (p *kubeconfigProvider) Remote(kube do.KubernetesService, clusterID string) (*clientcmdapi.Config, error) {
-	kubeconfig, err := kube.GetKubeConfig(clusterID)
+func (p *kubeconfigProvider) Remote(ctx context.Context, kube do.KubernetesService, clusterID string) (*clientcmdapi.Config, error) {
+	kubeconfig, err := kube.GetKubeConfig(ctx, clusterID)
 	if err != nil {
 		return nil, err
 	}
@@ -413,7 +413,7 @@ func (s *KubernetesCommandService) RunKubernetesClusterGet(c *CmdConfig) error {
 	}
 	clusterIDorName := c.Args[0]
 
-	cluster, err := clusterByIDorName(c.Kubernetes(), clusterIDorName)
+	cluster, err := clusterByIDorName(c.Ctx, c.Kubernetes(), clusterIDorName)
 	if err != nil {
 		return err
 	}
@@ -423,7 +423,7 @@ func (s *KubernetesCommandService) RunKubernetesClusterGet(c *CmdConfig) error {
 // RunKubernetesClusterList lists kubernetess.
 func (s *KubernetesCommandService) RunKubernetesClusterList(c *CmdConfig) error {
 	kube := c.Kubernetes()
-	list, err := kube.List()
+	list, err := kube.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -437,14 +437,14 @@ func (s *KubernetesCommandService) RunKubernetesClusterGetUpgrades(c *CmdConfig)
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 	clusterIDorName := c.Args[0]
-	clusterID, err := clusterIDize(c.Kubernetes(), clusterIDorName)
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), clusterIDorName)
 	if err != nil {
 		return err
 	}
 
 	kube := c.Kubernetes()
 
-	upgrades, err := kube.GetUpgrades(clusterID)
+	upgrades, err := kube.GetUpgrades(c.Ctx, clusterID)
 	if err != nil {
 		return err
 	}
@@ -472,6 +472,8 @@ func (s *KubernetesCommandService) RunKubernetesClusterCreate(defaultNodeSize st
 		if err != nil {
 			return err
 		}
+		// A dry run cluster has no credentials to add.
+		update = update && !dryRun()
 		setCurrentContext, err := c.Doit.GetBool(c.NS, doctl.ArgSetCurrentContext)
 		if err != nil {
 			return err
@@ -479,22 +481,25 @@ func (s *KubernetesCommandService) RunKubernetesClusterCreate(defaultNodeSize st
 
 		kube := c.Kubernetes()
 
-		cluster, err := kube.Create(r)
+		cluster, err := kube.Create(c.Ctx, r)
 		if err != nil {
 			return err
 		}
 
 		if wait {
 			notice("cluster is provisioning, waiting for cluster to be running")
-			cluster, err = waitForClusterRunning(kube, cluster.ID)
+			running, err := waitForClusterRunning(c.Ctx, kube, cluster.ID)
 			if err != nil {
-				warn("cluster didn't become running: %v", err)
+				return err
+			}
+			if running != nil {
+				cluster = running
 			}
 		}
 
 		if update {
 			notice("cluster created, fetching credentials")
-			s.tryUpdateKubeconfig(kube, cluster.ID, clusterName, setCurrentContext)
+			s.tryUpdateKubeconfig(c.Ctx, kube, cluster.ID, clusterName, setCurrentContext)
 		}
 
 		return displayClusters(c, true, *cluster)
@@ -510,12 +515,13 @@ func (s *KubernetesCommandService) RunKubernetesClusterUpdate(c *CmdConfig) erro
 	if err != nil {
 		return err
 	}
+	update = update && !dryRun()
 	setCurrentContext, err := c.Doit.GetBool(c.NS, doctl.ArgSetCurrentContext)
 	if err != nil {
 		return err
 	}
 	clusterIDorName := c.Args[0]
-	clusterID, err := clusterIDize(c.Kubernetes(), clusterIDorName)
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), clusterIDorName)
 	if err != nil {
 		return err
 	}
@@ -526,28 +532,28 @@ func (s *KubernetesCommandService) RunKubernetesClusterUpdate(c *CmdConfig) erro
 	}
 
 	kube := c.Kubernetes()
-	cluster, err := kube.Update(clusterID, r)
+	cluster, err := kube.Update(c.Ctx, clusterID, r)
 	if err != nil {
 		return err
 	}
 
 	if update {
 		notice("cluster updated, fetching new credentials")
-		s.tryUpdateKubeconfig(kube, clusterID, clusterIDorName, setCurrentContext)
+		s.tryUpdateKubeconfig(c.Ctx, kube, clusterID, clusterIDorName, setCurrentContext)
 	}
 
 	return displayClusters(c, true, *cluster)
 }
 
-func (s *KubernetesCommandService) tryUpdateKubeconfig(kube do.KubernetesService, clusterID, clusterName string, setCurrentContext bool) {
+func (s *KubernetesCommandService) tryUpdateKubeconfig(ctx context.Context, kube do.KubernetesService, clusterID, clusterName string, setCurrentContext bool) {
 	var (
 		remoteConfig *clientcmdapi.Config
 		err          error
 	)
-	ctx, cancel := context.WithTimeout(context.TODO(), timeoutFetchingKubeconfig)
+	ctx, cancel := context.WithTimeout(ctx, timeoutFetchingKubeconfig)
 	defer cancel()
 	for {
-		remoteConfig, err = s.KubeconfigProvider.Remote(kube, clusterID)
+		remoteConfig, err = s.KubeconfigProvider.Remote(ctx, kube, clusterID)
 		if err != nil {
 			select {
 			case <-ctx.Done():
@@ -569,7 +575,7 @@ func (s *KubernetesCommandService) RunKubernetesClusterUpgrade(c *CmdConfig) err
 	if len(c.Args) == 0 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	clusterID, err := clusterIDize(c.Kubernetes(), c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), c.Args[0])
 	if err != nil {
 		return err
 	}
@@ -584,7 +590,7 @@ func (s *KubernetesCommandService) RunKubernetesClusterUpgrade(c *CmdConfig) err
 	}
 
 	kube := c.Kubernetes()
-	err = kube.Upgrade(clusterID, version)
+	err = kube.Upgrade(c.Ctx, clusterID, version)
 	if err != nil {
 		return err
 	}
@@ -602,12 +608,12 @@ func getUpgradeVersionOrLatest(c *CmdConfig, clusterID string) (string, bool, er
 		return version, true, nil
 	}
 
-	cluster, err := c.Kubernetes().Get(clusterID)
+	cluster, err := c.Kubernetes().Get(c.Ctx, clusterID)
 	if err != nil {
 		return "", false, fmt.Errorf("unable to lookup cluster to find the latest version from the API: %v", err)
 	}
 
-	versions, err := c.Kubernetes().GetUpgrades(clusterID)
+	versions, err := c.Kubernetes().GetUpgrades(c.Ctx, clusterID)
 	if err != nil {
 		return "", false, fmt.Errorf("unable to lookup the latest version from the API: %v", err)
 	}
@@ -670,7 +676,8 @@ func (s *KubernetesCommandService) RunKubernetesClusterDelete(c *CmdConfig) erro
 	if err != nil {
 		return err
 	}
-	clusterID, err := clusterIDize(c.Kubernetes(), c.Args[0])
+	update = update && !dryRun()
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), c.Args[0])
 	if err != nil {
 		return err
 	}
@@ -691,12 +698,12 @@ func (s *KubernetesCommandService) RunKubernetesClusterDelete(c *CmdConfig) erro
 	if update {
 		// get the cluster's kubeconfig before issuing the delete, so that we can
 		// cleanup the entry from the local file
-		kubeconfig, err = kube.GetKubeConfig(clusterID)
+		kubeconfig, err = kube.GetKubeConfig(c.Ctx, clusterID)
 		if err != nil {
 			warn("couldn't get credentials for cluster, it will not be remove from your kubeconfig")
 		}
 	}
-	if err := kube.Delete(clusterID); err != nil {
+	if err := kube.Delete(c.Ctx, clusterID); err != nil {
 		return err
 	}
 	if kubeconfig != nil {
@@ -717,11 +724,11 @@ func (s *KubernetesCommandService) RunKubernetesKubeconfigShow(c *CmdConfig) err
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 	kube := c.Kubernetes()
-	clusterID, err := clusterIDize(kube, c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, kube, c.Args[0])
 	if err != nil {
 		return err
 	}
-	kubeconfig, err := kube.GetKubeConfig(clusterID)
+	kubeconfig, err := kube.GetKubeConfig(c.Ctx, clusterID)
 	if err != nil {
 		return err
 	}
@@ -817,7 +824,7 @@ func (s *KubernetesCommandService) RunKubernetesKubeconfigExecCredential(c *CmdC
 		return json.NewEncoder(c.Out).Encode(execCredential)
 	}
 
-	credentials, err := kube.GetCredentials(clusterID)
+	credentials, err := kube.GetCredentials(c.Ctx, clusterID)
 	if err != nil {
 		if errResponse, ok := err.(*godo.ErrorResponse); ok {
 			return fmt.Errorf("failed to fetch credentials for cluster %q: %v", clusterID, errResponse.Message)
@@ -854,12 +861,12 @@ func (s *KubernetesCommandService) RunKubernetesKubeconfigSave(c *CmdConfig) err
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 	kube := c.Kubernetes()
-	clusterID, err := clusterIDize(kube, c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, kube, c.Args[0])
 	if err != nil {
 		return err
 	}
 
-	remoteKubeconfig, err := s.KubeconfigProvider.Remote(kube, clusterID)
+	remoteKubeconfig, err := s.KubeconfigProvider.Remote(c.Ctx, kube, clusterID)
 	if err != nil {
 		return err
 	}
@@ -878,11 +885,11 @@ func (s *KubernetesCommandService) RunKubernetesKubeconfigRemove(c *CmdConfig) e
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 	kube := c.Kubernetes()
-	clusterID, err := clusterIDize(kube, c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, kube, c.Args[0])
 	if err != nil {
 		return err
 	}
-	kubeconfig, err := kube.GetKubeConfig(clusterID)
+	kubeconfig, err := kube.GetKubeConfig(c.Ctx, clusterID)
 	if err != nil {
 		return err
 	}
@@ -897,11 +904,11 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolGet(c *CmdConfig) error
 	if len(c.Args) != 2 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	clusterID, err := clusterIDize(c.Kubernetes(), c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), c.Args[0])
 	if err != nil {
 		return err
 	}
-	nodePool, err := poolByIDorName(c.Kubernetes(), clusterID, c.Args[1])
+	nodePool, err := poolByIDorName(c.Ctx, c.Kubernetes(), clusterID, c.Args[1])
 	if err != nil {
 		return err
 	}
@@ -913,12 +920,12 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolList(c *CmdConfig) error
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	clusterID, err := clusterIDize(c.Kubernetes(), c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), c.Args[0])
 	if err != nil {
 		return err
 	}
 	kube := c.Kubernetes()
-	list, err := kube.ListNodePools(clusterID)
+	list, err := kube.ListNodePools(c.Ctx, clusterID)
 	if err != nil {
 		return err
 	}
@@ -931,7 +938,7 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolCreate(c *CmdConfig) err
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	clusterID, err := clusterIDize(c.Kubernetes(), c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), c.Args[0])
 	if err != nil {
 		return err
 	}
@@ -942,7 +949,7 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolCreate(c *CmdConfig) err
 	}
 
 	kube := c.Kubernetes()
-	nodePool, err := kube.CreateNodePool(clusterID, r)
+	nodePool, err := kube.CreateNodePool(c.Ctx, clusterID, r)
 	if err != nil {
 		return err
 	}
@@ -955,11 +962,11 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolUpdate(c *CmdConfig) err
 	if len(c.Args) != 2 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	clusterID, err := clusterIDize(c.Kubernetes(), c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), c.Args[0])
 	if err != nil {
 		return err
 	}
-	poolID, err := poolIDize(c.Kubernetes(), clusterID, c.Args[1])
+	poolID, err := poolIDize(c.Ctx, c.Kubernetes(), clusterID, c.Args[1])
 	if err != nil {
 		return err
 	}
@@ -970,7 +977,7 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolUpdate(c *CmdConfig) err
 	}
 
 	kube := c.Kubernetes()
-	nodePool, err := kube.UpdateNodePool(clusterID, poolID, r)
+	nodePool, err := kube.UpdateNodePool(c.Ctx, clusterID, poolID, r)
 	if err != nil {
 		return err
 	}
@@ -983,11 +990,11 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolRecycle(c *CmdConfig) er
 	if len(c.Args) != 2 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	clusterID, err := clusterIDize(c.Kubernetes(), c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), c.Args[0])
 	if err != nil {
 		return err
 	}
-	poolID, err := poolIDize(c.Kubernetes(), clusterID, c.Args[1])
+	poolID, err := poolIDize(c.Ctx, c.Kubernetes(), clusterID, c.Args[1])
 	if err != nil {
 		return err
 	}
@@ -998,7 +1005,7 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolRecycle(c *CmdConfig) er
 	}
 
 	kube := c.Kubernetes()
-	return kube.RecycleNodePoolNodes(clusterID, poolID, r)
+	return kube.RecycleNodePoolNodes(c.Ctx, clusterID, poolID, r)
 }
 
 // RunKubernetesNodePoolDelete deletes a Kubernetes node pool
@@ -1006,11 +1013,11 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolDelete(c *CmdConfig) err
 	if len(c.Args) != 2 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	clusterID, err := clusterIDize(c.Kubernetes(), c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), c.Args[0])
 	if err != nil {
 		return err
 	}
-	poolID, err := poolIDize(c.Kubernetes(), clusterID, c.Args[1])
+	poolID, err := poolIDize(c.Ctx, c.Kubernetes(), clusterID, c.Args[1])
 	if err != nil {
 		return err
 	}
@@ -1021,7 +1028,7 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolDelete(c *CmdConfig) err
 	}
 	if force || AskForConfirm("delete this Kubernetes node pool") == nil {
 		kube := c.Kubernetes()
-		if err := kube.DeleteNodePool(clusterID, poolID); err != nil {
+		if err := kube.DeleteNodePool(c.Ctx, clusterID, poolID); err != nil {
 			return err
 		}
 	} else {
@@ -1044,11 +1051,11 @@ func kubernetesNodeDelete(replace bool, c *CmdConfig) error {
 	if len(c.Args) != 3 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	clusterID, err := clusterIDize(c.Kubernetes(), c.Args[0])
+	clusterID, err := clusterIDize(c.Ctx, c.Kubernetes(), c.Args[0])
 	if err != nil {
 		return err
 	}
-	poolID, err := poolIDize(c.Kubernetes(), clusterID, c.Args[1])
+	poolID, err := poolIDize(c.Ctx, c.Kubernetes(), clusterID, c.Args[1])
 	if err != nil {
 		return err
 	}
@@ -1074,7 +1081,7 @@ func kubernetesNodeDelete(replace bool, c *CmdConfig) error {
 	}
 
 	kube := c.Kubernetes()
-	return kube.DeleteNode(clusterID, poolID, nodeID, &godo.KubernetesNodeDeleteRequest{
+	return kube.DeleteNode(c.Ctx, clusterID, poolID, nodeID, &godo.KubernetesNodeDeleteRequest{
 		Replace:   replace,
 		SkipDrain: skipDrain,
 	})
@@ -1083,7 +1090,7 @@ func kubernetesNodeDelete(replace bool, c *CmdConfig) error {
 // RunKubeOptionsListVersion lists valid versions for kubernetes clusters.
 func (s *KubernetesCommandService) RunKubeOptionsListVersion(c *CmdConfig) error {
 	kube := c.Kubernetes()
-	versions, err := kube.GetVersions()
+	versions, err := kube.GetVersions(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -1094,7 +1101,7 @@ func (s *KubernetesCommandService) RunKubeOptionsListVersion(c *CmdConfig) error
 // RunKubeOptionsListRegion lists valid regions for kubernetes clusters.
 func (s *KubernetesCommandService) RunKubeOptionsListRegion(c *CmdConfig) error {
 	kube := c.Kubernetes()
-	regions, err := kube.GetRegions()
+	regions, err := kube.GetRegions(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -1105,7 +1112,7 @@ func (s *KubernetesCommandService) RunKubeOptionsListRegion(c *CmdConfig) error
 // RunKubeOptionsListNodeSizes lists valid node sizes for kubernetes clusters.
 func (s *KubernetesCommandService) RunKubeOptionsListNodeSizes(c *CmdConfig) error {
 	kube := c.Kubernetes()
-	sizes, err := kube.GetNodeSizes()
+	sizes, err := kube.GetNodeSizes(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -1228,7 +1235,7 @@ func buildNodePoolRecycleRequestFromArgs(c *CmdConfig, clusterID, poolID string,
 		r.Nodes = nodeIDorNames
 	} else {
 		// at least some of the args weren't UUIDs, so assume that they're all names
-		nodes, err := nodesByNames(c.Kubernetes(), clusterID, poolID, nodeIDorNames)
+		nodes, err := nodesByNames(c.Ctx, c.Kubernetes(), clusterID, poolID, nodeIDorNames)
 		if err != nil {
 			return err
 		}
@@ -1498,41 +1505,30 @@ func removeKubeconfig(remote, local *clientcmdapi.Config) error {
 }
 
 // waitForClusterRunning waits for a cluster to be running.
-func waitForClusterRunning(kube do.KubernetesService, clusterID string) (*do.KubernetesCluster, error) {
-	failCount := 0
-	printNewLineSet := false
-	for i := 0; ; i++ {
-		if i != 0 {
-			fmt.Fprint(os.Stderr, ".")
-			if !printNewLineSet {
-				printNewLineSet = true
-				defer fmt.Fprintln(os.Stderr)
-			}
+func waitForClusterRunning(ctx context.Context, kube do.KubernetesService, clusterID string) (*do.KubernetesCluster, error) {
+	var cluster *do.KubernetesCluster
+	err := newWaiter("cluster %s", clusterID).wait(ctx, func(ctx context.Context) (string, bool, error) {
+		c, err := kube.Get(ctx, clusterID)
+		if err != nil {
+			return "", false, err
 		}
-		cluster, err := kube.Get(clusterID)
-		if err == nil {
-			failCount = 0
-		} else {
-			// Allow for transient API failures
-			failCount++
-			if failCount >= maxAPIFailures {
-				return nil, err
-			}
+		if c == nil || c.Status == nil {
+			return "", false, nil
 		}
+		cluster = c
 
-		if cluster == nil || cluster.Status == nil {
-			time.Sleep(1 * time.Second)
-			continue
-		}
-		switch cluster.Status.State {
+		state := c.Status.State
+		switch state {
 		case godo.KubernetesClusterStatusRunning:
-			return cluster, nil
+			return string(state), true, nil
 		case godo.KubernetesClusterStatusProvisioning:
-			time.Sleep(5 * time.Second)
+			return string(state), false, nil
 		default:
-			return cluster, fmt.Errorf("unknown status: [%s]", cluster.Status.State)
+			return string(state), true, fmt.Errorf("unknown status: [%s]", state)
 		}
-	}
+	})
+
+	return cluster, err
 }
 
 func displayClusters(c *CmdConfig, short bool, clusters ...do.KubernetesCluster) error {
@@ -1545,141 +1541,50 @@ func displayNodePools(c *CmdConfig, nodePools ...do.KubernetesNodePool) error {
 	return c.Display(item)
 }
 
-// clusterByIDorName attempts to find a cluster by ID or by name if the argument isn't an ID. If multiple
+// clusterByIDorName attempts to find a cluster by ID, or by name or prefix if the argument isn't an ID. If multiple
 // clusters have the same name, then an error with the cluster IDs matching this name is returned.
-func clusterByIDorName(kube do.KubernetesService, idOrName string) (*do.KubernetesCluster, error) {
-	if looksLikeUUID(idOrName) {
-		clusterID := idOrName
-		return kube.Get(clusterID)
-	}
-	clusters, err := kube.List()
+func clusterByIDorName(ctx context.Context, kube do.KubernetesService, idOrName string) (*do.KubernetesCluster, error) {
+	res, err := clusterResolver(ctx, kube).lookup(idOrName)
 	if err != nil {
 		return nil, err
 	}
-	var out []*do.KubernetesCluster
-	for _, c := range clusters {
-		c1 := c
-		if c.Name == idOrName {
-			out = append(out, &c1)
-		}
-	}
-	switch {
-	case len(out) == 0:
-		return nil, errNoClusterByName(idOrName)
-	case len(out) > 1:
-		var ids []string
-		for _, c := range out {
-			ids = append(ids, c.ID)
-		}
-		return nil, errAmbigousClusterName(idOrName, ids)
-	default:
-		if len(out) != 1 {
-			panic("the default case should always have len(out) == 1")
-		}
-		return out[0], nil
+	if cluster, ok := res.Value.(do.KubernetesCluster); ok {
+		return &cluster, nil
 	}
+	return kube.Get(ctx, res.ID)
 }
 
-// clusterIDize attempts to make a cluster ID/name string be a cluster ID.
-// use this as opposed to `clusterByIDorName` if you just care about getting
-// a cluster ID and don't need the cluster object itself
-func clusterIDize(kube do.KubernetesService, idOrName string) (string, error) {
-	if looksLikeUUID(idOrName) {
-		return idOrName, nil
-	}
-	clusters, err := kube.List()
-	if err != nil {
-		return "", err
-	}
-	var ids []string
-	for _, c := range clusters {
-		if c.Name == idOrName {
-			id := c.ID
-			ids = append(ids, id)
-		}
-	}
-	switch {
-	case len(ids) == 0:
-		return "", errNoClusterByName(idOrName)
-	case len(ids) > 1:
-		return "", errAmbigousClusterName(idOrName, ids)
-	default:
-		if len(ids) != 1 {
-			panic("the default case should always have len(ids) == 1")
-		}
-		return ids[0], nil
-	}
+// clusterIDize attempts to make a cluster ID, name or prefix of either be a
+// cluster ID. use this as opposed to `clusterByIDorName` if you just care
+// about getting a cluster ID and don't need the cluster object itself
+func clusterIDize(ctx context.Context, kube do.KubernetesService, idOrName string) (string, error) {
+	return clusterResolver(ctx, kube).resolve(idOrName)
 }
 
-// poolByIDorName attempts to find a pool by ID or by name if the argument isn't an ID. If multiple
+// poolByIDorName attempts to find a pool by ID, or by name or prefix if the argument isn't an ID. If multiple
 // pools have the same name, then an error with the pool IDs matching this name is returned.
-func poolByIDorName(kube do.KubernetesService, clusterID, idOrName string) (*do.KubernetesNodePool, error) {
-	if looksLikeUUID(idOrName) {
-		poolID := idOrName
-		return kube.GetNodePool(clusterID, poolID)
-	}
-	nodePools, err := kube.ListNodePools(clusterID)
+func poolByIDorName(ctx context.Context, kube do.KubernetesService, clusterID, idOrName string) (*do.KubernetesNodePool, error) {
+	res, err := poolResolver(ctx, kube, clusterID).lookup(idOrName)
 	if err != nil {
 		return nil, err
 	}
-	var out []*do.KubernetesNodePool
-	for _, c := range nodePools {
-		c1 := c
-		if c.Name == idOrName {
-			out = append(out, &c1)
-		}
-	}
-	switch {
-	case len(out) == 0:
-		return nil, errNoPoolByName(idOrName)
-	case len(out) > 1:
-		var ids []string
-		for _, c := range out {
-			ids = append(ids, c.ID)
-		}
-		return nil, errAmbigousPoolName(idOrName, ids)
-	default:
-		if len(out) != 1 {
-			panic("the default case should always have len(out) == 1")
-		}
-		return out[0], nil
+	if pool, ok := res.Value.(do.KubernetesNodePool); ok {
+		return &pool, nil
 	}
+	return kube.GetNodePool(ctx, clusterID, res.ID)
 }
 
-// poolIDize attempts to make a node pool ID/name string be a node pool ID.
-// use this as opposed to `poolByIDorName` if you just care about getting
-// a node pool ID and don't need the node pool object itself
-func poolIDize(kube do.KubernetesService, clusterID, idOrName string) (string, error) {
-	if looksLikeUUID(idOrName) {
-		return idOrName, nil
-	}
-	pools, err := kube.ListNodePools(clusterID)
-	if err != nil {
-		return "", err
-	}
-	var ids []string
-	for _, c := range pools {
-		if c.Name == idOrName {
-			ids = append(ids, c.ID)
-		}
-	}
-	switch {
-	case len(ids) == 0:
-		return "", errNoPoolByName(idOrName)
-	case len(ids) > 1:
-		return "", errAmbigousPoolName(idOrName, ids)
-	default:
-		if len(ids) != 1 {
-			panic("the default case should always have len(ids) == 1")
-		}
-		return ids[0], nil
-	}
+// poolIDize attempts to make a node pool ID, name or prefix of either be a
+// node pool ID. use this as opposed to `poolByIDorName` if you just care
+// about getting a node pool ID and don't need the node pool object itself
+func poolIDize(ctx context.Context, kube do.KubernetesService, clusterID, idOrName string) (string, error) {
+	return poolResolver(ctx, kube, clusterID).resolve(idOrName)
 }
 
 // nodesByNames attempts to find nodes by names. If multiple nodes have the same name,
 // then an error with the node IDs matching this name is returned.
-func nodesByNames(kube do.KubernetesService, clusterID, poolID string, nodeNames []string) ([]*godo.KubernetesNode, error) {
-	nodePool, err := kube.GetNodePool(clusterID, poolID)
+func nodesByNames(ctx context.Context, kube do.KubernetesService, clusterID, poolID string, nodeNames []string) ([]*godo.KubernetesNode, error) {
+	nodePool, err := kube.GetNodePool(ctx, clusterID, poolID)
 	if err != nil {
 		return nil, err
 	}
@@ -1732,7 +1637,7 @@ func getVersionOrLatest(c *CmdConfig) (string, error) {
 	if version != "" && version != defaultKubernetesLatestVersion {
 		return version, nil
 	}
-	versions, err := c.Kubernetes().GetVersions()
+	versions, err := c.Kubernetes().GetVersions(c.Ctx)
 	if err != nil {
 		return "", fmt.Errorf("no version flag provided and unable to lookup the latest version from the API: %v", err)
 	}
@@ -1,12 +1,14 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -84,7 +86,7 @@ type mockKubeconfigProvider struct {
 	local, remote, written clientcmdapi.Config
 }
 
-func (m *mockKubeconfigProvider) Remote(_ do.KubernetesService, _ string) (*clientcmdapi.Config, error) {
+func (m *mockKubeconfigProvider) Remote(_ context.Context, _ do.KubernetesService, _ string) (*clientcmdapi.Config, error) {
 	return &m.local, nil
 }
 
@@ -163,7 +165,7 @@ func TestKubernetesOptionsCommand(t *testing.T) {
 func TestKubernetesGet(t *testing.T) {
 	// by ID
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().Get(testCluster.ID).Return(&testCluster, nil)
+		tm.kubernetes.EXPECT().Get(gomock.Any(), testCluster.ID).Return(&testCluster, nil)
 		config.Args = append(config.Args, testCluster.ID)
 		err := testK8sCmdService().RunKubernetesClusterGet(config)
 		assert.NoError(t, err)
@@ -172,7 +174,7 @@ func TestKubernetesGet(t *testing.T) {
 	// by name
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		// it'll see that no UUID is given and do a List call to find the cluster
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
 		config.Args = append(config.Args, testCluster.Name)
 		err := testK8sCmdService().RunKubernetesClusterGet(config)
 		assert.NoError(t, err)
@@ -181,7 +183,7 @@ func TestKubernetesGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		name := "not a cluster"
 		// it'll see that no UUID is given and do a List call to find the cluster
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
 		config.Args = append(config.Args, name)
 		err := testK8sCmdService().RunKubernetesClusterGet(config)
 		assert.EqualError(t, err, errNoClusterByName(name).Error())
@@ -203,7 +205,7 @@ func TestKubernetesGet(t *testing.T) {
 
 		clustersWithDups := append(testClusterList, testClusterWithSameName)
 		// it'll see that no UUID is given and do a List call to find the cluster
-		tm.kubernetes.EXPECT().List().Return(clustersWithDups, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(clustersWithDups, nil)
 		config.Args = append(config.Args, name)
 		err := testK8sCmdService().RunKubernetesClusterGet(config)
 		assert.EqualError(t, err, errAmbigousClusterName(name, []string{testCluster.ID, testClusterWithSameName.ID}).Error())
@@ -213,7 +215,7 @@ func TestKubernetesGet(t *testing.T) {
 func TestKubernetesGetUpgrades(t *testing.T) {
 	// by ID
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().GetUpgrades(testCluster.ID).Return(testClusterUpgrades, nil)
+		tm.kubernetes.EXPECT().GetUpgrades(gomock.Any(), testCluster.ID).Return(testClusterUpgrades, nil)
 		config.Args = append(config.Args, testCluster.ID)
 		err := testK8sCmdService().RunKubernetesClusterGetUpgrades(config)
 		assert.NoError(t, err)
@@ -222,9 +224,9 @@ func TestKubernetesGetUpgrades(t *testing.T) {
 	// by name
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		// it'll see that no UUID is given and do a List call to find the cluster
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
 		// then call GetUpgrades
-		tm.kubernetes.EXPECT().GetUpgrades(testCluster.ID).Return(testClusterUpgrades, nil)
+		tm.kubernetes.EXPECT().GetUpgrades(gomock.Any(), testCluster.ID).Return(testClusterUpgrades, nil)
 		config.Args = append(config.Args, testCluster.Name)
 		err := testK8sCmdService().RunKubernetesClusterGetUpgrades(config)
 		assert.NoError(t, err)
@@ -234,7 +236,7 @@ func TestKubernetesGetUpgrades(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		name := "not a cluster"
 		// it'll see that no UUID is given and do a List call to find the cluster
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
 		config.Args = append(config.Args, name)
 		err := testK8sCmdService().RunKubernetesClusterGetUpgrades(config)
 		assert.EqualError(t, err, errNoClusterByName(name).Error())
@@ -242,7 +244,7 @@ func TestKubernetesGetUpgrades(t *testing.T) {
 
 	// no upgrades available
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().GetUpgrades(testCluster.ID).Return(nil, nil)
+		tm.kubernetes.EXPECT().GetUpgrades(gomock.Any(), testCluster.ID).Return(nil, nil)
 		config.Args = append(config.Args, testCluster.ID)
 		err := testK8sCmdService().RunKubernetesClusterGetUpgrades(config)
 		assert.NoError(t, err)
@@ -296,7 +298,7 @@ func TestKubernetesKubeconfigSave(t *testing.T) {
 func TestKubernetesKubeconfigShow(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		kubeconfig := []byte(`i'm some yaml`)
-		tm.kubernetes.EXPECT().GetKubeConfig(testCluster.ID).Return(kubeconfig, nil)
+		tm.kubernetes.EXPECT().GetKubeConfig(gomock.Any(), testCluster.ID).Return(kubeconfig, nil)
 		config.Args = append(config.Args, testCluster.ID)
 		err := testK8sCmdService().RunKubernetesKubeconfigShow(config)
 		assert.NoError(t, err)
@@ -305,8 +307,8 @@ func TestKubernetesKubeconfigShow(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		kubeconfig := []byte(`i'm some yaml`)
 		// it'll see that no UUID is given and do a List call to find the cluster
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().GetKubeConfig(testCluster.ID).Return(kubeconfig, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().GetKubeConfig(gomock.Any(), testCluster.ID).Return(kubeconfig, nil)
 		config.Args = append(config.Args, testCluster.Name)
 		err := testK8sCmdService().RunKubernetesKubeconfigShow(config)
 		assert.NoError(t, err)
@@ -315,7 +317,7 @@ func TestKubernetesKubeconfigShow(t *testing.T) {
 
 func TestKubernetesList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
 		err := testK8sCmdService().RunKubernetesClusterList(config)
 		assert.NoError(t, err)
 	})
@@ -357,7 +359,7 @@ func TestKubernetesCreate(t *testing.T) {
 			AutoUpgrade: true,
 		}
 		//
-		tm.kubernetes.EXPECT().Create(&r).Return(&testCluster, nil)
+		tm.kubernetes.EXPECT().Create(gomock.Any(), &r).Return(&testCluster, nil)
 
 		config.Args = append(config.Args, testCluster.Name)
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, testCluster.RegionSlug)
@@ -392,7 +394,7 @@ func TestKubernetesUpdate(t *testing.T) {
 			},
 			AutoUpgrade: boolPtr(false),
 		}
-		tm.kubernetes.EXPECT().Update(testCluster.ID, &r).Return(&testCluster, nil)
+		tm.kubernetes.EXPECT().Update(gomock.Any(), testCluster.ID, &r).Return(&testCluster, nil)
 
 		config.Args = append(config.Args, testCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgClusterName, testCluster.Name)
@@ -415,8 +417,8 @@ func TestKubernetesUpdate(t *testing.T) {
 			},
 			AutoUpgrade: boolPtr(false),
 		}
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().Update(testCluster.ID, &r).Return(&testCluster, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().Update(gomock.Any(), testCluster.ID, &r).Return(&testCluster, nil)
 
 		config.Args = append(config.Args, testCluster.Name)
 		config.Doit.Set(config.NS, doctl.ArgClusterName, testCluster.Name)
@@ -434,7 +436,7 @@ func TestKubernetesUpgrade(t *testing.T) {
 
 	// by id
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().Upgrade(testCluster.ID, testUpgradeVersion).Return(nil)
+		tm.kubernetes.EXPECT().Upgrade(gomock.Any(), testCluster.ID, testUpgradeVersion).Return(nil)
 
 		config.Args = append(config.Args, testCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgVersion, testUpgradeVersion)
@@ -444,8 +446,8 @@ func TestKubernetesUpgrade(t *testing.T) {
 	})
 	// by name
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().Upgrade(testCluster.ID, testUpgradeVersion).Return(nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().Upgrade(gomock.Any(), testCluster.ID, testUpgradeVersion).Return(nil)
 
 		config.Args = append(config.Args, testCluster.Name)
 		config.Doit.Set(config.NS, doctl.ArgVersion, testUpgradeVersion)
@@ -456,9 +458,9 @@ func TestKubernetesUpgrade(t *testing.T) {
 
 	// using "latest" version
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().Get(testCluster.ID).Return(&testCluster, nil)
-		tm.kubernetes.EXPECT().GetUpgrades(testCluster.ID).Return(testClusterUpgrades, nil)
-		tm.kubernetes.EXPECT().Upgrade(testCluster.ID, testUpgradeVersion).Return(nil)
+		tm.kubernetes.EXPECT().Get(gomock.Any(), testCluster.ID).Return(&testCluster, nil)
+		tm.kubernetes.EXPECT().GetUpgrades(gomock.Any(), testCluster.ID).Return(testClusterUpgrades, nil)
+		tm.kubernetes.EXPECT().Upgrade(gomock.Any(), testCluster.ID, testUpgradeVersion).Return(nil)
 
 		config.Args = append(config.Args, testCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgVersion, defaultKubernetesLatestVersion)
@@ -469,9 +471,9 @@ func TestKubernetesUpgrade(t *testing.T) {
 
 	// without version flag set (defaults to latest)
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().Get(testCluster.ID).Return(&testCluster, nil)
-		tm.kubernetes.EXPECT().GetUpgrades(testCluster.ID).Return(testClusterUpgrades, nil)
-		tm.kubernetes.EXPECT().Upgrade(testCluster.ID, testUpgradeVersion).Return(nil)
+		tm.kubernetes.EXPECT().Get(gomock.Any(), testCluster.ID).Return(&testCluster, nil)
+		tm.kubernetes.EXPECT().GetUpgrades(gomock.Any(), testCluster.ID).Return(testClusterUpgrades, nil)
+		tm.kubernetes.EXPECT().Upgrade(gomock.Any(), testCluster.ID, testUpgradeVersion).Return(nil)
 
 		config.Args = append(config.Args, testCluster.ID)
 
@@ -481,8 +483,8 @@ func TestKubernetesUpgrade(t *testing.T) {
 
 	// for cluster that is up-to-date
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().Get(testCluster.ID).Return(&testCluster, nil)
-		tm.kubernetes.EXPECT().GetUpgrades(testCluster.ID).Return(nil, nil)
+		tm.kubernetes.EXPECT().Get(gomock.Any(), testCluster.ID).Return(&testCluster, nil)
+		tm.kubernetes.EXPECT().GetUpgrades(gomock.Any(), testCluster.ID).Return(nil, nil)
 
 		config.Args = append(config.Args, testCluster.ID)
 
@@ -502,7 +504,7 @@ func TestKubernetesDelete(t *testing.T) {
 	})
 	// by id
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().Delete(testCluster.ID).Return(nil)
+		tm.kubernetes.EXPECT().Delete(gomock.Any(), testCluster.ID).Return(nil)
 
 		config.Args = append(config.Args, testCluster.ID)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -512,8 +514,8 @@ func TestKubernetesDelete(t *testing.T) {
 	})
 	// by name
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().Delete(testCluster.ID).Return(nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().Delete(gomock.Any(), testCluster.ID).Return(nil)
 
 		config.Args = append(config.Args, testCluster.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -526,7 +528,7 @@ func TestKubernetesDelete(t *testing.T) {
 func TestKubernetesNodePool_Get(t *testing.T) {
 	// by id
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().GetNodePool(testCluster.ID, testNodePool.ID).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().GetNodePool(gomock.Any(), testCluster.ID, testNodePool.ID).Return(&testNodePool, nil)
 
 		config.Args = append(config.Args, testCluster.ID, testNodePool.ID)
 
@@ -535,7 +537,7 @@ func TestKubernetesNodePool_Get(t *testing.T) {
 	})
 	// by name
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().ListNodePools(testCluster.ID).Return(testNodePools, nil)
+		tm.kubernetes.EXPECT().ListNodePools(gomock.Any(), testCluster.ID).Return(testNodePools, nil)
 
 		// cluster ID but pool name
 		config.Args = append(config.Args, testCluster.ID, testNodePool.Name)
@@ -544,8 +546,8 @@ func TestKubernetesNodePool_Get(t *testing.T) {
 		assert.NoError(t, err)
 	})
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().GetNodePool(testCluster.ID, testNodePool.ID).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().GetNodePool(gomock.Any(), testCluster.ID, testNodePool.ID).Return(&testNodePool, nil)
 
 		// cluster name and pool ID
 		config.Args = append(config.Args, testCluster.Name, testNodePool.ID)
@@ -554,8 +556,8 @@ func TestKubernetesNodePool_Get(t *testing.T) {
 		assert.NoError(t, err)
 	})
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().ListNodePools(testCluster.ID).Return(testNodePools, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().ListNodePools(gomock.Any(), testCluster.ID).Return(testNodePools, nil)
 
 		// cluster name and pool name
 		config.Args = append(config.Args, testCluster.Name, testNodePool.Name)
@@ -575,7 +577,7 @@ func TestKubernetesNodePool_Get(t *testing.T) {
 
 		nodePoolsWithDups := append(testNodePools, testNodePoolWithSameName)
 		// it'll see that no UUID is given and do a List call to find the cluster
-		tm.kubernetes.EXPECT().ListNodePools(testCluster.ID).Return(nodePoolsWithDups, nil)
+		tm.kubernetes.EXPECT().ListNodePools(gomock.Any(), testCluster.ID).Return(nodePoolsWithDups, nil)
 		config.Args = append(config.Args, testCluster.ID, name)
 		err := testK8sCmdService().RunKubernetesNodePoolGet(config)
 		assert.EqualError(t, err, errAmbigousPoolName(name, []string{testNodePool.ID, testNodePoolWithSameName.ID}).Error())
@@ -584,7 +586,7 @@ func TestKubernetesNodePool_Get(t *testing.T) {
 
 func TestKubernetesNodePool_List(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().ListNodePools(testCluster.ID).Return(testNodePools, nil)
+		tm.kubernetes.EXPECT().ListNodePools(gomock.Any(), testCluster.ID).Return(testNodePools, nil)
 
 		config.Args = append(config.Args, testCluster.ID)
 
@@ -593,8 +595,8 @@ func TestKubernetesNodePool_List(t *testing.T) {
 	})
 	// by name
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().ListNodePools(testCluster.ID).Return(testNodePools, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().ListNodePools(gomock.Any(), testCluster.ID).Return(testNodePools, nil)
 
 		config.Args = append(config.Args, testCluster.Name)
 
@@ -620,7 +622,7 @@ func TestKubernetesNodePool_Create(t *testing.T) {
 			MinNodes:  testNodePool.MinNodes,
 			MaxNodes:  testNodePool.MaxNodes,
 		}
-		tm.kubernetes.EXPECT().CreateNodePool(testCluster.ID, &r).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().CreateNodePool(gomock.Any(), testCluster.ID, &r).Return(&testNodePool, nil)
 
 		config.Args = append(config.Args, testCluster.ID)
 
@@ -646,8 +648,8 @@ func TestKubernetesNodePool_Create(t *testing.T) {
 			MinNodes:  testNodePool.MinNodes,
 			MaxNodes:  testNodePool.MaxNodes,
 		}
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().CreateNodePool(testCluster.ID, &r).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().CreateNodePool(gomock.Any(), testCluster.ID, &r).Return(&testNodePool, nil)
 
 		config.Args = append(config.Args, testCluster.Name)
 
@@ -672,7 +674,7 @@ func TestKubernetesNodePool_Update(t *testing.T) {
 			Count: &testNodePool.Count,
 			Tags:  testNodePool.Tags,
 		}
-		tm.kubernetes.EXPECT().UpdateNodePool(testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().UpdateNodePool(gomock.Any(), testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
 
 		config.Args = append(config.Args, testCluster.ID, testNodePool.ID)
 
@@ -690,8 +692,8 @@ func TestKubernetesNodePool_Update(t *testing.T) {
 			Count: &testNodePool.Count,
 			Tags:  testNodePool.Tags,
 		}
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().UpdateNodePool(testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().UpdateNodePool(gomock.Any(), testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
 
 		config.Args = append(config.Args, testCluster.Name, testNodePool.ID)
 
@@ -709,8 +711,8 @@ func TestKubernetesNodePool_Update(t *testing.T) {
 			Count: &testNodePool.Count,
 			Tags:  testNodePool.Tags,
 		}
-		tm.kubernetes.EXPECT().ListNodePools(testCluster.ID).Return(testNodePools, nil)
-		tm.kubernetes.EXPECT().UpdateNodePool(testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().ListNodePools(gomock.Any(), testCluster.ID).Return(testNodePools, nil)
+		tm.kubernetes.EXPECT().UpdateNodePool(gomock.Any(), testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
 
 		config.Args = append(config.Args, testCluster.ID, testNodePool.Name)
 
@@ -728,9 +730,9 @@ func TestKubernetesNodePool_Update(t *testing.T) {
 			Count: &testNodePool.Count,
 			Tags:  testNodePool.Tags,
 		}
-		tm.kubernetes.EXPECT().List().Return(testClusterList, nil)
-		tm.kubernetes.EXPECT().ListNodePools(testCluster.ID).Return(testNodePools, nil)
-		tm.kubernetes.EXPECT().UpdateNodePool(testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().List(gomock.Any()).Return(testClusterList, nil)
+		tm.kubernetes.EXPECT().ListNodePools(gomock.Any(), testCluster.ID).Return(testNodePools, nil)
+		tm.kubernetes.EXPECT().UpdateNodePool(gomock.Any(), testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
 
 		config.Args = append(config.Args, testCluster.Name, testNodePool.Name)
 
@@ -755,7 +757,7 @@ func TestKubernetesNodePool_Update(t *testing.T) {
 			MinNodes:  &testNodePool.MinNodes,
 			MaxNodes:  &testNodePool.MaxNodes,
 		}
-		tm.kubernetes.EXPECT().UpdateNodePool(testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().UpdateNodePool(gomock.Any(), testCluster.ID, testNodePool.ID, &r).Return(&testNodePool, nil)
 
 		config.Args = append(config.Args, testCluster.ID, testNodePool.ID)
 
@@ -778,7 +780,7 @@ func TestKubernetesNodePool_Recycle(t *testing.T) {
 			Nodes: []string{testNode.ID},
 		}
 
-		tm.kubernetes.EXPECT().RecycleNodePoolNodes(testCluster.ID, testNodePool.ID, &r).Return(nil)
+		tm.kubernetes.EXPECT().RecycleNodePoolNodes(gomock.Any(), testCluster.ID, testNodePool.ID, &r).Return(nil)
 
 		config.Args = append(config.Args, testCluster.ID, testNodePool.ID)
 
@@ -793,8 +795,8 @@ func TestKubernetesNodePool_Recycle(t *testing.T) {
 			Nodes: []string{testNode.ID},
 		}
 
-		tm.kubernetes.EXPECT().GetNodePool(testCluster.ID, testNodePool.ID).Return(&testNodePool, nil)
-		tm.kubernetes.EXPECT().RecycleNodePoolNodes(testCluster.ID, testNodePool.ID, &r).Return(nil)
+		tm.kubernetes.EXPECT().GetNodePool(gomock.Any(), testCluster.ID, testNodePool.ID).Return(&testNodePool, nil)
+		tm.kubernetes.EXPECT().RecycleNodePoolNodes(gomock.Any(), testCluster.ID, testNodePool.ID, &r).Return(nil)
 
 		config.Args = append(config.Args, testCluster.ID, testNodePool.ID)
 
@@ -816,7 +818,7 @@ func TestKubernetesNodePool_Delete(t *testing.T) {
 	})
 	// by id
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().DeleteNodePool(testCluster.ID, testNodePool.ID).Return(nil)
+		tm.kubernetes.EXPECT().DeleteNodePool(gomock.Any(), testCluster.ID, testNodePool.ID).Return(nil)
 
 		config.Args = append(config.Args, testCluster.ID, testNodePool.ID)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -826,8 +828,8 @@ func TestKubernetesNodePool_Delete(t *testing.T) {
 	})
 	// by cluster ID and pool name
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.kubernetes.EXPECT().ListNodePools(testCluster.ID).Return(testNodePools, nil)
-		tm.kubernetes.EXPECT().DeleteNodePool(testCluster.ID, testNodePool.ID).Return(nil)
+		tm.kubernetes.EXPECT().ListNodePools(gomock.Any(), testCluster.ID).Return(testNodePools, nil)
+		tm.kubernetes.EXPECT().DeleteNodePool(gomock.Any(), testCluster.ID, testNodePool.ID).Return(nil)
 
 		config.Args = append(config.Args, testCluster.ID, testNodePool.Name)
 		config.Doit.Set(config.NS, doctl.ArgForce, "true")
@@ -844,7 +846,7 @@ func TestKubernetesOptions_Versions(t *testing.T) {
 				KubernetesVersion: &godo.KubernetesVersion{Slug: "1.10gen3", KubernetesVersion: "1.10"},
 			},
 		}
-		tm.kubernetes.EXPECT().GetVersions().Return(testVersions, nil)
+		tm.kubernetes.EXPECT().GetVersions(gomock.Any()).Return(testVersions, nil)
 
 		err := testK8sCmdService().RunKubeOptionsListVersion(config)
 		assert.NoError(t, err)
@@ -914,12 +916,12 @@ type nilCluster struct {
 	do.KubernetesService
 }
 
-func (n *nilCluster) Get(clusterID string) (*do.KubernetesCluster, error) {
+func (n *nilCluster) Get(_ context.Context, clusterID string) (*do.KubernetesCluster, error) {
 	return nil, fmt.Errorf("can't find %s", clusterID)
 }
 
 func Test_waitForClusterRunningDoesntPanicWithNilGet(t *testing.T) {
-	cluster, err := waitForClusterRunning(&nilCluster{}, "123")
+	cluster, err := waitForClusterRunning(context.Background(), &nilCluster{}, "123")
 	require.Nil(t, cluster)
 	require.EqualError(t, err, "can't find 123")
 }
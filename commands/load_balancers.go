@@ -14,6 +14,7 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -58,6 +59,7 @@ func LoadBalancer() *Command {
 		"comma-separated key:value list, example value: protocol:http,port:80,path:/index.html,check_interval_seconds:10,response_timeout_seconds:5,healthy_threshold:5,unhealthy_threshold:3")
 	AddStringFlag(cmdRecordCreate, doctl.ArgForwardingRules, "", "",
 		"comma-separated key:value list, example value: entry_protocol:tcp,entry_port:3306,target_protocol:tcp,target_port:3306, use quoted string of space-separated values for multiple rules")
+	AddBoolFlag(cmdRecordCreate, doctl.ArgCommandWait, "", false, "Wait for load balancer to be active")
 
 	cmdRecordUpdate := CmdBuilder(cmd, RunLoadBalancerUpdate, "update <id>",
 		"update load balancer", Writer, aliasOpt("u"))
@@ -115,10 +117,13 @@ func RunLoadBalancerGet(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	id := c.Args[0]
+	id, err := loadBalancerResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	lbs := c.LoadBalancers()
-	lb, err := lbs.Get(id)
+	lb, err := lbs.Get(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -130,7 +135,7 @@ func RunLoadBalancerGet(c *CmdConfig) error {
 // RunLoadBalancerList lists load balancers.
 func RunLoadBalancerList(c *CmdConfig) error {
 	lbs := c.LoadBalancers()
-	list, err := lbs.List()
+	list, err := lbs.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -146,12 +151,38 @@ func RunLoadBalancerCreate(c *CmdConfig) error {
 		return err
 	}
 
+	wait, err := c.Doit.GetBool(c.NS, doctl.ArgCommandWait)
+	if err != nil {
+		return err
+	}
+
 	lbs := c.LoadBalancers()
-	lb, err := lbs.Create(r)
+	lb, err := lbs.Create(c.Ctx, r)
 	if err != nil {
 		return err
 	}
 
+	if wait {
+		err := newWaiter("load balancer %s", lb.Name).wait(c.Ctx, func(ctx context.Context) (string, bool, error) {
+			updated, err := lbs.Get(ctx, lb.ID)
+			if err != nil {
+				return "", false, err
+			}
+			lb = updated
+
+			switch lb.Status {
+			case "active":
+				return lb.Status, true, nil
+			case "errored":
+				return lb.Status, true, fmt.Errorf("load balancer %s errored", lb.ID)
+			}
+			return lb.Status, false, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	item := &displayers.LoadBalancer{LoadBalancers: do.LoadBalancers{*lb}}
 	return c.Display(item)
 }
@@ -161,7 +192,10 @@ func RunLoadBalancerUpdate(c *CmdConfig) error {
 	if len(c.Args) == 0 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	lbID := c.Args[0]
+	lbID, err := loadBalancerResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	r := new(godo.LoadBalancerRequest)
 	if err := buildRequestFromArgs(c, r); err != nil {
@@ -169,7 +203,7 @@ func RunLoadBalancerUpdate(c *CmdConfig) error {
 	}
 
 	lbs := c.LoadBalancers()
-	lb, err := lbs.Update(lbID, r)
+	lb, err := lbs.Update(c.Ctx, lbID, r)
 	if err != nil {
 		return err
 	}
@@ -183,7 +217,10 @@ func RunLoadBalancerDelete(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	lbID := c.Args[0]
+	lbID, err := loadBalancerResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	force, err := c.Doit.GetBool(c.NS, doctl.ArgForce)
 	if err != nil {
@@ -192,7 +229,7 @@ func RunLoadBalancerDelete(c *CmdConfig) error {
 
 	if force || AskForConfirm("delete this load balancer") == nil {
 		lbs := c.LoadBalancers()
-		if err := lbs.Delete(lbID); err != nil {
+		if err := lbs.Delete(c.Ctx, lbID); err != nil {
 			return err
 		}
 	} else {
@@ -207,7 +244,10 @@ func RunLoadBalancerAddDroplets(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	lbID := c.Args[0]
+	lbID, err := loadBalancerResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	dropletIDsList, err := c.Doit.GetStringSlice(c.NS, doctl.ArgDropletIDs)
 	if err != nil {
@@ -219,7 +259,7 @@ func RunLoadBalancerAddDroplets(c *CmdConfig) error {
 		return err
 	}
 
-	return c.LoadBalancers().AddDroplets(lbID, dropletIDs...)
+	return c.LoadBalancers().AddDroplets(c.Ctx, lbID, dropletIDs...)
 }
 
 // RunLoadBalancerRemoveDroplets removes droplets from a load balancer.
@@ -227,7 +267,10 @@ func RunLoadBalancerRemoveDroplets(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	lbID := c.Args[0]
+	lbID, err := loadBalancerResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	dropletIDsList, err := c.Doit.GetStringSlice(c.NS, doctl.ArgDropletIDs)
 	if err != nil {
@@ -239,7 +282,7 @@ func RunLoadBalancerRemoveDroplets(c *CmdConfig) error {
 		return err
 	}
 
-	return c.LoadBalancers().RemoveDroplets(lbID, dropletIDs...)
+	return c.LoadBalancers().RemoveDroplets(c.Ctx, lbID, dropletIDs...)
 }
 
 // RunLoadBalancerAddForwardingRules adds forwarding rules to a load balancer.
@@ -247,7 +290,10 @@ func RunLoadBalancerAddForwardingRules(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	lbID := c.Args[0]
+	lbID, err := loadBalancerResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	fra, err := c.Doit.GetString(c.NS, doctl.ArgForwardingRules)
 	if err != nil {
@@ -259,7 +305,7 @@ func RunLoadBalancerAddForwardingRules(c *CmdConfig) error {
 		return err
 	}
 
-	return c.LoadBalancers().AddForwardingRules(lbID, forwardingRules...)
+	return c.LoadBalancers().AddForwardingRules(c.Ctx, lbID, forwardingRules...)
 }
 
 // RunLoadBalancerRemoveForwardingRules removes forwarding rules from a load balancer.
@@ -267,7 +313,10 @@ func RunLoadBalancerRemoveForwardingRules(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	lbID := c.Args[0]
+	lbID, err := loadBalancerResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	fra, err := c.Doit.GetString(c.NS, doctl.ArgForwardingRules)
 	if err != nil {
@@ -279,7 +328,7 @@ func RunLoadBalancerRemoveForwardingRules(c *CmdConfig) error {
 		return err
 	}
 
-	return c.LoadBalancers().RemoveForwardingRules(lbID, forwardingRules...)
+	return c.LoadBalancers().RemoveForwardingRules(c.Ctx, lbID, forwardingRules...)
 }
 
 func extractForwardingRules(s string) (forwardingRules []godo.ForwardingRule, err error) {
@@ -7,6 +7,7 @@ import (
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,7 +36,7 @@ func TestLoadBalancerCommand(t *testing.T) {
 func TestLoadBalancerGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
-		tm.loadBalancers.EXPECT().Get(lbID).Return(&testLoadBalancer, nil)
+		tm.loadBalancers.EXPECT().Get(gomock.Any(), lbID).Return(&testLoadBalancer, nil)
 
 		config.Args = append(config.Args, lbID)
 
@@ -53,7 +54,7 @@ func TestLoadBalancerGetNoID(t *testing.T) {
 
 func TestLoadBalancerList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.loadBalancers.EXPECT().List().Return(testLoadBalancerList, nil)
+		tm.loadBalancers.EXPECT().List(gomock.Any()).Return(testLoadBalancerList, nil)
 
 		err := RunLoadBalancerList(config)
 		assert.NoError(t, err)
@@ -105,7 +106,7 @@ func TestLoadBalancerCreate(t *testing.T) {
 				},
 			},
 		}
-		tm.loadBalancers.EXPECT().Create(&r).Return(&testLoadBalancer, nil)
+		tm.loadBalancers.EXPECT().Create(gomock.Any(), &r).Return(&testLoadBalancer, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "nyc1")
 		config.Doit.Set(config.NS, doctl.ArgLoadBalancerName, "lb-name")
@@ -119,6 +120,32 @@ func TestLoadBalancerCreate(t *testing.T) {
 	})
 }
 
+func TestLoadBalancerCreateWait(t *testing.T) {
+	defer shortWaits()()
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lb := *testLoadBalancer.LoadBalancer
+		lb.ID, lb.Status = "cde2c0d6-41e3-479e-ba60-ad971227232c", "new"
+		created := do.LoadBalancer{LoadBalancer: &lb}
+
+		activeLB := lb
+		activeLB.Status = "active"
+		active := do.LoadBalancer{LoadBalancer: &activeLB}
+
+		tm.loadBalancers.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&created, nil)
+		gomock.InOrder(
+			tm.loadBalancers.EXPECT().Get(gomock.Any(), lb.ID).Return(&created, nil),
+			tm.loadBalancers.EXPECT().Get(gomock.Any(), lb.ID).Return(&active, nil),
+		)
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "nyc1")
+		config.Doit.Set(config.NS, doctl.ArgCommandWait, true)
+
+		err := RunLoadBalancerCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
 func TestLoadBalancerUpdate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
@@ -149,7 +176,7 @@ func TestLoadBalancerUpdate(t *testing.T) {
 			},
 		}
 
-		tm.loadBalancers.EXPECT().Update(lbID, &r).Return(&testLoadBalancer, nil)
+		tm.loadBalancers.EXPECT().Update(gomock.Any(), lbID, &r).Return(&testLoadBalancer, nil)
 
 		config.Args = append(config.Args, lbID)
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "nyc1")
@@ -174,7 +201,7 @@ func TestLoadBalancerUpdateNoID(t *testing.T) {
 func TestLoadBalancerDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
-		tm.loadBalancers.EXPECT().Delete(lbID).Return(nil)
+		tm.loadBalancers.EXPECT().Delete(gomock.Any(), lbID).Return(nil)
 
 		config.Args = append(config.Args, lbID)
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -194,7 +221,7 @@ func TestLoadBalancerDeleteNoID(t *testing.T) {
 func TestLoadBalancerAddDroplets(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
-		tm.loadBalancers.EXPECT().AddDroplets(lbID, 1, 23).Return(nil)
+		tm.loadBalancers.EXPECT().AddDroplets(gomock.Any(), lbID, 1, 23).Return(nil)
 
 		config.Args = append(config.Args, lbID)
 		config.Doit.Set(config.NS, doctl.ArgDropletIDs, []string{"1", "23"})
@@ -214,7 +241,7 @@ func TestLoadBalancerAddDropletsNoID(t *testing.T) {
 func TestLoadBalancerRemoveDroplets(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
-		tm.loadBalancers.EXPECT().RemoveDroplets(lbID, 321).Return(nil)
+		tm.loadBalancers.EXPECT().RemoveDroplets(gomock.Any(), lbID, 321).Return(nil)
 
 		config.Args = append(config.Args, lbID)
 		config.Doit.Set(config.NS, doctl.ArgDropletIDs, []string{"321"})
@@ -240,7 +267,7 @@ func TestLoadBalancerAddForwardingRules(t *testing.T) {
 			TargetProtocol: "http",
 			TargetPort:     80,
 		}
-		tm.loadBalancers.EXPECT().AddForwardingRules(lbID, forwardingRule).Return(nil)
+		tm.loadBalancers.EXPECT().AddForwardingRules(gomock.Any(), lbID, forwardingRule).Return(nil)
 
 		config.Args = append(config.Args, lbID)
 		config.Doit.Set(config.NS, doctl.ArgForwardingRules, "entry_protocol:http,entry_port:80,target_protocol:http,target_port:80")
@@ -275,7 +302,7 @@ func TestLoadBalancerRemoveForwardingRules(t *testing.T) {
 				TlsPassthrough: true,
 			},
 		}
-		tm.loadBalancers.EXPECT().RemoveForwardingRules(lbID, forwardingRules[0], forwardingRules[1]).Return(nil)
+		tm.loadBalancers.EXPECT().RemoveForwardingRules(gomock.Any(), lbID, forwardingRules[0], forwardingRules[1]).Return(nil)
 
 		config.Args = append(config.Args, lbID)
 		config.Doit.Set(config.NS, doctl.ArgForwardingRules, "entry_protocol:http,entry_port:80,target_protocol:http,target_port:80 entry_protocol:tcp,entry_port:3306,target_protocol:tcp,target_port:3306,tls_passthrough:true")
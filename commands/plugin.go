@@ -15,127 +15,364 @@ package commands
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
 
+	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
-	"github.com/digitalocean/doctl/pluginhost"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// pluginPrefix is the prefix of the name of plugin executables.
+	pluginPrefix = "doctl-"
+	// pluginAnnotation marks the commands running plugins. Its value is
+	// the path of the plugin.
+	pluginAnnotation = "plugin"
+	// pluginSourcePath is the source of the plugins found on PATH.
+	pluginSourcePath = "PATH"
+	// pluginSourceInstalled is the source of the plugins found in the
+	// plugins directory that weren't installed by doctl.
+	pluginSourceInstalled = "installed"
 )
 
 // Plugin creates the plugin commands hierarchy.
 func Plugin() *Command {
 	cmd := &Command{
 		Command: &cobra.Command{
-			Use:        "plugin",
-			Short:      "plugin commands",
-			Long:       "plugin is used to access plugin commands",
-			Aliases:    []string{"p"},
-			Hidden:     true,
-			Deprecated: "this command will be removed in a future version of doctl",
+			Use:   "plugin",
+			Short: "plugin commands",
+			Long: `plugin is used to manage the plugins extending doctl.
+
+A plugin is an executable named doctl-<name>, installed with plugin install or
+found on PATH. It is run as doctl <name>, with the arguments following its
+name, and gets the settings doctl resolved through these environment
+variables:
+
+	DIGITALOCEAN_CONTEXT       the authentication context
+	DIGITALOCEAN_ACCESS_TOKEN  the access token of the context
+	DIGITALOCEAN_API_URL       the API endpoint
+	DIGITALOCEAN_OUTPUT        the output format
+	DIGITALOCEAN_CONFIG        the path of the config file
+
+doctl reads the same variables, so a plugin running doctl uses the same
+account. Global flags must come before the plugin's name. Installed plugins
+come before the ones on PATH. A plugin named like a built-in command, or like
+a plugin found earlier, is shadowed and never run.`,
 		},
 	}
 
-	CmdBuilder(cmd, RunPluginList, "list", "list plugins", Writer,
-		aliasOpt("ls"))
+	cmdBuilderWithInit(cmd, RunPluginList, "list", "list plugins", Writer, false,
+		aliasOpt("ls"), displayerType(&displayers.Plugin{}))
+
+	cmdInstall := cmdBuilderWithInit(cmd, RunPluginInstall, "install <name>[@<version>] | <url> | <path>", "install a plugin", Writer, false)
+	cmdInstall.Long = `install installs a plugin in the plugins directory, next to the default config
+file.
+
+A plugin given by name is looked up in the plugin index, a YAML file listing
+the versions of plugins and, for each operating system and architecture, the
+URL and SHA-256 checksum of the plugin's executable or of a .tar.gz or .zip
+archive holding it:
+
+	plugins:
+	  - name: hello
+	    versions:
+	      - version: 1.0.0
+	        artifacts:
+	          - os: linux
+	            arch: amd64
+	            url: hello/1.0.0/doctl-hello_linux_amd64.tar.gz
+	            sha256: 0c6d...
+
+The latest version is installed unless one is given, as in hello@1.0.0. The
+index is read from --index, or else from plugin-index in the config file or
+DIGITALOCEAN_PLUGIN_INDEX, and may be an http(s) or file URL or a path.
+Relative artifact URLs are relative to the index.
+
+A plugin given by URL or path is installed from there, and checked against
+--sha256. The checksum is required for http(s) URLs, unless
+--insecure-skip-verify is set. Its file must be named doctl-<name>.`
+	AddStringFlag(cmdInstall, doctl.ArgPluginIndex, "", "", "URL or path of the plugin index")
+	AddStringFlag(cmdInstall, doctl.ArgSHA256, "", "", "SHA-256 checksum the plugin downloaded from a URL or path must match")
+	AddBoolFlag(cmdInstall, doctl.ArgInsecureSkipVerify, "", false, "install a plugin downloaded from an http(s) URL without checking its checksum")
 
-	CmdBuilder(cmd, RunPluginRun, "run", "run plugin", Writer)
+	cmdUpgrade := cmdBuilderWithInit(cmd, RunPluginUpgrade, "upgrade [<name>...]", "upgrade installed plugins", Writer, false)
+	cmdUpgrade.Long = `upgrade installs the latest version of plugins installed from an index, or of
+every one of them when none is given. Plugins are upgraded from the index they
+were installed from, unless --index is set.`
+	AddStringFlag(cmdUpgrade, doctl.ArgPluginIndex, "", "", "URL or path of the plugin index")
+
+	cmdBuilderWithInit(cmd, RunPluginRemove, "remove <name>...", "remove installed plugins", Writer, false,
+		aliasOpt("rm"))
 
 	return cmd
 }
 
-// RunPluginRun is a command for running a plugin.
-func RunPluginRun(c *CmdConfig) error {
-	if len(c.Args) == 0 {
-		return fmt.Errorf("missing plugin name")
+// RunPluginList is a command for listing available plugins.
+func RunPluginList(c *CmdConfig) error {
+	item := &displayers.Plugin{Plugins: searchPlugins(DoitCmd)}
+	return c.Display(item)
+}
+
+// addPlugins adds a command to parent for every plugin that isn't
+// shadowed.
+func addPlugins(parent *Command) {
+	for _, p := range searchPlugins(parent) {
+		if p.ShadowedBy == "" {
+			pluginCmd(parent, p)
+		}
 	}
+}
 
-	plugs, err := searchPlugins()
-	if err != nil {
-		return err
+// pluginsNeeded reports whether the command line args may run or show
+// plugins: when no built-in command matches it, when it asks for help, or
+// when it completes the name of a command. Other command lines don't search
+// PATH for plugins, so they start quickly.
+func pluginsNeeded(root *Command, args []string) bool {
+	cmd, rest, err := root.Find(args)
+	if err != nil || cmd == root.Command {
+		return true
 	}
 
-	var selectedPlugin *displayers.PlugDesc
-	for i, p := range plugs {
-		if p.Name == c.Args[0] {
-			selectedPlugin = &plugs[i]
+	switch cmd.Name() {
+	case "help":
+		return true
+	case "__complete":
+		if len(rest) == 0 {
+			return true
 		}
+		cmd, _, err = root.Find(rest[:len(rest)-1])
+		return err != nil || cmd == root.Command
 	}
 
-	if selectedPlugin == nil {
-		return fmt.Errorf("unknown plugin %q", c.Args[0])
-	}
+	return false
+}
 
-	var pluginArgs []string
-	if len(c.Args) > 1 {
-		pluginArgs = c.Args[1:]
+// pluginCmd creates the command running the plugin p. Its flags are left
+// to the plugin, only the global flags before its name are doctl's.
+func pluginCmd(parent *Command, p displayers.PlugDesc) *Command {
+	runner := func(c *CmdConfig) error {
+		return runPlugin(c, p)
 	}
 
-	host, err := pluginhost.NewHost(selectedPlugin.Path)
-	if err != nil {
-		return err
-	}
+	cc := &cobra.Command{
+		Use:                p.Name,
+		Short:              fmt.Sprintf("run the %s%s plugin", pluginPrefix, p.Name),
+		Long:               fmt.Sprintf("%s runs the plugin at %s.", p.Name, p.Path),
+		Annotations:        map[string]string{pluginAnnotation: p.Path},
+		DisableFlagParsing: true,
+		Run: func(cmd *cobra.Command, _ []string) {
+			global, args := splitPluginArgs(os.Args[1:], p.Name)
+			if err := DoitCmd.PersistentFlags().Parse(global); err != nil {
+				checkErr(&errUsage{err: err})
+			}
+			// The config file may have been given with the global flags.
+			initConfig()
 
-	var method string
-	var methodArgs []string
+			ctx, cancel := newCmdContext()
+			defer cancel()
 
-	switch l := len(pluginArgs); {
-	case l == 0:
-		method = "Default"
-	case l == 1:
-		method = pluginArgs[0]
-	default:
-		method = pluginArgs[0]
-		methodArgs = pluginArgs[1:]
-	}
+			c, err := NewCmdConfig(ctx, cmdNS(cmd), &doctl.LiveConfig{}, Writer, args, false)
+			checkErr(err)
 
-	if len(pluginArgs) > 1 {
-		methodArgs = pluginArgs[1:]
+			checkErr(cmdContextErr(ctx, runner(c)))
+		},
 	}
 
-	results, err := host.Call(selectedPlugin.Name+"."+strings.Title(method), methodArgs...)
-	if err != nil {
-		return err
+	c := &Command{Command: cc, runner: runner}
+	parent.AddCommand(c)
+
+	return c
+}
+
+// runPlugin runs the plugin p with the arguments of c, passing it the
+// settings doctl resolved through its environment.
+func runPlugin(c *CmdConfig, p displayers.PlugDesc) error {
+	cmd := exec.CommandContext(c.Ctx, p.Path, c.Args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = c.Out
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), pluginEnv(c)...)
+
+	err := cmd.Run()
+	if ee, ok := err.(*exec.ExitError); ok {
+		return &errPluginExit{name: p.Name, code: ee.ExitCode()}
 	}
 
-	fmt.Fprintln(c.Out, results)
-	return nil
+	return err
 }
 
-// RunPluginList is a command for listing available plugins.
-func RunPluginList(c *CmdConfig) error {
-	plugs, err := searchPlugins()
-	if err != nil {
-		return err
+// pluginEnv returns the environment variables holding the settings passed
+// to plugins. They are the ones doctl reads them from.
+func pluginEnv(c *CmdConfig) []string {
+	return []string{
+		"DIGITALOCEAN_CONTEXT=" + currentContext(),
+		"DIGITALOCEAN_ACCESS_TOKEN=" + c.getContextAccessToken(),
+		"DIGITALOCEAN_API_URL=" + apiURL(),
+		"DIGITALOCEAN_OUTPUT=" + Output,
+		"DIGITALOCEAN_CONFIG=" + viper.GetString("config"),
 	}
+}
 
-	item := &displayers.Plugin{Plugins: plugs}
-	return c.Display(item)
+// splitPluginArgs splits the words of a command line running the plugin
+// name into the global flags before its name and the arguments after it.
+func splitPluginArgs(words []string, name string) ([]string, []string) {
+	flags := DoitCmd.PersistentFlags()
+
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		if w == name {
+			return words[:i], words[i+1:]
+		}
+
+		if !strings.HasPrefix(w, "-") || strings.Contains(w, "=") {
+			continue
+		}
+
+		f := flags.Lookup(strings.TrimLeft(w, "-"))
+		if !strings.HasPrefix(w, "--") && len(w) == 2 {
+			f = flags.ShorthandLookup(w[1:])
+		}
+		if f != nil && f.Value.Type() != "bool" {
+			// Skip the flag's value.
+			i++
+		}
+	}
+
+	return nil, words
 }
 
-func searchPlugins() ([]displayers.PlugDesc, error) {
-	envPath := os.Getenv("PATH")
-	paths := strings.Split(envPath, string(os.PathListSeparator))
+// searchPlugins finds the plugins installed by doctl, and then the
+// doctl-<name> executables on PATH. A plugin is shadowed by a command of
+// parent with the same name, or by a plugin found earlier.
+func searchPlugins(parent *Command) []displayers.PlugDesc {
+	builtins := builtinCommands(parent)
+
+	installDir := pluginsDir()
+	installed, err := readInstalledPlugins(installDir)
+	if err != nil {
+		warn("%v", err)
+	}
 
 	var plugs []displayers.PlugDesc
+	found := map[string]string{}
+	seen := map[string]bool{}
 
-	for _, p := range paths {
-		matches, err := filepath.Glob(filepath.Join(p, "doit-provider-*"))
+	dirs := append([]string{installDir}, filepath.SplitList(os.Getenv("PATH"))...)
+	for _, dir := range dirs {
+		if dir == "" || seen[filepath.Clean(dir)] {
+			continue
+		}
+		seen[filepath.Clean(dir)] = true
+
+		// Unreadable and missing directories are skipped, like the shell
+		// does.
+		files, err := ioutil.ReadDir(dir)
 		if err != nil {
-			return nil, err
+			continue
+		}
+
+		var names []string
+		for _, f := range files {
+			names = append(names, f.Name())
+		}
+		sort.Strings(names)
+
+		for _, file := range names {
+			name := pluginName(file)
+			path := filepath.Join(dir, file)
+			if name == "" || !isExecutable(path) {
+				continue
+			}
+
+			p := displayers.PlugDesc{Name: name, Path: path, Source: pluginSourcePath}
+			if dir == installDir {
+				p.Source = pluginSourceInstalled
+				if record, ok := installed[name]; ok {
+					p.Source, p.Version = record.Source, record.Version
+				}
+			}
+
+			switch {
+			case builtins[name]:
+				p.ShadowedBy = "built-in command"
+			case found[name] != "":
+				p.ShadowedBy = found[name]
+			default:
+				found[name] = path
+			}
+
+			plugs = append(plugs, p)
+		}
+	}
+
+	return plugs
+}
+
+// builtinCommands returns the names and aliases of the commands of parent
+// that don't run plugins.
+func builtinCommands(parent *Command) map[string]bool {
+	builtins := map[string]bool{"help": true}
+	for _, cmd := range parent.Commands() {
+		if _, ok := cmd.Annotations[pluginAnnotation]; ok {
+			continue
+		}
+		builtins[cmd.Name()] = true
+		for _, a := range cmd.Aliases {
+			builtins[a] = true
 		}
+	}
+
+	return builtins
+}
+
+// pluginName returns the name of the plugin run by the executable file, or
+// "" when it isn't a plugin.
+func pluginName(file string) string {
+	if !strings.HasPrefix(file, pluginPrefix) {
+		return ""
+	}
+	name := strings.TrimPrefix(file, pluginPrefix)
 
-		for _, pluginPath := range matches {
-			name := pluginName(pluginPath)
-			plugs = append(plugs, displayers.PlugDesc{Path: pluginPath, Name: name})
+	if runtime.GOOS == "windows" {
+		ext := filepath.Ext(name)
+		if !isWindowsExecutableExt(ext) {
+			return ""
 		}
+		name = strings.TrimSuffix(name, ext)
 	}
 
-	return plugs, nil
+	return name
 }
 
-func pluginName(p string) string {
-	base := filepath.Base(p)
-	return strings.TrimPrefix(base, "doit-provider-")
+func isExecutable(path string) bool {
+	fi, err := os.Stat(path)
+	if err != nil || !fi.Mode().IsRegular() {
+		return false
+	}
+
+	// Windows has no executable bit, the extension of the file was
+	// checked by pluginName.
+	return runtime.GOOS == "windows" || fi.Mode().Perm()&0111 != 0
+}
+
+func isWindowsExecutableExt(ext string) bool {
+	exts := os.Getenv("PATHEXT")
+	if exts == "" {
+		exts = ".com;.exe;.bat;.cmd"
+	}
+
+	for _, e := range filepath.SplitList(exts) {
+		if strings.EqualFold(e, ext) {
+			return true
+		}
+	}
+
+	return false
 }
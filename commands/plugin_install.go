@@ -0,0 +1,615 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/digitalocean/doctl"
+	"github.com/spf13/viper"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	// defaultPluginIndex is the index plugin install finds plugins in by
+	// default.
+	defaultPluginIndex = "https://raw.githubusercontent.com/digitalocean/doctl/master/plugins/index.yaml"
+	// pluginIndexKey is the config key of the plugin index. It is read
+	// from the config file or DIGITALOCEAN_PLUGIN_INDEX.
+	pluginIndexKey = "plugin-index"
+	// installedPluginsFile records the plugins installed by doctl, in the
+	// plugins directory.
+	installedPluginsFile = "plugins.yaml"
+)
+
+// Sources of the plugins installed by doctl.
+const (
+	pluginSourceIndex = "index"
+	pluginSourceURL   = "url"
+	pluginSourceFile  = "file"
+)
+
+// PluginIndex lists the plugins plugin install can install by name.
+type PluginIndex struct {
+	Plugins []IndexPlugin `yaml:"plugins"`
+}
+
+// IndexPlugin is a plugin of an index.
+type IndexPlugin struct {
+	Name        string               `yaml:"name"`
+	Description string               `yaml:"description,omitempty"`
+	Versions    []IndexPluginVersion `yaml:"versions"`
+}
+
+// IndexPluginVersion is a version of a plugin, built for one or more
+// platforms.
+type IndexPluginVersion struct {
+	Version   string           `yaml:"version"`
+	Artifacts []PluginArtifact `yaml:"artifacts"`
+}
+
+// PluginArtifact is the build of a plugin version for a platform.
+type PluginArtifact struct {
+	// OS and Arch are values of GOOS and GOARCH, such as linux and amd64.
+	OS   string `yaml:"os"`
+	Arch string `yaml:"arch"`
+	// URL is the plugin executable, or a .tar.gz or .zip archive holding
+	// it. Relative URLs are relative to the index.
+	URL string `yaml:"url"`
+	// SHA256 is the hex encoded checksum of the file at URL.
+	SHA256 string `yaml:"sha256"`
+}
+
+// installedPlugin records how a plugin was installed.
+type installedPlugin struct {
+	// Version is set for plugins installed from an index.
+	Version string `yaml:"version,omitempty"`
+	// Source is index, url or file.
+	Source string `yaml:"source"`
+	// Location is the index, URL or path the plugin was installed from.
+	Location string `yaml:"location"`
+	SHA256   string `yaml:"sha256"`
+}
+
+// pluginsDir returns the directory plugins are installed in. Tests replace
+// it.
+var pluginsDir = defaultPluginsDir
+
+// defaultPluginsDir is next to the default config file.
+func defaultPluginsDir() string {
+	return filepath.Join(configHome(), "plugins")
+}
+
+// pluginFileName returns the name of the executable of the plugin name.
+func pluginFileName(name string) string {
+	if runtime.GOOS == "windows" {
+		return pluginPrefix + name + ".exe"
+	}
+	return pluginPrefix + name
+}
+
+// RunPluginInstall installs a plugin from the index, a URL or a file.
+func RunPluginInstall(c *CmdConfig) error {
+	if len(c.Args) != 1 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+	target := c.Args[0]
+
+	checksum, err := c.Doit.GetString(c.NS, doctl.ArgSHA256)
+	if err != nil {
+		return err
+	}
+
+	skipVerify, err := c.Doit.GetBool(c.NS, doctl.ArgInsecureSkipVerify)
+	if err != nil {
+		return err
+	}
+
+	dir := pluginsDir()
+	installed, err := readInstalledPlugins(dir)
+	if err != nil {
+		return err
+	}
+
+	var (
+		name, file string
+		record     installedPlugin
+	)
+	switch {
+	case strings.Contains(target, "://"):
+		record = installedPlugin{Source: pluginSourceURL, Location: target, SHA256: checksum}
+		file = path.Base(target)
+	case strings.ContainsRune(target, filepath.Separator) || strings.ContainsRune(target, '/') || fileExists(target):
+		abs, err := filepath.Abs(target)
+		if err != nil {
+			return err
+		}
+		record = installedPlugin{Source: pluginSourceFile, Location: abs, SHA256: checksum}
+		file = filepath.Base(abs)
+	default:
+		index, err := pluginIndexLocation(c)
+		if err != nil {
+			return err
+		}
+
+		version := ""
+		if i := strings.Index(target, "@"); i >= 0 {
+			target, version = target[:i], target[i+1:]
+		}
+		name = target
+
+		artifact, v, err := findPluginArtifact(c.Ctx, index, name, version)
+		if err != nil {
+			return err
+		}
+		record = installedPlugin{Version: v, Source: pluginSourceIndex, Location: index, SHA256: artifact.SHA256}
+		file = path.Base(artifact.URL)
+		target = resolvePluginURL(index, artifact.URL)
+	}
+
+	if name == "" {
+		if name, err = pluginNameFromFile(file); err != nil {
+			return err
+		}
+	}
+	if _, ok := installed[name]; ok {
+		return fmt.Errorf("plugin %s is already installed, upgrade it with doctl plugin upgrade or remove it first", name)
+	}
+	if builtinCommands(DoitCmd)[name] {
+		return fmt.Errorf("plugin %s would be shadowed by the built-in command of the same name", name)
+	}
+
+	if record.SHA256 == "" {
+		remote := strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://")
+		switch {
+		case remote && !skipVerify:
+			return fmt.Errorf("no checksum given for %s, pass --%s to verify it or --%s to install it unchecked",
+				target, doctl.ArgSHA256, doctl.ArgInsecureSkipVerify)
+		case !remote:
+			warn("no checksum given for %s, pass --%s to verify it", target, doctl.ArgSHA256)
+		}
+	}
+
+	dest, err := installPlugin(c.Ctx, dir, name, file, target, record.SHA256)
+	if err != nil {
+		return err
+	}
+
+	installed[name] = record
+	if err := writeInstalledPlugins(dir, installed); err != nil {
+		return err
+	}
+
+	if record.Version != "" {
+		notice("installed plugin %s %s to %s", name, record.Version, dest)
+	} else {
+		notice("installed plugin %s to %s", name, dest)
+	}
+	return nil
+}
+
+// RunPluginUpgrade upgrades plugins installed from an index to their latest
+// version.
+func RunPluginUpgrade(c *CmdConfig) error {
+	indexFlag, err := c.Doit.GetString(c.NS, doctl.ArgPluginIndex)
+	if err != nil {
+		return err
+	}
+
+	dir := pluginsDir()
+	installed, err := readInstalledPlugins(dir)
+	if err != nil {
+		return err
+	}
+
+	names := c.Args
+	if len(names) == 0 {
+		for name := range installed {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	for _, name := range names {
+		record, ok := installed[name]
+		switch {
+		case !ok:
+			return fmt.Errorf("plugin %s wasn't installed by doctl", name)
+		case record.Source != pluginSourceIndex:
+			warn("plugin %s was installed from %s, remove and install it again to upgrade it", name, record.Location)
+			continue
+		}
+
+		// Plugins are upgraded from the index they were installed from.
+		index := record.Location
+		if indexFlag != "" {
+			index = indexFlag
+		}
+
+		artifact, version, err := findPluginArtifact(c.Ctx, index, name, "")
+		if err != nil {
+			return err
+		}
+
+		current, err := semver.ParseTolerant(record.Version)
+		if err != nil {
+			return fmt.Errorf("plugin %s has an invalid version %q: %v", name, record.Version, err)
+		}
+		latest, err := semver.ParseTolerant(version)
+		if err != nil {
+			return fmt.Errorf("plugin %s has an invalid version %q in %s: %v", name, version, index, err)
+		}
+		if !latest.GT(current) {
+			notice("plugin %s is up to date (%s)", name, record.Version)
+			continue
+		}
+
+		if _, err := installPlugin(c.Ctx, dir, name, path.Base(artifact.URL), resolvePluginURL(index, artifact.URL), artifact.SHA256); err != nil {
+			return err
+		}
+
+		installed[name] = installedPlugin{Version: version, Source: pluginSourceIndex, Location: index, SHA256: artifact.SHA256}
+		if err := writeInstalledPlugins(dir, installed); err != nil {
+			return err
+		}
+		notice("upgraded plugin %s from %s to %s", name, record.Version, version)
+	}
+
+	return nil
+}
+
+// RunPluginRemove removes plugins installed by doctl.
+func RunPluginRemove(c *CmdConfig) error {
+	if len(c.Args) == 0 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+
+	dir := pluginsDir()
+	installed, err := readInstalledPlugins(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range c.Args {
+		if _, ok := installed[name]; !ok {
+			return fmt.Errorf("plugin %s wasn't installed by doctl", name)
+		}
+
+		err := os.Remove(filepath.Join(dir, pluginFileName(name)))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		delete(installed, name)
+		if err := writeInstalledPlugins(dir, installed); err != nil {
+			return err
+		}
+		notice("removed plugin %s", name)
+	}
+
+	return nil
+}
+
+// pluginIndexLocation returns the URL or path of the plugin index: the one
+// given with --index, or else the one in the config, or else the default.
+func pluginIndexLocation(c *CmdConfig) (string, error) {
+	index, err := c.Doit.GetString(c.NS, doctl.ArgPluginIndex)
+	if err != nil {
+		return "", err
+	}
+
+	if index == "" {
+		index = viper.GetString(pluginIndexKey)
+	}
+	if index == "" {
+		index = defaultPluginIndex
+	}
+
+	return index, nil
+}
+
+// findPluginArtifact finds the artifact of the plugin name for this
+// platform in the index, at version or else at the latest version.
+func findPluginArtifact(ctx context.Context, index, name, version string) (*PluginArtifact, string, error) {
+	b, err := fetchPluginFile(ctx, index)
+	if err != nil {
+		return nil, "", fmt.Errorf("reading plugin index: %v", err)
+	}
+
+	var idx PluginIndex
+	if err := yaml.Unmarshal(b, &idx); err != nil {
+		return nil, "", fmt.Errorf("reading plugin index %s: %v", index, err)
+	}
+
+	var plugin *IndexPlugin
+	for i := range idx.Plugins {
+		if idx.Plugins[i].Name == name {
+			plugin = &idx.Plugins[i]
+		}
+	}
+	if plugin == nil {
+		return nil, "", fmt.Errorf("plugin %s isn't in the index %s", name, index)
+	}
+
+	v, err := plugin.version(version)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i, a := range v.Artifacts {
+		if a.OS == runtime.GOOS && a.Arch == runtime.GOARCH {
+			if a.SHA256 == "" {
+				return nil, "", fmt.Errorf("plugin %s %s has no checksum for %s/%s", name, v.Version, a.OS, a.Arch)
+			}
+			return &v.Artifacts[i], v.Version, nil
+		}
+	}
+
+	return nil, "", fmt.Errorf("plugin %s %s isn't available for %s/%s", name, v.Version, runtime.GOOS, runtime.GOARCH)
+}
+
+// version returns the version of the plugin, or its latest version when
+// version is "".
+func (p *IndexPlugin) version(version string) (*IndexPluginVersion, error) {
+	var latest *IndexPluginVersion
+	var latestSV semver.Version
+
+	for i, v := range p.Versions {
+		if version != "" {
+			if strings.TrimPrefix(v.Version, "v") == strings.TrimPrefix(version, "v") {
+				return &p.Versions[i], nil
+			}
+			continue
+		}
+
+		sv, err := semver.ParseTolerant(v.Version)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s has an invalid version %q: %v", p.Name, v.Version, err)
+		}
+		if latest == nil || sv.GT(latestSV) {
+			latest, latestSV = &p.Versions[i], sv
+		}
+	}
+
+	switch {
+	case version != "":
+		return nil, fmt.Errorf("plugin %s has no version %s", p.Name, version)
+	case latest == nil:
+		return nil, fmt.Errorf("plugin %s has no versions", p.Name)
+	}
+
+	return latest, nil
+}
+
+// resolvePluginURL resolves the URL of an artifact relative to the index.
+func resolvePluginURL(index, ref string) string {
+	if strings.Contains(ref, "://") || filepath.IsAbs(ref) {
+		return ref
+	}
+
+	if strings.Contains(index, "://") {
+		base, err := url.Parse(index)
+		if err != nil {
+			return ref
+		}
+		r, err := url.Parse(ref)
+		if err != nil {
+			return ref
+		}
+		return base.ResolveReference(r).String()
+	}
+
+	return filepath.Join(filepath.Dir(index), filepath.FromSlash(ref))
+}
+
+// fetchPluginFile reads an http(s) or file URL, or a path.
+func fetchPluginFile(ctx context.Context, location string) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err != nil || !strings.Contains(location, "://") {
+		return ioutil.ReadFile(location)
+	}
+
+	switch u.Scheme {
+	case "file":
+		return ioutil.ReadFile(filepath.FromSlash(u.Path))
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("unsupported URL scheme %q in %s", u.Scheme, location)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", fmt.Sprintf("doctl/%s", doctl.DoitVersion.String()))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", location, resp.Status)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+// installPlugin downloads the plugin name from location, checks its
+// checksum when one is given and installs it in dir. file is the name of
+// the downloaded file, telling archives apart.
+func installPlugin(ctx context.Context, dir, name, file, location, checksum string) (string, error) {
+	b, err := fetchPluginFile(ctx, location)
+	if err != nil {
+		return "", err
+	}
+
+	if checksum != "" {
+		sum := sha256.Sum256(b)
+		if got := hex.EncodeToString(sum[:]); !strings.EqualFold(got, checksum) {
+			return "", fmt.Errorf("checksum mismatch for %s: expected %s, got %s", location, checksum, got)
+		}
+	}
+
+	b, err = extractPlugin(name, file, b)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	// The plugin is written next to its destination and then renamed, so
+	// it is replaced at once.
+	path := filepath.Join(dir, pluginFileName(name))
+	tmp, err := ioutil.TempFile(dir, pluginFileName(name)+".*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return "", err
+	}
+
+	return path, os.Rename(tmp.Name(), path)
+}
+
+// extractPlugin returns the executable of the plugin name from the
+// downloaded file, as is unless it's a .tar.gz or .zip archive.
+func extractPlugin(name, file string, b []byte) ([]byte, error) {
+	want := pluginFileName(name)
+
+	switch {
+	case strings.HasSuffix(file, ".tar.gz"), strings.HasSuffix(file, ".tgz"):
+		gz, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %v", file, err)
+		}
+		tr := tar.NewReader(gz)
+		for {
+			h, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("reading %s: %v", file, err)
+			}
+			if h.Typeflag == tar.TypeReg && path.Base(h.Name) == want {
+				return ioutil.ReadAll(tr)
+			}
+		}
+	case strings.HasSuffix(file, ".zip"):
+		zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %v", file, err)
+		}
+		for _, f := range zr.File {
+			if path.Base(f.Name) != want {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer rc.Close()
+			return ioutil.ReadAll(rc)
+		}
+	default:
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("%s doesn't hold %s", file, want)
+}
+
+// pluginNameFromFile returns the name of the plugin in the downloaded
+// file, such as hello for doctl-hello_linux_amd64.tar.gz.
+func pluginNameFromFile(file string) (string, error) {
+	name := file
+	for _, ext := range []string{".tar.gz", ".tgz", ".zip", ".exe"} {
+		name = strings.TrimSuffix(name, ext)
+	}
+
+	if !strings.HasPrefix(name, pluginPrefix) || name == pluginPrefix {
+		return "", fmt.Errorf("%s isn't a doctl plugin, its name must start with %s", file, pluginPrefix)
+	}
+	name = strings.TrimPrefix(name, pluginPrefix)
+
+	// Archives are often named after their platform too.
+	if i := strings.Index(name, "_"); i > 0 {
+		name = name[:i]
+	}
+
+	return name, nil
+}
+
+// readInstalledPlugins reads the record of the plugins installed in dir.
+func readInstalledPlugins(dir string) (map[string]installedPlugin, error) {
+	installed := map[string]installedPlugin{}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, installedPluginsFile))
+	switch {
+	case os.IsNotExist(err):
+		return installed, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(b, &installed); err != nil {
+		return nil, fmt.Errorf("reading %s: %v", filepath.Join(dir, installedPluginsFile), err)
+	}
+
+	return installed, nil
+}
+
+func writeInstalledPlugins(dir string, installed map[string]installedPlugin) error {
+	b, err := yaml.Marshal(installed)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, installedPluginsFile), b, 0644)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/digitalocean/doctl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pluginIndexServer serves a plugin index holding the given versions of the
+// hello plugin, as .tar.gz archives.
+type pluginIndexServer struct {
+	*httptest.Server
+	files map[string][]byte
+}
+
+func newPluginIndexServer(t *testing.T, versions ...string) *pluginIndexServer {
+	s := &pluginIndexServer{files: map[string][]byte{}}
+	s.setVersions(t, versions...)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, ok := s.files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(b)
+	}))
+
+	return s
+}
+
+func (s *pluginIndexServer) setVersions(t *testing.T, versions ...string) {
+	index := "plugins:\n  - name: hello\n    versions:\n"
+	for _, v := range versions {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		script := []byte("#!/bin/sh\necho hello " + v + "\n")
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "bin/" + pluginFileName("hello"), Mode: 0755, Size: int64(len(script)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write(script)
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+
+		file := fmt.Sprintf("doctl-hello_%s_%s_%s.tar.gz", v, runtime.GOOS, runtime.GOARCH)
+		s.files["/"+v+"/"+file] = buf.Bytes()
+
+		sum := sha256.Sum256(buf.Bytes())
+		index += fmt.Sprintf(`      - version: %s
+        artifacts:
+          - os: %s
+            arch: %s
+            url: %s/%s
+            sha256: %s
+`, v, runtime.GOOS, runtime.GOARCH, v, file, hex.EncodeToString(sum[:]))
+	}
+
+	s.files["/index.yaml"] = []byte(index)
+}
+
+func withPluginsDir(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "doctl-plugins")
+	require.NoError(t, err)
+
+	pluginsDir = func() string { return dir }
+	return func() {
+		pluginsDir = defaultPluginsDir
+		os.RemoveAll(dir)
+	}
+}
+
+func TestPluginInstallUpgradeRemove(t *testing.T) {
+	defer withPluginsDir(t)()
+
+	server := newPluginIndexServer(t, "1.0.0", "0.9.0")
+	defer server.Close()
+	index := server.URL + "/index.yaml"
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = []string{"hello"}
+		config.Doit.Set(config.NS, doctl.ArgPluginIndex, index)
+
+		require.NoError(t, RunPluginInstall(config))
+
+		b, err := ioutil.ReadFile(filepath.Join(pluginsDir(), pluginFileName("hello")))
+		require.NoError(t, err)
+		assert.Equal(t, "#!/bin/sh\necho hello 1.0.0\n", string(b))
+
+		installed, err := readInstalledPlugins(pluginsDir())
+		require.NoError(t, err)
+		assert.Equal(t, "1.0.0", installed["hello"].Version)
+		assert.Equal(t, pluginSourceIndex, installed["hello"].Source)
+		assert.Equal(t, index, installed["hello"].Location)
+
+		assert.EqualError(t, RunPluginInstall(config), "plugin hello is already installed, upgrade it with doctl plugin upgrade or remove it first")
+	})
+
+	server.setVersions(t, "1.0.0", "1.1.0")
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		require.NoError(t, RunPluginUpgrade(config))
+
+		b, err := ioutil.ReadFile(filepath.Join(pluginsDir(), pluginFileName("hello")))
+		require.NoError(t, err)
+		assert.Equal(t, "#!/bin/sh\necho hello 1.1.0\n", string(b))
+
+		installed, err := readInstalledPlugins(pluginsDir())
+		require.NoError(t, err)
+		assert.Equal(t, "1.1.0", installed["hello"].Version)
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = []string{"hello"}
+		require.NoError(t, RunPluginRemove(config))
+
+		_, err := os.Stat(filepath.Join(pluginsDir(), pluginFileName("hello")))
+		assert.True(t, os.IsNotExist(err))
+
+		installed, err := readInstalledPlugins(pluginsDir())
+		require.NoError(t, err)
+		assert.Empty(t, installed)
+
+		assert.EqualError(t, RunPluginRemove(config), "plugin hello wasn't installed by doctl")
+	})
+}
+
+func TestPluginInstallChecksum(t *testing.T) {
+	defer withPluginsDir(t)()
+
+	dir, err := ioutil.TempDir("", "doctl-plugin")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "doctl-hello")
+	require.NoError(t, ioutil.WriteFile(path, []byte("#!/bin/sh\n"), 0755))
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = []string{path}
+		config.Doit.Set(config.NS, doctl.ArgSHA256, "0000")
+
+		err := RunPluginInstall(config)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "checksum mismatch for "+path)
+
+		sum := sha256.Sum256([]byte("#!/bin/sh\n"))
+		config.Doit.Set(config.NS, doctl.ArgSHA256, hex.EncodeToString(sum[:]))
+		require.NoError(t, RunPluginInstall(config))
+
+		installed, err := readInstalledPlugins(pluginsDir())
+		require.NoError(t, err)
+		assert.Equal(t, installedPlugin{Source: pluginSourceFile, Location: path, SHA256: hex.EncodeToString(sum[:])}, installed["hello"])
+	})
+}
+
+func TestPluginInstallURLChecksum(t *testing.T) {
+	defer withPluginsDir(t)()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("#!/bin/sh\n"))
+	}))
+	defer server.Close()
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		url := server.URL + "/" + pluginFileName("hello")
+		config.Args = []string{url}
+
+		err := RunPluginInstall(config)
+		assert.EqualError(t, err, "no checksum given for "+url+", pass --sha256 to verify it or --insecure-skip-verify to install it unchecked")
+		installed, err := readInstalledPlugins(pluginsDir())
+		require.NoError(t, err)
+		assert.Empty(t, installed)
+
+		config.Doit.Set(config.NS, doctl.ArgInsecureSkipVerify, true)
+		require.NoError(t, RunPluginInstall(config))
+
+		installed, err = readInstalledPlugins(pluginsDir())
+		require.NoError(t, err)
+		assert.Equal(t, installedPlugin{Source: pluginSourceURL, Location: url}, installed["hello"])
+	})
+}
+
+func TestPluginNameFromFile(t *testing.T) {
+	for file, name := range map[string]string{
+		"doctl-hello":                       "hello",
+		"doctl-hello.exe":                   "hello",
+		"doctl-hello_1.0.0_linux_amd64.zip": "hello",
+		"doctl-my-tool_darwin_amd64.tar.gz": "my-tool",
+		"doctl-my-tool_windows_amd64.tgz":   "my-tool",
+		"hello_1.0.0_linux_amd64.tar.gz":    "",
+		"doctl-":                            "",
+	} {
+		got, err := pluginNameFromFile(file)
+		if name == "" {
+			assert.Error(t, err, file)
+			continue
+		}
+		require.NoError(t, err, file)
+		assert.Equal(t, name, got, file)
+	}
+}
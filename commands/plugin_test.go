@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/commands/displayers"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePlugin(t *testing.T, dir, file, script string, mode os.FileMode) string {
+	path := filepath.Join(dir, file)
+	require.NoError(t, ioutil.WriteFile(path, []byte(script), mode))
+	return path
+}
+
+func TestSearchPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+
+	defer withPluginsDir(t)()
+	greet := writePlugin(t, pluginsDir(), "doctl-greet", "#!/bin/sh\n", 0755)
+	require.NoError(t, writeInstalledPlugins(pluginsDir(), map[string]installedPlugin{
+		"greet": {Version: "1.0.0", Source: pluginSourceIndex, Location: "index.yaml"},
+	}))
+
+	first, err := ioutil.TempDir("", "doctl-plugins")
+	require.NoError(t, err)
+	defer os.RemoveAll(first)
+	second, err := ioutil.TempDir("", "doctl-plugins")
+	require.NoError(t, err)
+	defer os.RemoveAll(second)
+
+	hello := writePlugin(t, first, "doctl-hello", "#!/bin/sh\n", 0755)
+	shadowed := writePlugin(t, second, "doctl-hello", "#!/bin/sh\n", 0755)
+	compute := writePlugin(t, second, "doctl-compute", "#!/bin/sh\n", 0755)
+	writePlugin(t, second, "doctl-noexec", "#!/bin/sh\n", 0644)
+	writePlugin(t, second, "other", "#!/bin/sh\n", 0755)
+
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", strings.Join([]string{first, "", second, first}, string(os.PathListSeparator)))
+
+	assert.Equal(t, []displayers.PlugDesc{
+		{Name: "greet", Version: "1.0.0", Path: greet, Source: pluginSourceIndex},
+		{Name: "hello", Path: hello, Source: pluginSourcePath},
+		{Name: "compute", Path: compute, Source: pluginSourcePath, ShadowedBy: "built-in command"},
+		{Name: "hello", Path: shadowed, Source: pluginSourcePath, ShadowedBy: hello},
+	}, searchPlugins(DoitCmd))
+}
+
+func TestSplitPluginArgs(t *testing.T) {
+	tests := []struct {
+		words        []string
+		global, args []string
+	}{
+		{
+			words:  []string{"hello", "--flag", "-t", "x"},
+			global: []string{},
+			args:   []string{"--flag", "-t", "x"},
+		},
+		{
+			words:  []string{"-t", "hello", "--trace", "--context=work", "-o", "json", "hello", "a"},
+			global: []string{"-t", "hello", "--trace", "--context=work", "-o", "json"},
+			args:   []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		global, args := splitPluginArgs(tt.words, "hello")
+		assert.Equal(t, tt.global, global, tt.words)
+		assert.Equal(t, tt.args, args, tt.words)
+	}
+}
+
+func TestPluginsNeeded(t *testing.T) {
+	tests := []struct {
+		args   []string
+		needed bool
+	}{
+		{args: []string{}, needed: true},
+		{args: []string{"--help"}, needed: true},
+		{args: []string{"help", "hello"}, needed: true},
+		{args: []string{"-t", "x", "hello", "a"}, needed: true},
+		{args: []string{"__complete", "he"}, needed: true},
+		{args: []string{"__complete", "--context", "work", ""}, needed: true},
+		{args: []string{"version"}, needed: false},
+		{args: []string{"compute", "droplet", "list", "--format", "ID"}, needed: false},
+		{args: []string{"__complete", "compute", ""}, needed: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.needed, pluginsNeeded(DoitCmd, tt.args), "%v", tt.args)
+	}
+}
+
+func TestRunPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts")
+	}
+
+	dir, err := ioutil.TempDir("", "doctl-plugins")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := writePlugin(t, dir, "doctl-hello", `#!/bin/sh
+echo "$@"
+echo "$DIGITALOCEAN_CONTEXT $DIGITALOCEAN_ACCESS_TOKEN $DIGITALOCEAN_OUTPUT"
+exit $1
+`, 0755)
+	p := displayers.PlugDesc{Name: "hello", Path: path, Source: pluginSourcePath}
+
+	viper.Set(doctl.ArgAccessToken, "secret")
+	defer viper.Set(doctl.ArgAccessToken, "")
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var out bytes.Buffer
+		config.Out = &out
+		config.Args = []string{"0", "--flag"}
+
+		require.NoError(t, runPlugin(config, p))
+		assert.Equal(t, "0 --flag\n"+doctl.ArgDefaultContext+" secret text\n", out.String())
+
+		config.Args = []string{"3"}
+		err := runPlugin(config, p)
+		assert.EqualError(t, err, "plugin hello exited with status 3")
+		assert.Equal(t, 3, exitCode(err))
+	})
+}
@@ -100,7 +100,7 @@ func ProjectResourcesCmd() *Command {
 // RunProjectsList lists Projects.
 func RunProjectsList(c *CmdConfig) error {
 	ps := c.Projects()
-	list, err := ps.List()
+	list, err := ps.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -114,10 +114,13 @@ func RunProjectsGet(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	id := c.Args[0]
+	id, err := projectResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	ps := c.Projects()
-	p, err := ps.Get(id)
+	p, err := ps.Get(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -133,7 +136,7 @@ func RunProjectsCreate(c *CmdConfig) error {
 	}
 
 	ps := c.Projects()
-	p, err := ps.Create(r)
+	p, err := ps.Create(c.Ctx, r)
 	if err != nil {
 		return err
 	}
@@ -146,7 +149,10 @@ func RunProjectsUpdate(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	id := c.Args[0]
+	id, err := projectResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	r := new(godo.UpdateProjectRequest)
 	if err := buildProjectsUpdateRequestFromArgs(c, r); err != nil {
@@ -154,7 +160,7 @@ func RunProjectsUpdate(c *CmdConfig) error {
 	}
 
 	ps := c.Projects()
-	p, err := ps.Update(id, r)
+	p, err := ps.Update(c.Ctx, id, r)
 	if err != nil {
 		return err
 	}
@@ -173,14 +179,19 @@ func RunProjectsDelete(c *CmdConfig) error {
 		return err
 	}
 
+	ids, err := projectResolver(c).resolveAll(c.Args)
+	if err != nil {
+		return err
+	}
+
 	ps := c.Projects()
 	var suffix string
-	if len(c.Args) != 1 {
+	if len(ids) != 1 {
 		suffix = "s"
 	}
-	if force || AskForConfirm(fmt.Sprintf("delete %d project%s", len(c.Args), suffix)) == nil {
-		for _, id := range c.Args {
-			if err := ps.Delete(id); err != nil {
+	if force || AskForConfirm(fmt.Sprintf("delete %d project%s", len(ids), suffix)) == nil {
+		for _, id := range ids {
+			if err := ps.Delete(c.Ctx, id); err != nil {
 				return err
 			}
 		}
@@ -196,10 +207,13 @@ func RunProjectResourcesList(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	id := c.Args[0]
+	id, err := projectResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	ps := c.Projects()
-	list, err := ps.ListResources(id)
+	list, err := ps.ListResources(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -241,7 +255,10 @@ func RunProjectResourcesAssign(c *CmdConfig) error {
 	if len(c.Args) != 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
-	projectUUID := c.Args[0]
+	projectUUID, err := projectResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	urns, err := c.Doit.GetStringSlice(c.NS, doctl.ArgProjectResource)
 	if err != nil {
@@ -249,7 +266,7 @@ func RunProjectResourcesAssign(c *CmdConfig) error {
 	}
 
 	ps := c.Projects()
-	list, err := ps.AssignResources(projectUUID, urns)
+	list, err := ps.AssignResources(c.Ctx, projectUUID, urns)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,7 +46,7 @@ func TestProjectsCommand(t *testing.T) {
 
 func TestProjectsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.projects.EXPECT().List().Return(testProjectList, nil)
+		tm.projects.EXPECT().List(gomock.Any()).Return(testProjectList, nil)
 
 		err := RunProjectsList(config)
 		assert.NoError(t, err)
@@ -55,7 +56,7 @@ func TestProjectsList(t *testing.T) {
 func TestProjectsGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		projectUUID := "ab06e011-6dd1-4034-9293-201f71aba299"
-		tm.projects.EXPECT().Get(projectUUID).Return(&testProject, nil)
+		tm.projects.EXPECT().Get(gomock.Any(), projectUUID).Return(&testProject, nil)
 
 		config.Args = append(config.Args, projectUUID)
 
@@ -72,7 +73,7 @@ func TestProjectsCreate(t *testing.T) {
 			Purpose:     "personal use",
 			Environment: "Staging",
 		}
-		tm.projects.EXPECT().Create(projectCreateRequest).Return(&testProject, nil)
+		tm.projects.EXPECT().Create(gomock.Any(), projectCreateRequest).Return(&testProject, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgProjectName, "project name")
 		config.Doit.Set(config.NS, doctl.ArgProjectDescription, "project description")
@@ -94,7 +95,7 @@ func TestProjectsUpdateAllAttributes(t *testing.T) {
 			Environment: "Production",
 			IsDefault:   false,
 		}
-		tm.projects.EXPECT().Update(projectUUID, updateReq).Return(&testProject, nil)
+		tm.projects.EXPECT().Update(gomock.Any(), projectUUID, updateReq).Return(&testProject, nil)
 
 		config.Args = append(config.Args, projectUUID)
 		config.Doit.Set(config.NS, doctl.ArgProjectName, "project name")
@@ -118,7 +119,7 @@ func TestProjectsUpdateSomeAttributes(t *testing.T) {
 			Environment: nil,
 			IsDefault:   nil,
 		}
-		tm.projects.EXPECT().Update(projectUUID, updateReq).Return(&testProject, nil)
+		tm.projects.EXPECT().Update(gomock.Any(), projectUUID, updateReq).Return(&testProject, nil)
 
 		config.Args = append(config.Args, projectUUID)
 		config.Doit.Set(config.NS, doctl.ArgProjectName, "project name")
@@ -139,7 +140,7 @@ func TestProjectsUpdateOneAttribute(t *testing.T) {
 			Environment: nil,
 			IsDefault:   nil,
 		}
-		tm.projects.EXPECT().Update(projectUUID, updateReq).Return(&testProject, nil)
+		tm.projects.EXPECT().Update(gomock.Any(), projectUUID, updateReq).Return(&testProject, nil)
 
 		config.Args = append(config.Args, projectUUID)
 		config.Doit.Set(config.NS, doctl.ArgProjectName, "project name")
@@ -152,7 +153,7 @@ func TestProjectsUpdateOneAttribute(t *testing.T) {
 func TestProjectsDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		projectUUID := "ab06e011-6dd1-4034-9293-201f71aba299"
-		tm.projects.EXPECT().Delete(projectUUID).Return(nil)
+		tm.projects.EXPECT().Delete(gomock.Any(), projectUUID).Return(nil)
 
 		config.Args = append(config.Args, projectUUID)
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -171,7 +172,7 @@ func TestProjectResourcesCommand(t *testing.T) {
 func TestProjectResourcesList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		projectUUID := "ab06e011-6dd1-4034-9293-201f71aba299"
-		tm.projects.EXPECT().ListResources(projectUUID).Return(testProjectResourcesList, nil)
+		tm.projects.EXPECT().ListResources(gomock.Any(), projectUUID).Return(testProjectResourcesList, nil)
 
 		config.Args = append(config.Args, projectUUID)
 		err := RunProjectResourcesList(config)
@@ -181,7 +182,7 @@ func TestProjectResourcesList(t *testing.T) {
 
 func TestProjectResourcesGetWithValidURN(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Get(1234).Return(&testDroplet, nil)
+		tm.droplets.EXPECT().Get(gomock.Any(), 1234).Return(&testDroplet, nil)
 
 		config.Args = append(config.Args, "do:droplet:1234")
 		err := RunProjectResourcesGet(config)
@@ -202,7 +203,7 @@ func TestProjectResourcesAssignOneResource(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		projectUUID := "ab06e011-6dd1-4034-9293-201f71aba299"
 		urn := "do:droplet:1234"
-		tm.projects.EXPECT().AssignResources(projectUUID, []string{urn}).Return(testProjectResourcesListSingle, nil)
+		tm.projects.EXPECT().AssignResources(gomock.Any(), projectUUID, []string{urn}).Return(testProjectResourcesListSingle, nil)
 
 		config.Args = append(config.Args, projectUUID)
 		config.Doit.Set(config.NS, doctl.ArgProjectResource, []string{urn})
@@ -217,7 +218,7 @@ func TestProjectResourcesAssignMultipleResources(t *testing.T) {
 		projectUUID := "ab06e011-6dd1-4034-9293-201f71aba299"
 		urn := "do:droplet:1234"
 		otherURN := "do:floatingip:1.2.3.4"
-		tm.projects.EXPECT().AssignResources(projectUUID, []string{urn, otherURN}).Return(testProjectResourcesList, nil)
+		tm.projects.EXPECT().AssignResources(gomock.Any(), projectUUID, []string{urn, otherURN}).Return(testProjectResourcesList, nil)
 
 		config.Args = append(config.Args, projectUUID)
 		config.Doit.Set(config.NS, doctl.ArgProjectResource, []string{urn, otherURN})
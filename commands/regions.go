@@ -38,7 +38,7 @@ func Region() *Command {
 func RunRegionList(c *CmdConfig) error {
 	rs := c.Regions()
 
-	list, err := rs.List()
+	list, err := rs.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,7 +35,7 @@ func TestRegionCommand(t *testing.T) {
 
 func TestRegionsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.regions.EXPECT().List().Return(testRegionList, nil)
+		tm.regions.EXPECT().List(gomock.Any()).Return(testRegionList, nil)
 
 		err := RunRegionList(config)
 		assert.NoError(t, err)
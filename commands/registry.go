@@ -77,7 +77,7 @@ func RunRegistryCreate(c *CmdConfig) error {
 	rs := c.Registry()
 
 	rcr := &godo.RegistryCreateRequest{Name: name}
-	r, err := rs.Create(rcr)
+	r, err := rs.Create(c.Ctx, rcr)
 	if err != nil {
 		return err
 	}
@@ -87,7 +87,7 @@ func RunRegistryCreate(c *CmdConfig) error {
 
 // RunRegistryGet returns the registry
 func RunRegistryGet(c *CmdConfig) error {
-	reg, err := c.Registry().Get()
+	reg, err := c.Registry().Get(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -106,7 +106,7 @@ func RunRegistryDelete(c *CmdConfig) error {
 		return fmt.Errorf("operation aborted")
 	}
 
-	return c.Registry().Delete()
+	return c.Registry().Delete(c.Ctx)
 }
 
 // store execCommand in a variable. Lets us override it while testing
@@ -121,7 +121,7 @@ func RunRegistryLogin(c *CmdConfig) error {
 
 	fmt.Printf("Logging Docker in to %s\n", c.Registry().Endpoint())
 
-	creds, err := c.Registry().DockerCredentials(&godo.RegistryDockerCredentialsRequest{
+	creds, err := c.Registry().DockerCredentials(c.Ctx, &godo.RegistryDockerCredentialsRequest{
 		ReadWrite: true,
 	})
 	if err != nil {
@@ -181,7 +181,7 @@ func RunKubernetesManifest(c *CmdConfig) error {
 
 	// if no secret name supplied, use the registry name
 	if secretName == "" {
-		reg, err := c.Registry().Get()
+		reg, err := c.Registry().Get(c.Ctx)
 		if err != nil {
 			return err
 		}
@@ -189,7 +189,7 @@ func RunKubernetesManifest(c *CmdConfig) error {
 	}
 
 	// fetch docker config
-	dockerCreds, err := c.Registry().DockerCredentials(&godo.RegistryDockerCredentialsRequest{
+	dockerCreds, err := c.Registry().DockerCredentials(c.Ctx, &godo.RegistryDockerCredentialsRequest{
 		ReadWrite: false,
 	})
 	if err != nil {
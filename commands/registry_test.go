@@ -25,6 +25,7 @@ import (
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	k8sapiv1 "k8s.io/api/core/v1"
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
@@ -48,7 +49,7 @@ func TestRegistryCommand(t *testing.T) {
 func TestRegistryCreate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		rcr := godo.RegistryCreateRequest{Name: testRegistryName}
-		tm.registry.EXPECT().Create(&rcr).Return(&testRegistry, nil)
+		tm.registry.EXPECT().Create(gomock.Any(), &rcr).Return(&testRegistry, nil)
 		config.Args = append(config.Args, testRegistryName)
 
 		err := RunRegistryCreate(config)
@@ -58,7 +59,7 @@ func TestRegistryCreate(t *testing.T) {
 
 func TestRegistryGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.registry.EXPECT().Get().Return(&testRegistry, nil)
+		tm.registry.EXPECT().Get(gomock.Any()).Return(&testRegistry, nil)
 
 		err := RunRegistryGet(config)
 		assert.NoError(t, err)
@@ -67,7 +68,7 @@ func TestRegistryGet(t *testing.T) {
 
 func TestRegistryDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.registry.EXPECT().Delete().Return(nil)
+		tm.registry.EXPECT().Delete(gomock.Any()).Return(nil)
 
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
 
@@ -99,9 +100,9 @@ func TestRegistryKubernetesManifest(t *testing.T) {
 
 		// mocks shared across both test cases
 		// Get should be called only when a name isn't supplied, to look up the registry's name
-		tm.registry.EXPECT().Get().Return(&testRegistry, nil).Times(1)
+		tm.registry.EXPECT().Get(gomock.Any()).Return(&testRegistry, nil).Times(1)
 		// DockerCredentials should be called both times to retrieve the credentials
-		tm.registry.EXPECT().DockerCredentials(&godo.RegistryDockerCredentialsRequest{
+		tm.registry.EXPECT().DockerCredentials(gomock.Any(), &godo.RegistryDockerCredentialsRequest{
 			ReadWrite: false,
 		}).Return(testDockerCredentials, nil).Times(2)
 
@@ -187,7 +188,7 @@ func TestRegistryLogin(t *testing.T) {
 
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.registry.EXPECT().Endpoint().Return(do.RegistryHostname)
-		tm.registry.EXPECT().DockerCredentials(&godo.RegistryDockerCredentialsRequest{
+		tm.registry.EXPECT().DockerCredentials(gomock.Any(), &godo.RegistryDockerCredentialsRequest{
 			ReadWrite: true,
 		}).Return(testDockerCredentials, nil)
 
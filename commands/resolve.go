@@ -0,0 +1,361 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/doctl/do"
+)
+
+func errNoResourceByName(kind, name string) error {
+	return fmt.Errorf("no %s goes by the name %q", kind, name)
+}
+
+func errAmbiguousResourceName(kinds, name string, ids []string) error {
+	return fmt.Errorf("many %s go by the name %q, they have the following IDs: %v", kinds, name, ids)
+}
+
+func errAmbiguousResourcePrefix(kinds, prefix string, ids []string) error {
+	return fmt.Errorf("many %s have a name or ID starting with %q, they have the following IDs: %v", kinds, prefix, ids)
+}
+
+// namedResource is a resource as seen by a resolver: its ID, the names it
+// goes by and the resource itself, if listed.
+type namedResource struct {
+	ID    string
+	Names []string
+	Value interface{}
+}
+
+// resolver resolves the arguments naming a type of resource to IDs. An
+// argument is an ID, the exact name of a resource, or a prefix of the ID or
+// name of a single resource.
+type resolver struct {
+	// kind and kinds name the type of resource in errors.
+	kind, kinds string
+	// isID reports whether an argument is an ID, used without listing the
+	// resources.
+	isID func(string) bool
+	// list lists the resources names and prefixes are looked up in.
+	list func() ([]namedResource, error)
+
+	resources []namedResource
+	listed    bool
+}
+
+// resolve returns the ID of the resource arg names.
+func (r *resolver) resolve(arg string) (string, error) {
+	res, err := r.lookup(arg)
+	if err != nil {
+		return "", err
+	}
+	return res.ID, nil
+}
+
+// lookup returns the resource arg names. Only its ID is known when arg is
+// an ID.
+func (r *resolver) lookup(arg string) (namedResource, error) {
+	if r.isID != nil && r.isID(arg) {
+		return namedResource{ID: arg}, nil
+	}
+
+	if !r.listed {
+		resources, err := r.list()
+		if err != nil {
+			return namedResource{}, err
+		}
+		r.resources, r.listed = resources, true
+	}
+
+	var exact, prefixed []namedResource
+	for _, res := range r.resources {
+		matches, starts := res.ID == arg, strings.HasPrefix(res.ID, arg)
+		for _, name := range res.Names {
+			matches = matches || name == arg
+			starts = starts || strings.HasPrefix(name, arg)
+		}
+
+		switch {
+		case matches:
+			exact = append(exact, res)
+		case starts && arg != "":
+			prefixed = append(prefixed, res)
+		}
+	}
+
+	switch {
+	case len(exact) == 1:
+		return exact[0], nil
+	case len(exact) > 1:
+		return namedResource{}, errAmbiguousResourceName(r.kinds, arg, resourceIDs(exact))
+	case len(prefixed) == 1:
+		return prefixed[0], nil
+	case len(prefixed) > 1:
+		return namedResource{}, errAmbiguousResourcePrefix(r.kinds, arg, resourceIDs(prefixed))
+	default:
+		return namedResource{}, errNoResourceByName(r.kind, arg)
+	}
+}
+
+func resourceIDs(resources []namedResource) []string {
+	ids := make([]string, 0, len(resources))
+	for _, res := range resources {
+		ids = append(ids, res.ID)
+	}
+	return ids
+}
+
+// resolveAll returns the IDs of the resources args name, listing the
+// resources at most once.
+func (r *resolver) resolveAll(args []string) ([]string, error) {
+	ids := make([]string, 0, len(args))
+	for _, arg := range args {
+		id, err := r.resolve(arg)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// resolveInt returns the numeric ID of the resource arg names.
+func (r *resolver) resolveInt(arg string) (int, error) {
+	id, err := r.resolve(arg)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(id)
+}
+
+func isNumericID(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+func isNumericOrUUID(s string) bool {
+	return isNumericID(s) || looksLikeUUID(s)
+}
+
+func dropletResolver(c *CmdConfig) *resolver {
+	return &resolver{
+		kind: "droplet", kinds: "droplets",
+		isID: isNumericID,
+		list: func() ([]namedResource, error) {
+			list, err := c.Droplets().List(c.Ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, d := range list {
+				resources = append(resources, namedResource{ID: strconv.Itoa(d.ID), Names: []string{d.Name}, Value: d})
+			}
+			return resources, nil
+		},
+	}
+}
+
+func volumeResolver(c *CmdConfig) *resolver {
+	return &resolver{
+		kind: "volume", kinds: "volumes",
+		isID: looksLikeUUID,
+		list: func() ([]namedResource, error) {
+			list, err := c.Volumes().List(c.Ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, v := range list {
+				resources = append(resources, namedResource{ID: v.ID, Names: []string{v.Name}})
+			}
+			return resources, nil
+		},
+	}
+}
+
+func loadBalancerResolver(c *CmdConfig) *resolver {
+	return &resolver{
+		kind: "load balancer", kinds: "load balancers",
+		isID: looksLikeUUID,
+		list: func() ([]namedResource, error) {
+			list, err := c.LoadBalancers().List(c.Ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, lb := range list {
+				resources = append(resources, namedResource{ID: lb.ID, Names: []string{lb.Name}})
+			}
+			return resources, nil
+		},
+	}
+}
+
+func firewallResolver(c *CmdConfig) *resolver {
+	return &resolver{
+		kind: "firewall", kinds: "firewalls",
+		isID: looksLikeUUID,
+		list: func() ([]namedResource, error) {
+			list, err := c.Firewalls().List(c.Ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, f := range list {
+				resources = append(resources, namedResource{ID: f.ID, Names: []string{f.Name}})
+			}
+			return resources, nil
+		},
+	}
+}
+
+func certificateResolver(c *CmdConfig) *resolver {
+	return &resolver{
+		kind: "certificate", kinds: "certificates",
+		isID: looksLikeUUID,
+		list: func() ([]namedResource, error) {
+			list, err := c.Certificates().List(c.Ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, cert := range list {
+				resources = append(resources, namedResource{ID: cert.ID, Names: []string{cert.Name}})
+			}
+			return resources, nil
+		},
+	}
+}
+
+// cdnResolver resolves CDNs, which have no name, by their origin, endpoint
+// or custom domain.
+func cdnResolver(c *CmdConfig) *resolver {
+	return &resolver{
+		kind: "cdn", kinds: "cdns",
+		isID: looksLikeUUID,
+		list: func() ([]namedResource, error) {
+			list, err := c.CDNs().List(c.Ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, cdn := range list {
+				names := []string{cdn.Origin, cdn.Endpoint}
+				if cdn.CustomDomain != "" {
+					names = append(names, cdn.CustomDomain)
+				}
+				resources = append(resources, namedResource{ID: cdn.ID, Names: names})
+			}
+			return resources, nil
+		},
+	}
+}
+
+// snapshotResolver resolves snapshots, whose IDs are numbers for droplet
+// snapshots and UUIDs for volume snapshots.
+func snapshotResolver(c *CmdConfig) *resolver {
+	return &resolver{
+		kind: "snapshot", kinds: "snapshots",
+		isID: isNumericOrUUID,
+		list: func() ([]namedResource, error) {
+			list, err := c.Snapshots().List(c.Ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, s := range list {
+				resources = append(resources, namedResource{ID: s.ID, Names: []string{s.Name}})
+			}
+			return resources, nil
+		},
+	}
+}
+
+func databaseResolver(c *CmdConfig) *resolver {
+	return &resolver{
+		kind: "database cluster", kinds: "database clusters",
+		isID: looksLikeUUID,
+		list: func() ([]namedResource, error) {
+			list, err := c.Databases().List(c.Ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, db := range list {
+				resources = append(resources, namedResource{ID: db.ID, Names: []string{db.Name}})
+			}
+			return resources, nil
+		},
+	}
+}
+
+// projectResolver resolves projects, taking default for the default
+// project as the API does.
+func projectResolver(c *CmdConfig) *resolver {
+	return &resolver{
+		kind: "project", kinds: "projects",
+		isID: func(s string) bool { return s == "default" || looksLikeUUID(s) },
+		list: func() ([]namedResource, error) {
+			list, err := c.Projects().List(c.Ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, p := range list {
+				resources = append(resources, namedResource{ID: p.ID, Names: []string{p.Name}, Value: p})
+			}
+			return resources, nil
+		},
+	}
+}
+
+func clusterResolver(ctx context.Context, kube do.KubernetesService) *resolver {
+	return &resolver{
+		kind: "cluster", kinds: "clusters",
+		isID: looksLikeUUID,
+		list: func() ([]namedResource, error) {
+			list, err := kube.List(ctx)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, k := range list {
+				resources = append(resources, namedResource{ID: k.ID, Names: []string{k.Name}, Value: k})
+			}
+			return resources, nil
+		},
+	}
+}
+
+func poolResolver(ctx context.Context, kube do.KubernetesService, clusterID string) *resolver {
+	return &resolver{
+		kind: "node pool", kinds: "node pools",
+		isID: looksLikeUUID,
+		list: func() ([]namedResource, error) {
+			list, err := kube.ListNodePools(ctx, clusterID)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]namedResource, 0, len(list))
+			for _, p := range list {
+				resources = append(resources, namedResource{ID: p.ID, Names: []string{p.Name}, Value: p})
+			}
+			return resources, nil
+		},
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver(t *testing.T) {
+	lists := 0
+	r := &resolver{
+		kind: "widget", kinds: "widgets",
+		isID: looksLikeUUID,
+		list: func() ([]namedResource, error) {
+			lists++
+			return []namedResource{
+				{ID: "aaaa-1", Names: []string{"web"}},
+				{ID: "aaaa-2", Names: []string{"web-2"}},
+				{ID: "bbbb-1", Names: []string{"db"}},
+				{ID: "bbbb-2", Names: []string{"db"}},
+				{ID: "cccc-1", Names: []string{"cache"}},
+			}, nil
+		},
+	}
+
+	tests := []struct {
+		arg  string
+		id   string
+		err  string
+		desc string
+	}{
+		{desc: "id", arg: "00000000-0000-4000-8000-000000000000", id: "00000000-0000-4000-8000-000000000000"},
+		{desc: "listed id", arg: "cccc-1", id: "cccc-1"},
+		{desc: "name", arg: "web", id: "aaaa-1"},
+		{desc: "name prefix", arg: "ca", id: "cccc-1"},
+		{desc: "id prefix", arg: "cc", id: "cccc-1"},
+		{desc: "ambiguous name", arg: "db", err: `many widgets go by the name "db", they have the following IDs: [bbbb-1 bbbb-2]`},
+		{desc: "ambiguous prefix", arg: "aaaa", err: `many widgets have a name or ID starting with "aaaa", they have the following IDs: [aaaa-1 aaaa-2]`},
+		{desc: "unknown", arg: "queue", err: `no widget goes by the name "queue"`},
+	}
+
+	for _, tt := range tests {
+		id, err := r.resolve(tt.arg)
+		if tt.err != "" {
+			assert.EqualError(t, err, tt.err, tt.desc)
+			continue
+		}
+		require.NoError(t, err, tt.desc)
+		assert.Equal(t, tt.id, id, tt.desc)
+	}
+
+	assert.Equal(t, 1, lists)
+
+	ids, err := r.resolveAll([]string{"web", "cache"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"aaaa-1", "cccc-1"}, ids)
+
+	r = &resolver{kind: "widget", kinds: "widgets", list: func() ([]namedResource, error) {
+		return nil, errors.New("unreachable")
+	}}
+	_, err = r.resolve("web")
+	assert.EqualError(t, err, "unreachable")
+}
+
+func TestDropletResolver(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.EXPECT().List(gomock.Any()).Return(do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Name: "web-01"}},
+			{Droplet: &godo.Droplet{ID: 2, Name: "db-01"}},
+		}, nil)
+
+		r := dropletResolver(config)
+
+		id, err := r.resolveInt("3")
+		require.NoError(t, err)
+		assert.Equal(t, 3, id)
+
+		id, err = r.resolveInt("db")
+		require.NoError(t, err)
+		assert.Equal(t, 2, id)
+
+		_, err = r.resolveInt("app")
+		assert.EqualError(t, err, `no droplet goes by the name "app"`)
+	})
+}
@@ -0,0 +1,600 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/doctl"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	shellHistoryFile = "shell_history"
+	// shellHistorySize is the number of lines of history kept, as many as the
+	// terminal remembers.
+	shellHistorySize = 100
+)
+
+const shellHelp = `Run doctl commands without the leading doctl, e.g. compute droplet list.
+Add --help to a command to see its usage.
+
+  use context NAME    switch to another authentication context
+  set                 show the settings
+  set output FORMAT   set the output format: text, json, yaml, csv or tsv
+  set template TMPL   set the Go template applied to each item, empty to unset
+  set query EXPR      set the JMESPath expression evaluated against the JSON
+                      output, empty to unset
+  help                show this help
+  exit                leave the shell
+`
+
+// shellOutputs are the output formats set output accepts.
+var shellOutputs = []string{"text", "json", "yaml", "csv", "tsv"}
+
+// Shell creates the shell command.
+func Shell() *Command {
+	cmd := cmdBuilderWithInit(nil, RunShell, "shell", "run doctl commands interactively", Writer, true)
+	cmd.Long = `shell reads doctl commands, without the leading doctl, and runs them in the
+same process, keeping the authentication context, output format and API client
+between commands.
+
+Tab completes commands, flags, the names and IDs of resources, and regions,
+sizes and images; pressing it again cycles through the candidates. The last
+100 commands are kept in the configuration directory, except those setting a
+secret flag such as --access-token.
+
+Global flags can't be set on commands. Use these instead:
+
+  use context NAME    switch to another authentication context
+  set output FORMAT   set the output format: text, json, yaml, csv or tsv
+  set template TMPL   set the Go template applied to each item
+  set query EXPR      set the JMESPath expression evaluated against the JSON output
+
+When stdin is not a terminal, commands are read from it one per line.`
+
+	return cmd
+}
+
+// shell runs the commands of doctl shell.
+type shell struct {
+	config *CmdConfig
+
+	// names caches the values completed, by kind, until a command runs.
+	names map[string][]completion
+	cycle *shellCycle
+}
+
+// shellCycle is the state of a completion cycling through candidates.
+type shellCycle struct {
+	line       string
+	pos        int
+	start      int
+	candidates []string
+	index      int
+}
+
+// RunShell runs doctl commands interactively.
+func RunShell(c *CmdConfig) error {
+	s := &shell{config: c}
+
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if s.exec(scanner.Text()) {
+				return nil
+			}
+		}
+		return scanner.Err()
+	}
+
+	return s.interactive()
+}
+
+// interactive reads commands from the terminal until exit or end of file.
+func (s *shell) interactive() error {
+	fd := int(os.Stdin.Fd())
+	conn := &shellConn{Reader: os.Stdin, Writer: os.Stdout}
+	term := terminal.NewTerminal(conn, s.prompt())
+	term.AutoCompleteCallback = s.complete
+
+	historyPath := filepath.Join(configHome(), shellHistoryFile)
+	history, err := readShellHistory(historyPath)
+	if err == nil {
+		err = loadShellHistory(term, conn, history)
+	}
+	if err != nil {
+		warn("Unable to read the shell history: %v", err)
+	}
+
+	for {
+		state, err := terminal.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		if width, height, err := terminal.GetSize(fd); err == nil && width > 0 {
+			term.SetSize(width, height)
+		}
+
+		line, err := term.ReadLine()
+		terminal.Restore(fd, state)
+		switch {
+		case err == io.EOF:
+			fmt.Fprintln(os.Stdout)
+			return nil
+		case err != nil && err != terminal.ErrPasteIndicator:
+			return err
+		}
+
+		if entry := strings.TrimSpace(strings.Replace(line, "\t", " ", -1)); entry != "" && !secretCommandLine(entry) {
+			history = appendShellHistory(history, entry)
+			if err := writeShellHistory(historyPath, history); err != nil {
+				warn("Unable to save the shell history: %v", err)
+			}
+		}
+
+		if s.exec(line) {
+			return nil
+		}
+		term.SetPrompt(s.prompt())
+	}
+}
+
+// shellConn connects the terminal to stdin and stdout. While replay holds
+// the saved history, the terminal reads it instead and what it writes is
+// discarded.
+type shellConn struct {
+	io.Reader
+	io.Writer
+
+	replay *bytes.Reader
+}
+
+func (c *shellConn) Read(b []byte) (int, error) {
+	if c.replay != nil {
+		return c.replay.Read(b)
+	}
+	return c.Reader.Read(b)
+}
+
+func (c *shellConn) Write(b []byte) (int, error) {
+	if c.replay != nil {
+		return len(b), nil
+	}
+	return c.Writer.Write(b)
+}
+
+// readShellHistory returns the last lines of the history saved at path,
+// leaving out lines setting secrets saved by earlier versions.
+func readShellHistory(path string) ([]string, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var history []string
+	for _, line := range strings.Split(string(b), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !secretCommandLine(line) {
+			history = appendShellHistory(history, line)
+		}
+	}
+
+	return history, nil
+}
+
+// appendShellHistory appends line to history, keeping its last
+// shellHistorySize lines.
+func appendShellHistory(history []string, line string) []string {
+	history = append(history, line)
+	if len(history) > shellHistorySize {
+		history = history[len(history)-shellHistorySize:]
+	}
+	return history
+}
+
+// writeShellHistory replaces the history saved at path.
+func writeShellHistory(path string, history []string) error {
+	return ioutil.WriteFile(path, []byte(strings.Join(history, "\n")+"\n"), 0600)
+}
+
+// secretCommandLine reports whether a command line sets a secret flag, such
+// as --access-token, so it must not be saved in the history. Lines which
+// can't be parsed aren't saved either.
+func secretCommandLine(line string) bool {
+	words, err := splitCommandLine(line)
+	if err != nil {
+		return true
+	}
+	if len(words) > 0 && words[0] == DoitCmd.Name() {
+		words = words[1:]
+	}
+
+	cmd, _, err := DoitCmd.Find(words)
+	if err != nil {
+		cmd = DoitCmd.Command
+	}
+
+	for _, w := range words {
+		if w == "--" {
+			break
+		}
+		if len(w) < 2 || w[0] != '-' {
+			continue
+		}
+
+		name := strings.SplitN(strings.TrimLeft(w, "-"), "=", 2)[0]
+		if !strings.HasPrefix(w, "--") && name != "" {
+			f := cmd.Flags().ShorthandLookup(name[:1])
+			if f == nil {
+				f = cmd.InheritedFlags().ShorthandLookup(name[:1])
+			}
+			if f == nil {
+				continue
+			}
+			name = f.Name
+		}
+
+		if doctl.IsSecretKey(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadShellHistory loads history in term. The terminal has no way to set its
+// history, so the lines are replayed to it as if they were typed.
+func loadShellHistory(term *terminal.Terminal, conn *shellConn, history []string) error {
+	var replay bytes.Buffer
+	for _, line := range history {
+		replay.WriteString(line + "\r")
+	}
+
+	conn.replay = bytes.NewReader(replay.Bytes())
+	defer func() { conn.replay = nil }()
+
+	for range history {
+		if _, err := term.ReadLine(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prompt returns the prompt, which shows the authentication context unless
+// it is the default one.
+func (s *shell) prompt() string {
+	if context := currentContext(); context != doctl.ArgDefaultContext {
+		return fmt.Sprintf("doctl (%s)> ", context)
+	}
+	return "doctl> "
+}
+
+// currentContext returns the authentication context in use.
+func currentContext() string {
+	if Context != "" {
+		return Context
+	}
+	return viper.GetString(doctl.ArgContext)
+}
+
+// exec runs a line of the shell and reports whether the shell should exit.
+// Errors are reported, not returned, so the shell goes on.
+func (s *shell) exec(line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return false
+	}
+
+	words, err := splitCommandLine(line)
+	if err != nil {
+		printErr(err)
+		return false
+	}
+
+	switch words[0] {
+	case "exit", "quit":
+		return true
+	case "help":
+		fmt.Fprint(s.config.Out, shellHelp)
+	case "use":
+		if len(words) != 3 || words[1] != doctl.ArgContext {
+			err = fmt.Errorf("usage: use context NAME")
+		} else {
+			err = s.useContext(words[2])
+		}
+	case "set":
+		err = s.set(words[1:])
+	default:
+		err = s.run(words)
+	}
+
+	if err != nil {
+		printErr(err)
+	}
+
+	return false
+}
+
+// run runs a doctl command.
+func (s *shell) run(words []string) error {
+	if len(words) > 0 && words[0] == DoitCmd.Name() {
+		words = words[1:]
+	}
+
+	// Commands grouping others, and those asked for, show their usage.
+	if cc, _, err := DoitCmd.Find(words); err == nil && (!cc.Runnable() || containsString(words, "--help") || containsString(words, "-h")) {
+		cc.SetOutput(s.config.Out)
+		return cc.Help()
+	}
+
+	ctx, cancel := newCmdContext()
+	defer cancel()
+
+	c := *s.config
+	c.Ctx = ctx
+
+	runner, config, err := parseCommandLine(&c, words)
+	if flags, ok := err.(*errGlobalFlags); ok {
+		err = &errUsage{err: fmt.Errorf("global flags can't be set in the shell, use set or use context instead of %s", strings.Join(flags.flags, ", "))}
+	}
+	if err != nil {
+		return err
+	}
+
+	// The command may have created or deleted resources.
+	s.names = nil
+
+	out := &lineWriter{Writer: config.Out}
+	config.Out = out
+	err = runner(config)
+	if out.partial {
+		fmt.Fprintln(out)
+	}
+
+	return addHint(config, cmdContextErr(ctx, err))
+}
+
+// lineWriter remembers whether what was written to it ends with a partial
+// line, so the prompt can start on a line of its own.
+type lineWriter struct {
+	io.Writer
+	partial bool
+}
+
+func (w *lineWriter) Write(b []byte) (int, error) {
+	if len(b) > 0 {
+		w.partial = b[len(b)-1] != '\n'
+	}
+	return w.Writer.Write(b)
+}
+
+// useContext switches to another authentication context.
+func (s *shell) useContext(name string) error {
+	if name != doctl.ArgDefaultContext {
+		if _, ok := viper.GetStringMap("auth-contexts")[name]; !ok {
+			return fmt.Errorf("context %q doesn't exist, see doctl auth list", name)
+		}
+	}
+
+	previous := Context
+	Context = name
+	if err := s.config.initServices(s.config); err != nil {
+		Context = previous
+		return err
+	}
+	viper.Set(doctl.ArgContext, name)
+	s.names = nil
+
+	return nil
+}
+
+// set changes or, without arguments, shows the settings of the shell.
+func (s *shell) set(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintf(s.config.Out, "context:  %s\noutput:   %s\ntemplate: %s\nquery:    %s\n", currentContext(), Output, Template, Query)
+		return nil
+	}
+
+	value := strings.Join(args[1:], " ")
+	switch args[0] {
+	case doctl.ArgOutput:
+		if !containsString(shellOutputs, value) {
+			return fmt.Errorf("unknown output format %q, use one of %s", value, strings.Join(shellOutputs, ", "))
+		}
+		Output = value
+		viper.Set(doctl.ArgOutput, value)
+	case doctl.ArgTemplate:
+		Template = value
+		viper.Set(doctl.ArgTemplate, value)
+	case doctl.ArgQuery:
+		Query = value
+		viper.Set(doctl.ArgQuery, value)
+	default:
+		return fmt.Errorf("unknown setting %q, use output, template or query", args[0])
+	}
+
+	return nil
+}
+
+// complete is the terminal's completion callback. Tab completes the word
+// before the cursor up to the prefix its candidates share, and then cycles
+// through them.
+func (s *shell) complete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		s.cycle = nil
+		return "", 0, false
+	}
+
+	if cy := s.cycle; cy != nil && cy.line == line && cy.pos == pos {
+		cy.index = (cy.index + 1) % len(cy.candidates)
+		return s.replaceWord(line, cy.start, pos, cy.candidates[cy.index])
+	}
+	s.cycle = nil
+
+	prefix := line[:pos]
+	words, err := splitCommandLine(prefix)
+	if err != nil {
+		return "", 0, false
+	}
+
+	current := ""
+	if len(words) > 0 && !strings.HasSuffix(prefix, " ") {
+		current = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+	if !strings.HasSuffix(prefix, current) {
+		// The word is quoted or escaped.
+		return "", 0, false
+	}
+	start := pos - len(current)
+
+	candidates := s.candidates(words, current)
+	switch {
+	case len(candidates) == 0:
+		return "", 0, false
+	case len(candidates) == 1:
+		return s.replaceWord(line, start, pos, candidates[0]+" ")
+	}
+
+	if common := commonPrefix(candidates); len(common) > len(current) {
+		return s.replaceWord(line, start, pos, common)
+	}
+
+	newLine, newPos, ok := s.replaceWord(line, start, pos, candidates[0])
+	s.cycle = &shellCycle{line: newLine, pos: newPos, start: start, candidates: candidates}
+
+	return newLine, newPos, ok
+}
+
+// replaceWord replaces line[start:end] with word and returns the new line
+// and cursor position. A cycle in progress tracks the new line.
+func (s *shell) replaceWord(line string, start, end int, word string) (string, int, bool) {
+	newLine := line[:start] + word + line[end:]
+	newPos := start + len(word)
+
+	if s.cycle != nil {
+		s.cycle.line, s.cycle.pos = newLine, newPos
+	}
+
+	return newLine, newPos, true
+}
+
+// candidates returns the completions of current following words, sorted.
+func (s *shell) candidates(words []string, current string) []string {
+	if len(words) > 0 && words[0] == DoitCmd.Name() {
+		words = words[1:]
+	}
+
+	var all []string
+	switch {
+	case len(words) == 0:
+		all = append(all, "exit", "help", "set", "use")
+		all = append(all, subcommandNames(DoitCmd.Command)...)
+	case words[0] == "use":
+		if len(words) == 1 {
+			all = []string{doctl.ArgContext}
+		} else if len(words) == 2 {
+			all = []string{doctl.ArgDefaultContext}
+			for name := range viper.GetStringMap("auth-contexts") {
+				all = append(all, name)
+			}
+		}
+	case words[0] == "set":
+		if len(words) == 1 {
+			all = []string{doctl.ArgOutput, doctl.ArgQuery, doctl.ArgTemplate}
+		} else if len(words) == 2 && words[1] == doctl.ArgOutput {
+			all = shellOutputs
+		}
+	default:
+		comps, _ := completeCommandLine(words, current, s.resourceNames)
+		for _, comp := range comps {
+			// Global flags can't be set in the shell.
+			if strings.HasPrefix(comp.Value, "--") && DoitCmd.PersistentFlags().Lookup(comp.Value[2:]) != nil {
+				continue
+			}
+			all = append(all, comp.Value)
+		}
+	}
+
+	var candidates []string
+	for _, c := range all {
+		if strings.HasPrefix(c, current) && c != "batch" && c != "shell" {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.Strings(candidates)
+
+	return candidates
+}
+
+// resourceNames lists the values of a kind, caching them until a command
+// runs. Errors leave the values to the user.
+func (s *shell) resourceNames(kind string) []completion {
+	if names, ok := s.names[kind]; ok {
+		return names
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	names, err := listCompletions(ctx, s.config, kind)
+	if err != nil {
+		return nil
+	}
+
+	if s.names == nil {
+		s.names = map[string][]completion{}
+	}
+	s.names[kind] = names
+
+	return names
+}
+
+// subcommandNames returns the names of the available subcommands of cmd.
+func subcommandNames(cmd *cobra.Command) []string {
+	var names []string
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() {
+			names = append(names, c.Name())
+		}
+	}
+	return names
+}
+
+// commonPrefix returns the longest prefix of all the words.
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, w := range words[1:] {
+		for !strings.HasPrefix(w, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
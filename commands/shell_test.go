@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellExec(t *testing.T) {
+	defer func(output string) {
+		Output = output
+		viper.Set(doctl.ArgOutput, output)
+	}(Output)
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var out bytes.Buffer
+		config.Out = &out
+		s := &shell{config: config}
+
+		tm.tags.EXPECT().Get(gomock.Any(), "web").Return(&do.Tag{Tag: &godo.Tag{
+			Name:      "web",
+			Resources: &godo.TaggedResources{Droplets: &godo.TaggedDropletsResources{}},
+		}}, nil).Times(2)
+
+		assert.False(t, s.exec("compute tag get web --format Name"))
+		assert.Equal(t, "Name\nweb\n", out.String())
+
+		out.Reset()
+		assert.False(t, s.exec("set output json"))
+		assert.Equal(t, "json", Output)
+		assert.False(t, s.exec("doctl compute tag get web"))
+		assert.Contains(t, out.String(), `"name": "web"`)
+		assert.True(t, bytes.HasSuffix(out.Bytes(), []byte("]\n")))
+
+		assert.EqualError(t, s.set([]string{"output", "xml"}), `unknown output format "xml", use one of text, json, yaml, csv, tsv`)
+		assert.EqualError(t, s.useContext("nope"), `context "nope" doesn't exist, see doctl auth list`)
+		assert.EqualError(t, s.run([]string{"compute", "tag", "list", "--trace"}),
+			"global flags can't be set in the shell, use set or use context instead of --trace")
+
+		assert.True(t, s.exec("exit"))
+	})
+}
+
+func TestShellComplete(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		s := &shell{config: config}
+
+		assert.Equal(t, []string{"compute"}, s.candidates(nil, "compu"))
+		assert.Equal(t, []string{"droplet", "droplet-action"}, s.candidates([]string{"compute"}, "dro"))
+		assert.Equal(t, []string{"--tag-name"}, s.candidates([]string{"compute", "droplet", "list"}, "--tag"))
+		assert.Equal(t, []string{"json"}, s.candidates([]string{"set", "output"}, "j"))
+		assert.Empty(t, s.candidates([]string{"compute", "droplet", "list", "--tag-name"}, ""))
+
+		tm.regions.EXPECT().List(gomock.Any()).Return(do.Regions{
+			{Region: &godo.Region{Slug: "nyc1", Available: true}},
+			{Region: &godo.Region{Slug: "nyc2"}},
+			{Region: &godo.Region{Slug: "nyc3", Available: true}},
+		}, nil)
+		assert.Equal(t, []string{"nyc1", "nyc3"}, s.candidates([]string{"compute", "droplet", "create", "--region"}, "ny"))
+
+		tm.droplets.EXPECT().List(gomock.Any()).Return(do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Name: "web-01"}},
+			{Droplet: &godo.Droplet{ID: 2, Name: "web-02"}},
+			{Droplet: &godo.Droplet{ID: 3, Name: "db-01"}},
+		}, nil)
+
+		line := "compute droplet delete w"
+		line, pos, ok := s.complete(line, len(line), '\t')
+		assert.True(t, ok)
+		assert.Equal(t, "compute droplet delete web-0", line)
+
+		for _, want := range []string{"web-01", "web-02", "web-01"} {
+			line, pos, ok = s.complete(line, pos, '\t')
+			assert.True(t, ok)
+			assert.Equal(t, "compute droplet delete "+want, line)
+		}
+
+		_, _, ok = s.complete(line, pos, 'x')
+		assert.False(t, ok)
+
+		line = "compute droplet delete d"
+		line, _, ok = s.complete(line, len(line), '\t')
+		assert.True(t, ok)
+		assert.Equal(t, "compute droplet delete db-01 ", line)
+	})
+}
+
+func TestSecretCommandLine(t *testing.T) {
+	for line, secret := range map[string]bool{
+		"compute droplet list":                    false,
+		"compute droplet list -t xyz":             true,
+		"doctl compute droplet list -txyz":        true,
+		"compute droplet list --access-token xyz": true,
+		"compute droplet list --access-token=xyz": true,
+		"auth init -t xyz":                        true,
+		"compute tag create web -- -t":            false,
+		"compute droplet list --format ID":        false,
+		`compute droplet list "unterminated`:      true,
+	} {
+		assert.Equal(t, secret, secretCommandLine(line), line)
+	}
+}
+
+func TestShellHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "doctl-shell")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, shellHistoryFile)
+
+	var lines []string
+	for i := 0; i < shellHistorySize+10; i++ {
+		lines = append(lines, fmt.Sprintf("compute droplet get %d", i))
+	}
+	lines = append(lines, "auth init -t xyz")
+	require.NoError(t, ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")), 0600))
+
+	history, err := readShellHistory(path)
+	require.NoError(t, err)
+	assert.Len(t, history, shellHistorySize)
+	assert.Equal(t, "compute droplet get 10", history[0])
+	assert.Equal(t, fmt.Sprintf("compute droplet get %d", shellHistorySize+9), history[len(history)-1])
+
+	history = appendShellHistory(history, "compute tag list")
+	require.NoError(t, writeShellHistory(path, history))
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	saved := strings.Split(strings.TrimSpace(string(b)), "\n")
+	assert.Len(t, saved, shellHistorySize)
+	assert.Equal(t, "compute droplet get 11", saved[0])
+	assert.Equal(t, "compute tag list", saved[len(saved)-1])
+	assert.NotContains(t, string(b), "xyz")
+}
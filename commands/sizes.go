@@ -38,7 +38,7 @@ func Size() *Command {
 func RunSizeList(c *CmdConfig) error {
 	sizes := c.Sizes()
 
-	list, err := sizes.List()
+	list, err := sizes.List(c.Ctx)
 	if err != nil {
 		return err
 	}
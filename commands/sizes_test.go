@@ -18,6 +18,7 @@ import (
 
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,7 +35,7 @@ func TestSizeCommand(t *testing.T) {
 
 func TestSizesList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.sizes.EXPECT().List().Return(testSizeList, nil)
+		tm.sizes.EXPECT().List(gomock.Any()).Return(testSizeList, nil)
 
 		err := RunSizeList(config)
 		assert.NoError(t, err)
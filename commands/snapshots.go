@@ -78,17 +78,17 @@ func RunSnapshotList(c *CmdConfig) error {
 	var list []do.Snapshot
 
 	if restype == "droplet" {
-		list, err = ss.ListDroplet()
+		list, err = ss.ListDroplet(c.Ctx)
 		if err != nil {
 			return err
 		}
 	} else if restype == "volume" {
-		list, err = ss.ListVolume()
+		list, err = ss.ListVolume(c.Ctx)
 		if err != nil {
 			return err
 		}
 	} else {
-		list, err = ss.List()
+		list, err = ss.List(c.Ctx)
 		if err != nil {
 			return err
 		}
@@ -137,12 +137,15 @@ func RunSnapshotGet(c *CmdConfig) error {
 	}
 
 	ss := c.Snapshots()
-	ids := c.Args
+	ids, err := snapshotResolver(c).resolveAll(c.Args)
+	if err != nil {
+		return err
+	}
 
 	var matchedList []do.Snapshot
 
 	for _, id := range ids {
-		s, err := ss.Get(id)
+		s, err := ss.Get(c.Ctx, id)
 		if err != nil {
 			return err
 		}
@@ -164,11 +167,14 @@ func RunSnapshotDelete(c *CmdConfig) error {
 	}
 
 	ss := c.Snapshots()
-	ids := c.Args
+	ids, err := snapshotResolver(c).resolveAll(c.Args)
+	if err != nil {
+		return err
+	}
 
 	if force || AskForConfirm("delete snapshot(s)") == nil {
 		for _, id := range ids {
-			err := ss.Delete(id)
+			err := ss.Delete(c.Ctx, id)
 			if err != nil {
 				return err
 			}
@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,7 +29,7 @@ func TestSnapshotCommand(t *testing.T) {
 
 func TestSnapshotList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.snapshots.EXPECT().List().Return(testSnapshotList, nil)
+		tm.snapshots.EXPECT().List(gomock.Any()).Return(testSnapshotList, nil)
 
 		err := RunSnapshotList(config)
 		assert.NoError(t, err)
@@ -37,7 +38,7 @@ func TestSnapshotList(t *testing.T) {
 
 func TestSnapshotListID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.snapshots.EXPECT().List().Return(testSnapshotList, nil)
+		tm.snapshots.EXPECT().List(gomock.Any()).Return(testSnapshotList, nil)
 
 		config.Args = append(config.Args, testSnapshot.ID)
 
@@ -48,7 +49,7 @@ func TestSnapshotListID(t *testing.T) {
 
 func TestSnapshotListName(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.snapshots.EXPECT().List().Return(testSnapshotList, nil)
+		tm.snapshots.EXPECT().List(gomock.Any()).Return(testSnapshotList, nil)
 
 		config.Args = append(config.Args, testSnapshot.Name)
 
@@ -59,7 +60,7 @@ func TestSnapshotListName(t *testing.T) {
 
 func TestSnapshotListMultiple(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.snapshots.EXPECT().List().Return(testSnapshotList, nil)
+		tm.snapshots.EXPECT().List(gomock.Any()).Return(testSnapshotList, nil)
 
 		config.Args = append(config.Args, testSnapshot.ID, testSnapshotSecondary.ID)
 
@@ -70,7 +71,7 @@ func TestSnapshotListMultiple(t *testing.T) {
 
 func TestSnapshotListRegion(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.snapshots.EXPECT().List().Return(testSnapshotList, nil)
+		tm.snapshots.EXPECT().List(gomock.Any()).Return(testSnapshotList, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
 
@@ -81,7 +82,7 @@ func TestSnapshotListRegion(t *testing.T) {
 
 func TestSnapshotGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.snapshots.EXPECT().Get(testSnapshot.ID).Return(&testSnapshot, nil)
+		tm.snapshots.EXPECT().Get(gomock.Any(), testSnapshot.ID).Return(&testSnapshot, nil)
 
 		config.Args = append(config.Args, testSnapshot.ID)
 
@@ -92,8 +93,8 @@ func TestSnapshotGet(t *testing.T) {
 
 func TestSnapshotGetMultiple(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.snapshots.EXPECT().Get(testSnapshot.ID).Return(&testSnapshot, nil)
-		tm.snapshots.EXPECT().Get(testSnapshotSecondary.ID).Return(&testSnapshotSecondary, nil)
+		tm.snapshots.EXPECT().Get(gomock.Any(), testSnapshot.ID).Return(&testSnapshot, nil)
+		tm.snapshots.EXPECT().Get(gomock.Any(), testSnapshotSecondary.ID).Return(&testSnapshotSecondary, nil)
 
 		config.Args = append(config.Args, testSnapshot.ID, testSnapshotSecondary.ID)
 
@@ -104,7 +105,7 @@ func TestSnapshotGetMultiple(t *testing.T) {
 
 func TestSnapshotDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.snapshots.EXPECT().Delete(testSnapshot.ID).Return(nil)
+		tm.snapshots.EXPECT().Delete(gomock.Any(), testSnapshot.ID).Return(nil)
 
 		config.Args = append(config.Args, testSnapshot.ID)
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -97,19 +97,14 @@ func RunSSH(c *CmdConfig) error {
 	if id, err := strconv.Atoi(dropletID); err == nil {
 		// dropletID is an integer
 
-		doDroplet, err := ds.Get(id)
+		doDroplet, err := ds.Get(c.Ctx, id)
 		if err != nil {
 			return err
 		}
 
 		droplet = doDroplet
 	} else {
-		// dropletID is a string
-		droplets, err := ds.List()
-		if err != nil {
-			return err
-		}
-
+		// dropletID is a name, a prefix or user@host:port
 		shi := extractHostInfo(dropletID)
 
 		if shi.user != "" {
@@ -120,19 +115,23 @@ func RunSSH(c *CmdConfig) error {
 			port = i
 		}
 
-		for _, d := range droplets {
-			if d.Name == shi.host {
-				droplet = &d
-				break
-			}
-			if strconv.Itoa(d.ID) == shi.host {
-				droplet = &d
-				break
-			}
+		res, err := dropletResolver(c).lookup(shi.host)
+		if err != nil {
+			return err
 		}
 
-		if droplet == nil {
-			return errors.New("could not find droplet")
+		if d, ok := res.Value.(do.Droplet); ok {
+			droplet = &d
+		} else {
+			id, err := strconv.Atoi(res.ID)
+			if err != nil {
+				return err
+			}
+
+			droplet, err = ds.Get(c.Ctx, id)
+			if err != nil {
+				return err
+			}
 		}
 
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/digitalocean/doctl/pkg/runner"
 	"github.com/digitalocean/doctl/pkg/runner/mocks"
 	"github.com/digitalocean/doctl/pkg/ssh"
+	"github.com/golang/mock/gomock"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 )
@@ -40,7 +41,7 @@ func TestSSHComand(t *testing.T) {
 
 func TestSSH_ID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().Get(testDroplet.ID).Return(&testDroplet, nil)
+		tm.droplets.EXPECT().Get(gomock.Any(), testDroplet.ID).Return(&testDroplet, nil)
 
 		config.Args = append(config.Args, strconv.Itoa(testDroplet.ID))
 
@@ -58,18 +59,18 @@ func TestSSH_InvalidID(t *testing.T) {
 
 func TestSSH_UnknownDroplet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
 
 		config.Args = append(config.Args, "missing")
 
 		err := RunSSH(config)
-		assert.EqualError(t, err, "could not find droplet")
+		assert.EqualError(t, err, `no droplet goes by the name "missing"`)
 	})
 }
 
 func TestSSH_DropletWithNoPublic(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.droplets.EXPECT().List().Return(testPrivateDropletList, nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testPrivateDropletList, nil)
 
 		config.Args = append(config.Args, testPrivateDroplet.Name)
 
@@ -89,7 +90,7 @@ func TestSSH_CustomPort(t *testing.T) {
 			return rm
 		}
 
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgsSSHPort, "2222")
 		config.Args = append(config.Args, testDroplet.Name)
@@ -110,7 +111,7 @@ func TestSSH_CustomUser(t *testing.T) {
 			return rm
 		}
 
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgSSHUser, "foobar")
 		config.Args = append(config.Args, testDroplet.Name)
@@ -131,7 +132,7 @@ func TestSSH_AgentForwarding(t *testing.T) {
 			return rm
 		}
 
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgsSSHAgentForwarding, true)
 		config.Args = append(config.Args, testDroplet.Name)
@@ -152,7 +153,7 @@ func TestSSH_CommandExecuting(t *testing.T) {
 			return rm
 		}
 
-		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+		tm.droplets.EXPECT().List(gomock.Any()).Return(testDropletList, nil)
 		config.Doit.Set(config.NS, doctl.ArgSSHCommand, "uptime")
 		config.Args = append(config.Args, testDroplet.Name)
 
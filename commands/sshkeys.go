@@ -65,7 +65,7 @@ func SSHKeys() *Command {
 func RunKeyList(c *CmdConfig) error {
 	ks := c.Keys()
 
-	list, err := ks.List()
+	list, err := ks.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -83,7 +83,7 @@ func RunKeyGet(c *CmdConfig) error {
 	}
 
 	rawKey := c.Args[0]
-	k, err := ks.Get(rawKey)
+	k, err := ks.Get(c.Ctx, rawKey)
 
 	if err != nil {
 		return err
@@ -113,7 +113,7 @@ func RunKeyCreate(c *CmdConfig) error {
 		PublicKey: publicKey,
 	}
 
-	r, err := ks.Create(kcr)
+	r, err := ks.Create(c.Ctx, kcr)
 	if err != nil {
 		return err
 	}
@@ -156,7 +156,7 @@ func RunKeyImport(c *CmdConfig) error {
 		PublicKey: string(keyFile),
 	}
 
-	r, err := ks.Create(kcr)
+	r, err := ks.Create(c.Ctx, kcr)
 	if err != nil {
 		return err
 	}
@@ -180,7 +180,7 @@ func RunKeyDelete(c *CmdConfig) error {
 
 	if force || AskForConfirm("delete ssh key") == nil {
 		rawKey := c.Args[0]
-		return ks.Delete(rawKey)
+		return ks.Delete(c.Ctx, rawKey)
 	}
 
 	return fmt.Errorf("operation aborted")
@@ -205,7 +205,7 @@ func RunKeyUpdate(c *CmdConfig) error {
 		Name: name,
 	}
 
-	k, err := ks.Update(rawKey, req)
+	k, err := ks.Update(c.Ctx, rawKey, req)
 	if err != nil {
 		return err
 	}
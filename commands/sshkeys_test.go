@@ -22,6 +22,7 @@ import (
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,7 +39,7 @@ func TestSSHKeysCommand(t *testing.T) {
 
 func TestKeysList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.keys.EXPECT().List().Return(testKeyList, nil)
+		tm.keys.EXPECT().List(gomock.Any()).Return(testKeyList, nil)
 
 		err := RunKeyList(config)
 		assert.NoError(t, err)
@@ -47,7 +48,7 @@ func TestKeysList(t *testing.T) {
 
 func TestKeysGetByID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.keys.EXPECT().Get("1").Return(&testKey, nil)
+		tm.keys.EXPECT().Get(gomock.Any(), "1").Return(&testKey, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -58,7 +59,7 @@ func TestKeysGetByID(t *testing.T) {
 
 func TestKeysGetByFingerprint(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.keys.EXPECT().Get(testKey.Fingerprint).Return(&testKey, nil)
+		tm.keys.EXPECT().Get(gomock.Any(), testKey.Fingerprint).Return(&testKey, nil)
 
 		config.Args = append(config.Args, testKey.Fingerprint)
 
@@ -70,7 +71,7 @@ func TestKeysGetByFingerprint(t *testing.T) {
 func TestKeysCreate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		kcr := &godo.KeyCreateRequest{Name: "the key", PublicKey: "fingerprint"}
-		tm.keys.EXPECT().Create(kcr).Return(&testKey, nil)
+		tm.keys.EXPECT().Create(gomock.Any(), kcr).Return(&testKey, nil)
 
 		config.Args = append(config.Args, "the key")
 
@@ -83,7 +84,7 @@ func TestKeysCreate(t *testing.T) {
 
 func TestKeysDeleteByID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.keys.EXPECT().Delete("1").Return(nil)
+		tm.keys.EXPECT().Delete(gomock.Any(), "1").Return(nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -96,7 +97,7 @@ func TestKeysDeleteByID(t *testing.T) {
 
 func TestKeysDeleteByFingerprint(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.keys.EXPECT().Delete("fingerprint").Return(nil)
+		tm.keys.EXPECT().Delete(gomock.Any(), "fingerprint").Return(nil)
 
 		config.Args = append(config.Args, "fingerprint")
 
@@ -111,7 +112,7 @@ func TestKeysDeleteByFingerprint(t *testing.T) {
 func TestKeysUpdateByID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		kur := &godo.KeyUpdateRequest{Name: "the key"}
-		tm.keys.EXPECT().Update("1", kur).Return(&testKey, nil)
+		tm.keys.EXPECT().Update(gomock.Any(), "1", kur).Return(&testKey, nil)
 
 		config.Args = append(config.Args, "1")
 
@@ -126,7 +127,7 @@ func TestKeysUpdateByID(t *testing.T) {
 func TestKeysUpdateByFingerprint(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		kur := &godo.KeyUpdateRequest{Name: "the key"}
-		tm.keys.EXPECT().Update("fingerprint", kur).Return(&testKey, nil)
+		tm.keys.EXPECT().Update(gomock.Any(), "fingerprint", kur).Return(&testKey, nil)
 
 		config.Args = append(config.Args, "fingerprint")
 
@@ -147,7 +148,7 @@ func TestSSHPublicKeyImportWithName(t *testing.T) {
 
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		kcr := &godo.KeyCreateRequest{Name: "custom", PublicKey: pubkey}
-		tm.keys.EXPECT().Create(kcr).Return(&testKey, nil)
+		tm.keys.EXPECT().Create(gomock.Any(), kcr).Return(&testKey, nil)
 
 		config.Args = append(config.Args, "custom")
 
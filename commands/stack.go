@@ -0,0 +1,172 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/commands/displayers"
+)
+
+const stackLong = `Resources are matched by name: droplets, volumes, firewalls and load balancers
+are created when no resource has their name, and updated when they differ from
+the spec. Changes that would require replacing a resource, such as moving a
+droplet to another region, are reported as warnings instead.
+
+Changes are applied in dependency order: tags, droplets, volumes and their
+attachments, firewalls, load balancers, and finally domains and their records.
+
+With --prune, the droplets, volumes, firewalls and load balancers carrying one
+of the spec's tags but not declared in it, and the undeclared records of its
+domains, are deleted.
+
+A stack spec looks like:
+
+	tags: [web]
+	droplets:
+	  - name: web-01
+	    region: nyc3
+	    size: s-1vcpu-1gb
+	    image: ubuntu-18-04-x64
+	    tags: [web]
+	    volumes: [web-data]
+	volumes:
+	  - name: web-data
+	    region: nyc3
+	    size: 10
+	    tags: [web]
+	firewalls:
+	  - name: web
+	    tags: [web]
+	    inbound_rules:
+	      - protocol: tcp
+	        ports: "80"
+	        load_balancers: [web]
+	load_balancers:
+	  - name: web
+	    region: nyc3
+	    tag: web
+	    forwarding_rules:
+	      - entry_protocol: http
+	        entry_port: 80
+	        target_protocol: http
+	        target_port: 80
+	domains:
+	  - name: example.com
+	    records:
+	      - type: A
+	        name: www
+	        load_balancer: web`
+
+// Plan creates the plan command.
+func Plan() *Command {
+	cmd := cmdBuilderWithInit(nil, RunPlan, "plan", "show the changes applying a stack spec would make", Writer, true,
+		displayerType(&displayers.StackPlan{}))
+	cmd.Long = "plan compares a stack spec with the resources of the account and lists the\nchanges apply would make, without making them.\n\n" + stackLong
+	AddStringFlag(cmd, doctl.ArgFile, doctl.ArgShortFile, "", "stack spec file, - for stdin", requiredOpt())
+	AddBoolFlag(cmd, doctl.ArgPrune, "", false, "delete managed resources missing from the spec")
+
+	return cmd
+}
+
+// Apply creates the apply command.
+func Apply() *Command {
+	cmd := cmdBuilderWithInit(nil, RunApply, "apply", "make the resources of the account match a stack spec", Writer, true,
+		displayerType(&displayers.StackPlan{}))
+	cmd.Long = "apply shows the changes a stack spec requires, as plan does, and makes them\nonce confirmed.\n\n" + stackLong
+	AddStringFlag(cmd, doctl.ArgFile, doctl.ArgShortFile, "", "stack spec file, - for stdin", requiredOpt())
+	AddBoolFlag(cmd, doctl.ArgPrune, "", false, "delete managed resources missing from the spec")
+	AddBoolFlag(cmd, doctl.ArgForce, "", false, "apply without confirmation")
+
+	return cmd
+}
+
+var stackActionDone = map[string]string{
+	"create": "Created",
+	"update": "Updated",
+	"delete": "Deleted",
+}
+
+func stackPlanFromFlags(c *CmdConfig) (*stackPlan, error) {
+	path, err := c.Doit.GetString(c.NS, doctl.ArgFile)
+	if err != nil {
+		return nil, err
+	}
+
+	prune, err := c.Doit.GetBool(c.NS, doctl.ArgPrune)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := readStackSpec(path)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := planStack(c, spec, prune)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range plan.warnings {
+		warn(w)
+	}
+
+	return plan, nil
+}
+
+// RunPlan shows the changes applying a stack spec would make.
+func RunPlan(c *CmdConfig) error {
+	plan, err := stackPlanFromFlags(c)
+	if err != nil {
+		return err
+	}
+
+	return c.Display(plan.displayable())
+}
+
+// RunApply makes the changes a stack spec requires.
+func RunApply(c *CmdConfig) error {
+	plan, err := stackPlanFromFlags(c)
+	if err != nil {
+		return err
+	}
+
+	if len(plan.changes) == 0 {
+		notice("Resources match the stack spec, nothing to apply")
+		return nil
+	}
+
+	if err := c.Display(plan.displayable()); err != nil {
+		return err
+	}
+
+	force, err := c.Doit.GetBool(c.NS, doctl.ArgForce)
+	if err != nil {
+		return err
+	}
+	if !force && AskForConfirm(fmt.Sprintf("apply %d changes", len(plan.changes))) != nil {
+		return fmt.Errorf("operation aborted")
+	}
+
+	for _, change := range plan.changes {
+		if err := change.apply(c.Ctx); err != nil {
+			return fmt.Errorf("%s %s %s: %v", change.Action, change.Type, change.Name, err)
+		}
+		notice("%s %s %s", stackActionDone[change.Action], change.Type, change.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,1494 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/doctl/commands/displayers"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+)
+
+// stackPollInterval is how often apply polls actions and load balancers it
+// waits for.
+var stackPollInterval = 2 * time.Second
+
+// stackChange is a step of a stack plan.
+type stackChange struct {
+	displayers.StackChange
+	apply func(context.Context) error
+}
+
+// stackPlan holds the changes turning the actual state of a stack's
+// resources into the desired one, in the order they must be applied:
+// tags, droplets, volumes and their attachments, firewalls, load balancers
+// and DNS, followed by deletions in the reverse order.
+type stackPlan struct {
+	changes  []*stackChange
+	warnings []string
+}
+
+func (p *stackPlan) displayable() *displayers.StackPlan {
+	changes := make([]displayers.StackChange, 0, len(p.changes))
+	for _, c := range p.changes {
+		changes = append(changes, c.StackChange)
+	}
+
+	return &displayers.StackPlan{Changes: changes}
+}
+
+// stackState is what exists in the account, indexed by name. Applying a
+// plan adds the resources it creates, so later steps can refer to them.
+type stackState struct {
+	tags          map[string]bool
+	droplets      map[string][]*godo.Droplet
+	volumes       map[string][]*godo.Volume
+	firewalls     map[string][]*godo.Firewall
+	loadBalancers map[string][]*godo.LoadBalancer
+	domains       map[string]bool
+	records       map[string][]godo.DomainRecord
+	// certificates are listed the first time a load balancer refers to one
+	// by name.
+	certificates map[string][]string
+}
+
+func loadStackState(c *CmdConfig, spec *StackSpec) (*stackState, error) {
+	s := &stackState{
+		tags:          map[string]bool{},
+		droplets:      map[string][]*godo.Droplet{},
+		volumes:       map[string][]*godo.Volume{},
+		firewalls:     map[string][]*godo.Firewall{},
+		loadBalancers: map[string][]*godo.LoadBalancer{},
+		domains:       map[string]bool{},
+		records:       map[string][]godo.DomainRecord{},
+	}
+
+	tags, err := c.Tags().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		s.tags[t.Name] = true
+	}
+
+	droplets, err := c.Droplets().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range droplets {
+		s.droplets[d.Name] = append(s.droplets[d.Name], d.Droplet)
+	}
+
+	volumes, err := c.Volumes().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range volumes {
+		s.volumes[v.Name] = append(s.volumes[v.Name], v.Volume)
+	}
+
+	firewalls, err := c.Firewalls().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, fw := range firewalls {
+		s.firewalls[fw.Name] = append(s.firewalls[fw.Name], fw.Firewall)
+	}
+
+	lbs, err := c.LoadBalancers().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, lb := range lbs {
+		s.loadBalancers[lb.Name] = append(s.loadBalancers[lb.Name], lb.LoadBalancer)
+	}
+
+	domains, err := c.Domains().List(c.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range domains {
+		s.domains[d.Name] = true
+	}
+	for _, d := range spec.Domains {
+		if !s.domains[d.Name] {
+			continue
+		}
+
+		records, err := c.Domains().Records(c.Ctx, d.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			s.records[d.Name] = append(s.records[d.Name], *r.DomainRecord)
+		}
+	}
+
+	return s, nil
+}
+
+// uniqueName checks that a name a stack refers to is not shared by several
+// resources, as it couldn't tell which one is meant.
+func uniqueName(kind, name string, n int) error {
+	if n > 1 {
+		return fmt.Errorf("there are %d %ss named %s, names must be unique for a stack to manage them", n, kind, name)
+	}
+
+	return nil
+}
+
+func (s *stackState) droplet(name string) (*godo.Droplet, error) {
+	if err := uniqueName("droplet", name, len(s.droplets[name])); err != nil || len(s.droplets[name]) == 0 {
+		return nil, err
+	}
+
+	return s.droplets[name][0], nil
+}
+
+func (s *stackState) volume(name string) (*godo.Volume, error) {
+	if err := uniqueName("volume", name, len(s.volumes[name])); err != nil || len(s.volumes[name]) == 0 {
+		return nil, err
+	}
+
+	return s.volumes[name][0], nil
+}
+
+func (s *stackState) firewall(name string) (*godo.Firewall, error) {
+	if err := uniqueName("firewall", name, len(s.firewalls[name])); err != nil || len(s.firewalls[name]) == 0 {
+		return nil, err
+	}
+
+	return s.firewalls[name][0], nil
+}
+
+// certificateID returns the ID of the certificate with the given name.
+func (p *stackPlanner) certificateID(name, referrer string) (string, error) {
+	if p.state.certificates == nil {
+		certs, err := p.c.Certificates().List(p.c.Ctx)
+		if err != nil {
+			return "", err
+		}
+
+		p.state.certificates = map[string][]string{}
+		for _, cert := range certs {
+			p.state.certificates[cert.Name] = append(p.state.certificates[cert.Name], cert.ID)
+		}
+	}
+
+	ids := p.state.certificates[name]
+	if len(ids) == 0 {
+		return "", fmt.Errorf("%s refers to certificate %s, which doesn't exist", referrer, name)
+	}
+	if err := uniqueName("certificate", name, len(ids)); err != nil {
+		return "", err
+	}
+
+	return ids[0], nil
+}
+
+func (s *stackState) loadBalancer(name string) (*godo.LoadBalancer, error) {
+	if err := uniqueName("load balancer", name, len(s.loadBalancers[name])); err != nil || len(s.loadBalancers[name]) == 0 {
+		return nil, err
+	}
+
+	return s.loadBalancers[name][0], nil
+}
+
+// stackPlanner compares a stack spec to the state of the account.
+type stackPlanner struct {
+	c     *CmdConfig
+	spec  *StackSpec
+	prune bool
+	state *stackState
+
+	plan    *stackPlan
+	deletes map[string][]*stackChange
+	// lbFirewalls are the firewalls with rules for load balancers that are
+	// yet to be created, which are updated once they are.
+	lbFirewalls []StackFirewall
+}
+
+// planStack returns the changes applying spec would make. Resources that
+// are managed by the stack but not declared are only deleted when prune is
+// set.
+func planStack(c *CmdConfig, spec *StackSpec, prune bool) (*stackPlan, error) {
+	state, err := loadStackState(c, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &stackPlanner{
+		c:       c,
+		spec:    spec,
+		prune:   prune,
+		state:   state,
+		plan:    &stackPlan{},
+		deletes: map[string][]*stackChange{},
+	}
+
+	for _, step := range []func() error{
+		p.planTags,
+		p.planDroplets,
+		p.planVolumes,
+		p.planAttachments,
+		p.planFirewalls,
+		p.planLoadBalancers,
+		p.planFirewallLoadBalancers,
+		p.planDomains,
+	} {
+		if err := step(); err != nil {
+			return nil, err
+		}
+	}
+
+	var unmanaged []string
+	if len(spec.Certificates) > 0 {
+		unmanaged = append(unmanaged, "certificates")
+	}
+	if len(spec.KubernetesClusters) > 0 {
+		unmanaged = append(unmanaged, "kubernetes clusters")
+	}
+	if len(spec.Databases) > 0 {
+		unmanaged = append(unmanaged, "databases")
+	}
+	if len(unmanaged) > 0 {
+		p.warn("the %s of the spec are not managed by plan and apply", strings.Join(unmanaged, " and "))
+	}
+
+	if prune {
+		p.planPrune()
+	}
+	for _, typ := range []string{"record", "load balancer", "firewall", "droplet", "volume"} {
+		p.plan.changes = append(p.plan.changes, p.deletes[typ]...)
+	}
+
+	return p.plan, nil
+}
+
+func (p *stackPlanner) add(action, typ, name string, details []string, apply func(context.Context) error) {
+	p.plan.changes = append(p.plan.changes, &stackChange{
+		StackChange: displayers.StackChange{Action: action, Type: typ, Name: name, Details: details},
+		apply:       apply,
+	})
+}
+
+func (p *stackPlanner) delete(typ, name string, apply func(context.Context) error) {
+	p.deletes[typ] = append(p.deletes[typ], &stackChange{
+		StackChange: displayers.StackChange{Action: "delete", Type: typ, Name: name},
+		apply:       apply,
+	})
+}
+
+func (p *stackPlanner) warn(format string, args ...interface{}) {
+	p.plan.warnings = append(p.plan.warnings, fmt.Sprintf(format, args...))
+}
+
+// managed reports whether a resource carries one of the stack's tags.
+func (p *stackPlanner) managed(tags ...string) bool {
+	for _, t := range tags {
+		if containsString(p.spec.Tags, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *stackPlanner) declaredDroplet(name string) bool {
+	for _, d := range p.spec.Droplets {
+		if d.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *stackPlanner) declaredLoadBalancer(name string) bool {
+	for _, lb := range p.spec.LoadBalancers {
+		if lb.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// planTags creates the declared tags and those the stack's resources use.
+func (p *stackPlanner) planTags() error {
+	tags := append([]string{}, p.spec.Tags...)
+	for _, d := range p.spec.Droplets {
+		tags = append(tags, d.Tags...)
+	}
+	for _, v := range p.spec.Volumes {
+		tags = append(tags, v.Tags...)
+	}
+	for _, fw := range p.spec.Firewalls {
+		tags = append(tags, fw.Tags...)
+	}
+	for _, lb := range p.spec.LoadBalancers {
+		if lb.Tag != "" {
+			tags = append(tags, lb.Tag)
+		}
+	}
+
+	for _, t := range tags {
+		if p.state.tags[t] {
+			continue
+		}
+
+		name := t
+		p.state.tags[name] = true
+		p.add("create", "tag", name, nil, func(ctx context.Context) error {
+			_, err := p.c.Tags().Create(ctx, &godo.TagCreateRequest{Name: name})
+			return err
+		})
+	}
+
+	return nil
+}
+
+func (p *stackPlanner) planDroplets() error {
+	for _, spec := range p.spec.Droplets {
+		spec := spec
+
+		actual, err := p.state.droplet(spec.Name)
+		if err != nil {
+			return err
+		}
+
+		if actual == nil {
+			details := []string{"region=" + spec.Region, "size=" + spec.Size, "image=" + spec.Image}
+			p.add("create", "droplet", spec.Name, details, func(ctx context.Context) error {
+				return p.createDroplet(ctx, spec)
+			})
+			continue
+		}
+
+		if actual.Region != nil && actual.Region.Slug != spec.Region {
+			p.warn("droplet %s is in region %s, not %s; it is not moved", spec.Name, actual.Region.Slug, spec.Region)
+		}
+		if actual.SizeSlug != spec.Size {
+			p.warn("droplet %s has size %s, not %s; it is not resized", spec.Name, actual.SizeSlug, spec.Size)
+		}
+
+		if details, apply := p.retag(godo.DropletResourceType, strconv.Itoa(actual.ID), actual.Tags, spec.Tags); apply != nil {
+			p.add("update", "droplet", spec.Name, details, apply)
+		}
+	}
+
+	return nil
+}
+
+func (p *stackPlanner) createDroplet(ctx context.Context, spec StackDroplet) error {
+	image := godo.DropletCreateImage{Slug: spec.Image}
+	if id, err := strconv.Atoi(spec.Image); err == nil {
+		image = godo.DropletCreateImage{ID: id}
+	}
+
+	d, err := p.c.Droplets().Create(ctx, &godo.DropletCreateRequest{
+		Name:              spec.Name,
+		Region:            spec.Region,
+		Size:              spec.Size,
+		Image:             image,
+		SSHKeys:           extractSSHKeys(spec.SSHKeys),
+		Backups:           spec.Backups,
+		IPv6:              spec.IPv6,
+		PrivateNetworking: spec.PrivateNetworking,
+		Monitoring:        spec.Monitoring,
+		UserData:          spec.UserData,
+		Tags:              spec.Tags,
+	}, true)
+	if err != nil {
+		return err
+	}
+
+	p.state.droplets[spec.Name] = []*godo.Droplet{d.Droplet}
+	return nil
+}
+
+// retag returns the change making a resource's tags match the desired ones,
+// or a nil function when they already do.
+func (p *stackPlanner) retag(typ godo.ResourceType, id string, actual, desired []string) ([]string, func(context.Context) error) {
+	add, remove := stringsDiff(desired, actual)
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, nil
+	}
+
+	details := []string{fmt.Sprintf("tags: %s -> %s", formatList(actual), formatList(desired))}
+	resources := []godo.Resource{{ID: id, Type: typ}}
+
+	return details, func(ctx context.Context) error {
+		for _, t := range add {
+			if err := p.c.Tags().TagResources(ctx, t, &godo.TagResourcesRequest{Resources: resources}); err != nil {
+				return err
+			}
+		}
+		for _, t := range remove {
+			if err := p.c.Tags().UntagResources(ctx, t, &godo.UntagResourcesRequest{Resources: resources}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func (p *stackPlanner) planVolumes() error {
+	for _, spec := range p.spec.Volumes {
+		spec := spec
+
+		actual, err := p.state.volume(spec.Name)
+		if err != nil {
+			return err
+		}
+
+		if actual == nil {
+			details := []string{"region=" + spec.Region, fmt.Sprintf("size=%dGiB", spec.Size)}
+			p.add("create", "volume", spec.Name, details, func(ctx context.Context) error {
+				v, err := p.c.Volumes().CreateVolume(ctx, &godo.VolumeCreateRequest{
+					Region:          spec.Region,
+					Name:            spec.Name,
+					Description:     spec.Description,
+					SizeGigaBytes:   spec.Size,
+					FilesystemType:  spec.FilesystemType,
+					FilesystemLabel: spec.FilesystemLabel,
+					Tags:            spec.Tags,
+				})
+				if err != nil {
+					return err
+				}
+
+				p.state.volumes[spec.Name] = []*godo.Volume{v.Volume}
+				return nil
+			})
+			continue
+		}
+
+		if actual.Region != nil && actual.Region.Slug != spec.Region {
+			p.warn("volume %s is in region %s, not %s; it is not moved", spec.Name, actual.Region.Slug, spec.Region)
+		}
+
+		var (
+			details []string
+			applies []func(context.Context) error
+		)
+
+		switch {
+		case spec.Size > actual.SizeGigaBytes:
+			details = append(details, fmt.Sprintf("size: %dGiB -> %dGiB", actual.SizeGigaBytes, spec.Size))
+			id, region := actual.ID, actual.Region.Slug
+			applies = append(applies, func(ctx context.Context) error {
+				a, err := p.c.VolumeActions().Resize(ctx, id, int(spec.Size), region)
+				if err != nil {
+					return err
+				}
+
+				return p.waitForAction(ctx, a)
+			})
+		case spec.Size < actual.SizeGigaBytes:
+			p.warn("volume %s has %dGiB, more than %dGiB; volumes can't shrink", spec.Name, actual.SizeGigaBytes, spec.Size)
+		}
+
+		if tagDetails, apply := p.retag(godo.VolumeResourceType, actual.ID, actual.Tags, spec.Tags); apply != nil {
+			details = append(details, tagDetails...)
+			applies = append(applies, apply)
+		}
+
+		if len(applies) > 0 {
+			p.add("update", "volume", spec.Name, details, func(ctx context.Context) error {
+				for _, apply := range applies {
+					if err := apply(ctx); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+		}
+	}
+
+	return nil
+}
+
+// planAttachments attaches volumes to the droplets declaring them, and
+// detaches declared volumes from the droplets that no longer do. Volumes
+// the stack doesn't declare are left alone.
+func (p *stackPlanner) planAttachments() error {
+	for _, ds := range p.spec.Droplets {
+		droplet, err := p.state.droplet(ds.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range ds.Volumes {
+			volume, err := p.state.volume(name)
+			if err != nil {
+				return err
+			}
+			if droplet != nil && volume != nil && containsInt(volume.DropletIDs, droplet.ID) {
+				continue
+			}
+
+			volumeName, dropletName := name, ds.Name
+			p.add("update", "volume", name, []string{"attach to droplet " + dropletName}, func(ctx context.Context) error {
+				return p.attach(ctx, volumeName, dropletName)
+			})
+		}
+	}
+
+	for _, vs := range p.spec.Volumes {
+		volume, err := p.state.volume(vs.Name)
+		if err != nil || volume == nil {
+			return err
+		}
+
+		for _, id := range volume.DropletIDs {
+			droplet := p.dropletByID(id)
+			if droplet == nil || !p.declaredDroplet(droplet.Name) {
+				continue
+			}
+
+			var attached bool
+			for _, ds := range p.spec.Droplets {
+				if ds.Name == droplet.Name && containsString(ds.Volumes, vs.Name) {
+					attached = true
+				}
+			}
+			if attached {
+				continue
+			}
+
+			volumeID, dropletID := volume.ID, id
+			p.add("update", "volume", vs.Name, []string{"detach from droplet " + droplet.Name}, func(ctx context.Context) error {
+				return p.detach(ctx, volumeID, dropletID)
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *stackPlanner) dropletByID(id int) *godo.Droplet {
+	for _, droplets := range p.state.droplets {
+		for _, d := range droplets {
+			if d.ID == id {
+				return d
+			}
+		}
+	}
+
+	return nil
+}
+
+// attach attaches a volume to a droplet, detaching it from the droplet it
+// is attached to first, as a volume is attached to one droplet at most.
+func (p *stackPlanner) attach(ctx context.Context, volumeName, dropletName string) error {
+	volume, err := p.state.volume(volumeName)
+	if err != nil {
+		return err
+	}
+	droplet, err := p.state.droplet(dropletName)
+	if err != nil {
+		return err
+	}
+	if volume == nil || droplet == nil {
+		return fmt.Errorf("volume %s or droplet %s doesn't exist", volumeName, dropletName)
+	}
+
+	for _, id := range volume.DropletIDs {
+		if err := p.detach(ctx, volume.ID, id); err != nil {
+			return err
+		}
+	}
+
+	a, err := p.c.VolumeActions().Attach(ctx, volume.ID, droplet.ID)
+	if err != nil {
+		return err
+	}
+	if err := p.waitForAction(ctx, a); err != nil {
+		return err
+	}
+
+	volume.DropletIDs = []int{droplet.ID}
+	return nil
+}
+
+func (p *stackPlanner) detach(ctx context.Context, volumeID string, dropletID int) error {
+	a, err := p.c.VolumeActions().Detach(ctx, volumeID, dropletID)
+	if err != nil {
+		return err
+	}
+
+	return p.waitForAction(ctx, a)
+}
+
+// waitForAction waits for an action to complete, failing if it errors.
+func (p *stackPlanner) waitForAction(ctx context.Context, a *do.Action) error {
+	for a.Status == godo.ActionInProgress {
+		var err error
+		a, err = p.c.Actions().Get(ctx, a.ID)
+		if err != nil {
+			return err
+		}
+		if a.Status != godo.ActionInProgress {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(stackPollInterval):
+		}
+	}
+
+	if a.Status == "errored" {
+		return fmt.Errorf("%s action %d errored", a.Type, a.ID)
+	}
+
+	return nil
+}
+
+// dropletIDs resolves droplet names to IDs. It reports false when some of
+// them are yet to be created.
+func (p *stackPlanner) dropletIDs(names []string, referrer string) ([]int, bool, error) {
+	ids := []int{}
+	resolved := true
+
+	for _, name := range names {
+		d, err := p.state.droplet(name)
+		if err != nil {
+			return nil, false, err
+		}
+		if d == nil {
+			if !p.declaredDroplet(name) {
+				return nil, false, fmt.Errorf("%s refers to droplet %s, which neither exists nor is declared", referrer, name)
+			}
+
+			resolved = false
+			continue
+		}
+
+		ids = append(ids, d.ID)
+	}
+
+	return ids, resolved, nil
+}
+
+// loadBalancerIDs resolves load balancer names to IDs. It reports false
+// when some of them are yet to be created.
+func (p *stackPlanner) loadBalancerIDs(names []string, referrer string) ([]string, bool, error) {
+	ids := []string{}
+	resolved := true
+
+	for _, name := range names {
+		lb, err := p.state.loadBalancer(name)
+		if err != nil {
+			return nil, false, err
+		}
+		if lb == nil {
+			if !p.declaredLoadBalancer(name) {
+				return nil, false, fmt.Errorf("%s refers to load balancer %s, which neither exists nor is declared", referrer, name)
+			}
+
+			resolved = false
+			continue
+		}
+
+		ids = append(ids, lb.ID)
+	}
+
+	return ids, resolved, nil
+}
+
+// firewallRequest builds the request for a firewall, reporting false when
+// it refers to droplets or load balancers that are yet to be created.
+func (p *stackPlanner) firewallRequest(spec StackFirewall) (*godo.FirewallRequest, bool, error) {
+	referrer := "firewall " + spec.Name
+
+	dropletIDs, resolved, err := p.dropletIDs(spec.Droplets, referrer)
+	if err != nil {
+		return nil, false, err
+	}
+
+	req := &godo.FirewallRequest{
+		Name:          spec.Name,
+		InboundRules:  []godo.InboundRule{},
+		OutboundRules: []godo.OutboundRule{},
+		DropletIDs:    dropletIDs,
+		Tags:          append([]string{}, spec.Tags...),
+	}
+
+	target := func(r StackFirewallRule) ([]int, []string, error) {
+		dropletIDs, ok, err := p.dropletIDs(r.Droplets, referrer)
+		if err != nil {
+			return nil, nil, err
+		}
+		resolved = resolved && ok
+
+		lbIDs, ok, err := p.loadBalancerIDs(r.LoadBalancers, referrer)
+		if err != nil {
+			return nil, nil, err
+		}
+		resolved = resolved && ok
+
+		return dropletIDs, lbIDs, nil
+	}
+
+	for _, r := range spec.InboundRules {
+		dropletIDs, lbIDs, err := target(r)
+		if err != nil {
+			return nil, false, err
+		}
+
+		req.InboundRules = append(req.InboundRules, godo.InboundRule{
+			Protocol:  r.Protocol,
+			PortRange: r.Ports,
+			Sources: &godo.Sources{
+				Addresses:        r.Addresses,
+				Tags:             r.Tags,
+				DropletIDs:       dropletIDs,
+				LoadBalancerUIDs: lbIDs,
+			},
+		})
+	}
+	for _, r := range spec.OutboundRules {
+		dropletIDs, lbIDs, err := target(r)
+		if err != nil {
+			return nil, false, err
+		}
+
+		req.OutboundRules = append(req.OutboundRules, godo.OutboundRule{
+			Protocol:  r.Protocol,
+			PortRange: r.Ports,
+			Destinations: &godo.Destinations{
+				Addresses:        r.Addresses,
+				Tags:             r.Tags,
+				DropletIDs:       dropletIDs,
+				LoadBalancerUIDs: lbIDs,
+			},
+		})
+	}
+
+	return req, resolved, nil
+}
+
+// firewallRuleKey describes a rule in a form that doesn't depend on the
+// order of its targets, or on how all ports are spelled.
+func firewallRuleKey(protocol, ports string, addresses, tags []string, dropletIDs []int, lbIDs []string) string {
+	switch {
+	case protocol == "icmp":
+		ports = ""
+	case ports == "" || ports == "all":
+		ports = "0"
+	}
+
+	return fmt.Sprintf("%s:%s addresses=%v tags=%v droplets=%v load_balancers=%v",
+		protocol, ports, sortedStrings(addresses), sortedStrings(tags), sortedInts(dropletIDs), sortedStrings(lbIDs))
+}
+
+func inboundRuleKeys(rules []godo.InboundRule) []string {
+	keys := []string{}
+	for _, r := range rules {
+		s := r.Sources
+		if s == nil {
+			s = &godo.Sources{}
+		}
+		keys = append(keys, firewallRuleKey(r.Protocol, r.PortRange, s.Addresses, s.Tags, s.DropletIDs, s.LoadBalancerUIDs))
+	}
+
+	return sortedStrings(keys)
+}
+
+func outboundRuleKeys(rules []godo.OutboundRule) []string {
+	keys := []string{}
+	for _, r := range rules {
+		d := r.Destinations
+		if d == nil {
+			d = &godo.Destinations{}
+		}
+		keys = append(keys, firewallRuleKey(r.Protocol, r.PortRange, d.Addresses, d.Tags, d.DropletIDs, d.LoadBalancerUIDs))
+	}
+
+	return sortedStrings(keys)
+}
+
+func (p *stackPlanner) planFirewalls() error {
+	for _, spec := range p.spec.Firewalls {
+		spec := spec
+
+		actual, err := p.state.firewall(spec.Name)
+		if err != nil {
+			return err
+		}
+		req, resolved, err := p.firewallRequest(spec)
+		if err != nil {
+			return err
+		}
+		for _, r := range append(append([]StackFirewallRule{}, spec.InboundRules...), spec.OutboundRules...) {
+			if _, ok, _ := p.loadBalancerIDs(r.LoadBalancers, ""); !ok {
+				p.lbFirewalls = append(p.lbFirewalls, spec)
+				break
+			}
+		}
+
+		if actual == nil {
+			details := []string{fmt.Sprintf("%d inbound rules", len(spec.InboundRules)), fmt.Sprintf("%d outbound rules", len(spec.OutboundRules))}
+			p.add("create", "firewall", spec.Name, details, func(ctx context.Context) error {
+				req, _, err := p.firewallRequest(spec)
+				if err != nil {
+					return err
+				}
+
+				fw, err := p.c.Firewalls().Create(ctx, req)
+				if err != nil {
+					return err
+				}
+
+				p.state.firewalls[spec.Name] = []*godo.Firewall{fw.Firewall}
+				return nil
+			})
+			continue
+		}
+
+		var details []string
+		if !resolved || !reflect.DeepEqual(inboundRuleKeys(req.InboundRules), inboundRuleKeys(actual.InboundRules)) {
+			details = append(details, "inbound rules")
+		}
+		if !resolved || !reflect.DeepEqual(outboundRuleKeys(req.OutboundRules), outboundRuleKeys(actual.OutboundRules)) {
+			details = append(details, "outbound rules")
+		}
+		if !resolved || !sameInts(req.DropletIDs, actual.DropletIDs) {
+			details = append(details, "droplets")
+		}
+		if !sameStrings(req.Tags, actual.Tags) {
+			details = append(details, fmt.Sprintf("tags: %s -> %s", formatList(actual.Tags), formatList(req.Tags)))
+		}
+		if len(details) == 0 {
+			continue
+		}
+
+		id := actual.ID
+		p.add("update", "firewall", spec.Name, details, func(ctx context.Context) error {
+			req, _, err := p.firewallRequest(spec)
+			if err != nil {
+				return err
+			}
+
+			_, err = p.c.Firewalls().Update(ctx, id, req)
+			return err
+		})
+	}
+
+	return nil
+}
+
+// planFirewallLoadBalancers adds the load balancers created by the plan to
+// the rules of the firewalls referring to them, as firewalls are created
+// before load balancers.
+func (p *stackPlanner) planFirewallLoadBalancers() error {
+	for _, spec := range p.lbFirewalls {
+		spec := spec
+
+		p.add("update", "firewall", spec.Name, []string{"load balancer rules"}, func(ctx context.Context) error {
+			fw, err := p.state.firewall(spec.Name)
+			if err != nil {
+				return err
+			}
+			req, _, err := p.firewallRequest(spec)
+			if err != nil {
+				return err
+			}
+
+			_, err = p.c.Firewalls().Update(ctx, fw.ID, req)
+			return err
+		})
+	}
+
+	return nil
+}
+
+// loadBalancerRequest builds the request for a load balancer, reporting
+// false when it refers to droplets that are yet to be created. The health
+// check and sticky sessions of an existing load balancer are kept when the
+// spec doesn't set them.
+func (p *stackPlanner) loadBalancerRequest(spec StackLoadBalancer, actual *godo.LoadBalancer) (*godo.LoadBalancerRequest, bool, error) {
+	dropletIDs, resolved, err := p.dropletIDs(spec.Droplets, "load balancer "+spec.Name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	req := &godo.LoadBalancerRequest{
+		Name:                spec.Name,
+		Algorithm:           spec.Algorithm,
+		Region:              spec.Region,
+		DropletIDs:          dropletIDs,
+		Tag:                 spec.Tag,
+		RedirectHttpToHttps: spec.RedirectHTTPToHTTPS,
+		EnableProxyProtocol: spec.EnableProxyProtocol,
+	}
+	if req.Algorithm == "" {
+		req.Algorithm = "round_robin"
+	}
+
+	for _, r := range spec.ForwardingRules {
+		certificateID := r.CertificateID
+		if r.Certificate != "" {
+			certificateID, err = p.certificateID(r.Certificate, "load balancer "+spec.Name)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		req.ForwardingRules = append(req.ForwardingRules, godo.ForwardingRule{
+			EntryProtocol:  r.EntryProtocol,
+			EntryPort:      r.EntryPort,
+			TargetProtocol: r.TargetProtocol,
+			TargetPort:     r.TargetPort,
+			CertificateID:  certificateID,
+			TlsPassthrough: r.TLSPassthrough,
+		})
+	}
+
+	if hc := spec.HealthCheck; hc != nil {
+		req.HealthCheck = &godo.HealthCheck{
+			Protocol:               hc.Protocol,
+			Port:                   hc.Port,
+			Path:                   hc.Path,
+			CheckIntervalSeconds:   hc.CheckIntervalSeconds,
+			ResponseTimeoutSeconds: hc.ResponseTimeoutSeconds,
+			HealthyThreshold:       hc.HealthyThreshold,
+			UnhealthyThreshold:     hc.UnhealthyThreshold,
+		}
+	} else if actual != nil {
+		req.HealthCheck = actual.HealthCheck
+	}
+
+	if ss := spec.StickySessions; ss != nil {
+		req.StickySessions = &godo.StickySessions{
+			Type:             ss.Type,
+			CookieName:       ss.CookieName,
+			CookieTtlSeconds: ss.CookieTTLSeconds,
+		}
+	} else if actual != nil {
+		req.StickySessions = actual.StickySessions
+	}
+
+	return req, resolved, nil
+}
+
+func forwardingRuleKeys(rules []godo.ForwardingRule) []string {
+	keys := []string{}
+	for _, r := range rules {
+		keys = append(keys, r.String())
+	}
+
+	return sortedStrings(keys)
+}
+
+func (p *stackPlanner) planLoadBalancers() error {
+	for _, spec := range p.spec.LoadBalancers {
+		spec := spec
+
+		actual, err := p.state.loadBalancer(spec.Name)
+		if err != nil {
+			return err
+		}
+		req, resolved, err := p.loadBalancerRequest(spec, actual)
+		if err != nil {
+			return err
+		}
+
+		if actual == nil {
+			details := []string{"region=" + spec.Region, fmt.Sprintf("%d forwarding rules", len(spec.ForwardingRules))}
+			p.add("create", "load balancer", spec.Name, details, func(ctx context.Context) error {
+				req, _, err := p.loadBalancerRequest(spec, nil)
+				if err != nil {
+					return err
+				}
+
+				lb, err := p.c.LoadBalancers().Create(ctx, req)
+				if err != nil {
+					return err
+				}
+
+				p.state.loadBalancers[spec.Name] = []*godo.LoadBalancer{lb.LoadBalancer}
+				return nil
+			})
+			continue
+		}
+
+		if actual.Region != nil && actual.Region.Slug != spec.Region {
+			p.warn("load balancer %s is in region %s, not %s; it is not moved", spec.Name, actual.Region.Slug, spec.Region)
+		}
+
+		var details []string
+		if req.Algorithm != actual.Algorithm {
+			details = append(details, fmt.Sprintf("algorithm: %s -> %s", actual.Algorithm, req.Algorithm))
+		}
+		if !reflect.DeepEqual(forwardingRuleKeys(req.ForwardingRules), forwardingRuleKeys(actual.ForwardingRules)) {
+			details = append(details, "forwarding rules")
+		}
+		if spec.HealthCheck != nil && !reflect.DeepEqual(req.HealthCheck, actual.HealthCheck) {
+			details = append(details, "health check")
+		}
+		if spec.StickySessions != nil && !reflect.DeepEqual(req.StickySessions, actual.StickySessions) {
+			details = append(details, "sticky sessions")
+		}
+		// Load balancers using a tag list the tagged droplets.
+		if req.Tag == "" && (!resolved || !sameInts(req.DropletIDs, actual.DropletIDs)) {
+			details = append(details, "droplets")
+		}
+		if req.Tag != actual.Tag {
+			details = append(details, fmt.Sprintf("tag: %q -> %q", actual.Tag, req.Tag))
+		}
+		if req.RedirectHttpToHttps != actual.RedirectHttpToHttps {
+			details = append(details, fmt.Sprintf("redirect_http_to_https: %t -> %t", actual.RedirectHttpToHttps, req.RedirectHttpToHttps))
+		}
+		if req.EnableProxyProtocol != actual.EnableProxyProtocol {
+			details = append(details, fmt.Sprintf("enable_proxy_protocol: %t -> %t", actual.EnableProxyProtocol, req.EnableProxyProtocol))
+		}
+		if len(details) == 0 {
+			continue
+		}
+
+		p.add("update", "load balancer", spec.Name, details, func(ctx context.Context) error {
+			req, _, err := p.loadBalancerRequest(spec, actual)
+			if err != nil {
+				return err
+			}
+
+			_, err = p.c.LoadBalancers().Update(ctx, actual.ID, req)
+			return err
+		})
+	}
+
+	return nil
+}
+
+// recordData returns the data of a record, reporting false when it is the
+// address of a droplet or load balancer that doesn't have one yet.
+func (p *stackPlanner) recordData(r StackRecord, referrer string) (string, bool, error) {
+	switch {
+	case r.Droplet != "":
+		d, err := p.state.droplet(r.Droplet)
+		if err != nil {
+			return "", false, err
+		}
+		if d == nil {
+			if !p.declaredDroplet(r.Droplet) {
+				return "", false, fmt.Errorf("%s refers to droplet %s, which neither exists nor is declared", referrer, r.Droplet)
+			}
+			return "", false, nil
+		}
+
+		ip, err := d.PublicIPv4()
+		return ip, ip != "", err
+	case r.LoadBalancer != "":
+		lb, err := p.state.loadBalancer(r.LoadBalancer)
+		if err != nil {
+			return "", false, err
+		}
+		if lb == nil {
+			if !p.declaredLoadBalancer(r.LoadBalancer) {
+				return "", false, fmt.Errorf("%s refers to load balancer %s, which neither exists nor is declared", referrer, r.LoadBalancer)
+			}
+			return "", false, nil
+		}
+
+		return lb.IP, lb.IP != "", nil
+	default:
+		return r.Data, true, nil
+	}
+}
+
+// resolveRecordData is recordData for applying a plan. New load balancers
+// get their address a little while after being created, so it is waited
+// for.
+func (p *stackPlanner) resolveRecordData(ctx context.Context, r StackRecord, referrer string) (string, error) {
+	for {
+		data, ok, err := p.recordData(r, referrer)
+		if err != nil || ok {
+			return data, err
+		}
+		if r.LoadBalancer == "" {
+			return "", fmt.Errorf("droplet %s of %s has no public IPv4 address", r.Droplet, referrer)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(stackPollInterval):
+		}
+
+		lb, _ := p.state.loadBalancer(r.LoadBalancer)
+		fresh, err := p.c.LoadBalancers().Get(ctx, lb.ID)
+		if err != nil {
+			return "", err
+		}
+		p.state.loadBalancers[r.LoadBalancer] = []*godo.LoadBalancer{fresh.LoadBalancer}
+	}
+}
+
+func recordEditRequest(r StackRecord, data string) *do.DomainRecordEditRequest {
+	req := &do.DomainRecordEditRequest{
+		Type:     r.Type,
+		Name:     recordName(r.Name),
+		Data:     data,
+		Priority: r.Priority,
+		TTL:      r.TTL,
+		Weight:   r.Weight,
+		Flags:    r.Flags,
+		Tag:      r.Tag,
+	}
+	if r.Port != 0 {
+		port := r.Port
+		req.Port = &port
+	}
+
+	return req
+}
+
+func recordName(name string) string {
+	if name == "" {
+		return "@"
+	}
+
+	return name
+}
+
+func recordTitle(typ, name, domain string) string {
+	if name == "@" {
+		return typ + " " + domain
+	}
+
+	return typ + " " + name + "." + domain
+}
+
+func sameRecordData(a, b string) bool {
+	return strings.TrimSuffix(a, ".") == strings.TrimSuffix(b, ".")
+}
+
+// recordSettingsDiffer compares everything but the data of a record. The
+// TTL is only compared when the spec sets it.
+func recordSettingsDiffer(spec StackRecord, actual godo.DomainRecord) bool {
+	return (spec.TTL != 0 && spec.TTL != actual.TTL) ||
+		spec.Priority != actual.Priority ||
+		spec.Port != actual.Port ||
+		spec.Weight != actual.Weight ||
+		spec.Flags != actual.Flags ||
+		spec.Tag != actual.Tag
+}
+
+func (p *stackPlanner) planDomains() error {
+	for _, domain := range p.spec.Domains {
+		domainName := domain.Name
+
+		if !p.state.domains[domainName] {
+			p.add("create", "domain", domainName, nil, func(ctx context.Context) error {
+				_, err := p.c.Domains().Create(ctx, &godo.DomainCreateRequest{Name: domainName})
+				return err
+			})
+		}
+
+		if err := p.planRecords(domain); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// planRecords pairs the declared records of a domain with the existing ones
+// of the same type and name, first by data and then in order. Unpaired
+// declared records are created, and unpaired existing ones deleted.
+func (p *stackPlanner) planRecords(domain StackDomain) error {
+	type group struct {
+		declared []StackRecord
+		existing []godo.DomainRecord
+	}
+	groups := map[string]*group{}
+	var keys []string
+	groupOf := func(typ, name string) *group {
+		key := typ + " " + recordName(name)
+		if groups[key] == nil {
+			groups[key] = &group{}
+			keys = append(keys, key)
+		}
+		return groups[key]
+	}
+
+	for _, r := range domain.Records {
+		g := groupOf(r.Type, r.Name)
+		g.declared = append(g.declared, r)
+	}
+	for _, r := range p.state.records[domain.Name] {
+		g := groupOf(r.Type, r.Name)
+		g.existing = append(g.existing, r)
+	}
+
+	for _, key := range keys {
+		g := groups[key]
+
+		used := make([]bool, len(g.existing))
+		var unpaired []StackRecord
+		for _, r := range g.declared {
+			referrer := "record " + recordTitle(r.Type, recordName(r.Name), domain.Name)
+			data, resolved, err := p.recordData(r, referrer)
+			if err != nil {
+				return err
+			}
+
+			paired := false
+			for i, e := range g.existing {
+				if resolved && !used[i] && sameRecordData(data, e.Data) {
+					used[i], paired = true, true
+					if recordSettingsDiffer(r, e) {
+						p.updateRecord(domain.Name, r, e, nil)
+					}
+					break
+				}
+			}
+			if !paired {
+				unpaired = append(unpaired, r)
+			}
+		}
+
+		for _, r := range unpaired {
+			paired := false
+			for i, e := range g.existing {
+				if !used[i] {
+					used[i], paired = true, true
+					p.updateRecord(domain.Name, r, e, []string{"data: " + e.Data + " -> " + p.describeRecordData(r)})
+					break
+				}
+			}
+			if !paired {
+				p.createRecord(domain.Name, r)
+			}
+		}
+
+		for i, e := range g.existing {
+			if used[i] || e.Type == "SOA" || (e.Type == "NS" && e.Name == "@") {
+				continue
+			}
+
+			domainName, id := domain.Name, e.ID
+			p.delete("record", recordTitle(e.Type, e.Name, domain.Name), func(ctx context.Context) error {
+				return p.c.Domains().DeleteRecord(ctx, domainName, id)
+			})
+		}
+	}
+
+	if !p.prune {
+		delete(p.deletes, "record")
+	}
+
+	return nil
+}
+
+func (p *stackPlanner) describeRecordData(r StackRecord) string {
+	switch {
+	case r.Droplet != "":
+		return "address of droplet " + r.Droplet
+	case r.LoadBalancer != "":
+		return "address of load balancer " + r.LoadBalancer
+	default:
+		return r.Data
+	}
+}
+
+func (p *stackPlanner) createRecord(domain string, r StackRecord) {
+	title := recordTitle(r.Type, recordName(r.Name), domain)
+	p.add("create", "record", title, []string{p.describeRecordData(r)}, func(ctx context.Context) error {
+		data, err := p.resolveRecordData(ctx, r, "record "+title)
+		if err != nil {
+			return err
+		}
+
+		_, err = p.c.Domains().CreateRecord(ctx, domain, recordEditRequest(r, data))
+		return err
+	})
+}
+
+func (p *stackPlanner) updateRecord(domain string, r StackRecord, existing godo.DomainRecord, details []string) {
+	title := recordTitle(r.Type, recordName(r.Name), domain)
+	if details == nil {
+		details = []string{"ttl, priority, port, weight, flags or tag"}
+	}
+
+	p.add("update", "record", title, details, func(ctx context.Context) error {
+		data, err := p.resolveRecordData(ctx, r, "record "+title)
+		if err != nil {
+			return err
+		}
+
+		_, err = p.c.Domains().EditRecord(ctx, domain, existing.ID, recordEditRequest(r, data))
+		return err
+	})
+}
+
+// planPrune deletes the droplets, volumes, firewalls and load balancers
+// carrying one of the stack's tags that the stack doesn't declare.
+func (p *stackPlanner) planPrune() {
+	for _, name := range sortedKeys(p.state.droplets) {
+		for _, d := range p.state.droplets[name] {
+			if !p.managed(d.Tags...) || p.declaredDroplet(d.Name) {
+				continue
+			}
+
+			id := d.ID
+			p.delete("droplet", d.Name, func(ctx context.Context) error {
+				return p.c.Droplets().Delete(ctx, id)
+			})
+		}
+	}
+
+	for _, name := range sortedKeys(p.state.volumes) {
+		for _, v := range p.state.volumes[name] {
+			declared := false
+			for _, vs := range p.spec.Volumes {
+				declared = declared || vs.Name == v.Name
+			}
+			if !p.managed(v.Tags...) || declared {
+				continue
+			}
+
+			id := v.ID
+			p.delete("volume", v.Name, func(ctx context.Context) error {
+				// Deleting droplets detaches their volumes, so the volume is
+				// fetched again to only detach it from the remaining ones.
+				v, err := p.c.Volumes().Get(ctx, id)
+				if err != nil {
+					return err
+				}
+				for _, dropletID := range v.DropletIDs {
+					if err := p.detach(ctx, id, dropletID); err != nil {
+						return err
+					}
+				}
+
+				return p.c.Volumes().DeleteVolume(ctx, id)
+			})
+		}
+	}
+
+	for _, name := range sortedKeys(p.state.firewalls) {
+		for _, fw := range p.state.firewalls[name] {
+			declared := false
+			for _, fs := range p.spec.Firewalls {
+				declared = declared || fs.Name == fw.Name
+			}
+			if !p.managed(fw.Tags...) || declared {
+				continue
+			}
+
+			id := fw.ID
+			p.delete("firewall", fw.Name, func(ctx context.Context) error {
+				return p.c.Firewalls().Delete(ctx, id)
+			})
+		}
+	}
+
+	for _, name := range sortedKeys(p.state.loadBalancers) {
+		for _, lb := range p.state.loadBalancers[name] {
+			if !p.managed(lb.Tag) || p.declaredLoadBalancer(lb.Name) {
+				continue
+			}
+
+			id := lb.ID
+			p.delete("load balancer", lb.Name, func(ctx context.Context) error {
+				return p.c.LoadBalancers().Delete(ctx, id)
+			})
+		}
+	}
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedStrings(in []string) []string {
+	out := append([]string{}, in...)
+	sort.Strings(out)
+	return out
+}
+
+func sortedInts(in []int) []int {
+	out := append([]int{}, in...)
+	sort.Ints(out)
+	return out
+}
+
+func sameStrings(a, b []string) bool {
+	return reflect.DeepEqual(sortedStrings(a), sortedStrings(b))
+}
+
+func sameInts(a, b []int) bool {
+	return reflect.DeepEqual(sortedInts(a), sortedInts(b))
+}
+
+// stringsDiff returns the strings to add to actual, and to remove from it,
+// to get desired.
+func stringsDiff(desired, actual []string) (add, remove []string) {
+	for _, s := range desired {
+		if !containsString(actual, s) {
+			add = append(add, s)
+		}
+	}
+	for _, s := range actual {
+		if !containsString(desired, s) {
+			remove = append(remove, s)
+		}
+	}
+
+	return add, remove
+}
+
+func containsString(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsInt(list []int, i int) bool {
+	for _, x := range list {
+		if x == i {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatList(list []string) string {
+	return "[" + strings.Join(list, " ") + "]"
+}
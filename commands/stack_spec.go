@@ -0,0 +1,349 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// StackSpec is the desired state of a set of resources, as read by plan
+// and apply. Resources are identified by name. Resources referring to each
+// other, such as a firewall applying to droplets, do so by name too.
+type StackSpec struct {
+	// Tags are created if missing. Droplets, volumes, firewalls and load
+	// balancers carrying one of them are managed by the stack, so they are
+	// deleted by --prune when they are not declared.
+	Tags          []string            `yaml:"tags,omitempty"`
+	Droplets      []StackDroplet      `yaml:"droplets,omitempty"`
+	Volumes       []StackVolume       `yaml:"volumes,omitempty"`
+	Firewalls     []StackFirewall     `yaml:"firewalls,omitempty"`
+	LoadBalancers []StackLoadBalancer `yaml:"load_balancers,omitempty"`
+	Domains       []StackDomain       `yaml:"domains,omitempty"`
+
+	// Certificates, Kubernetes clusters and databases are written by export.
+	// plan and apply don't manage them yet, though load balancers can refer
+	// to certificates by name.
+	Certificates       []StackCertificate       `yaml:"certificates,omitempty"`
+	KubernetesClusters []StackKubernetesCluster `yaml:"kubernetes_clusters,omitempty"`
+	Databases          []StackDatabase          `yaml:"databases,omitempty"`
+}
+
+// StackDroplet is a droplet of a stack.
+type StackDroplet struct {
+	Name              string   `yaml:"name"`
+	Region            string   `yaml:"region"`
+	Size              string   `yaml:"size"`
+	Image             string   `yaml:"image"`
+	SSHKeys           []string `yaml:"ssh_keys,omitempty"`
+	Backups           bool     `yaml:"backups,omitempty"`
+	IPv6              bool     `yaml:"ipv6,omitempty"`
+	PrivateNetworking bool     `yaml:"private_networking,omitempty"`
+	Monitoring        bool     `yaml:"monitoring,omitempty"`
+	UserData          string   `yaml:"user_data,omitempty"`
+	Tags              []string `yaml:"tags,omitempty"`
+	// Volumes are the names of the volumes attached to the droplet.
+	Volumes []string `yaml:"volumes,omitempty"`
+}
+
+// StackVolume is a block storage volume of a stack.
+type StackVolume struct {
+	Name   string `yaml:"name"`
+	Region string `yaml:"region"`
+	// Size is in GiB.
+	Size            int64    `yaml:"size"`
+	Description     string   `yaml:"description,omitempty"`
+	FilesystemType  string   `yaml:"filesystem_type,omitempty"`
+	FilesystemLabel string   `yaml:"filesystem_label,omitempty"`
+	Tags            []string `yaml:"tags,omitempty"`
+}
+
+// StackFirewall is a cloud firewall of a stack.
+type StackFirewall struct {
+	Name          string              `yaml:"name"`
+	InboundRules  []StackFirewallRule `yaml:"inbound_rules,omitempty"`
+	OutboundRules []StackFirewallRule `yaml:"outbound_rules,omitempty"`
+	Droplets      []string            `yaml:"droplets,omitempty"`
+	Tags          []string            `yaml:"tags,omitempty"`
+}
+
+// StackFirewallRule is an inbound or outbound firewall rule. Its addresses,
+// droplets, load balancers and tags are the sources of an inbound rule and
+// the destinations of an outbound one.
+type StackFirewallRule struct {
+	Protocol string `yaml:"protocol"`
+	// Ports is a port, a range such as 8000-9000, or all.
+	Ports         string   `yaml:"ports,omitempty"`
+	Addresses     []string `yaml:"addresses,omitempty"`
+	Droplets      []string `yaml:"droplets,omitempty"`
+	LoadBalancers []string `yaml:"load_balancers,omitempty"`
+	Tags          []string `yaml:"tags,omitempty"`
+}
+
+// StackLoadBalancer is a load balancer of a stack.
+type StackLoadBalancer struct {
+	Name                string                `yaml:"name"`
+	Region              string                `yaml:"region"`
+	Algorithm           string                `yaml:"algorithm,omitempty"`
+	ForwardingRules     []StackForwardingRule `yaml:"forwarding_rules"`
+	HealthCheck         *StackHealthCheck     `yaml:"health_check,omitempty"`
+	StickySessions      *StackStickySessions  `yaml:"sticky_sessions,omitempty"`
+	Droplets            []string              `yaml:"droplets,omitempty"`
+	Tag                 string                `yaml:"tag,omitempty"`
+	RedirectHTTPToHTTPS bool                  `yaml:"redirect_http_to_https,omitempty"`
+	EnableProxyProtocol bool                  `yaml:"enable_proxy_protocol,omitempty"`
+}
+
+// StackForwardingRule is a forwarding rule of a load balancer.
+type StackForwardingRule struct {
+	EntryProtocol  string `yaml:"entry_protocol"`
+	EntryPort      int    `yaml:"entry_port"`
+	TargetProtocol string `yaml:"target_protocol"`
+	TargetPort     int    `yaml:"target_port"`
+	// Certificate is the name of a certificate, an alternative to its ID.
+	Certificate    string `yaml:"certificate,omitempty"`
+	CertificateID  string `yaml:"certificate_id,omitempty"`
+	TLSPassthrough bool   `yaml:"tls_passthrough,omitempty"`
+}
+
+// StackHealthCheck is the health check of a load balancer. When it is
+// omitted, the API's default health check is kept.
+type StackHealthCheck struct {
+	Protocol               string `yaml:"protocol"`
+	Port                   int    `yaml:"port"`
+	Path                   string `yaml:"path,omitempty"`
+	CheckIntervalSeconds   int    `yaml:"check_interval_seconds,omitempty"`
+	ResponseTimeoutSeconds int    `yaml:"response_timeout_seconds,omitempty"`
+	HealthyThreshold       int    `yaml:"healthy_threshold,omitempty"`
+	UnhealthyThreshold     int    `yaml:"unhealthy_threshold,omitempty"`
+}
+
+// StackStickySessions configures the sticky sessions of a load balancer.
+// When it is omitted, the API's default of none is kept.
+type StackStickySessions struct {
+	Type             string `yaml:"type"`
+	CookieName       string `yaml:"cookie_name,omitempty"`
+	CookieTTLSeconds int    `yaml:"cookie_ttl_seconds,omitempty"`
+}
+
+// StackDomain is a domain of a stack and its DNS records. Records that are
+// not declared are deleted by --prune, except for the NS records of the
+// domain itself and its SOA record.
+type StackDomain struct {
+	Name    string        `yaml:"name"`
+	Records []StackRecord `yaml:"records,omitempty"`
+}
+
+// StackRecord is a DNS record. Its data is either given, or the public IPv4
+// address of a droplet or load balancer of the stack.
+type StackRecord struct {
+	Type         string `yaml:"type"`
+	Name         string `yaml:"name"`
+	Data         string `yaml:"data,omitempty"`
+	Droplet      string `yaml:"droplet,omitempty"`
+	LoadBalancer string `yaml:"load_balancer,omitempty"`
+	TTL          int    `yaml:"ttl,omitempty"`
+	Priority     int    `yaml:"priority,omitempty"`
+	Port         int    `yaml:"port,omitempty"`
+	Weight       int    `yaml:"weight,omitempty"`
+	Flags        int    `yaml:"flags,omitempty"`
+	Tag          string `yaml:"tag,omitempty"`
+}
+
+// StackCertificate is a TLS certificate. The private key of a custom
+// certificate can't be read back, so only its name and type are exported.
+type StackCertificate struct {
+	Name     string   `yaml:"name"`
+	Type     string   `yaml:"type"`
+	DNSNames []string `yaml:"dns_names,omitempty"`
+}
+
+// StackKubernetesCluster is a Kubernetes cluster and its node pools.
+type StackKubernetesCluster struct {
+	Name        string          `yaml:"name"`
+	Region      string          `yaml:"region"`
+	Version     string          `yaml:"version"`
+	AutoUpgrade bool            `yaml:"auto_upgrade,omitempty"`
+	Tags        []string        `yaml:"tags,omitempty"`
+	NodePools   []StackNodePool `yaml:"node_pools"`
+}
+
+// StackNodePool is a node pool of a Kubernetes cluster.
+type StackNodePool struct {
+	Name      string   `yaml:"name"`
+	Size      string   `yaml:"size"`
+	Count     int      `yaml:"count"`
+	Tags      []string `yaml:"tags,omitempty"`
+	AutoScale bool     `yaml:"auto_scale,omitempty"`
+	MinNodes  int      `yaml:"min_nodes,omitempty"`
+	MaxNodes  int      `yaml:"max_nodes,omitempty"`
+}
+
+// StackDatabase is a database cluster.
+type StackDatabase struct {
+	Name    string   `yaml:"name"`
+	Engine  string   `yaml:"engine"`
+	Version string   `yaml:"version,omitempty"`
+	Region  string   `yaml:"region"`
+	Size    string   `yaml:"size"`
+	Nodes   int      `yaml:"nodes"`
+	Tags    []string `yaml:"tags,omitempty"`
+}
+
+// readStackSpec reads a stack spec from a file, or from stdin when path is
+// -. Unknown keys are rejected, so typos don't go unnoticed.
+func readStackSpec(path string) (*StackSpec, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if path == "-" {
+		b, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		b, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := parseStackSpec(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return spec, nil
+}
+
+func parseStackSpec(b []byte) (*StackSpec, error) {
+	var spec StackSpec
+	if err := yaml.UnmarshalStrict(b, &spec); err != nil {
+		return nil, err
+	}
+
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+// validate checks that resources have the fields needed to create them
+// and unique names, and that records know where their data comes from.
+func (s *StackSpec) validate() error {
+	var errs []string
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf(format, args...))
+	}
+
+	seen := map[string]bool{}
+	unique := func(kind, name string) {
+		if name == "" {
+			fail("a %s has no name", kind)
+			return
+		}
+		if seen[kind+"/"+name] {
+			fail("%s %s is declared more than once", kind, name)
+		}
+		seen[kind+"/"+name] = true
+	}
+
+	for _, t := range s.Tags {
+		unique("tag", t)
+	}
+	for _, d := range s.Droplets {
+		unique("droplet", d.Name)
+		if d.Region == "" || d.Size == "" || d.Image == "" {
+			fail("droplet %s needs a region, size and image", d.Name)
+		}
+	}
+	for _, v := range s.Volumes {
+		unique("volume", v.Name)
+		if v.Region == "" || v.Size <= 0 {
+			fail("volume %s needs a region and size", v.Name)
+		}
+	}
+	for _, d := range s.Droplets {
+		for _, v := range d.Volumes {
+			if !seen["volume/"+v] {
+				fail("droplet %s attaches volume %s, which is not declared", d.Name, v)
+			}
+		}
+	}
+	for _, fw := range s.Firewalls {
+		unique("firewall", fw.Name)
+		for _, r := range append(append([]StackFirewallRule{}, fw.InboundRules...), fw.OutboundRules...) {
+			if r.Protocol == "" {
+				fail("a rule of firewall %s has no protocol", fw.Name)
+			}
+		}
+	}
+	for _, lb := range s.LoadBalancers {
+		unique("load balancer", lb.Name)
+		if lb.Region == "" || len(lb.ForwardingRules) == 0 {
+			fail("load balancer %s needs a region and forwarding rules", lb.Name)
+		}
+		if lb.Tag != "" && len(lb.Droplets) > 0 {
+			fail("load balancer %s has both droplets and a tag", lb.Name)
+		}
+		for _, r := range lb.ForwardingRules {
+			if r.Certificate != "" && r.CertificateID != "" {
+				fail("a forwarding rule of load balancer %s has both a certificate and a certificate_id", lb.Name)
+			}
+		}
+	}
+	for _, d := range s.Domains {
+		unique("domain", d.Name)
+		for _, r := range d.Records {
+			if r.Type == "" {
+				fail("a record of domain %s has no type", d.Name)
+			}
+
+			sources := 0
+			for _, source := range []string{r.Data, r.Droplet, r.LoadBalancer} {
+				if source != "" {
+					sources++
+				}
+			}
+			if sources != 1 {
+				fail("record %s %s of domain %s needs exactly one of data, droplet or load_balancer", r.Type, r.Name, d.Name)
+			}
+		}
+	}
+
+	for _, c := range s.Certificates {
+		unique("certificate", c.Name)
+	}
+	for _, k := range s.KubernetesClusters {
+		unique("kubernetes cluster", k.Name)
+		if k.Region == "" || k.Version == "" || len(k.NodePools) == 0 {
+			fail("kubernetes cluster %s needs a region, version and node pools", k.Name)
+		}
+	}
+	for _, db := range s.Databases {
+		unique("database", db.Name)
+		if db.Engine == "" || db.Region == "" || db.Size == "" || db.Nodes == 0 {
+			fail("database %s needs an engine, region, size and nodes", db.Name)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid stack spec:\n  %s", strings.Join(errs, "\n  "))
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStackSpec = `
+tags: [web]
+droplets:
+  - name: web-01
+    region: nyc3
+    size: s-1vcpu-1gb
+    image: ubuntu-18-04-x64
+    tags: [web]
+firewalls:
+  - name: web
+    droplets: [web-01]
+    inbound_rules:
+      - protocol: tcp
+        ports: "22"
+        addresses: [0.0.0.0/0]
+domains:
+  - name: example.com
+    records:
+      - type: A
+        name: www
+        droplet: web-01
+`
+
+func expectStackLists(tm *tcMocks, droplets do.Droplets, domains do.Domains) {
+	tm.tags.EXPECT().List(gomock.Any()).Return(do.Tags{}, nil)
+	tm.droplets.EXPECT().List(gomock.Any()).Return(droplets, nil)
+	tm.volumes.EXPECT().List(gomock.Any()).Return([]do.Volume{}, nil)
+	tm.firewalls.EXPECT().List(gomock.Any()).Return(do.Firewalls{}, nil)
+	tm.loadBalancers.EXPECT().List(gomock.Any()).Return(do.LoadBalancers{}, nil)
+	tm.domains.EXPECT().List(gomock.Any()).Return(domains, nil)
+}
+
+func stackChanges(plan *stackPlan) []string {
+	var changes []string
+	for _, c := range plan.changes {
+		changes = append(changes, c.Action+" "+c.Type+" "+c.Name)
+	}
+	return changes
+}
+
+func TestParseStackSpec(t *testing.T) {
+	spec, err := parseStackSpec([]byte(testStackSpec))
+	require.NoError(t, err)
+	assert.Equal(t, "web-01", spec.Droplets[0].Name)
+	assert.Equal(t, "web-01", spec.Domains[0].Records[0].Droplet)
+
+	_, err = parseStackSpec([]byte("droplet:\n  - name: web-01\n"))
+	assert.Error(t, err)
+
+	_, err = parseStackSpec([]byte(`
+droplets:
+  - name: web-01
+    region: nyc3
+  - name: web-01
+    region: nyc3
+    size: s-1vcpu-1gb
+    image: ubuntu-18-04-x64
+    volumes: [data]
+domains:
+  - name: example.com
+    records:
+      - type: A
+        name: www
+        data: 192.0.2.1
+        droplet: web-01
+`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "droplet web-01 needs a region, size and image")
+	assert.Contains(t, err.Error(), "droplet web-01 is declared more than once")
+	assert.Contains(t, err.Error(), "droplet web-01 attaches volume data, which is not declared")
+	assert.Contains(t, err.Error(), "record A www of domain example.com needs exactly one of data, droplet or load_balancer")
+}
+
+func TestStackPlanCreates(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		spec, err := parseStackSpec([]byte(testStackSpec))
+		require.NoError(t, err)
+
+		expectStackLists(tm, do.Droplets{}, do.Domains{})
+
+		plan, err := planStack(config, spec, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"create tag web",
+			"create droplet web-01",
+			"create firewall web",
+			"create domain example.com",
+			"create record A www.example.com",
+		}, stackChanges(plan))
+
+		tm.tags.EXPECT().Create(gomock.Any(), &godo.TagCreateRequest{Name: "web"}).Return(&do.Tag{Tag: &godo.Tag{Name: "web"}}, nil)
+		tm.droplets.EXPECT().Create(gomock.Any(), &godo.DropletCreateRequest{
+			Name:    "web-01",
+			Region:  "nyc3",
+			Size:    "s-1vcpu-1gb",
+			Image:   godo.DropletCreateImage{Slug: "ubuntu-18-04-x64"},
+			SSHKeys: []godo.DropletCreateSSHKey{},
+			Tags:    []string{"web"},
+		}, true).Return(&do.Droplet{Droplet: &godo.Droplet{
+			ID:   1,
+			Name: "web-01",
+			Networks: &godo.Networks{
+				V4: []godo.NetworkV4{{IPAddress: "192.0.2.1", Type: "public"}},
+			},
+		}}, nil)
+		tm.firewalls.EXPECT().Create(gomock.Any(), &godo.FirewallRequest{
+			Name: "web",
+			InboundRules: []godo.InboundRule{{
+				Protocol:  "tcp",
+				PortRange: "22",
+				Sources: &godo.Sources{
+					Addresses:        []string{"0.0.0.0/0"},
+					DropletIDs:       []int{},
+					LoadBalancerUIDs: []string{},
+				},
+			}},
+			OutboundRules: []godo.OutboundRule{},
+			DropletIDs:    []int{1},
+			Tags:          []string{},
+		}).Return(&do.Firewall{Firewall: &godo.Firewall{ID: "fw", Name: "web"}}, nil)
+		tm.domains.EXPECT().Create(gomock.Any(), &godo.DomainCreateRequest{Name: "example.com"}).Return(&do.Domain{Domain: &godo.Domain{Name: "example.com"}}, nil)
+		tm.domains.EXPECT().CreateRecord(gomock.Any(), "example.com", &do.DomainRecordEditRequest{
+			Type: "A",
+			Name: "www",
+			Data: "192.0.2.1",
+		}).Return(&do.DomainRecord{DomainRecord: &godo.DomainRecord{ID: 1}}, nil)
+
+		for _, c := range plan.changes {
+			require.NoError(t, c.apply(context.Background()), c.Name)
+		}
+	})
+}
+
+func TestStackPlanPrune(t *testing.T) {
+	spec, err := parseStackSpec([]byte(`
+tags: [web]
+droplets:
+  - name: web-01
+    region: nyc3
+    size: s-1vcpu-1gb
+    image: ubuntu-18-04-x64
+    tags: [web]
+`))
+	require.NoError(t, err)
+
+	droplets := do.Droplets{
+		{Droplet: &godo.Droplet{ID: 1, Name: "web-01", Region: &godo.Region{Slug: "nyc3"}, SizeSlug: "s-1vcpu-1gb", Tags: []string{"web"}}},
+		{Droplet: &godo.Droplet{ID: 2, Name: "web-02", Tags: []string{"web"}}},
+		{Droplet: &godo.Droplet{ID: 3, Name: "db-01", Tags: []string{"db"}}},
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		expectStackLists(tm, droplets, do.Domains{})
+
+		plan, err := planStack(config, spec, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"create tag web"}, stackChanges(plan))
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		expectStackLists(tm, droplets, do.Domains{})
+
+		plan, err := planStack(config, spec, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"create tag web", "delete droplet web-02"}, stackChanges(plan))
+
+		tm.droplets.EXPECT().Delete(gomock.Any(), 2).Return(nil)
+		assert.NoError(t, plan.changes[1].apply(context.Background()))
+	})
+}
+
+func TestStackPlanAmbiguousName(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		spec, err := parseStackSpec([]byte(testStackSpec))
+		require.NoError(t, err)
+
+		expectStackLists(tm, do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Name: "web-01"}},
+			{Droplet: &godo.Droplet{ID: 2, Name: "web-01"}},
+		}, do.Domains{})
+
+		_, err = planStack(config, spec, false)
+		assert.EqualError(t, err, "there are 2 droplets named web-01, names must be unique for a stack to manage them")
+	})
+}
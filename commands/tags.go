@@ -57,7 +57,7 @@ func RunCmdTagCreate(c *CmdConfig) error {
 	ts := c.Tags()
 
 	tcr := &godo.TagCreateRequest{Name: name}
-	t, err := ts.Create(tcr)
+	t, err := ts.Create(c.Ctx, tcr)
 	if err != nil {
 		return err
 	}
@@ -73,7 +73,7 @@ func RunCmdTagGet(c *CmdConfig) error {
 
 	name := c.Args[0]
 	ts := c.Tags()
-	t, err := ts.Get(name)
+	t, err := ts.Get(c.Ctx, name)
 	if err != nil {
 		return err
 	}
@@ -84,7 +84,7 @@ func RunCmdTagGet(c *CmdConfig) error {
 // RunCmdTagList runs tag list.
 func RunCmdTagList(c *CmdConfig) error {
 	ts := c.Tags()
-	tags, err := ts.List()
+	tags, err := ts.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -107,7 +107,7 @@ func RunCmdTagDelete(c *CmdConfig) error {
 		for id := range c.Args {
 			name := c.Args[id]
 			ts := c.Tags()
-			if err := ts.Delete(name); err != nil {
+			if err := ts.Delete(c.Ctx, name); err != nil {
 				return err
 			}
 		}
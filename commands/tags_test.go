@@ -20,6 +20,7 @@ import (
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,7 +52,7 @@ func TestTTagCommand(t *testing.T) {
 
 func TestTagGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.tags.EXPECT().Get("mytag").Return(&testTag, nil)
+		tm.tags.EXPECT().Get(gomock.Any(), "mytag").Return(&testTag, nil)
 
 		config.Args = append(config.Args, "mytag")
 
@@ -62,7 +63,7 @@ func TestTagGet(t *testing.T) {
 
 func TestTagList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.tags.EXPECT().List().Return(testTagList, nil)
+		tm.tags.EXPECT().List(gomock.Any()).Return(testTagList, nil)
 
 		err := RunCmdTagList(config)
 		assert.NoError(t, err)
@@ -72,7 +73,7 @@ func TestTagList(t *testing.T) {
 func TestTagCreate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tcr := godo.TagCreateRequest{Name: "new-tag"}
-		tm.tags.EXPECT().Create(&tcr).Return(&testTag, nil)
+		tm.tags.EXPECT().Create(gomock.Any(), &tcr).Return(&testTag, nil)
 		config.Args = append(config.Args, "new-tag")
 
 		err := RunCmdTagCreate(config)
@@ -82,7 +83,7 @@ func TestTagCreate(t *testing.T) {
 
 func TestTagDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.tags.EXPECT().Delete("my-tag").Return(nil)
+		tm.tags.EXPECT().Delete(gomock.Any(), "my-tag").Return(nil)
 		config.Args = append(config.Args, "my-tag")
 
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
@@ -94,8 +95,8 @@ func TestTagDelete(t *testing.T) {
 
 func TestTagDeleteMultiple(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.tags.EXPECT().Delete("my-tag").Return(nil)
-		tm.tags.EXPECT().Delete("my-tag-secondary").Return(nil)
+		tm.tags.EXPECT().Delete(gomock.Any(), "my-tag").Return(nil)
+		tm.tags.EXPECT().Delete(gomock.Any(), "my-tag-secondary").Return(nil)
 		config.Args = append(config.Args, "my-tag", "my-tag-secondary")
 
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
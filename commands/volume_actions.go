@@ -14,8 +14,6 @@ limitations under the License.
 package commands
 
 import (
-	"strconv"
-
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
 	"github.com/digitalocean/doctl/do"
@@ -38,7 +36,7 @@ func performVolumeAction(c *CmdConfig, fn volumeActionFn) error {
 	}
 
 	if wait {
-		a, err = actionWait(c, a.ID, 5)
+		a, err = actionWait(c, newWaiter("%s action %d", a.Type, a.ID), a.ID)
 		if err != nil {
 			return err
 		}
@@ -88,13 +86,16 @@ func RunVolumeAttach(c *CmdConfig) error {
 		if len(c.Args) != 2 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		volumeID := c.Args[0]
-		dropletID, err := strconv.Atoi(c.Args[1])
+		volumeID, err := volumeResolver(c).resolve(c.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		dropletID, err := dropletResolver(c).resolveInt(c.Args[1])
 		if err != nil {
 			return nil, err
 
 		}
-		a, err := das.Attach(volumeID, dropletID)
+		a, err := das.Attach(c.Ctx, volumeID, dropletID)
 		return a, err
 	}
 	return performVolumeAction(c, fn)
@@ -106,12 +107,15 @@ func RunVolumeDetach(c *CmdConfig) error {
 		if len(c.Args) != 2 {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
-		volumeID := c.Args[0]
-		dropletID, err := strconv.Atoi(c.Args[1])
+		volumeID, err := volumeResolver(c).resolve(c.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		dropletID, err := dropletResolver(c).resolveInt(c.Args[1])
 		if err != nil {
 			return nil, err
 		}
-		a, err := das.Detach(volumeID, dropletID)
+		a, err := das.Detach(c.Ctx, volumeID, dropletID)
 		return a, err
 	}
 	return performVolumeAction(c, fn)
@@ -124,7 +128,10 @@ func RunVolumeResize(c *CmdConfig) error {
 			return nil, doctl.NewMissingArgsErr(c.NS)
 		}
 
-		volumeID := c.Args[0]
+		volumeID, err := volumeResolver(c).resolve(c.Args[0])
+		if err != nil {
+			return nil, err
+		}
 
 		size, err := c.Doit.GetInt(c.NS, doctl.ArgSizeSlug)
 		if err != nil {
@@ -136,7 +143,7 @@ func RunVolumeResize(c *CmdConfig) error {
 			return nil, err
 		}
 
-		a, err := das.Resize(volumeID, size, region)
+		a, err := das.Resize(c.Ctx, volumeID, size, region)
 		return a, err
 	}
 	return performVolumeAction(c, fn)
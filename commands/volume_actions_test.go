@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,7 +30,7 @@ func TestVolumeActionCommand(t *testing.T) {
 
 func TestVolumeActionsAttach(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumeActions.EXPECT().Attach(testVolume.ID, testDroplet.ID).Return(&testAction, nil)
+		tm.volumeActions.EXPECT().Attach(gomock.Any(), testVolume.ID, testDroplet.ID).Return(&testAction, nil)
 		config.Args = append(config.Args, testVolume.ID)
 		config.Args = append(config.Args, fmt.Sprintf("%d", testDroplet.ID))
 
@@ -38,8 +39,8 @@ func TestVolumeActionsAttach(t *testing.T) {
 	})
 
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumeActions.EXPECT().Attach(testVolume.ID, testDroplet.ID).Return(&testAction, nil)
-		tm.actions.EXPECT().Get(1).Return(&testAction, nil)
+		tm.volumeActions.EXPECT().Attach(gomock.Any(), testVolume.ID, testDroplet.ID).Return(&testAction, nil)
+		tm.actions.EXPECT().Get(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, testVolume.ID)
 		config.Args = append(config.Args, fmt.Sprintf("%d", testDroplet.ID))
@@ -52,7 +53,7 @@ func TestVolumeActionsAttach(t *testing.T) {
 
 func TestVolumeDetach(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumeActions.EXPECT().Detach(testVolume.ID, testDroplet.ID).Return(&testAction, nil)
+		tm.volumeActions.EXPECT().Detach(gomock.Any(), testVolume.ID, testDroplet.ID).Return(&testAction, nil)
 		config.Args = append(config.Args, testVolume.ID)
 		config.Args = append(config.Args, fmt.Sprintf("%d", testDroplet.ID))
 
@@ -61,8 +62,8 @@ func TestVolumeDetach(t *testing.T) {
 	})
 
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumeActions.EXPECT().Detach(testVolume.ID, testDroplet.ID).Return(&testAction, nil)
-		tm.actions.EXPECT().Get(1).Return(&testAction, nil)
+		tm.volumeActions.EXPECT().Detach(gomock.Any(), testVolume.ID, testDroplet.ID).Return(&testAction, nil)
+		tm.actions.EXPECT().Get(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, testVolume.ID)
 		config.Args = append(config.Args, fmt.Sprintf("%d", testDroplet.ID))
@@ -75,7 +76,7 @@ func TestVolumeDetach(t *testing.T) {
 
 func TestVolumeResize(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumeActions.EXPECT().Resize(testVolume.ID, 150, "dev0").Return(&testAction, nil)
+		tm.volumeActions.EXPECT().Resize(gomock.Any(), testVolume.ID, 150, "dev0").Return(&testAction, nil)
 		config.Args = append(config.Args, testVolume.ID)
 
 		config.Doit.Set(config.NS, doctl.ArgSizeSlug, 150)
@@ -86,8 +87,8 @@ func TestVolumeResize(t *testing.T) {
 	})
 
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumeActions.EXPECT().Resize(testVolume.ID, 150, "dev0").Return(&testAction, nil)
-		tm.actions.EXPECT().Get(1).Return(&testAction, nil)
+		tm.volumeActions.EXPECT().Resize(gomock.Any(), testVolume.ID, 150, "dev0").Return(&testAction, nil)
+		tm.actions.EXPECT().Get(gomock.Any(), 1).Return(&testAction, nil)
 
 		config.Args = append(config.Args, testVolume.ID)
 
@@ -87,7 +87,7 @@ func RunVolumeList(c *CmdConfig) error {
 		matches = append(matches, g)
 	}
 
-	list, err := al.List()
+	list, err := al.List(c.Ctx)
 	if err != nil {
 		return err
 	}
@@ -186,7 +186,7 @@ func RunVolumeCreate(c *CmdConfig) error {
 
 	al := c.Volumes()
 
-	d, err := al.CreateVolume(&createVolume)
+	d, err := al.CreateVolume(c.Ctx, &createVolume)
 	if err != nil {
 		return err
 	}
@@ -207,9 +207,13 @@ func RunVolumeDelete(c *CmdConfig) error {
 		return err
 	}
 
+	id, err := volumeResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
+
 	if force || AskForConfirm("delete volume") == nil {
-		id := c.Args[0]
-		return c.Volumes().DeleteVolume(id)
+		return c.Volumes().DeleteVolume(c.Ctx, id)
 	}
 	return fmt.Errorf("operation aborted")
 }
@@ -220,9 +224,12 @@ func RunVolumeGet(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 
 	}
-	id := c.Args[0]
+	id, err := volumeResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 	al := c.Volumes()
-	d, err := al.Get(id)
+	d, err := al.Get(c.Ctx, id)
 	if err != nil {
 		return err
 	}
@@ -237,7 +244,10 @@ func RunVolumeSnapshot(c *CmdConfig) error {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
-	id := c.Args[0]
+	id, err := volumeResolver(c).resolve(c.Args[0])
+	if err != nil {
+		return err
+	}
 
 	name, err := c.Doit.GetString(c.NS, doctl.ArgSnapshotName)
 	if err != nil {
@@ -261,6 +271,6 @@ func RunVolumeSnapshot(c *CmdConfig) error {
 		Tags:        tags,
 	}
 
-	_, err = c.Volumes().CreateSnapshot(req)
+	_, err = c.Volumes().CreateSnapshot(c.Ctx, req)
 	return err
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,9 +46,21 @@ func TestVolumeCommand(t *testing.T) {
 
 func TestVolumesGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumes.EXPECT().Get("test-volume").Return(&testVolume, nil)
+		tm.volumes.EXPECT().Get(gomock.Any(), testVolume.ID).Return(&testVolume, nil)
 
-		config.Args = append(config.Args, "test-volume")
+		config.Args = append(config.Args, testVolume.ID)
+
+		err := RunVolumeGet(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestVolumesGetByName(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.volumes.EXPECT().List(gomock.Any()).Return(testVolumeList, nil)
+		tm.volumes.EXPECT().Get(gomock.Any(), testVolume.ID).Return(&testVolume, nil)
+
+		config.Args = append(config.Args, testVolume.Name)
 
 		err := RunVolumeGet(config)
 		assert.NoError(t, err)
@@ -56,7 +69,7 @@ func TestVolumesGet(t *testing.T) {
 
 func TestVolumesList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumes.EXPECT().List().Return(testVolumeList, nil)
+		tm.volumes.EXPECT().List(gomock.Any()).Return(testVolumeList, nil)
 
 		err := RunVolumeList(config)
 		assert.NoError(t, err)
@@ -65,7 +78,7 @@ func TestVolumesList(t *testing.T) {
 
 func TestVolumesListID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumes.EXPECT().List().Return(testVolumeList, nil)
+		tm.volumes.EXPECT().List(gomock.Any()).Return(testVolumeList, nil)
 
 		config.Args = append(config.Args, testVolume.ID)
 
@@ -76,7 +89,7 @@ func TestVolumesListID(t *testing.T) {
 
 func TestVolumesListName(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumes.EXPECT().List().Return(testVolumeList, nil)
+		tm.volumes.EXPECT().List(gomock.Any()).Return(testVolumeList, nil)
 
 		config.Args = append(config.Args, "test-volume")
 
@@ -94,7 +107,7 @@ func TestVolumeCreate(t *testing.T) {
 			Description:   "test description",
 			Tags:          []string{"one", "two"},
 		}
-		tm.volumes.EXPECT().CreateVolume(&tcr).Return(&testVolume, nil)
+		tm.volumes.EXPECT().CreateVolume(gomock.Any(), &tcr).Return(&testVolume, nil)
 
 		config.Args = append(config.Args, "test-volume")
 
@@ -117,7 +130,7 @@ func TestVolumeCreateFromSnapshot(t *testing.T) {
 			Description:   "test description",
 			Tags:          []string{"one", "two"},
 		}
-		tm.volumes.EXPECT().CreateVolume(&tcr).Return(&testVolume, nil)
+		tm.volumes.EXPECT().CreateVolume(gomock.Any(), &tcr).Return(&testVolume, nil)
 
 		config.Args = append(config.Args, "test-volume")
 
@@ -133,9 +146,9 @@ func TestVolumeCreateFromSnapshot(t *testing.T) {
 
 func TestVolumesDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.volumes.EXPECT().DeleteVolume("test-volume").Return(nil)
+		tm.volumes.EXPECT().DeleteVolume(gomock.Any(), testVolume.ID).Return(nil)
 
-		config.Args = append(config.Args, "test-volume")
+		config.Args = append(config.Args, testVolume.ID)
 
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
 
@@ -152,7 +165,7 @@ func TestVolumesSnapshot(t *testing.T) {
 			Description: "test description",
 			Tags:        []string{"one", "two"},
 		}
-		tm.volumes.EXPECT().CreateSnapshot(&tcr).Return(nil, nil)
+		tm.volumes.EXPECT().CreateSnapshot(gomock.Any(), &tcr).Return(nil, nil)
 
 		config.Args = append(config.Args, testVolume.ID)
 		config.Doit.Set(config.NS, doctl.ArgSnapshotName, "test-volume-snapshot")
@@ -0,0 +1,208 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/digitalocean/doctl"
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var (
+	// waitInterval is how long a waiter waits before polling again at first.
+	// The interval doubles after each poll, up to maxWaitInterval.
+	waitInterval    = time.Second
+	maxWaitInterval = 16 * time.Second
+
+	// spinnerInterval is how often the spinner shown on terminals turns.
+	spinnerInterval = 100 * time.Millisecond
+	spinnerFrames   = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+)
+
+// pollFunc polls an operation being waited for, returning its status and
+// whether it's over. An operation over with an error failed, while transient
+// errors of operations which aren't over are retried, up to maxAPIFailures in
+// a row.
+type pollFunc func(ctx context.Context) (status string, done bool, err error)
+
+// waiter waits for a long-running operation, polling it with exponential
+// backoff. It shows a spinner on terminals and a line per status change
+// otherwise, unless quiet.
+type waiter struct {
+	// what is waited for, as shown in progress and errors.
+	what string
+	// timeout is how long waiting may take, 0 meaning no limit.
+	timeout time.Duration
+	// interval is the first interval between polls, doubling up to
+	// maxInterval.
+	interval, maxInterval time.Duration
+	quiet, tty            bool
+	out                   io.Writer
+
+	start   time.Time
+	status  string
+	spinned bool
+}
+
+// newWaiter returns a waiter for what, set up by the global --wait-timeout
+// and --quiet flags.
+func newWaiter(format string, a ...interface{}) *waiter {
+	return &waiter{
+		what:        fmt.Sprintf(format, a...),
+		timeout:     viper.GetDuration(doctl.ArgWaitTimeout),
+		interval:    waitInterval,
+		maxInterval: maxWaitInterval,
+		quiet:       viper.GetBool(doctl.ArgQuiet),
+		tty:         terminal.IsTerminal(int(os.Stderr.Fd())),
+		out:         os.Stderr,
+	}
+}
+
+// wait polls until the operation is over, returning the error it failed
+// with, or an error when waiting times out.
+func (w *waiter) wait(ctx context.Context, poll pollFunc) error {
+	if doctl.DryRunIntercepted(ctx) {
+		// Nothing was changed, there is nothing to wait for.
+		return nil
+	}
+
+	parent := ctx
+	if w.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.timeout)
+		defer cancel()
+	}
+
+	w.start = time.Now()
+	defer w.clear()
+
+	interval := w.interval
+	failures := 0
+	for {
+		status, done, err := poll(ctx)
+		switch {
+		case done:
+			return err
+		case ctx.Err() != nil:
+			return w.canceled(parent, ctx)
+		case err != nil && !transientErr(err):
+			return err
+		case err != nil:
+			// Allow for transient API failures, polling again soon.
+			failures++
+			if failures >= maxAPIFailures {
+				return err
+			}
+		default:
+			failures = 0
+			w.report(status)
+		}
+
+		if err := w.sleep(ctx, interval); err != nil {
+			return w.canceled(parent, ctx)
+		}
+
+		if failures == 0 {
+			interval *= 2
+			if interval > w.maxInterval {
+				interval = w.maxInterval
+			}
+		}
+	}
+}
+
+// transientErr reports whether polling again may succeed after err: network
+// errors, rate limiting and server errors, as retried by the API client.
+func transientErr(err error) bool {
+	er, status := apiErrorStatus(err)
+	if er == nil {
+		return true
+	}
+	return status == http.StatusTooManyRequests ||
+		status >= 500 && status != http.StatusNotImplemented
+}
+
+// canceled returns why ctx, derived from parent, is done.
+func (w *waiter) canceled(parent, ctx context.Context) error {
+	if parent.Err() == nil && ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("timed out after %s waiting for %s", w.timeout, w.what)
+	}
+	return ctx.Err()
+}
+
+// sleep waits for d, turning the spinner meanwhile.
+func (w *waiter) sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	var spin <-chan time.Time
+	if w.tty && !w.quiet {
+		ticker := time.NewTicker(spinnerInterval)
+		defer ticker.Stop()
+		spin = ticker.C
+		w.spin()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return nil
+		case <-spin:
+			w.spin()
+		}
+	}
+}
+
+// report shows a new status of the operation on other outputs than
+// terminals, which show it along the spinner.
+func (w *waiter) report(status string) {
+	if status == w.status {
+		return
+	}
+	w.status = status
+
+	if w.quiet || w.tty || status == "" {
+		return
+	}
+	fmt.Fprintf(w.out, "waiting for %s: %s\n", w.what, status)
+}
+
+func (w *waiter) spin() {
+	elapsed := time.Since(w.start)
+	frame := spinnerFrames[int(elapsed/spinnerInterval)%len(spinnerFrames)]
+
+	line := fmt.Sprintf("%s waiting for %s", frame, w.what)
+	if w.status != "" {
+		line += ": " + w.status
+	}
+	fmt.Fprintf(w.out, "\r%s (%s)\033[K", line, elapsed.Round(time.Second))
+	w.spinned = true
+}
+
+// clear erases the spinner.
+func (w *waiter) clear() {
+	if w.spinned {
+		fmt.Fprint(w.out, "\r\033[K")
+		w.spinned = false
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWaiter(out *bytes.Buffer) *waiter {
+	return &waiter{
+		what:        "widget 1",
+		interval:    time.Millisecond,
+		maxInterval: 4 * time.Millisecond,
+		out:         out,
+	}
+}
+
+// shortWaits makes waiters poll without delay until the returned func is
+// called.
+func shortWaits() func() {
+	interval, max := waitInterval, maxWaitInterval
+	waitInterval, maxWaitInterval = time.Millisecond, time.Millisecond
+	return func() { waitInterval, maxWaitInterval = interval, max }
+}
+
+func TestWaiter(t *testing.T) {
+	var out bytes.Buffer
+	w := testWaiter(&out)
+
+	var polls []time.Time
+	statuses := []string{"new", "new", "building", "active"}
+	err := w.wait(context.Background(), func(ctx context.Context) (string, bool, error) {
+		polls = append(polls, time.Now())
+		status := statuses[len(polls)-1]
+		return status, status == "active", nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, polls, 4)
+	assert.Equal(t, "waiting for widget 1: new\nwaiting for widget 1: building\n", out.String())
+
+	// The interval doubles between polls.
+	assert.True(t, polls[3].Sub(polls[2]) >= 4*time.Millisecond)
+}
+
+func TestWaiterFailures(t *testing.T) {
+	var out bytes.Buffer
+	w := testWaiter(&out)
+	w.quiet = true
+
+	polls := 0
+	err := w.wait(context.Background(), func(ctx context.Context) (string, bool, error) {
+		polls++
+		if polls < 3 {
+			return "", false, errors.New("bad gateway")
+		}
+		return "errored", true, errors.New("widget 1 errored")
+	})
+	assert.EqualError(t, err, "widget 1 errored")
+	assert.Equal(t, 3, polls)
+	assert.Empty(t, out.String())
+
+	polls = 0
+	err = w.wait(context.Background(), func(ctx context.Context) (string, bool, error) {
+		polls++
+		return "", false, errors.New("bad gateway")
+	})
+	assert.EqualError(t, err, "bad gateway")
+	assert.Equal(t, maxAPIFailures, polls)
+	for _, code := range []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound, http.StatusNotImplemented} {
+		polls = 0
+		apiErr := &godo.ErrorResponse{Response: &http.Response{StatusCode: code}, Message: http.StatusText(code)}
+		err = w.wait(context.Background(), func(ctx context.Context) (string, bool, error) {
+			polls++
+			return "", false, apiErr
+		})
+		assert.Equal(t, apiErr, err, code)
+		assert.Equal(t, 1, polls, code)
+	}
+
+	for _, code := range []int{http.StatusTooManyRequests, http.StatusBadGateway} {
+		polls = 0
+		err = w.wait(context.Background(), func(ctx context.Context) (string, bool, error) {
+			polls++
+			if polls < 3 {
+				return "", false, &godo.ErrorResponse{Response: &http.Response{StatusCode: code}}
+			}
+			return "active", true, nil
+		})
+		assert.NoError(t, err, code)
+		assert.Equal(t, 3, polls, code)
+	}
+}
+
+func TestWaiterTimeout(t *testing.T) {
+	var out bytes.Buffer
+	w := testWaiter(&out)
+	w.timeout = 20 * time.Millisecond
+
+	err := w.wait(context.Background(), func(ctx context.Context) (string, bool, error) {
+		return "new", false, nil
+	})
+	assert.EqualError(t, err, "timed out after 20ms waiting for widget 1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = w.wait(ctx, func(ctx context.Context) (string, bool, error) {
+		return "new", false, nil
+	})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestWaiterSpinner(t *testing.T) {
+	var out bytes.Buffer
+	w := testWaiter(&out)
+	w.tty = true
+
+	polls := 0
+	err := w.wait(context.Background(), func(ctx context.Context) (string, bool, error) {
+		polls++
+		return "new", polls == 2, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "\r⠋ waiting for widget 1: new (0s)\033[K\r\033[K", out.String())
+}
@@ -30,8 +30,8 @@ type RateLimit struct {
 
 // AccountService is an interface for interacting with DigitalOcean's account api.
 type AccountService interface {
-	Get() (*Account, error)
-	RateLimit() (*RateLimit, error)
+	Get(context.Context) (*Account, error)
+	RateLimit(context.Context) (*RateLimit, error)
 }
 
 type accountService struct {
@@ -47,8 +47,8 @@ func NewAccountService(godoClient *godo.Client) AccountService {
 	}
 }
 
-func (as *accountService) Get() (*Account, error) {
-	godoAccount, _, err := as.client.Account.Get(context.TODO())
+func (as *accountService) Get(ctx context.Context) (*Account, error) {
+	godoAccount, _, err := as.client.Account.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +57,8 @@ func (as *accountService) Get() (*Account, error) {
 	return account, nil
 }
 
-func (as *accountService) RateLimit() (*RateLimit, error) {
-	_, resp, err := as.client.Account.Get(context.TODO())
+func (as *accountService) RateLimit(ctx context.Context) (*RateLimit, error) {
+	_, resp, err := as.client.Account.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +70,7 @@ func TestAccountServiceGet(t *testing.T) {
 	}
 	as := NewAccountService(client)
 
-	account, err := as.Get()
+	account, err := as.Get(context.TODO())
 	assert.NoError(t, err)
 	assert.Equal(t, "uuid", account.UUID)
 }
@@ -29,8 +29,9 @@ type Actions []Action
 
 // ActionsService is an interface for interacting with DigitalOcean's action api.
 type ActionsService interface {
-	List() (Actions, error)
-	Get(int) (*Action, error)
+	List(context.Context) (Actions, error)
+	ListPages(context.Context, func(Actions) error) error
+	Get(context.Context, int) (*Action, error)
 }
 
 type actionsService struct {
@@ -46,9 +47,24 @@ func NewActionsService(godoClient *godo.Client) ActionsService {
 	}
 }
 
-func (as *actionsService) List() (Actions, error) {
+func (as *actionsService) List(ctx context.Context) (Actions, error) {
+	list := Actions{}
+	err := as.ListPages(ctx, func(page Actions) error {
+		list = append(list, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// ListPages calls fn with each page of actions as it arrives, the most
+// recent first.
+func (as *actionsService) ListPages(ctx context.Context, fn func(Actions) error) error {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := as.client.Actions.List(context.TODO(), opt)
+		list, resp, err := as.client.Actions.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -61,22 +77,19 @@ func (as *actionsService) List() (Actions, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
-	if err != nil {
-		return nil, err
-	}
-
-	list := make(Actions, len(si))
-	for i := range si {
-		a := si[i].(godo.Action)
-		list[i] = Action{Action: &a}
-	}
+	return PaginateEach(ctx, f, func(si []interface{}) error {
+		list := make(Actions, len(si))
+		for i := range si {
+			a := si[i].(godo.Action)
+			list[i] = Action{Action: &a}
+		}
 
-	return list, nil
+		return fn(list)
+	})
 }
 
-func (as *actionsService) Get(id int) (*Action, error) {
-	a, _, err := as.client.Actions.Get(context.TODO(), id)
+func (as *actionsService) Get(ctx context.Context, id int) (*Action, error) {
+	a, _, err := as.client.Actions.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,200 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// Cache keeps API responses which rarely change, such as the regions and
+// sizes, on disk as JSON. Services opt into it with their NewCached
+// constructors. A nil Cache caches nothing.
+type Cache struct {
+	// Dir is the directory entries are kept in.
+	Dir string
+	// Key separates the entries of accounts and API endpoints.
+	Key string
+	// TTL is how long entries are served without asking the API.
+	TTL time.Duration
+	// Stale is called when an entry older than TTL is served because the
+	// API couldn't be reached.
+	Stale func(name string, age time.Duration, err error)
+
+	now func() time.Time
+}
+
+// cacheEntry is a value cached on disk.
+type cacheEntry struct {
+	Created time.Time       `json:"created"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// NewCache builds a Cache keeping entries of the authentication context
+// and API URL in dir for ttl.
+func NewCache(dir, context, apiURL string, ttl time.Duration) *Cache {
+	sum := sha256.Sum256([]byte(context + "\x00" + apiURL))
+	return &Cache{
+		Dir: dir,
+		Key: hex.EncodeToString(sum[:8]),
+		TTL: ttl,
+	}
+}
+
+func (c *Cache) path(name string) string {
+	return filepath.Join(c.Dir, c.Key, name+".json")
+}
+
+func (c *Cache) since(t time.Time) time.Duration {
+	if c.now != nil {
+		return c.now().Sub(t)
+	}
+	return time.Since(t)
+}
+
+// Read reads the entry cached under name into v, unless it is older than
+// maxAge. A maxAge of zero reads entries of any age. It reports whether an
+// entry was read and when it was created.
+func (c *Cache) Read(name string, maxAge time.Duration, v interface{}) (time.Time, bool) {
+	if c == nil || c.Dir == "" {
+		return time.Time{}, false
+	}
+
+	b, err := ioutil.ReadFile(c.path(name))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return time.Time{}, false
+	}
+	if maxAge > 0 && c.since(entry.Created) > maxAge {
+		return time.Time{}, false
+	}
+	if err := json.Unmarshal(entry.Value, v); err != nil {
+		return time.Time{}, false
+	}
+
+	return entry.Created, true
+}
+
+// Write caches v under name.
+func (c *Cache) Write(name string, v interface{}) error {
+	if c == nil || c.Dir == "" {
+		return nil
+	}
+
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	created := time.Now()
+	if c.now != nil {
+		created = c.now()
+	}
+	b, err := json.Marshal(cacheEntry{Created: created, Value: value})
+	if err != nil {
+		return err
+	}
+
+	path := c.path(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	// Concurrent commands may write the same entry, so it is written next
+	// to its destination and then renamed.
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Fetch reads the entry cached under name into v if it is fresh, and calls
+// fetch to fill v from the API otherwise, caching the result. When the API
+// can't be reached, a stale entry is served instead.
+func (c *Cache) Fetch(name string, v interface{}, fetch func() error) error {
+	if c == nil {
+		return fetch()
+	}
+
+	if _, ok := c.Read(name, c.TTL, v); ok {
+		return nil
+	}
+
+	err := fetch()
+	if err == nil {
+		// Failing to cache doesn't fail the request.
+		c.Write(name, v)
+		return nil
+	}
+	if !unreachable(err) {
+		return err
+	}
+
+	created, ok := c.Read(name, 0, v)
+	if !ok {
+		return err
+	}
+	if c.Stale != nil {
+		c.Stale(name, c.since(created), err)
+	}
+
+	return nil
+}
+
+// Clear removes the entries of every account and API endpoint.
+func (c *Cache) Clear() error {
+	if c == nil || c.Dir == "" {
+		return nil
+	}
+	return os.RemoveAll(c.Dir)
+}
+
+// unreachable reports whether err tells the API couldn't be reached or
+// failed to answer, rather than refused the request.
+func unreachable(err error) bool {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	var respErr *godo.ErrorResponse
+	if errors.As(err, &respErr) {
+		return respErr.Response != nil && respErr.Response.StatusCode >= 500
+	}
+
+	return false
+}
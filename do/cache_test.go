@@ -0,0 +1,134 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRegionsService lists regions, or fails with err.
+type fakeRegionsService struct {
+	regions Regions
+	err     error
+	calls   int
+}
+
+func (rs *fakeRegionsService) List(ctx context.Context) (Regions, error) {
+	rs.calls++
+	if rs.err != nil {
+		return nil, rs.err
+	}
+	return rs.regions[:limitedLen(ctx, len(rs.regions))], nil
+}
+
+func TestCachedRegionsService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "doctl-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	cache := NewCache(dir, "default", "https://api.digitalocean.com/", time.Hour)
+	cache.now = func() time.Time { return now }
+	var stale []string
+	cache.Stale = func(name string, age time.Duration, err error) {
+		stale = append(stale, name)
+	}
+
+	fake := &fakeRegionsService{regions: Regions{{Region: &godo.Region{Slug: "nyc1", Available: true}}}}
+	rs := NewCachedRegionsService(fake, cache)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		list, err := rs.List(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, fake.regions, list)
+	}
+	assert.Equal(t, 1, fake.calls)
+
+	// Other accounts don't share entries.
+	other := NewCachedRegionsService(fake, NewCache(dir, "other", "https://api.digitalocean.com/", time.Hour))
+	_, err = other.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.calls)
+
+	// Stale entries are served when the API is unreachable.
+	now = now.Add(2 * time.Hour)
+	want := fake.regions
+	fake.regions, fake.err = nil, &url.Error{Op: "Get", URL: "https://api.digitalocean.com/v2/regions", Err: errors.New("connection refused")}
+	list, err := rs.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, list)
+	assert.Equal(t, []string{"regions"}, stale)
+	assert.Equal(t, 3, fake.calls)
+
+	// Refused requests fail.
+	fake.err = &godo.ErrorResponse{Response: &http.Response{StatusCode: http.StatusUnauthorized}, Message: "Unable to authenticate you"}
+	_, err = rs.List(ctx)
+	assert.Equal(t, fake.err, err)
+
+	require.NoError(t, cache.Clear())
+	fake.err = &url.Error{Op: "Get", URL: "https://api.digitalocean.com/v2/regions", Err: errors.New("connection refused")}
+	_, err = rs.List(ctx)
+	assert.Equal(t, fake.err, err)
+}
+
+func TestCachedRegionsServiceLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "doctl-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fake := &fakeRegionsService{regions: Regions{
+		{Region: &godo.Region{Slug: "nyc1"}},
+		{Region: &godo.Region{Slug: "sfo1"}},
+	}}
+	rs := NewCachedRegionsService(fake, NewCache(dir, "default", "https://api.digitalocean.com/", time.Hour))
+	limited := WithListLimit(context.Background(), 1)
+
+	list, err := rs.List(limited)
+	require.NoError(t, err)
+	assert.Equal(t, fake.regions[:1], list)
+
+	// The whole list was cached.
+	list, err = rs.List(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, fake.regions, list)
+
+	list, err = rs.List(limited)
+	require.NoError(t, err)
+	assert.Equal(t, fake.regions[:1], list)
+	assert.Equal(t, 1, fake.calls)
+}
+
+func TestNilCache(t *testing.T) {
+	fake := &fakeRegionsService{regions: Regions{{Region: &godo.Region{Slug: "nyc1"}}}}
+	rs := NewCachedRegionsService(fake, nil)
+
+	for i := 0; i < 2; i++ {
+		list, err := rs.List(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, fake.regions, list)
+	}
+	assert.Equal(t, 2, fake.calls)
+}
@@ -13,13 +13,13 @@ type CDN struct {
 
 // CDNsService is an interface for interacting with DigitalOcean's CDN api.
 type CDNsService interface {
-	List() ([]CDN, error)
-	Get(string) (*CDN, error)
-	Create(*godo.CDNCreateRequest) (*CDN, error)
-	UpdateTTL(string, *godo.CDNUpdateTTLRequest) (*CDN, error)
-	UpdateCustomDomain(string, *godo.CDNUpdateCustomDomainRequest) (*CDN, error)
-	FlushCache(string, *godo.CDNFlushCacheRequest) error
-	Delete(string) error
+	List(context.Context) ([]CDN, error)
+	Get(context.Context, string) (*CDN, error)
+	Create(context.Context, *godo.CDNCreateRequest) (*CDN, error)
+	UpdateTTL(context.Context, string, *godo.CDNUpdateTTLRequest) (*CDN, error)
+	UpdateCustomDomain(context.Context, string, *godo.CDNUpdateCustomDomainRequest) (*CDN, error)
+	FlushCache(context.Context, string, *godo.CDNFlushCacheRequest) error
+	Delete(context.Context, string) error
 }
 
 type cdnsService struct {
@@ -33,9 +33,9 @@ func NewCDNsService(godoClient *godo.Client) CDNsService {
 	}
 }
 
-func (c *cdnsService) List() ([]CDN, error) {
+func (c *cdnsService) List(ctx context.Context) ([]CDN, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := c.client.CDNs.List(context.TODO(), opt)
+		list, resp, err := c.client.CDNs.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -48,7 +48,7 @@ func (c *cdnsService) List() ([]CDN, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +61,8 @@ func (c *cdnsService) List() ([]CDN, error) {
 	return list, nil
 }
 
-func (c *cdnsService) Get(id string) (*CDN, error) {
-	cdn, _, err := c.client.CDNs.Get(context.TODO(), id)
+func (c *cdnsService) Get(ctx context.Context, id string) (*CDN, error) {
+	cdn, _, err := c.client.CDNs.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -70,8 +70,8 @@ func (c *cdnsService) Get(id string) (*CDN, error) {
 	return &CDN{CDN: cdn}, nil
 }
 
-func (c *cdnsService) Create(req *godo.CDNCreateRequest) (*CDN, error) {
-	cdn, _, err := c.client.CDNs.Create(context.TODO(), req)
+func (c *cdnsService) Create(ctx context.Context, req *godo.CDNCreateRequest) (*CDN, error) {
+	cdn, _, err := c.client.CDNs.Create(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +79,8 @@ func (c *cdnsService) Create(req *godo.CDNCreateRequest) (*CDN, error) {
 	return &CDN{CDN: cdn}, nil
 }
 
-func (c *cdnsService) UpdateTTL(id string, req *godo.CDNUpdateTTLRequest) (*CDN, error) {
-	cdn, _, err := c.client.CDNs.UpdateTTL(context.TODO(), id, req)
+func (c *cdnsService) UpdateTTL(ctx context.Context, id string, req *godo.CDNUpdateTTLRequest) (*CDN, error) {
+	cdn, _, err := c.client.CDNs.UpdateTTL(ctx, id, req)
 	if err != nil {
 		return nil, err
 	}
@@ -88,8 +88,8 @@ func (c *cdnsService) UpdateTTL(id string, req *godo.CDNUpdateTTLRequest) (*CDN,
 	return &CDN{CDN: cdn}, nil
 }
 
-func (c *cdnsService) UpdateCustomDomain(id string, req *godo.CDNUpdateCustomDomainRequest) (*CDN, error) {
-	cdn, _, err := c.client.CDNs.UpdateCustomDomain(context.TODO(), id, req)
+func (c *cdnsService) UpdateCustomDomain(ctx context.Context, id string, req *godo.CDNUpdateCustomDomainRequest) (*CDN, error) {
+	cdn, _, err := c.client.CDNs.UpdateCustomDomain(ctx, id, req)
 	if err != nil {
 		return nil, err
 	}
@@ -97,14 +97,14 @@ func (c *cdnsService) UpdateCustomDomain(id string, req *godo.CDNUpdateCustomDom
 	return &CDN{CDN: cdn}, nil
 }
 
-func (c *cdnsService) Delete(id string) error {
-	_, err := c.client.CDNs.Delete(context.TODO(), id)
+func (c *cdnsService) Delete(ctx context.Context, id string) error {
+	_, err := c.client.CDNs.Delete(ctx, id)
 
 	return err
 }
 
-func (c *cdnsService) FlushCache(id string, req *godo.CDNFlushCacheRequest) error {
-	_, err := c.client.CDNs.FlushCache(context.TODO(), id, req)
+func (c *cdnsService) FlushCache(ctx context.Context, id string, req *godo.CDNFlushCacheRequest) error {
+	_, err := c.client.CDNs.FlushCache(ctx, id, req)
 
 	return err
 }
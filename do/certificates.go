@@ -29,10 +29,10 @@ type Certificates []Certificate
 
 // CertificatesService is the godo CertificatesService interface.
 type CertificatesService interface {
-	Get(cID string) (*Certificate, error)
-	Create(cr *godo.CertificateRequest) (*Certificate, error)
-	List() (Certificates, error)
-	Delete(cID string) error
+	Get(ctx context.Context, cID string) (*Certificate, error)
+	Create(ctx context.Context, cr *godo.CertificateRequest) (*Certificate, error)
+	List(context.Context) (Certificates, error)
+	Delete(ctx context.Context, cID string) error
 }
 
 var _ CertificatesService = &certificatesService{}
@@ -48,8 +48,8 @@ func NewCertificatesService(client *godo.Client) CertificatesService {
 	}
 }
 
-func (cs *certificatesService) Get(cID string) (*Certificate, error) {
-	c, _, err := cs.client.Certificates.Get(context.TODO(), cID)
+func (cs *certificatesService) Get(ctx context.Context, cID string) (*Certificate, error) {
+	c, _, err := cs.client.Certificates.Get(ctx, cID)
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +57,8 @@ func (cs *certificatesService) Get(cID string) (*Certificate, error) {
 	return &Certificate{Certificate: c}, nil
 }
 
-func (cs *certificatesService) Create(cr *godo.CertificateRequest) (*Certificate, error) {
-	c, _, err := cs.client.Certificates.Create(context.TODO(), cr)
+func (cs *certificatesService) Create(ctx context.Context, cr *godo.CertificateRequest) (*Certificate, error) {
+	c, _, err := cs.client.Certificates.Create(ctx, cr)
 	if err != nil {
 		return nil, err
 	}
@@ -66,9 +66,9 @@ func (cs *certificatesService) Create(cr *godo.CertificateRequest) (*Certificate
 	return &Certificate{Certificate: c}, nil
 }
 
-func (cs *certificatesService) List() (Certificates, error) {
+func (cs *certificatesService) List(ctx context.Context) (Certificates, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := cs.client.Certificates.List(context.TODO(), opt)
+		list, resp, err := cs.client.Certificates.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -81,7 +81,7 @@ func (cs *certificatesService) List() (Certificates, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +95,7 @@ func (cs *certificatesService) List() (Certificates, error) {
 	return list, nil
 }
 
-func (cs *certificatesService) Delete(cID string) error {
-	_, err := cs.client.Certificates.Delete(context.TODO(), cID)
+func (cs *certificatesService) Delete(ctx context.Context, cID string) error {
+	_, err := cs.client.Certificates.Delete(ctx, cID)
 	return err
 }
@@ -0,0 +1,68 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicesUseCallerContext(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(server.Client())
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client.BaseURL = u
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := map[string]func() error{
+		"registry get": func() error {
+			_, err := NewRegistryService(client).Get(ctx)
+			return err
+		},
+		"projects list": func() error {
+			_, err := NewProjectsService(client).List(ctx)
+			return err
+		},
+		"projects get": func() error {
+			_, err := NewProjectsService(client).Get(ctx, "default")
+			return err
+		},
+		"domain record create": func() error {
+			_, err := NewDomainsService(client).CreateRecord(ctx, "example.com", &DomainRecordEditRequest{Type: "A"})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		err := call()
+		assert.True(t, errors.Is(err, context.Canceled), "%s: %v", name, err)
+	}
+	assert.Equal(t, 0, requests)
+}
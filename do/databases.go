@@ -80,41 +80,41 @@ type DatabaseMaintenanceWindow struct {
 
 // DatabasesService is an interface for interacting with DigitalOcean's Database API
 type DatabasesService interface {
-	List() (Databases, error)
-	Get(string) (*Database, error)
-	Create(*godo.DatabaseCreateRequest) (*Database, error)
-	Delete(string) error
-	GetConnection(string) (*DatabaseConnection, error)
-	ListBackups(string) (DatabaseBackups, error)
-	Resize(string, *godo.DatabaseResizeRequest) error
-	Migrate(string, *godo.DatabaseMigrateRequest) error
-
-	GetMaintenance(string) (*DatabaseMaintenanceWindow, error)
-	UpdateMaintenance(string, *godo.DatabaseUpdateMaintenanceRequest) error
-
-	GetUser(string, string) (*DatabaseUser, error)
-	ListUsers(string) (DatabaseUsers, error)
-	CreateUser(string, *godo.DatabaseCreateUserRequest) (*DatabaseUser, error)
-	DeleteUser(string, string) error
-
-	ListDBs(string) (DatabaseDBs, error)
-	CreateDB(string, *godo.DatabaseCreateDBRequest) (*DatabaseDB, error)
-	GetDB(string, string) (*DatabaseDB, error)
-	DeleteDB(string, string) error
-
-	ListPools(string) (DatabasePools, error)
-	CreatePool(string, *godo.DatabaseCreatePoolRequest) (*DatabasePool, error)
-	GetPool(string, string) (*DatabasePool, error)
-	DeletePool(string, string) error
-
-	GetReplica(string, string) (*DatabaseReplica, error)
-	ListReplicas(string) (DatabaseReplicas, error)
-	CreateReplica(string, *godo.DatabaseCreateReplicaRequest) (*DatabaseReplica, error)
-	DeleteReplica(string, string) error
-	GetReplicaConnection(string, string) (*DatabaseConnection, error)
-
-	GetSQLMode(string) ([]string, error)
-	SetSQLMode(string, ...string) error
+	List(context.Context) (Databases, error)
+	Get(context.Context, string) (*Database, error)
+	Create(context.Context, *godo.DatabaseCreateRequest) (*Database, error)
+	Delete(context.Context, string) error
+	GetConnection(context.Context, string) (*DatabaseConnection, error)
+	ListBackups(context.Context, string) (DatabaseBackups, error)
+	Resize(context.Context, string, *godo.DatabaseResizeRequest) error
+	Migrate(context.Context, string, *godo.DatabaseMigrateRequest) error
+
+	GetMaintenance(context.Context, string) (*DatabaseMaintenanceWindow, error)
+	UpdateMaintenance(context.Context, string, *godo.DatabaseUpdateMaintenanceRequest) error
+
+	GetUser(context.Context, string, string) (*DatabaseUser, error)
+	ListUsers(context.Context, string) (DatabaseUsers, error)
+	CreateUser(context.Context, string, *godo.DatabaseCreateUserRequest) (*DatabaseUser, error)
+	DeleteUser(context.Context, string, string) error
+
+	ListDBs(context.Context, string) (DatabaseDBs, error)
+	CreateDB(context.Context, string, *godo.DatabaseCreateDBRequest) (*DatabaseDB, error)
+	GetDB(context.Context, string, string) (*DatabaseDB, error)
+	DeleteDB(context.Context, string, string) error
+
+	ListPools(context.Context, string) (DatabasePools, error)
+	CreatePool(context.Context, string, *godo.DatabaseCreatePoolRequest) (*DatabasePool, error)
+	GetPool(context.Context, string, string) (*DatabasePool, error)
+	DeletePool(context.Context, string, string) error
+
+	GetReplica(context.Context, string, string) (*DatabaseReplica, error)
+	ListReplicas(context.Context, string) (DatabaseReplicas, error)
+	CreateReplica(context.Context, string, *godo.DatabaseCreateReplicaRequest) (*DatabaseReplica, error)
+	DeleteReplica(context.Context, string, string) error
+	GetReplicaConnection(context.Context, string, string) (*DatabaseConnection, error)
+
+	GetSQLMode(context.Context, string) ([]string, error)
+	SetSQLMode(context.Context, string, ...string) error
 }
 
 type databasesService struct {
@@ -130,9 +130,9 @@ func NewDatabasesService(client *godo.Client) DatabasesService {
 	}
 }
 
-func (ds *databasesService) List() (Databases, error) {
+func (ds *databasesService) List(ctx context.Context) (Databases, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Databases.List(context.TODO(), opt)
+		list, resp, err := ds.client.Databases.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -145,7 +145,7 @@ func (ds *databasesService) List() (Databases, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -158,8 +158,8 @@ func (ds *databasesService) List() (Databases, error) {
 	return list, nil
 }
 
-func (ds *databasesService) Get(databaseID string) (*Database, error) {
-	db, _, err := ds.client.Databases.Get(context.TODO(), databaseID)
+func (ds *databasesService) Get(ctx context.Context, databaseID string) (*Database, error) {
+	db, _, err := ds.client.Databases.Get(ctx, databaseID)
 	if err != nil {
 		return nil, err
 	}
@@ -167,8 +167,8 @@ func (ds *databasesService) Get(databaseID string) (*Database, error) {
 	return &Database{Database: db}, nil
 }
 
-func (ds *databasesService) Create(req *godo.DatabaseCreateRequest) (*Database, error) {
-	db, _, err := ds.client.Databases.Create(context.TODO(), req)
+func (ds *databasesService) Create(ctx context.Context, req *godo.DatabaseCreateRequest) (*Database, error) {
+	db, _, err := ds.client.Databases.Create(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -176,14 +176,14 @@ func (ds *databasesService) Create(req *godo.DatabaseCreateRequest) (*Database,
 	return &Database{Database: db}, nil
 }
 
-func (ds *databasesService) Delete(databaseID string) error {
-	_, err := ds.client.Databases.Delete(context.TODO(), databaseID)
+func (ds *databasesService) Delete(ctx context.Context, databaseID string) error {
+	_, err := ds.client.Databases.Delete(ctx, databaseID)
 
 	return err
 }
 
-func (ds *databasesService) GetConnection(databaseID string) (*DatabaseConnection, error) {
-	db, err := ds.Get(databaseID)
+func (ds *databasesService) GetConnection(ctx context.Context, databaseID string) (*DatabaseConnection, error) {
+	db, err := ds.Get(ctx, databaseID)
 	if err != nil {
 		return nil, err
 	}
@@ -193,20 +193,20 @@ func (ds *databasesService) GetConnection(databaseID string) (*DatabaseConnectio
 	}, nil
 }
 
-func (ds *databasesService) Resize(databaseID string, req *godo.DatabaseResizeRequest) error {
-	_, err := ds.client.Databases.Resize(context.TODO(), databaseID, req)
+func (ds *databasesService) Resize(ctx context.Context, databaseID string, req *godo.DatabaseResizeRequest) error {
+	_, err := ds.client.Databases.Resize(ctx, databaseID, req)
 
 	return err
 }
 
-func (ds *databasesService) Migrate(databaseID string, req *godo.DatabaseMigrateRequest) error {
-	_, err := ds.client.Databases.Migrate(context.TODO(), databaseID, req)
+func (ds *databasesService) Migrate(ctx context.Context, databaseID string, req *godo.DatabaseMigrateRequest) error {
+	_, err := ds.client.Databases.Migrate(ctx, databaseID, req)
 
 	return err
 }
 
-func (ds *databasesService) GetMaintenance(databaseID string) (*DatabaseMaintenanceWindow, error) {
-	db, err := ds.Get(databaseID)
+func (ds *databasesService) GetMaintenance(ctx context.Context, databaseID string) (*DatabaseMaintenanceWindow, error) {
+	db, err := ds.Get(ctx, databaseID)
 	if err != nil {
 		return nil, err
 	}
@@ -216,15 +216,15 @@ func (ds *databasesService) GetMaintenance(databaseID string) (*DatabaseMaintena
 	}, nil
 }
 
-func (ds *databasesService) UpdateMaintenance(databaseID string, req *godo.DatabaseUpdateMaintenanceRequest) error {
-	_, err := ds.client.Databases.UpdateMaintenance(context.TODO(), databaseID, req)
+func (ds *databasesService) UpdateMaintenance(ctx context.Context, databaseID string, req *godo.DatabaseUpdateMaintenanceRequest) error {
+	_, err := ds.client.Databases.UpdateMaintenance(ctx, databaseID, req)
 
 	return err
 }
 
-func (ds *databasesService) ListBackups(databaseID string) (DatabaseBackups, error) {
+func (ds *databasesService) ListBackups(ctx context.Context, databaseID string) (DatabaseBackups, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Databases.ListBackups(context.TODO(), databaseID, opt)
+		list, resp, err := ds.client.Databases.ListBackups(ctx, databaseID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -237,7 +237,7 @@ func (ds *databasesService) ListBackups(databaseID string) (DatabaseBackups, err
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -250,8 +250,8 @@ func (ds *databasesService) ListBackups(databaseID string) (DatabaseBackups, err
 	return list, nil
 }
 
-func (ds *databasesService) GetUser(databaseID, userName string) (*DatabaseUser, error) {
-	u, _, err := ds.client.Databases.GetUser(context.TODO(), databaseID, userName)
+func (ds *databasesService) GetUser(ctx context.Context, databaseID, userName string) (*DatabaseUser, error) {
+	u, _, err := ds.client.Databases.GetUser(ctx, databaseID, userName)
 	if err != nil {
 		return nil, err
 	}
@@ -259,9 +259,9 @@ func (ds *databasesService) GetUser(databaseID, userName string) (*DatabaseUser,
 	return &DatabaseUser{DatabaseUser: u}, nil
 }
 
-func (ds *databasesService) ListUsers(databaseID string) (DatabaseUsers, error) {
+func (ds *databasesService) ListUsers(ctx context.Context, databaseID string) (DatabaseUsers, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Databases.ListUsers(context.TODO(), databaseID, opt)
+		list, resp, err := ds.client.Databases.ListUsers(ctx, databaseID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -274,7 +274,7 @@ func (ds *databasesService) ListUsers(databaseID string) (DatabaseUsers, error)
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -287,8 +287,8 @@ func (ds *databasesService) ListUsers(databaseID string) (DatabaseUsers, error)
 	return list, nil
 }
 
-func (ds *databasesService) CreateUser(databaseID string, req *godo.DatabaseCreateUserRequest) (*DatabaseUser, error) {
-	u, _, err := ds.client.Databases.CreateUser(context.TODO(), databaseID, req)
+func (ds *databasesService) CreateUser(ctx context.Context, databaseID string, req *godo.DatabaseCreateUserRequest) (*DatabaseUser, error) {
+	u, _, err := ds.client.Databases.CreateUser(ctx, databaseID, req)
 	if err != nil {
 		return nil, err
 	}
@@ -296,15 +296,15 @@ func (ds *databasesService) CreateUser(databaseID string, req *godo.DatabaseCrea
 	return &DatabaseUser{DatabaseUser: u}, nil
 }
 
-func (ds *databasesService) DeleteUser(databaseID, userName string) error {
-	_, err := ds.client.Databases.DeleteUser(context.TODO(), databaseID, userName)
+func (ds *databasesService) DeleteUser(ctx context.Context, databaseID, userName string) error {
+	_, err := ds.client.Databases.DeleteUser(ctx, databaseID, userName)
 
 	return err
 }
 
-func (ds *databasesService) ListDBs(databaseID string) (DatabaseDBs, error) {
+func (ds *databasesService) ListDBs(ctx context.Context, databaseID string) (DatabaseDBs, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Databases.ListDBs(context.TODO(), databaseID, opt)
+		list, resp, err := ds.client.Databases.ListDBs(ctx, databaseID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -317,7 +317,7 @@ func (ds *databasesService) ListDBs(databaseID string) (DatabaseDBs, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -330,8 +330,8 @@ func (ds *databasesService) ListDBs(databaseID string) (DatabaseDBs, error) {
 	return list, nil
 }
 
-func (ds *databasesService) CreateDB(databaseID string, req *godo.DatabaseCreateDBRequest) (*DatabaseDB, error) {
-	db, _, err := ds.client.Databases.CreateDB(context.TODO(), databaseID, req)
+func (ds *databasesService) CreateDB(ctx context.Context, databaseID string, req *godo.DatabaseCreateDBRequest) (*DatabaseDB, error) {
+	db, _, err := ds.client.Databases.CreateDB(ctx, databaseID, req)
 	if err != nil {
 		return nil, err
 	}
@@ -339,8 +339,8 @@ func (ds *databasesService) CreateDB(databaseID string, req *godo.DatabaseCreate
 	return &DatabaseDB{DatabaseDB: db}, nil
 }
 
-func (ds *databasesService) GetDB(databaseID, dbID string) (*DatabaseDB, error) {
-	db, _, err := ds.client.Databases.GetDB(context.TODO(), databaseID, dbID)
+func (ds *databasesService) GetDB(ctx context.Context, databaseID, dbID string) (*DatabaseDB, error) {
+	db, _, err := ds.client.Databases.GetDB(ctx, databaseID, dbID)
 	if err != nil {
 		return nil, err
 	}
@@ -348,15 +348,15 @@ func (ds *databasesService) GetDB(databaseID, dbID string) (*DatabaseDB, error)
 	return &DatabaseDB{DatabaseDB: db}, nil
 }
 
-func (ds *databasesService) DeleteDB(databaseID, dbID string) error {
-	_, err := ds.client.Databases.DeleteDB(context.TODO(), databaseID, dbID)
+func (ds *databasesService) DeleteDB(ctx context.Context, databaseID, dbID string) error {
+	_, err := ds.client.Databases.DeleteDB(ctx, databaseID, dbID)
 
 	return err
 }
 
-func (ds *databasesService) ListPools(databaseID string) (DatabasePools, error) {
+func (ds *databasesService) ListPools(ctx context.Context, databaseID string) (DatabasePools, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Databases.ListPools(context.TODO(), databaseID, opt)
+		list, resp, err := ds.client.Databases.ListPools(ctx, databaseID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -369,7 +369,7 @@ func (ds *databasesService) ListPools(databaseID string) (DatabasePools, error)
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -382,8 +382,8 @@ func (ds *databasesService) ListPools(databaseID string) (DatabasePools, error)
 	return list, nil
 }
 
-func (ds *databasesService) CreatePool(databaseID string, req *godo.DatabaseCreatePoolRequest) (*DatabasePool, error) {
-	p, _, err := ds.client.Databases.CreatePool(context.TODO(), databaseID, req)
+func (ds *databasesService) CreatePool(ctx context.Context, databaseID string, req *godo.DatabaseCreatePoolRequest) (*DatabasePool, error) {
+	p, _, err := ds.client.Databases.CreatePool(ctx, databaseID, req)
 	if err != nil {
 		return nil, err
 	}
@@ -391,8 +391,8 @@ func (ds *databasesService) CreatePool(databaseID string, req *godo.DatabaseCrea
 	return &DatabasePool{DatabasePool: p}, nil
 }
 
-func (ds *databasesService) GetPool(databaseID, poolName string) (*DatabasePool, error) {
-	p, _, err := ds.client.Databases.GetPool(context.TODO(), databaseID, poolName)
+func (ds *databasesService) GetPool(ctx context.Context, databaseID, poolName string) (*DatabasePool, error) {
+	p, _, err := ds.client.Databases.GetPool(ctx, databaseID, poolName)
 	if err != nil {
 		return nil, err
 	}
@@ -400,14 +400,14 @@ func (ds *databasesService) GetPool(databaseID, poolName string) (*DatabasePool,
 	return &DatabasePool{DatabasePool: p}, nil
 }
 
-func (ds *databasesService) DeletePool(databaseID, poolName string) error {
-	_, err := ds.client.Databases.DeletePool(context.TODO(), databaseID, poolName)
+func (ds *databasesService) DeletePool(ctx context.Context, databaseID, poolName string) error {
+	_, err := ds.client.Databases.DeletePool(ctx, databaseID, poolName)
 
 	return err
 }
 
-func (ds *databasesService) GetReplica(databaseID, replicaID string) (*DatabaseReplica, error) {
-	r, _, err := ds.client.Databases.GetReplica(context.TODO(), databaseID, replicaID)
+func (ds *databasesService) GetReplica(ctx context.Context, databaseID, replicaID string) (*DatabaseReplica, error) {
+	r, _, err := ds.client.Databases.GetReplica(ctx, databaseID, replicaID)
 	if err != nil {
 		return nil, err
 	}
@@ -415,9 +415,9 @@ func (ds *databasesService) GetReplica(databaseID, replicaID string) (*DatabaseR
 	return &DatabaseReplica{DatabaseReplica: r}, nil
 }
 
-func (ds *databasesService) ListReplicas(databaseID string) (DatabaseReplicas, error) {
+func (ds *databasesService) ListReplicas(ctx context.Context, databaseID string) (DatabaseReplicas, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Databases.ListReplicas(context.TODO(), databaseID, opt)
+		list, resp, err := ds.client.Databases.ListReplicas(ctx, databaseID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -430,7 +430,7 @@ func (ds *databasesService) ListReplicas(databaseID string) (DatabaseReplicas, e
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -443,8 +443,8 @@ func (ds *databasesService) ListReplicas(databaseID string) (DatabaseReplicas, e
 	return list, nil
 }
 
-func (ds *databasesService) CreateReplica(databaseID string, req *godo.DatabaseCreateReplicaRequest) (*DatabaseReplica, error) {
-	r, _, err := ds.client.Databases.CreateReplica(context.TODO(), databaseID, req)
+func (ds *databasesService) CreateReplica(ctx context.Context, databaseID string, req *godo.DatabaseCreateReplicaRequest) (*DatabaseReplica, error) {
+	r, _, err := ds.client.Databases.CreateReplica(ctx, databaseID, req)
 	if err != nil {
 		return nil, err
 	}
@@ -452,14 +452,14 @@ func (ds *databasesService) CreateReplica(databaseID string, req *godo.DatabaseC
 	return &DatabaseReplica{DatabaseReplica: r}, nil
 }
 
-func (ds *databasesService) DeleteReplica(databaseID string, replicaID string) error {
-	_, err := ds.client.Databases.DeleteReplica(context.TODO(), databaseID, replicaID)
+func (ds *databasesService) DeleteReplica(ctx context.Context, databaseID string, replicaID string) error {
+	_, err := ds.client.Databases.DeleteReplica(ctx, databaseID, replicaID)
 
 	return err
 }
 
-func (ds *databasesService) GetReplicaConnection(databaseID, replicaID string) (*DatabaseConnection, error) {
-	rep, err := ds.GetReplica(databaseID, replicaID)
+func (ds *databasesService) GetReplicaConnection(ctx context.Context, databaseID, replicaID string) (*DatabaseConnection, error) {
+	rep, err := ds.GetReplica(ctx, databaseID, replicaID)
 	if err != nil {
 		return nil, err
 	}
@@ -469,12 +469,12 @@ func (ds *databasesService) GetReplicaConnection(databaseID, replicaID string) (
 	}, nil
 }
 
-func (ds *databasesService) GetSQLMode(databaseID string) ([]string, error) {
-	sqlModes, _, err := ds.client.Databases.GetSQLMode(context.TODO(), databaseID)
+func (ds *databasesService) GetSQLMode(ctx context.Context, databaseID string) ([]string, error) {
+	sqlModes, _, err := ds.client.Databases.GetSQLMode(ctx, databaseID)
 	return strings.Split(sqlModes, ","), err
 }
 
-func (ds *databasesService) SetSQLMode(databaseID string, sqlModes ...string) error {
-	_, err := ds.client.Databases.SetSQLMode(context.TODO(), databaseID, sqlModes...)
+func (ds *databasesService) SetSQLMode(ctx context.Context, databaseID string, sqlModes ...string) error {
+	_, err := ds.client.Databases.SetSQLMode(ctx, databaseID, sqlModes...)
 	return err
 }
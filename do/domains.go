@@ -59,16 +59,16 @@ type DomainRecords []DomainRecord
 
 // DomainsService is the godo DOmainsService interface.
 type DomainsService interface {
-	List() (Domains, error)
-	Get(string) (*Domain, error)
-	Create(*godo.DomainCreateRequest) (*Domain, error)
-	Delete(string) error
+	List(context.Context) (Domains, error)
+	Get(context.Context, string) (*Domain, error)
+	Create(context.Context, *godo.DomainCreateRequest) (*Domain, error)
+	Delete(context.Context, string) error
 
-	Records(string) (DomainRecords, error)
-	Record(string, int) (*DomainRecord, error)
-	DeleteRecord(string, int) error
-	EditRecord(string, int, *DomainRecordEditRequest) (*DomainRecord, error)
-	CreateRecord(string, *DomainRecordEditRequest) (*DomainRecord, error)
+	Records(context.Context, string) (DomainRecords, error)
+	Record(context.Context, string, int) (*DomainRecord, error)
+	DeleteRecord(context.Context, string, int) error
+	EditRecord(context.Context, string, int, *DomainRecordEditRequest) (*DomainRecord, error)
+	CreateRecord(context.Context, string, *DomainRecordEditRequest) (*DomainRecord, error)
 }
 
 type domainsService struct {
@@ -84,9 +84,9 @@ func NewDomainsService(client *godo.Client) DomainsService {
 	}
 }
 
-func (ds *domainsService) List() (Domains, error) {
+func (ds *domainsService) List(ctx context.Context) (Domains, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Domains.List(context.TODO(), opt)
+		list, resp, err := ds.client.Domains.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -99,7 +99,7 @@ func (ds *domainsService) List() (Domains, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -113,8 +113,8 @@ func (ds *domainsService) List() (Domains, error) {
 	return list, nil
 }
 
-func (ds *domainsService) Get(name string) (*Domain, error) {
-	d, _, err := ds.client.Domains.Get(context.TODO(), name)
+func (ds *domainsService) Get(ctx context.Context, name string) (*Domain, error) {
+	d, _, err := ds.client.Domains.Get(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -122,8 +122,8 @@ func (ds *domainsService) Get(name string) (*Domain, error) {
 	return &Domain{Domain: d}, nil
 }
 
-func (ds *domainsService) Create(dcr *godo.DomainCreateRequest) (*Domain, error) {
-	d, _, err := ds.client.Domains.Create(context.TODO(), dcr)
+func (ds *domainsService) Create(ctx context.Context, dcr *godo.DomainCreateRequest) (*Domain, error) {
+	d, _, err := ds.client.Domains.Create(ctx, dcr)
 	if err != nil {
 		return nil, err
 	}
@@ -131,14 +131,14 @@ func (ds *domainsService) Create(dcr *godo.DomainCreateRequest) (*Domain, error)
 	return &Domain{Domain: d}, nil
 }
 
-func (ds *domainsService) Delete(name string) error {
-	_, err := ds.client.Domains.Delete(context.TODO(), name)
+func (ds *domainsService) Delete(ctx context.Context, name string) error {
+	_, err := ds.client.Domains.Delete(ctx, name)
 	return err
 }
 
-func (ds *domainsService) Records(name string) (DomainRecords, error) {
+func (ds *domainsService) Records(ctx context.Context, name string) (DomainRecords, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Domains.Records(context.TODO(), name, opt)
+		list, resp, err := ds.client.Domains.Records(ctx, name, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -151,7 +151,7 @@ func (ds *domainsService) Records(name string) (DomainRecords, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -165,8 +165,8 @@ func (ds *domainsService) Records(name string) (DomainRecords, error) {
 	return list, nil
 }
 
-func (ds *domainsService) Record(domain string, id int) (*DomainRecord, error) {
-	dr, _, err := ds.client.Domains.Record(context.TODO(), domain, id)
+func (ds *domainsService) Record(ctx context.Context, domain string, id int) (*DomainRecord, error) {
+	dr, _, err := ds.client.Domains.Record(ctx, domain, id)
 	if err != nil {
 		return nil, err
 	}
@@ -174,8 +174,8 @@ func (ds *domainsService) Record(domain string, id int) (*DomainRecord, error) {
 	return &DomainRecord{DomainRecord: dr}, nil
 }
 
-func (ds *domainsService) DeleteRecord(domain string, id int) error {
-	_, err := ds.client.Domains.DeleteRecord(context.TODO(), domain, id)
+func (ds *domainsService) DeleteRecord(ctx context.Context, domain string, id int) error {
+	_, err := ds.client.Domains.DeleteRecord(ctx, domain, id)
 	return err
 }
 
@@ -186,7 +186,7 @@ type domainRecordRoot struct {
 	DomainRecord *DomainRecord `json:"domain_record"`
 }
 
-func (ds *domainsService) EditRecord(domain string, id int, drer *DomainRecordEditRequest) (*DomainRecord, error) {
+func (ds *domainsService) EditRecord(ctx context.Context, domain string, id int, drer *DomainRecordEditRequest) (*DomainRecord, error) {
 	if len(domain) < 1 {
 		return nil, godo.NewArgError("domain", "cannot be an empty string")
 	}
@@ -198,19 +198,19 @@ func (ds *domainsService) EditRecord(domain string, id int, drer *DomainRecordEd
 	}
 
 	path := fmt.Sprintf(domainRecordPath, domain, id)
-	req, err := ds.client.NewRequest(context.TODO(), http.MethodPut, path, drer)
+	req, err := ds.client.NewRequest(ctx, http.MethodPut, path, drer)
 	if err != nil {
 		return nil, err
 	}
 
 	root := new(domainRecordRoot)
-	if _, err := ds.client.Do(context.TODO(), req, root); err != nil {
+	if _, err := ds.client.Do(ctx, req, root); err != nil {
 		return nil, err
 	}
 	return root.DomainRecord, nil
 }
 
-func (ds *domainsService) CreateRecord(domain string, drer *DomainRecordEditRequest) (*DomainRecord, error) {
+func (ds *domainsService) CreateRecord(ctx context.Context, domain string, drer *DomainRecordEditRequest) (*DomainRecord, error) {
 	if len(domain) < 1 {
 		return nil, godo.NewArgError("domain", "cannot be empty string")
 	}
@@ -219,13 +219,13 @@ func (ds *domainsService) CreateRecord(domain string, drer *DomainRecordEditRequ
 	}
 
 	path := fmt.Sprintf(domainRecordsPath, domain)
-	req, err := ds.client.NewRequest(context.Background(), http.MethodPost, path, drer)
+	req, err := ds.client.NewRequest(ctx, http.MethodPost, path, drer)
 	if err != nil {
 		return nil, err
 	}
 
 	root := new(domainRecordRoot)
-	if _, err := ds.client.Do(context.Background(), req, root); err != nil {
+	if _, err := ds.client.Do(ctx, req, root); err != nil {
 		return nil, err
 	}
 	return root.DomainRecord, err
@@ -21,34 +21,34 @@ import (
 
 // DropletActionsService is an interface for interacting with DigitalOcean's droplet action api.
 type DropletActionsService interface {
-	Shutdown(int) (*Action, error)
-	ShutdownByTag(string) (Actions, error)
-	PowerOff(int) (*Action, error)
-	PowerOffByTag(string) (Actions, error)
-	PowerOn(int) (*Action, error)
-	PowerOnByTag(string) (Actions, error)
-	PowerCycle(int) (*Action, error)
-	PowerCycleByTag(string) (Actions, error)
-	Reboot(int) (*Action, error)
-	Restore(int, int) (*Action, error)
-	Resize(int, string, bool) (*Action, error)
-	Rename(int, string) (*Action, error)
-	Snapshot(int, string) (*Action, error)
-	SnapshotByTag(string, string) (Actions, error)
-	EnableBackups(int) (*Action, error)
-	EnableBackupsByTag(string) (Actions, error)
-	DisableBackups(int) (*Action, error)
-	DisableBackupsByTag(string) (Actions, error)
-	PasswordReset(int) (*Action, error)
-	RebuildByImageID(int, int) (*Action, error)
-	RebuildByImageSlug(int, string) (*Action, error)
-	ChangeKernel(int, int) (*Action, error)
-	EnableIPv6(int) (*Action, error)
-	EnableIPv6ByTag(string) (Actions, error)
-	EnablePrivateNetworking(int) (*Action, error)
-	EnablePrivateNetworkingByTag(string) (Actions, error)
-	Get(int, int) (*Action, error)
-	GetByURI(string) (*Action, error)
+	Shutdown(context.Context, int) (*Action, error)
+	ShutdownByTag(context.Context, string) (Actions, error)
+	PowerOff(context.Context, int) (*Action, error)
+	PowerOffByTag(context.Context, string) (Actions, error)
+	PowerOn(context.Context, int) (*Action, error)
+	PowerOnByTag(context.Context, string) (Actions, error)
+	PowerCycle(context.Context, int) (*Action, error)
+	PowerCycleByTag(context.Context, string) (Actions, error)
+	Reboot(context.Context, int) (*Action, error)
+	Restore(context.Context, int, int) (*Action, error)
+	Resize(context.Context, int, string, bool) (*Action, error)
+	Rename(context.Context, int, string) (*Action, error)
+	Snapshot(context.Context, int, string) (*Action, error)
+	SnapshotByTag(context.Context, string, string) (Actions, error)
+	EnableBackups(context.Context, int) (*Action, error)
+	EnableBackupsByTag(context.Context, string) (Actions, error)
+	DisableBackups(context.Context, int) (*Action, error)
+	DisableBackupsByTag(context.Context, string) (Actions, error)
+	PasswordReset(context.Context, int) (*Action, error)
+	RebuildByImageID(context.Context, int, int) (*Action, error)
+	RebuildByImageSlug(context.Context, int, string) (*Action, error)
+	ChangeKernel(context.Context, int, int) (*Action, error)
+	EnableIPv6(context.Context, int) (*Action, error)
+	EnableIPv6ByTag(context.Context, string) (Actions, error)
+	EnablePrivateNetworking(context.Context, int) (*Action, error)
+	EnablePrivateNetworkingByTag(context.Context, string) (Actions, error)
+	Get(context.Context, int, int) (*Action, error)
+	GetByURI(context.Context, string) (*Action, error)
 }
 
 type dropletActionsService struct {
@@ -86,142 +86,142 @@ func (das *dropletActionsService) handleTagActionResponse(a []godo.Action, err e
 	return actions, nil
 }
 
-func (das *dropletActionsService) Shutdown(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.Shutdown(context.TODO(), id)
+func (das *dropletActionsService) Shutdown(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.Shutdown(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) ShutdownByTag(tag string) (Actions, error) {
-	a, _, err := das.client.DropletActions.ShutdownByTag(context.TODO(), tag)
+func (das *dropletActionsService) ShutdownByTag(ctx context.Context, tag string) (Actions, error) {
+	a, _, err := das.client.DropletActions.ShutdownByTag(ctx, tag)
 	return das.handleTagActionResponse(a, err)
 }
 
-func (das *dropletActionsService) PowerOff(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.PowerOff(context.TODO(), id)
+func (das *dropletActionsService) PowerOff(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.PowerOff(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) PowerOffByTag(tag string) (Actions, error) {
-	a, _, err := das.client.DropletActions.PowerOffByTag(context.TODO(), tag)
+func (das *dropletActionsService) PowerOffByTag(ctx context.Context, tag string) (Actions, error) {
+	a, _, err := das.client.DropletActions.PowerOffByTag(ctx, tag)
 	return das.handleTagActionResponse(a, err)
 }
 
-func (das *dropletActionsService) PowerOn(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.PowerOn(context.TODO(), id)
+func (das *dropletActionsService) PowerOn(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.PowerOn(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) PowerOnByTag(tag string) (Actions, error) {
-	a, _, err := das.client.DropletActions.PowerOnByTag(context.TODO(), tag)
+func (das *dropletActionsService) PowerOnByTag(ctx context.Context, tag string) (Actions, error) {
+	a, _, err := das.client.DropletActions.PowerOnByTag(ctx, tag)
 	return das.handleTagActionResponse(a, err)
 }
 
-func (das *dropletActionsService) PowerCycle(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.PowerCycle(context.TODO(), id)
+func (das *dropletActionsService) PowerCycle(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.PowerCycle(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) PowerCycleByTag(tag string) (Actions, error) {
-	a, _, err := das.client.DropletActions.PowerCycleByTag(context.TODO(), tag)
+func (das *dropletActionsService) PowerCycleByTag(ctx context.Context, tag string) (Actions, error) {
+	a, _, err := das.client.DropletActions.PowerCycleByTag(ctx, tag)
 	return das.handleTagActionResponse(a, err)
 }
 
-func (das *dropletActionsService) Reboot(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.Reboot(context.TODO(), id)
+func (das *dropletActionsService) Reboot(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.Reboot(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) Restore(id, imageID int) (*Action, error) {
-	a, _, err := das.client.DropletActions.Restore(context.TODO(), id, imageID)
+func (das *dropletActionsService) Restore(ctx context.Context, id, imageID int) (*Action, error) {
+	a, _, err := das.client.DropletActions.Restore(ctx, id, imageID)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) Resize(id int, sizeSlug string, resizeDisk bool) (*Action, error) {
-	a, _, err := das.client.DropletActions.Resize(context.TODO(), id, sizeSlug, resizeDisk)
+func (das *dropletActionsService) Resize(ctx context.Context, id int, sizeSlug string, resizeDisk bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.Resize(ctx, id, sizeSlug, resizeDisk)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) Rename(id int, name string) (*Action, error) {
-	a, _, err := das.client.DropletActions.Rename(context.TODO(), id, name)
+func (das *dropletActionsService) Rename(ctx context.Context, id int, name string) (*Action, error) {
+	a, _, err := das.client.DropletActions.Rename(ctx, id, name)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) Snapshot(id int, name string) (*Action, error) {
-	a, _, err := das.client.DropletActions.Snapshot(context.TODO(), id, name)
+func (das *dropletActionsService) Snapshot(ctx context.Context, id int, name string) (*Action, error) {
+	a, _, err := das.client.DropletActions.Snapshot(ctx, id, name)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) SnapshotByTag(tag string, name string) (Actions, error) {
-	a, _, err := das.client.DropletActions.SnapshotByTag(context.TODO(), tag, name)
+func (das *dropletActionsService) SnapshotByTag(ctx context.Context, tag string, name string) (Actions, error) {
+	a, _, err := das.client.DropletActions.SnapshotByTag(ctx, tag, name)
 	return das.handleTagActionResponse(a, err)
 }
 
-func (das *dropletActionsService) EnableBackups(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.EnableBackups(context.TODO(), id)
+func (das *dropletActionsService) EnableBackups(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.EnableBackups(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) EnableBackupsByTag(tag string) (Actions, error) {
-	a, _, err := das.client.DropletActions.EnableBackupsByTag(context.TODO(), tag)
+func (das *dropletActionsService) EnableBackupsByTag(ctx context.Context, tag string) (Actions, error) {
+	a, _, err := das.client.DropletActions.EnableBackupsByTag(ctx, tag)
 	return das.handleTagActionResponse(a, err)
 }
 
-func (das *dropletActionsService) DisableBackups(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.DisableBackups(context.TODO(), id)
+func (das *dropletActionsService) DisableBackups(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.DisableBackups(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) DisableBackupsByTag(tag string) (Actions, error) {
-	a, _, err := das.client.DropletActions.DisableBackupsByTag(context.TODO(), tag)
+func (das *dropletActionsService) DisableBackupsByTag(ctx context.Context, tag string) (Actions, error) {
+	a, _, err := das.client.DropletActions.DisableBackupsByTag(ctx, tag)
 	return das.handleTagActionResponse(a, err)
 }
 
-func (das *dropletActionsService) PasswordReset(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.PasswordReset(context.TODO(), id)
+func (das *dropletActionsService) PasswordReset(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.PasswordReset(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) RebuildByImageID(id, imageID int) (*Action, error) {
-	a, _, err := das.client.DropletActions.RebuildByImageID(context.TODO(), id, imageID)
+func (das *dropletActionsService) RebuildByImageID(ctx context.Context, id, imageID int) (*Action, error) {
+	a, _, err := das.client.DropletActions.RebuildByImageID(ctx, id, imageID)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) RebuildByImageSlug(id int, slug string) (*Action, error) {
-	a, _, err := das.client.DropletActions.RebuildByImageSlug(context.TODO(), id, slug)
+func (das *dropletActionsService) RebuildByImageSlug(ctx context.Context, id int, slug string) (*Action, error) {
+	a, _, err := das.client.DropletActions.RebuildByImageSlug(ctx, id, slug)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) ChangeKernel(id, kernelID int) (*Action, error) {
-	a, _, err := das.client.DropletActions.ChangeKernel(context.TODO(), id, kernelID)
+func (das *dropletActionsService) ChangeKernel(ctx context.Context, id, kernelID int) (*Action, error) {
+	a, _, err := das.client.DropletActions.ChangeKernel(ctx, id, kernelID)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) EnableIPv6(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.EnableIPv6(context.TODO(), id)
+func (das *dropletActionsService) EnableIPv6(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.EnableIPv6(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) EnableIPv6ByTag(tag string) (Actions, error) {
-	a, _, err := das.client.DropletActions.EnableIPv6ByTag(context.TODO(), tag)
+func (das *dropletActionsService) EnableIPv6ByTag(ctx context.Context, tag string) (Actions, error) {
+	a, _, err := das.client.DropletActions.EnableIPv6ByTag(ctx, tag)
 	return das.handleTagActionResponse(a, err)
 }
 
-func (das *dropletActionsService) EnablePrivateNetworking(id int) (*Action, error) {
-	a, _, err := das.client.DropletActions.EnablePrivateNetworking(context.TODO(), id)
+func (das *dropletActionsService) EnablePrivateNetworking(ctx context.Context, id int) (*Action, error) {
+	a, _, err := das.client.DropletActions.EnablePrivateNetworking(ctx, id)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) EnablePrivateNetworkingByTag(tag string) (Actions, error) {
-	a, _, err := das.client.DropletActions.EnablePrivateNetworkingByTag(context.TODO(), tag)
+func (das *dropletActionsService) EnablePrivateNetworkingByTag(ctx context.Context, tag string) (Actions, error) {
+	a, _, err := das.client.DropletActions.EnablePrivateNetworkingByTag(ctx, tag)
 	return das.handleTagActionResponse(a, err)
 }
 
-func (das *dropletActionsService) Get(id int, actionID int) (*Action, error) {
-	a, _, err := das.client.DropletActions.Get(context.TODO(), id, actionID)
+func (das *dropletActionsService) Get(ctx context.Context, id int, actionID int) (*Action, error) {
+	a, _, err := das.client.DropletActions.Get(ctx, id, actionID)
 	return das.handleActionResponse(a, err)
 }
 
-func (das *dropletActionsService) GetByURI(uri string) (*Action, error) {
-	a, _, err := das.client.DropletActions.GetByURI(context.TODO(), uri)
+func (das *dropletActionsService) GetByURI(ctx context.Context, uri string) (*Action, error) {
+	a, _, err := das.client.DropletActions.GetByURI(ctx, uri)
 	return das.handleActionResponse(a, err)
 }
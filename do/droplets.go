@@ -51,18 +51,19 @@ type Kernels []Kernel
 
 // DropletsService is an interface for interacting with DigitalOcean's droplet api.
 type DropletsService interface {
-	List() (Droplets, error)
-	ListByTag(string) (Droplets, error)
-	Get(int) (*Droplet, error)
-	Create(*godo.DropletCreateRequest, bool) (*Droplet, error)
-	CreateMultiple(*godo.DropletMultiCreateRequest) (Droplets, error)
-	Delete(int) error
-	DeleteByTag(string) error
-	Kernels(int) (Kernels, error)
-	Snapshots(int) (Images, error)
-	Backups(int) (Images, error)
-	Actions(int) (Actions, error)
-	Neighbors(int) (Droplets, error)
+	List(context.Context) (Droplets, error)
+	ListPages(context.Context, func(Droplets) error) error
+	ListByTag(context.Context, string) (Droplets, error)
+	Get(context.Context, int) (*Droplet, error)
+	Create(context.Context, *godo.DropletCreateRequest, bool) (*Droplet, error)
+	CreateMultiple(context.Context, *godo.DropletMultiCreateRequest) (Droplets, error)
+	Delete(context.Context, int) error
+	DeleteByTag(context.Context, string) error
+	Kernels(context.Context, int) (Kernels, error)
+	Snapshots(context.Context, int) (Images, error)
+	Backups(context.Context, int) (Images, error)
+	Actions(context.Context, int) (Actions, error)
+	Neighbors(context.Context, int) (Droplets, error)
 }
 
 type dropletsService struct {
@@ -78,9 +79,23 @@ func NewDropletsService(client *godo.Client) DropletsService {
 	}
 }
 
-func (ds *dropletsService) List() (Droplets, error) {
+func (ds *dropletsService) List(ctx context.Context) (Droplets, error) {
+	list := Droplets{}
+	err := ds.ListPages(ctx, func(page Droplets) error {
+		list = append(list, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// ListPages calls fn with each page of droplets as it arrives.
+func (ds *dropletsService) ListPages(ctx context.Context, fn func(Droplets) error) error {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.List(context.TODO(), opt)
+		list, resp, err := ds.client.Droplets.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -93,23 +108,20 @@ func (ds *dropletsService) List() (Droplets, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
-	if err != nil {
-		return nil, err
-	}
-
-	list := make(Droplets, len(si))
-	for i := range si {
-		a := si[i].(godo.Droplet)
-		list[i] = Droplet{Droplet: &a}
-	}
+	return PaginateEach(ctx, f, func(si []interface{}) error {
+		list := make(Droplets, len(si))
+		for i := range si {
+			a := si[i].(godo.Droplet)
+			list[i] = Droplet{Droplet: &a}
+		}
 
-	return list, nil
+		return fn(list)
+	})
 }
 
-func (ds *dropletsService) ListByTag(tagName string) (Droplets, error) {
+func (ds *dropletsService) ListByTag(ctx context.Context, tagName string) (Droplets, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.ListByTag(context.TODO(), tagName, opt)
+		list, resp, err := ds.client.Droplets.ListByTag(ctx, tagName, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -122,7 +134,7 @@ func (ds *dropletsService) ListByTag(tagName string) (Droplets, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -136,8 +148,8 @@ func (ds *dropletsService) ListByTag(tagName string) (Droplets, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Get(id int) (*Droplet, error) {
-	d, _, err := ds.client.Droplets.Get(context.TODO(), id)
+func (ds *dropletsService) Get(ctx context.Context, id int) (*Droplet, error) {
+	d, _, err := ds.client.Droplets.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -145,8 +157,8 @@ func (ds *dropletsService) Get(id int) (*Droplet, error) {
 	return &Droplet{Droplet: d}, nil
 }
 
-func (ds *dropletsService) Create(dcr *godo.DropletCreateRequest, wait bool) (*Droplet, error) {
-	d, resp, err := ds.client.Droplets.Create(context.TODO(), dcr)
+func (ds *dropletsService) Create(ctx context.Context, dcr *godo.DropletCreateRequest, wait bool) (*Droplet, error) {
+	d, resp, err := ds.client.Droplets.Create(ctx, dcr)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +173,10 @@ func (ds *dropletsService) Create(dcr *godo.DropletCreateRequest, wait bool) (*D
 		}
 
 		if action != nil {
-			_ = util.WaitForActive(context.TODO(), ds.client, action.HREF)
-			doDroplet, err := ds.Get(d.ID)
+			if err := util.WaitForActive(ctx, ds.client, action.HREF); err != nil {
+				return nil, err
+			}
+			doDroplet, err := ds.Get(ctx, d.ID)
 			if err != nil {
 				return nil, err
 			}
@@ -173,8 +187,8 @@ func (ds *dropletsService) Create(dcr *godo.DropletCreateRequest, wait bool) (*D
 	return &Droplet{Droplet: d}, nil
 }
 
-func (ds *dropletsService) CreateMultiple(dmcr *godo.DropletMultiCreateRequest) (Droplets, error) {
-	godoDroplets, _, err := ds.client.Droplets.CreateMultiple(context.TODO(), dmcr)
+func (ds *dropletsService) CreateMultiple(ctx context.Context, dmcr *godo.DropletMultiCreateRequest) (Droplets, error) {
+	godoDroplets, _, err := ds.client.Droplets.CreateMultiple(ctx, dmcr)
 	if err != nil {
 		return nil, err
 	}
@@ -187,19 +201,19 @@ func (ds *dropletsService) CreateMultiple(dmcr *godo.DropletMultiCreateRequest)
 	return droplets, nil
 }
 
-func (ds *dropletsService) Delete(id int) error {
-	_, err := ds.client.Droplets.Delete(context.TODO(), id)
+func (ds *dropletsService) Delete(ctx context.Context, id int) error {
+	_, err := ds.client.Droplets.Delete(ctx, id)
 	return err
 }
 
-func (ds *dropletsService) DeleteByTag(tag string) error {
-	_, err := ds.client.Droplets.DeleteByTag(context.TODO(), tag)
+func (ds *dropletsService) DeleteByTag(ctx context.Context, tag string) error {
+	_, err := ds.client.Droplets.DeleteByTag(ctx, tag)
 	return err
 }
 
-func (ds *dropletsService) Kernels(id int) (Kernels, error) {
+func (ds *dropletsService) Kernels(ctx context.Context, id int) (Kernels, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.Kernels(context.TODO(), id, opt)
+		list, resp, err := ds.client.Droplets.Kernels(ctx, id, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -212,7 +226,7 @@ func (ds *dropletsService) Kernels(id int) (Kernels, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -226,9 +240,9 @@ func (ds *dropletsService) Kernels(id int) (Kernels, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Snapshots(id int) (Images, error) {
+func (ds *dropletsService) Snapshots(ctx context.Context, id int) (Images, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.Snapshots(context.TODO(), id, opt)
+		list, resp, err := ds.client.Droplets.Snapshots(ctx, id, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -241,7 +255,7 @@ func (ds *dropletsService) Snapshots(id int) (Images, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -255,9 +269,9 @@ func (ds *dropletsService) Snapshots(id int) (Images, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Backups(id int) (Images, error) {
+func (ds *dropletsService) Backups(ctx context.Context, id int) (Images, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.Backups(context.TODO(), id, opt)
+		list, resp, err := ds.client.Droplets.Backups(ctx, id, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -270,7 +284,7 @@ func (ds *dropletsService) Backups(id int) (Images, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -284,9 +298,9 @@ func (ds *dropletsService) Backups(id int) (Images, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Actions(id int) (Actions, error) {
+func (ds *dropletsService) Actions(ctx context.Context, id int) (Actions, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.Actions(context.TODO(), id, opt)
+		list, resp, err := ds.client.Droplets.Actions(ctx, id, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -299,7 +313,7 @@ func (ds *dropletsService) Actions(id int) (Actions, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -313,8 +327,8 @@ func (ds *dropletsService) Actions(id int) (Actions, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Neighbors(id int) (Droplets, error) {
-	list, _, err := ds.client.Droplets.Neighbors(context.TODO(), id)
+func (ds *dropletsService) Neighbors(ctx context.Context, id int) (Droplets, error) {
+	list, _, err := ds.client.Droplets.Neighbors(ctx, id)
 	if err != nil {
 		return nil, err
 	}
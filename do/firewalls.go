@@ -30,18 +30,18 @@ type Firewalls []Firewall
 
 // FirewallsService is the godo FirewallsService interface.
 type FirewallsService interface {
-	Get(fID string) (*Firewall, error)
-	Create(fr *godo.FirewallRequest) (*Firewall, error)
-	Update(fID string, fr *godo.FirewallRequest) (*Firewall, error)
-	List() (Firewalls, error)
-	ListByDroplet(dID int) (Firewalls, error)
-	Delete(fID string) error
-	AddDroplets(fID string, dIDs ...int) error
-	RemoveDroplets(fID string, dIDs ...int) error
-	AddTags(fID string, tags ...string) error
-	RemoveTags(fID string, tags ...string) error
-	AddRules(fID string, rr *godo.FirewallRulesRequest) error
-	RemoveRules(fID string, rr *godo.FirewallRulesRequest) error
+	Get(ctx context.Context, fID string) (*Firewall, error)
+	Create(ctx context.Context, fr *godo.FirewallRequest) (*Firewall, error)
+	Update(ctx context.Context, fID string, fr *godo.FirewallRequest) (*Firewall, error)
+	List(context.Context) (Firewalls, error)
+	ListByDroplet(ctx context.Context, dID int) (Firewalls, error)
+	Delete(ctx context.Context, fID string) error
+	AddDroplets(ctx context.Context, fID string, dIDs ...int) error
+	RemoveDroplets(ctx context.Context, fID string, dIDs ...int) error
+	AddTags(ctx context.Context, fID string, tags ...string) error
+	RemoveTags(ctx context.Context, fID string, tags ...string) error
+	AddRules(ctx context.Context, fID string, rr *godo.FirewallRulesRequest) error
+	RemoveRules(ctx context.Context, fID string, rr *godo.FirewallRulesRequest) error
 }
 
 var _ FirewallsService = &firewallsService{}
@@ -55,8 +55,8 @@ func NewFirewallsService(client *godo.Client) FirewallsService {
 	return &firewallsService{client: client}
 }
 
-func (fs *firewallsService) Get(fID string) (*Firewall, error) {
-	f, _, err := fs.client.Firewalls.Get(context.TODO(), fID)
+func (fs *firewallsService) Get(ctx context.Context, fID string) (*Firewall, error) {
+	f, _, err := fs.client.Firewalls.Get(ctx, fID)
 	if err != nil {
 		return nil, err
 	}
@@ -64,8 +64,8 @@ func (fs *firewallsService) Get(fID string) (*Firewall, error) {
 	return &Firewall{Firewall: f}, nil
 }
 
-func (fs *firewallsService) Create(fr *godo.FirewallRequest) (*Firewall, error) {
-	f, _, err := fs.client.Firewalls.Create(context.TODO(), fr)
+func (fs *firewallsService) Create(ctx context.Context, fr *godo.FirewallRequest) (*Firewall, error) {
+	f, _, err := fs.client.Firewalls.Create(ctx, fr)
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +73,8 @@ func (fs *firewallsService) Create(fr *godo.FirewallRequest) (*Firewall, error)
 	return &Firewall{Firewall: f}, nil
 }
 
-func (fs *firewallsService) Update(fID string, fr *godo.FirewallRequest) (*Firewall, error) {
-	f, _, err := fs.client.Firewalls.Update(context.TODO(), fID, fr)
+func (fs *firewallsService) Update(ctx context.Context, fID string, fr *godo.FirewallRequest) (*Firewall, error) {
+	f, _, err := fs.client.Firewalls.Update(ctx, fID, fr)
 	if err != nil {
 		return nil, err
 	}
@@ -82,9 +82,9 @@ func (fs *firewallsService) Update(fID string, fr *godo.FirewallRequest) (*Firew
 	return &Firewall{Firewall: f}, nil
 }
 
-func (fs *firewallsService) List() (Firewalls, error) {
+func (fs *firewallsService) List(ctx context.Context) (Firewalls, error) {
 	listFn := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := fs.client.Firewalls.List(context.TODO(), opt)
+		list, resp, err := fs.client.Firewalls.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -97,12 +97,12 @@ func (fs *firewallsService) List() (Firewalls, error) {
 		return si, resp, err
 	}
 
-	return paginatedListHelper(listFn)
+	return paginatedListHelper(ctx, listFn)
 }
 
-func (fs *firewallsService) ListByDroplet(dID int) (Firewalls, error) {
+func (fs *firewallsService) ListByDroplet(ctx context.Context, dID int) (Firewalls, error) {
 	listFn := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := fs.client.Firewalls.ListByDroplet(context.TODO(), dID, opt)
+		list, resp, err := fs.client.Firewalls.ListByDroplet(ctx, dID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -115,46 +115,46 @@ func (fs *firewallsService) ListByDroplet(dID int) (Firewalls, error) {
 		return si, resp, err
 	}
 
-	return paginatedListHelper(listFn)
+	return paginatedListHelper(ctx, listFn)
 }
 
-func (fs *firewallsService) Delete(fID string) error {
-	_, err := fs.client.Firewalls.Delete(context.TODO(), fID)
+func (fs *firewallsService) Delete(ctx context.Context, fID string) error {
+	_, err := fs.client.Firewalls.Delete(ctx, fID)
 	return err
 }
 
-func (fs *firewallsService) AddDroplets(fID string, dIDs ...int) error {
-	_, err := fs.client.Firewalls.AddDroplets(context.TODO(), fID, dIDs...)
+func (fs *firewallsService) AddDroplets(ctx context.Context, fID string, dIDs ...int) error {
+	_, err := fs.client.Firewalls.AddDroplets(ctx, fID, dIDs...)
 	return err
 }
 
-func (fs *firewallsService) RemoveDroplets(fID string, dIDs ...int) error {
-	_, err := fs.client.Firewalls.RemoveDroplets(context.TODO(), fID, dIDs...)
+func (fs *firewallsService) RemoveDroplets(ctx context.Context, fID string, dIDs ...int) error {
+	_, err := fs.client.Firewalls.RemoveDroplets(ctx, fID, dIDs...)
 	return err
 }
 
-func (fs *firewallsService) AddTags(fID string, tags ...string) error {
-	_, err := fs.client.Firewalls.AddTags(context.TODO(), fID, tags...)
+func (fs *firewallsService) AddTags(ctx context.Context, fID string, tags ...string) error {
+	_, err := fs.client.Firewalls.AddTags(ctx, fID, tags...)
 	return err
 }
 
-func (fs *firewallsService) RemoveTags(fID string, tags ...string) error {
-	_, err := fs.client.Firewalls.RemoveTags(context.TODO(), fID, tags...)
+func (fs *firewallsService) RemoveTags(ctx context.Context, fID string, tags ...string) error {
+	_, err := fs.client.Firewalls.RemoveTags(ctx, fID, tags...)
 	return err
 }
 
-func (fs *firewallsService) AddRules(fID string, rr *godo.FirewallRulesRequest) error {
-	_, err := fs.client.Firewalls.AddRules(context.TODO(), fID, rr)
+func (fs *firewallsService) AddRules(ctx context.Context, fID string, rr *godo.FirewallRulesRequest) error {
+	_, err := fs.client.Firewalls.AddRules(ctx, fID, rr)
 	return err
 }
 
-func (fs *firewallsService) RemoveRules(fID string, rr *godo.FirewallRulesRequest) error {
-	_, err := fs.client.Firewalls.RemoveRules(context.TODO(), fID, rr)
+func (fs *firewallsService) RemoveRules(ctx context.Context, fID string, rr *godo.FirewallRulesRequest) error {
+	_, err := fs.client.Firewalls.RemoveRules(ctx, fID, rr)
 	return err
 }
 
-func paginatedListHelper(listFn func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error)) (Firewalls, error) {
-	si, err := PaginateResp(listFn)
+func paginatedListHelper(ctx context.Context, listFn func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error)) (Firewalls, error) {
+	si, err := PaginateResp(ctx, listFn)
 	if err != nil {
 		return nil, err
 	}
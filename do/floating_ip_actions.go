@@ -22,10 +22,10 @@ import (
 // FloatingIPActionsService is an interface for interacting with
 // DigitalOcean's floating ip action api.
 type FloatingIPActionsService interface {
-	Assign(ip string, dropletID int) (*Action, error)
-	Unassign(ip string) (*Action, error)
-	Get(ip string, actionID int) (*Action, error)
-	List(ip string, opt *godo.ListOptions) ([]Action, error)
+	Assign(ctx context.Context, ip string, dropletID int) (*Action, error)
+	Unassign(ctx context.Context, ip string) (*Action, error)
+	Get(ctx context.Context, ip string, actionID int) (*Action, error)
+	List(ctx context.Context, ip string, opt *godo.ListOptions) ([]Action, error)
 }
 
 type floatingIPActionsService struct {
@@ -41,8 +41,8 @@ func NewFloatingIPActionsService(godoClient *godo.Client) FloatingIPActionsServi
 	}
 }
 
-func (fia *floatingIPActionsService) Assign(ip string, dropletID int) (*Action, error) {
-	a, _, err := fia.client.FloatingIPActions.Assign(context.TODO(), ip, dropletID)
+func (fia *floatingIPActionsService) Assign(ctx context.Context, ip string, dropletID int) (*Action, error) {
+	a, _, err := fia.client.FloatingIPActions.Assign(ctx, ip, dropletID)
 	if err != nil {
 		return nil, err
 	}
@@ -50,8 +50,8 @@ func (fia *floatingIPActionsService) Assign(ip string, dropletID int) (*Action,
 	return &Action{Action: a}, nil
 }
 
-func (fia *floatingIPActionsService) Unassign(ip string) (*Action, error) {
-	a, _, err := fia.client.FloatingIPActions.Unassign(context.TODO(), ip)
+func (fia *floatingIPActionsService) Unassign(ctx context.Context, ip string) (*Action, error) {
+	a, _, err := fia.client.FloatingIPActions.Unassign(ctx, ip)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +59,8 @@ func (fia *floatingIPActionsService) Unassign(ip string) (*Action, error) {
 	return &Action{Action: a}, nil
 }
 
-func (fia *floatingIPActionsService) Get(ip string, actionID int) (*Action, error) {
-	a, _, err := fia.client.FloatingIPActions.Get(context.TODO(), ip, actionID)
+func (fia *floatingIPActionsService) Get(ctx context.Context, ip string, actionID int) (*Action, error) {
+	a, _, err := fia.client.FloatingIPActions.Get(ctx, ip, actionID)
 	if err != nil {
 		return nil, err
 	}
@@ -68,9 +68,9 @@ func (fia *floatingIPActionsService) Get(ip string, actionID int) (*Action, erro
 	return &Action{Action: a}, nil
 }
 
-func (fia *floatingIPActionsService) List(ip string, opt *godo.ListOptions) ([]Action, error) {
+func (fia *floatingIPActionsService) List(ctx context.Context, ip string, opt *godo.ListOptions) ([]Action, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := fia.client.FloatingIPActions.List(context.TODO(), ip, opt)
+		list, resp, err := fia.client.FloatingIPActions.List(ctx, ip, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -83,7 +83,7 @@ func (fia *floatingIPActionsService) List(ip string, opt *godo.ListOptions) ([]A
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -29,10 +29,10 @@ type FloatingIPs []FloatingIP
 
 // FloatingIPsService is the godo FloatingIPsService interface.
 type FloatingIPsService interface {
-	List() (FloatingIPs, error)
-	Get(ip string) (*FloatingIP, error)
-	Create(ficr *godo.FloatingIPCreateRequest) (*FloatingIP, error)
-	Delete(ip string) error
+	List(context.Context) (FloatingIPs, error)
+	Get(ctx context.Context, ip string) (*FloatingIP, error)
+	Create(ctx context.Context, ficr *godo.FloatingIPCreateRequest) (*FloatingIP, error)
+	Delete(ctx context.Context, ip string) error
 }
 
 type floatingIPsService struct {
@@ -48,9 +48,9 @@ func NewFloatingIPsService(client *godo.Client) FloatingIPsService {
 	}
 }
 
-func (fis *floatingIPsService) List() (FloatingIPs, error) {
+func (fis *floatingIPsService) List(ctx context.Context) (FloatingIPs, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := fis.client.FloatingIPs.List(context.TODO(), opt)
+		list, resp, err := fis.client.FloatingIPs.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -63,7 +63,7 @@ func (fis *floatingIPsService) List() (FloatingIPs, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -77,8 +77,8 @@ func (fis *floatingIPsService) List() (FloatingIPs, error) {
 	return list, nil
 }
 
-func (fis *floatingIPsService) Get(ip string) (*FloatingIP, error) {
-	fip, _, err := fis.client.FloatingIPs.Get(context.TODO(), ip)
+func (fis *floatingIPsService) Get(ctx context.Context, ip string) (*FloatingIP, error) {
+	fip, _, err := fis.client.FloatingIPs.Get(ctx, ip)
 	if err != nil {
 		return nil, err
 	}
@@ -86,8 +86,8 @@ func (fis *floatingIPsService) Get(ip string) (*FloatingIP, error) {
 	return &FloatingIP{FloatingIP: fip}, nil
 }
 
-func (fis *floatingIPsService) Create(ficr *godo.FloatingIPCreateRequest) (*FloatingIP, error) {
-	fip, _, err := fis.client.FloatingIPs.Create(context.TODO(), ficr)
+func (fis *floatingIPsService) Create(ctx context.Context, ficr *godo.FloatingIPCreateRequest) (*FloatingIP, error) {
+	fip, _, err := fis.client.FloatingIPs.Create(ctx, ficr)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +95,7 @@ func (fis *floatingIPsService) Create(ficr *godo.FloatingIPCreateRequest) (*Floa
 	return &FloatingIP{FloatingIP: fip}, nil
 }
 
-func (fis *floatingIPsService) Delete(ip string) error {
-	_, err := fis.client.FloatingIPs.Delete(context.TODO(), ip)
+func (fis *floatingIPsService) Delete(ctx context.Context, ip string) error {
+	_, err := fis.client.FloatingIPs.Delete(ctx, ip)
 	return err
 }
@@ -21,9 +21,9 @@ import (
 
 // ImageActionsService is an interface for interacting with DigitalOcean's image action api.
 type ImageActionsService interface {
-	Get(int, int) (*Action, error)
-	Convert(int) (*Action, error)
-	Transfer(int, *godo.ActionRequest) (*Action, error)
+	Get(context.Context, int, int) (*Action, error)
+	Convert(context.Context, int) (*Action, error)
+	Transfer(context.Context, int, *godo.ActionRequest) (*Action, error)
 }
 
 type imageActionsService struct {
@@ -39,8 +39,8 @@ func NewImageActionsService(client *godo.Client) ImageActionsService {
 	}
 }
 
-func (ia *imageActionsService) Get(imageID, actionID int) (*Action, error) {
-	a, _, err := ia.client.ImageActions.Get(context.TODO(), imageID, actionID)
+func (ia *imageActionsService) Get(ctx context.Context, imageID, actionID int) (*Action, error) {
+	a, _, err := ia.client.ImageActions.Get(ctx, imageID, actionID)
 	if err != nil {
 		return nil, err
 	}
@@ -48,8 +48,8 @@ func (ia *imageActionsService) Get(imageID, actionID int) (*Action, error) {
 	return &Action{Action: a}, nil
 }
 
-func (ia *imageActionsService) Convert(imageID int) (*Action, error) {
-	a, _, err := ia.client.ImageActions.Convert(context.TODO(), imageID)
+func (ia *imageActionsService) Convert(ctx context.Context, imageID int) (*Action, error) {
+	a, _, err := ia.client.ImageActions.Convert(ctx, imageID)
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +57,8 @@ func (ia *imageActionsService) Convert(imageID int) (*Action, error) {
 	return &Action{Action: a}, nil
 }
 
-func (ia *imageActionsService) Transfer(imageID int, transferRequest *godo.ActionRequest) (*Action, error) {
-	a, _, err := ia.client.ImageActions.Transfer(context.TODO(), imageID, transferRequest)
+func (ia *imageActionsService) Transfer(ctx context.Context, imageID int, transferRequest *godo.ActionRequest) (*Action, error) {
+	a, _, err := ia.client.ImageActions.Transfer(ctx, imageID, transferRequest)
 	if err != nil {
 		return nil, err
 	}
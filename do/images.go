@@ -29,15 +29,16 @@ type Images []Image
 
 // ImagesService is the godo ImagesService interface.
 type ImagesService interface {
-	List(public bool) (Images, error)
-	ListDistribution(public bool) (Images, error)
-	ListApplication(public bool) (Images, error)
-	ListUser(public bool) (Images, error)
-	GetByID(id int) (*Image, error)
-	GetBySlug(slug string) (*Image, error)
-	Update(id int, iur *godo.ImageUpdateRequest) (*Image, error)
-	Delete(id int) error
-	Create(icr *godo.CustomImageCreateRequest) (*Image, error)
+	List(ctx context.Context, public bool) (Images, error)
+	ListPages(ctx context.Context, public bool, fn func(Images) error) error
+	ListDistribution(ctx context.Context, public bool) (Images, error)
+	ListApplication(ctx context.Context, public bool) (Images, error)
+	ListUser(ctx context.Context, public bool) (Images, error)
+	GetByID(ctx context.Context, id int) (*Image, error)
+	GetBySlug(ctx context.Context, slug string) (*Image, error)
+	Update(ctx context.Context, id int, iur *godo.ImageUpdateRequest) (*Image, error)
+	Delete(ctx context.Context, id int) error
+	Create(ctx context.Context, icr *godo.CustomImageCreateRequest) (*Image, error)
 }
 
 type imagesService struct {
@@ -53,24 +54,29 @@ func NewImagesService(client *godo.Client) ImagesService {
 	}
 }
 
-func (is *imagesService) List(public bool) (Images, error) {
-	return is.listImages(is.client.Images.List, public)
+func (is *imagesService) List(ctx context.Context, public bool) (Images, error) {
+	return is.listImages(ctx, is.client.Images.List, public)
 }
 
-func (is *imagesService) ListDistribution(public bool) (Images, error) {
-	return is.listImages(is.client.Images.ListDistribution, public)
+// ListPages calls fn with each page of images as it arrives.
+func (is *imagesService) ListPages(ctx context.Context, public bool, fn func(Images) error) error {
+	return is.listImagePages(ctx, is.client.Images.List, public, fn)
 }
 
-func (is *imagesService) ListApplication(public bool) (Images, error) {
-	return is.listImages(is.client.Images.ListApplication, public)
+func (is *imagesService) ListDistribution(ctx context.Context, public bool) (Images, error) {
+	return is.listImages(ctx, is.client.Images.ListDistribution, public)
 }
 
-func (is *imagesService) ListUser(public bool) (Images, error) {
-	return is.listImages(is.client.Images.ListUser, public)
+func (is *imagesService) ListApplication(ctx context.Context, public bool) (Images, error) {
+	return is.listImages(ctx, is.client.Images.ListApplication, public)
 }
 
-func (is *imagesService) GetByID(id int) (*Image, error) {
-	i, _, err := is.client.Images.GetByID(context.TODO(), id)
+func (is *imagesService) ListUser(ctx context.Context, public bool) (Images, error) {
+	return is.listImages(ctx, is.client.Images.ListUser, public)
+}
+
+func (is *imagesService) GetByID(ctx context.Context, id int) (*Image, error) {
+	i, _, err := is.client.Images.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -78,8 +84,8 @@ func (is *imagesService) GetByID(id int) (*Image, error) {
 	return &Image{Image: i}, nil
 }
 
-func (is *imagesService) GetBySlug(slug string) (*Image, error) {
-	i, _, err := is.client.Images.GetBySlug(context.TODO(), slug)
+func (is *imagesService) GetBySlug(ctx context.Context, slug string) (*Image, error) {
+	i, _, err := is.client.Images.GetBySlug(ctx, slug)
 	if err != nil {
 		return nil, err
 	}
@@ -87,8 +93,8 @@ func (is *imagesService) GetBySlug(slug string) (*Image, error) {
 	return &Image{Image: i}, nil
 }
 
-func (is *imagesService) Update(id int, iur *godo.ImageUpdateRequest) (*Image, error) {
-	i, _, err := is.client.Images.Update(context.TODO(), id, iur)
+func (is *imagesService) Update(ctx context.Context, id int, iur *godo.ImageUpdateRequest) (*Image, error) {
+	i, _, err := is.client.Images.Update(ctx, id, iur)
 	if err != nil {
 		return nil, err
 	}
@@ -96,13 +102,13 @@ func (is *imagesService) Update(id int, iur *godo.ImageUpdateRequest) (*Image, e
 	return &Image{Image: i}, nil
 }
 
-func (is *imagesService) Delete(id int) error {
-	_, err := is.client.Images.Delete(context.TODO(), id)
+func (is *imagesService) Delete(ctx context.Context, id int) error {
+	_, err := is.client.Images.Delete(ctx, id)
 	return err
 }
 
-func (is *imagesService) Create(icr *godo.CustomImageCreateRequest) (*Image, error) {
-	i, _, err := is.client.Images.Create(context.TODO(), icr)
+func (is *imagesService) Create(ctx context.Context, icr *godo.CustomImageCreateRequest) (*Image, error) {
+	i, _, err := is.client.Images.Create(ctx, icr)
 	if err != nil {
 		return nil, err
 	}
@@ -112,9 +118,22 @@ func (is *imagesService) Create(icr *godo.CustomImageCreateRequest) (*Image, err
 
 type listFn func(context.Context, *godo.ListOptions) ([]godo.Image, *godo.Response, error)
 
-func (is *imagesService) listImages(lFn listFn, public bool) (Images, error) {
-	fn := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := lFn(context.TODO(), opt)
+func (is *imagesService) listImages(ctx context.Context, lFn listFn, public bool) (Images, error) {
+	var list Images
+	err := is.listImagePages(ctx, lFn, public, func(page Images) error {
+		list = append(list, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (is *imagesService) listImagePages(ctx context.Context, lFn listFn, public bool, fn func(Images) error) error {
+	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		list, resp, err := lFn(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -129,16 +148,41 @@ func (is *imagesService) listImages(lFn listFn, public bool) (Images, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(fn)
-	if err != nil {
-		return nil, err
+	return PaginateEach(ctx, f, func(si []interface{}) error {
+		var list Images
+		for i := range si {
+			image := si[i].(godo.Image)
+			list = append(list, Image{Image: &image})
+		}
+
+		return fn(list)
+	})
+}
+
+type cachedImagesService struct {
+	ImagesService
+	cache *Cache
+}
+
+// NewCachedImagesService builds an ImagesService listing the distribution
+// images of is through cache.
+func NewCachedImagesService(is ImagesService, cache *Cache) ImagesService {
+	return &cachedImagesService{ImagesService: is, cache: cache}
+}
+
+func (is *cachedImagesService) ListDistribution(ctx context.Context, public bool) (Images, error) {
+	name := "images-distribution"
+	if public {
+		name += "-public"
 	}
 
 	var list Images
-	for i := range si {
-		image := si[i].(godo.Image)
-		list = append(list, Image{Image: &image})
+	err := is.cache.Fetch(name, &list, func() (err error) {
+		list, err = is.ImagesService.ListDistribution(wholeList(ctx), public)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	return list, nil
+	return list[:limitedLen(ctx, len(list))], nil
 }
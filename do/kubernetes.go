@@ -66,28 +66,28 @@ type KubernetesNodeSize struct {
 
 // KubernetesService is the godo KubernetesService interface.
 type KubernetesService interface {
-	Get(clusterID string) (*KubernetesCluster, error)
-	GetKubeConfig(clusterID string) ([]byte, error)
-	GetCredentials(clusterID string) (*KubernetesClusterCredentials, error)
-	GetUpgrades(clusterID string) (KubernetesVersions, error)
-	List() (KubernetesClusters, error)
-	Create(create *godo.KubernetesClusterCreateRequest) (*KubernetesCluster, error)
-	Update(clusterID string, update *godo.KubernetesClusterUpdateRequest) (*KubernetesCluster, error)
-	Upgrade(clusterID string, versionSlug string) error
-	Delete(clusterID string) error
-
-	CreateNodePool(clusterID string, req *godo.KubernetesNodePoolCreateRequest) (*KubernetesNodePool, error)
-	GetNodePool(clusterID, poolID string) (*KubernetesNodePool, error)
-	ListNodePools(clusterID string) (KubernetesNodePools, error)
-	UpdateNodePool(clusterID, poolID string, req *godo.KubernetesNodePoolUpdateRequest) (*KubernetesNodePool, error)
+	Get(ctx context.Context, clusterID string) (*KubernetesCluster, error)
+	GetKubeConfig(ctx context.Context, clusterID string) ([]byte, error)
+	GetCredentials(ctx context.Context, clusterID string) (*KubernetesClusterCredentials, error)
+	GetUpgrades(ctx context.Context, clusterID string) (KubernetesVersions, error)
+	List(context.Context) (KubernetesClusters, error)
+	Create(ctx context.Context, create *godo.KubernetesClusterCreateRequest) (*KubernetesCluster, error)
+	Update(ctx context.Context, clusterID string, update *godo.KubernetesClusterUpdateRequest) (*KubernetesCluster, error)
+	Upgrade(ctx context.Context, clusterID string, versionSlug string) error
+	Delete(ctx context.Context, clusterID string) error
+
+	CreateNodePool(ctx context.Context, clusterID string, req *godo.KubernetesNodePoolCreateRequest) (*KubernetesNodePool, error)
+	GetNodePool(ctx context.Context, clusterID, poolID string) (*KubernetesNodePool, error)
+	ListNodePools(ctx context.Context, clusterID string) (KubernetesNodePools, error)
+	UpdateNodePool(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolUpdateRequest) (*KubernetesNodePool, error)
 	// RecycleNodePoolNodes is DEPRECATED please use DeleteNode
-	RecycleNodePoolNodes(clusterID, poolID string, req *godo.KubernetesNodePoolRecycleNodesRequest) error
-	DeleteNodePool(clusterID, poolID string) error
-	DeleteNode(clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) error
+	RecycleNodePoolNodes(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolRecycleNodesRequest) error
+	DeleteNodePool(ctx context.Context, clusterID, poolID string) error
+	DeleteNode(ctx context.Context, clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) error
 
-	GetVersions() (KubernetesVersions, error)
-	GetRegions() (KubernetesRegions, error)
-	GetNodeSizes() (KubernetesNodeSizes, error)
+	GetVersions(context.Context) (KubernetesVersions, error)
+	GetRegions(context.Context) (KubernetesRegions, error)
+	GetNodeSizes(context.Context) (KubernetesNodeSizes, error)
 }
 
 var _ KubernetesService = &kubernetesClusterService{}
@@ -103,8 +103,8 @@ func NewKubernetesService(client *godo.Client) KubernetesService {
 	}
 }
 
-func (k8s *kubernetesClusterService) Get(clusterID string) (*KubernetesCluster, error) {
-	cluster, _, err := k8s.client.Get(context.TODO(), clusterID)
+func (k8s *kubernetesClusterService) Get(ctx context.Context, clusterID string) (*KubernetesCluster, error) {
+	cluster, _, err := k8s.client.Get(ctx, clusterID)
 	if err != nil {
 		return nil, err
 	}
@@ -112,8 +112,8 @@ func (k8s *kubernetesClusterService) Get(clusterID string) (*KubernetesCluster,
 	return &KubernetesCluster{KubernetesCluster: cluster}, nil
 }
 
-func (k8s *kubernetesClusterService) GetKubeConfig(clusterID string) ([]byte, error) {
-	config, _, err := k8s.client.GetKubeConfig(context.TODO(), clusterID)
+func (k8s *kubernetesClusterService) GetKubeConfig(ctx context.Context, clusterID string) ([]byte, error) {
+	config, _, err := k8s.client.GetKubeConfig(ctx, clusterID)
 	if err != nil {
 		return nil, err
 	}
@@ -121,8 +121,8 @@ func (k8s *kubernetesClusterService) GetKubeConfig(clusterID string) ([]byte, er
 	return config.KubeconfigYAML, nil
 }
 
-func (k8s *kubernetesClusterService) GetCredentials(clusterID string) (*KubernetesClusterCredentials, error) {
-	credentials, _, err := k8s.client.GetCredentials(context.TODO(), clusterID, &godo.KubernetesClusterCredentialsGetRequest{})
+func (k8s *kubernetesClusterService) GetCredentials(ctx context.Context, clusterID string) (*KubernetesClusterCredentials, error) {
+	credentials, _, err := k8s.client.GetCredentials(ctx, clusterID, &godo.KubernetesClusterCredentialsGetRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -132,8 +132,8 @@ func (k8s *kubernetesClusterService) GetCredentials(clusterID string) (*Kubernet
 	}, nil
 }
 
-func (k8s *kubernetesClusterService) GetUpgrades(clusterID string) (KubernetesVersions, error) {
-	upgrades, _, err := k8s.client.GetUpgrades(context.TODO(), clusterID)
+func (k8s *kubernetesClusterService) GetUpgrades(ctx context.Context, clusterID string) (KubernetesVersions, error) {
+	upgrades, _, err := k8s.client.GetUpgrades(ctx, clusterID)
 	if err != nil {
 		return nil, err
 	}
@@ -148,9 +148,9 @@ func (k8s *kubernetesClusterService) GetUpgrades(clusterID string) (KubernetesVe
 	return versions, nil
 }
 
-func (k8s *kubernetesClusterService) List() (KubernetesClusters, error) {
+func (k8s *kubernetesClusterService) List(ctx context.Context) (KubernetesClusters, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := k8s.client.List(context.TODO(), opt)
+		list, resp, err := k8s.client.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -163,7 +163,7 @@ func (k8s *kubernetesClusterService) List() (KubernetesClusters, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -177,55 +177,55 @@ func (k8s *kubernetesClusterService) List() (KubernetesClusters, error) {
 	return list, nil
 }
 
-func (k8s *kubernetesClusterService) Create(create *godo.KubernetesClusterCreateRequest) (*KubernetesCluster, error) {
-	cluster, _, err := k8s.client.Create(context.TODO(), create)
+func (k8s *kubernetesClusterService) Create(ctx context.Context, create *godo.KubernetesClusterCreateRequest) (*KubernetesCluster, error) {
+	cluster, _, err := k8s.client.Create(ctx, create)
 	if err != nil {
 		return nil, err
 	}
 	return &KubernetesCluster{KubernetesCluster: cluster}, nil
 }
 
-func (k8s *kubernetesClusterService) Update(clusterID string, update *godo.KubernetesClusterUpdateRequest) (*KubernetesCluster, error) {
-	cluster, _, err := k8s.client.Update(context.TODO(), clusterID, update)
+func (k8s *kubernetesClusterService) Update(ctx context.Context, clusterID string, update *godo.KubernetesClusterUpdateRequest) (*KubernetesCluster, error) {
+	cluster, _, err := k8s.client.Update(ctx, clusterID, update)
 	if err != nil {
 		return nil, err
 	}
 	return &KubernetesCluster{KubernetesCluster: cluster}, nil
 }
 
-func (k8s *kubernetesClusterService) Upgrade(clusterID string, versionSlug string) error {
+func (k8s *kubernetesClusterService) Upgrade(ctx context.Context, clusterID string, versionSlug string) error {
 	req := &godo.KubernetesClusterUpgradeRequest{
 		VersionSlug: versionSlug,
 	}
 
-	_, err := k8s.client.Upgrade(context.TODO(), clusterID, req)
+	_, err := k8s.client.Upgrade(ctx, clusterID, req)
 	return err
 }
 
-func (k8s *kubernetesClusterService) Delete(clusterID string) error {
-	_, err := k8s.client.Delete(context.TODO(), clusterID)
+func (k8s *kubernetesClusterService) Delete(ctx context.Context, clusterID string) error {
+	_, err := k8s.client.Delete(ctx, clusterID)
 	return err
 }
 
-func (k8s *kubernetesClusterService) CreateNodePool(clusterID string, req *godo.KubernetesNodePoolCreateRequest) (*KubernetesNodePool, error) {
-	pool, _, err := k8s.client.CreateNodePool(context.TODO(), clusterID, req)
+func (k8s *kubernetesClusterService) CreateNodePool(ctx context.Context, clusterID string, req *godo.KubernetesNodePoolCreateRequest) (*KubernetesNodePool, error) {
+	pool, _, err := k8s.client.CreateNodePool(ctx, clusterID, req)
 	if err != nil {
 		return nil, err
 	}
 	return &KubernetesNodePool{KubernetesNodePool: pool}, nil
 }
 
-func (k8s *kubernetesClusterService) GetNodePool(clusterID, poolID string) (*KubernetesNodePool, error) {
-	pool, _, err := k8s.client.GetNodePool(context.TODO(), clusterID, poolID)
+func (k8s *kubernetesClusterService) GetNodePool(ctx context.Context, clusterID, poolID string) (*KubernetesNodePool, error) {
+	pool, _, err := k8s.client.GetNodePool(ctx, clusterID, poolID)
 	if err != nil {
 		return nil, err
 	}
 	return &KubernetesNodePool{KubernetesNodePool: pool}, nil
 }
 
-func (k8s *kubernetesClusterService) ListNodePools(clusterID string) (KubernetesNodePools, error) {
+func (k8s *kubernetesClusterService) ListNodePools(ctx context.Context, clusterID string) (KubernetesNodePools, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := k8s.client.ListNodePools(context.TODO(), clusterID, opt)
+		list, resp, err := k8s.client.ListNodePools(ctx, clusterID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -238,7 +238,7 @@ func (k8s *kubernetesClusterService) ListNodePools(clusterID string) (Kubernetes
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -253,31 +253,31 @@ func (k8s *kubernetesClusterService) ListNodePools(clusterID string) (Kubernetes
 
 }
 
-func (k8s *kubernetesClusterService) UpdateNodePool(clusterID, poolID string, req *godo.KubernetesNodePoolUpdateRequest) (*KubernetesNodePool, error) {
-	pool, _, err := k8s.client.UpdateNodePool(context.TODO(), clusterID, poolID, req)
+func (k8s *kubernetesClusterService) UpdateNodePool(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolUpdateRequest) (*KubernetesNodePool, error) {
+	pool, _, err := k8s.client.UpdateNodePool(ctx, clusterID, poolID, req)
 	if err != nil {
 		return nil, err
 	}
 	return &KubernetesNodePool{KubernetesNodePool: pool}, nil
 }
 
-func (k8s *kubernetesClusterService) RecycleNodePoolNodes(clusterID, poolID string, req *godo.KubernetesNodePoolRecycleNodesRequest) error {
-	_, err := k8s.client.RecycleNodePoolNodes(context.TODO(), clusterID, poolID, req)
+func (k8s *kubernetesClusterService) RecycleNodePoolNodes(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolRecycleNodesRequest) error {
+	_, err := k8s.client.RecycleNodePoolNodes(ctx, clusterID, poolID, req)
 	return err
 }
 
-func (k8s *kubernetesClusterService) DeleteNodePool(clusterID, poolID string) error {
-	_, err := k8s.client.DeleteNodePool(context.TODO(), clusterID, poolID)
+func (k8s *kubernetesClusterService) DeleteNodePool(ctx context.Context, clusterID, poolID string) error {
+	_, err := k8s.client.DeleteNodePool(ctx, clusterID, poolID)
 	return err
 }
 
-func (k8s *kubernetesClusterService) DeleteNode(clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) error {
-	_, err := k8s.client.DeleteNode(context.TODO(), clusterID, poolID, nodeID, req)
+func (k8s *kubernetesClusterService) DeleteNode(ctx context.Context, clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) error {
+	_, err := k8s.client.DeleteNode(ctx, clusterID, poolID, nodeID, req)
 	return err
 }
 
-func (k8s *kubernetesClusterService) GetVersions() (KubernetesVersions, error) {
-	opts, _, err := k8s.client.GetOptions(context.TODO())
+func (k8s *kubernetesClusterService) GetVersions(ctx context.Context) (KubernetesVersions, error) {
+	opts, _, err := k8s.client.GetOptions(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -288,8 +288,8 @@ func (k8s *kubernetesClusterService) GetVersions() (KubernetesVersions, error) {
 	return list, err
 }
 
-func (k8s *kubernetesClusterService) GetRegions() (KubernetesRegions, error) {
-	opts, _, err := k8s.client.GetOptions(context.TODO())
+func (k8s *kubernetesClusterService) GetRegions(ctx context.Context) (KubernetesRegions, error) {
+	opts, _, err := k8s.client.GetOptions(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -300,8 +300,8 @@ func (k8s *kubernetesClusterService) GetRegions() (KubernetesRegions, error) {
 	return list, err
 }
 
-func (k8s *kubernetesClusterService) GetNodeSizes() (KubernetesNodeSizes, error) {
-	opts, _, err := k8s.client.GetOptions(context.TODO())
+func (k8s *kubernetesClusterService) GetNodeSizes(ctx context.Context) (KubernetesNodeSizes, error) {
+	opts, _, err := k8s.client.GetOptions(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -311,3 +311,41 @@ func (k8s *kubernetesClusterService) GetNodeSizes() (KubernetesNodeSizes, error)
 	}
 	return list, err
 }
+
+type cachedKubernetesService struct {
+	KubernetesService
+	cache *Cache
+}
+
+// NewCachedKubernetesService builds a KubernetesService getting the
+// versions, regions and node sizes of k8s through cache.
+func NewCachedKubernetesService(k8s KubernetesService, cache *Cache) KubernetesService {
+	return &cachedKubernetesService{KubernetesService: k8s, cache: cache}
+}
+
+func (k8s *cachedKubernetesService) GetVersions(ctx context.Context) (KubernetesVersions, error) {
+	var list KubernetesVersions
+	err := k8s.cache.Fetch("kubernetes-versions", &list, func() (err error) {
+		list, err = k8s.KubernetesService.GetVersions(ctx)
+		return err
+	})
+	return list, err
+}
+
+func (k8s *cachedKubernetesService) GetRegions(ctx context.Context) (KubernetesRegions, error) {
+	var list KubernetesRegions
+	err := k8s.cache.Fetch("kubernetes-regions", &list, func() (err error) {
+		list, err = k8s.KubernetesService.GetRegions(ctx)
+		return err
+	})
+	return list, err
+}
+
+func (k8s *cachedKubernetesService) GetNodeSizes(ctx context.Context) (KubernetesNodeSizes, error) {
+	var list KubernetesNodeSizes
+	err := k8s.cache.Fetch("kubernetes-node-sizes", &list, func() (err error) {
+		list, err = k8s.KubernetesService.GetNodeSizes(ctx)
+		return err
+	})
+	return list, err
+}
@@ -29,15 +29,15 @@ type LoadBalancers []LoadBalancer
 
 // LoadBalancersService is the godo LoadBalancersService interface.
 type LoadBalancersService interface {
-	Get(lbID string) (*LoadBalancer, error)
-	List() (LoadBalancers, error)
-	Create(lbr *godo.LoadBalancerRequest) (*LoadBalancer, error)
-	Update(lbID string, lbr *godo.LoadBalancerRequest) (*LoadBalancer, error)
-	Delete(lbID string) error
-	AddDroplets(lbID string, dIDs ...int) error
-	RemoveDroplets(lbID string, dIDs ...int) error
-	AddForwardingRules(lbID string, rules ...godo.ForwardingRule) error
-	RemoveForwardingRules(lbID string, rules ...godo.ForwardingRule) error
+	Get(ctx context.Context, lbID string) (*LoadBalancer, error)
+	List(context.Context) (LoadBalancers, error)
+	Create(ctx context.Context, lbr *godo.LoadBalancerRequest) (*LoadBalancer, error)
+	Update(ctx context.Context, lbID string, lbr *godo.LoadBalancerRequest) (*LoadBalancer, error)
+	Delete(ctx context.Context, lbID string) error
+	AddDroplets(ctx context.Context, lbID string, dIDs ...int) error
+	RemoveDroplets(ctx context.Context, lbID string, dIDs ...int) error
+	AddForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) error
+	RemoveForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) error
 }
 
 var _ LoadBalancersService = &loadBalancersService{}
@@ -53,8 +53,8 @@ func NewLoadBalancersService(client *godo.Client) LoadBalancersService {
 	}
 }
 
-func (lbs *loadBalancersService) Get(lbID string) (*LoadBalancer, error) {
-	lb, _, err := lbs.client.LoadBalancers.Get(context.TODO(), lbID)
+func (lbs *loadBalancersService) Get(ctx context.Context, lbID string) (*LoadBalancer, error) {
+	lb, _, err := lbs.client.LoadBalancers.Get(ctx, lbID)
 	if err != nil {
 		return nil, err
 	}
@@ -62,9 +62,9 @@ func (lbs *loadBalancersService) Get(lbID string) (*LoadBalancer, error) {
 	return &LoadBalancer{LoadBalancer: lb}, nil
 }
 
-func (lbs *loadBalancersService) List() (LoadBalancers, error) {
+func (lbs *loadBalancersService) List(ctx context.Context) (LoadBalancers, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := lbs.client.LoadBalancers.List(context.TODO(), opt)
+		list, resp, err := lbs.client.LoadBalancers.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -77,7 +77,7 @@ func (lbs *loadBalancersService) List() (LoadBalancers, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -91,8 +91,8 @@ func (lbs *loadBalancersService) List() (LoadBalancers, error) {
 	return list, nil
 }
 
-func (lbs *loadBalancersService) Create(lbr *godo.LoadBalancerRequest) (*LoadBalancer, error) {
-	lb, _, err := lbs.client.LoadBalancers.Create(context.TODO(), lbr)
+func (lbs *loadBalancersService) Create(ctx context.Context, lbr *godo.LoadBalancerRequest) (*LoadBalancer, error) {
+	lb, _, err := lbs.client.LoadBalancers.Create(ctx, lbr)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +100,8 @@ func (lbs *loadBalancersService) Create(lbr *godo.LoadBalancerRequest) (*LoadBal
 	return &LoadBalancer{LoadBalancer: lb}, nil
 }
 
-func (lbs *loadBalancersService) Update(lbID string, lbr *godo.LoadBalancerRequest) (*LoadBalancer, error) {
-	lb, _, err := lbs.client.LoadBalancers.Update(context.TODO(), lbID, lbr)
+func (lbs *loadBalancersService) Update(ctx context.Context, lbID string, lbr *godo.LoadBalancerRequest) (*LoadBalancer, error) {
+	lb, _, err := lbs.client.LoadBalancers.Update(ctx, lbID, lbr)
 	if err != nil {
 		return nil, err
 	}
@@ -109,27 +109,27 @@ func (lbs *loadBalancersService) Update(lbID string, lbr *godo.LoadBalancerReque
 	return &LoadBalancer{LoadBalancer: lb}, nil
 }
 
-func (lbs *loadBalancersService) Delete(lbID string) error {
-	_, err := lbs.client.LoadBalancers.Delete(context.TODO(), lbID)
+func (lbs *loadBalancersService) Delete(ctx context.Context, lbID string) error {
+	_, err := lbs.client.LoadBalancers.Delete(ctx, lbID)
 	return err
 }
 
-func (lbs *loadBalancersService) AddDroplets(lbID string, dIDs ...int) error {
-	_, err := lbs.client.LoadBalancers.AddDroplets(context.TODO(), lbID, dIDs...)
+func (lbs *loadBalancersService) AddDroplets(ctx context.Context, lbID string, dIDs ...int) error {
+	_, err := lbs.client.LoadBalancers.AddDroplets(ctx, lbID, dIDs...)
 	return err
 }
 
-func (lbs *loadBalancersService) RemoveDroplets(lbID string, dIDs ...int) error {
-	_, err := lbs.client.LoadBalancers.RemoveDroplets(context.TODO(), lbID, dIDs...)
+func (lbs *loadBalancersService) RemoveDroplets(ctx context.Context, lbID string, dIDs ...int) error {
+	_, err := lbs.client.LoadBalancers.RemoveDroplets(ctx, lbID, dIDs...)
 	return err
 }
 
-func (lbs *loadBalancersService) AddForwardingRules(lbID string, rules ...godo.ForwardingRule) error {
-	_, err := lbs.client.LoadBalancers.AddForwardingRules(context.TODO(), lbID, rules...)
+func (lbs *loadBalancersService) AddForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) error {
+	_, err := lbs.client.LoadBalancers.AddForwardingRules(ctx, lbID, rules...)
 	return err
 }
 
-func (lbs *loadBalancersService) RemoveForwardingRules(lbID string, rules ...godo.ForwardingRule) error {
-	_, err := lbs.client.LoadBalancers.RemoveForwardingRules(context.TODO(), lbID, rules...)
+func (lbs *loadBalancersService) RemoveForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) error {
+	_, err := lbs.client.LoadBalancers.RemoveForwardingRules(ctx, lbID, rules...)
 	return err
 }
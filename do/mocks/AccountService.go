@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,31 +35,31 @@ func (m *MockAccountService) EXPECT() *MockAccountServiceMockRecorder {
 }
 
 // Get mocks base method
-func (m *MockAccountService) Get() (*do.Account, error) {
+func (m *MockAccountService) Get(arg0 context.Context) (*do.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get")
+	ret := m.ctrl.Call(m, "Get", arg0)
 	ret0, _ := ret[0].(*do.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockAccountServiceMockRecorder) Get() *gomock.Call {
+func (mr *MockAccountServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAccountService)(nil).Get))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAccountService)(nil).Get), arg0)
 }
 
 // RateLimit mocks base method
-func (m *MockAccountService) RateLimit() (*do.RateLimit, error) {
+func (m *MockAccountService) RateLimit(arg0 context.Context) (*do.RateLimit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RateLimit")
+	ret := m.ctrl.Call(m, "RateLimit", arg0)
 	ret0, _ := ret[0].(*do.RateLimit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RateLimit indicates an expected call of RateLimit
-func (mr *MockAccountServiceMockRecorder) RateLimit() *gomock.Call {
+func (mr *MockAccountServiceMockRecorder) RateLimit(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateLimit", reflect.TypeOf((*MockAccountService)(nil).RateLimit))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateLimit", reflect.TypeOf((*MockAccountService)(nil).RateLimit), arg0)
 }
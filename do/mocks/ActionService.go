@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,31 +35,45 @@ func (m *MockActionsService) EXPECT() *MockActionsServiceMockRecorder {
 }
 
 // List mocks base method
-func (m *MockActionsService) List() (do.Actions, error) {
+func (m *MockActionsService) List(arg0 context.Context) (do.Actions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.Actions)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockActionsServiceMockRecorder) List() *gomock.Call {
+func (mr *MockActionsServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockActionsService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockActionsService)(nil).List), arg0)
+}
+
+// ListPages mocks base method
+func (m *MockActionsService) ListPages(arg0 context.Context, arg1 func(do.Actions) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListPages indicates an expected call of ListPages
+func (mr *MockActionsServiceMockRecorder) ListPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPages", reflect.TypeOf((*MockActionsService)(nil).ListPages), arg0, arg1)
 }
 
 // Get mocks base method
-func (m *MockActionsService) Get(arg0 int) (*do.Action, error) {
+func (m *MockActionsService) Get(arg0 context.Context, arg1 int) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockActionsServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
+func (mr *MockActionsServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockActionsService)(nil).Get), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockActionsService)(nil).Get), arg0, arg1)
 }
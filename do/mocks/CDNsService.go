@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,104 +36,104 @@ func (m *MockCDNsService) EXPECT() *MockCDNsServiceMockRecorder {
 }
 
 // List mocks base method
-func (m *MockCDNsService) List() ([]do.CDN, error) {
+func (m *MockCDNsService) List(arg0 context.Context) ([]do.CDN, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].([]do.CDN)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockCDNsServiceMockRecorder) List() *gomock.Call {
+func (mr *MockCDNsServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCDNsService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCDNsService)(nil).List), arg0)
 }
 
 // Get mocks base method
-func (m *MockCDNsService) Get(arg0 string) (*do.CDN, error) {
+func (m *MockCDNsService) Get(arg0 context.Context, arg1 string) (*do.CDN, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
 	ret0, _ := ret[0].(*do.CDN)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockCDNsServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
+func (mr *MockCDNsServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCDNsService)(nil).Get), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCDNsService)(nil).Get), arg0, arg1)
 }
 
 // Create mocks base method
-func (m *MockCDNsService) Create(arg0 *godo.CDNCreateRequest) (*do.CDN, error) {
+func (m *MockCDNsService) Create(arg0 context.Context, arg1 *godo.CDNCreateRequest) (*do.CDN, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(*do.CDN)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockCDNsServiceMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockCDNsServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCDNsService)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCDNsService)(nil).Create), arg0, arg1)
 }
 
 // UpdateTTL mocks base method
-func (m *MockCDNsService) UpdateTTL(arg0 string, arg1 *godo.CDNUpdateTTLRequest) (*do.CDN, error) {
+func (m *MockCDNsService) UpdateTTL(arg0 context.Context, arg1 string, arg2 *godo.CDNUpdateTTLRequest) (*do.CDN, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTTL", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateTTL", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*do.CDN)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateTTL indicates an expected call of UpdateTTL
-func (mr *MockCDNsServiceMockRecorder) UpdateTTL(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockCDNsServiceMockRecorder) UpdateTTL(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTTL", reflect.TypeOf((*MockCDNsService)(nil).UpdateTTL), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTTL", reflect.TypeOf((*MockCDNsService)(nil).UpdateTTL), arg0, arg1, arg2)
 }
 
 // UpdateCustomDomain mocks base method
-func (m *MockCDNsService) UpdateCustomDomain(arg0 string, arg1 *godo.CDNUpdateCustomDomainRequest) (*do.CDN, error) {
+func (m *MockCDNsService) UpdateCustomDomain(arg0 context.Context, arg1 string, arg2 *godo.CDNUpdateCustomDomainRequest) (*do.CDN, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCustomDomain", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateCustomDomain", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*do.CDN)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateCustomDomain indicates an expected call of UpdateCustomDomain
-func (mr *MockCDNsServiceMockRecorder) UpdateCustomDomain(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockCDNsServiceMockRecorder) UpdateCustomDomain(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomDomain", reflect.TypeOf((*MockCDNsService)(nil).UpdateCustomDomain), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomDomain", reflect.TypeOf((*MockCDNsService)(nil).UpdateCustomDomain), arg0, arg1, arg2)
 }
 
 // FlushCache mocks base method
-func (m *MockCDNsService) FlushCache(arg0 string, arg1 *godo.CDNFlushCacheRequest) error {
+func (m *MockCDNsService) FlushCache(arg0 context.Context, arg1 string, arg2 *godo.CDNFlushCacheRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FlushCache", arg0, arg1)
+	ret := m.ctrl.Call(m, "FlushCache", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // FlushCache indicates an expected call of FlushCache
-func (mr *MockCDNsServiceMockRecorder) FlushCache(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockCDNsServiceMockRecorder) FlushCache(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushCache", reflect.TypeOf((*MockCDNsService)(nil).FlushCache), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushCache", reflect.TypeOf((*MockCDNsService)(nil).FlushCache), arg0, arg1, arg2)
 }
 
 // Delete mocks base method
-func (m *MockCDNsService) Delete(arg0 string) error {
+func (m *MockCDNsService) Delete(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockCDNsServiceMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+func (mr *MockCDNsServiceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCDNsService)(nil).Delete), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCDNsService)(nil).Delete), arg0, arg1)
 }
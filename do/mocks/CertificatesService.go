@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,60 +36,60 @@ func (m *MockCertificatesService) EXPECT() *MockCertificatesServiceMockRecorder
 }
 
 // Get mocks base method
-func (m *MockCertificatesService) Get(cID string) (*do.Certificate, error) {
+func (m *MockCertificatesService) Get(ctx context.Context, cID string) (*do.Certificate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", cID)
+	ret := m.ctrl.Call(m, "Get", ctx, cID)
 	ret0, _ := ret[0].(*do.Certificate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockCertificatesServiceMockRecorder) Get(cID interface{}) *gomock.Call {
+func (mr *MockCertificatesServiceMockRecorder) Get(ctx, cID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCertificatesService)(nil).Get), cID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCertificatesService)(nil).Get), ctx, cID)
 }
 
 // Create mocks base method
-func (m *MockCertificatesService) Create(cr *godo.CertificateRequest) (*do.Certificate, error) {
+func (m *MockCertificatesService) Create(ctx context.Context, cr *godo.CertificateRequest) (*do.Certificate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", cr)
+	ret := m.ctrl.Call(m, "Create", ctx, cr)
 	ret0, _ := ret[0].(*do.Certificate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockCertificatesServiceMockRecorder) Create(cr interface{}) *gomock.Call {
+func (mr *MockCertificatesServiceMockRecorder) Create(ctx, cr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCertificatesService)(nil).Create), cr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCertificatesService)(nil).Create), ctx, cr)
 }
 
 // List mocks base method
-func (m *MockCertificatesService) List() (do.Certificates, error) {
+func (m *MockCertificatesService) List(arg0 context.Context) (do.Certificates, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.Certificates)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockCertificatesServiceMockRecorder) List() *gomock.Call {
+func (mr *MockCertificatesServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCertificatesService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCertificatesService)(nil).List), arg0)
 }
 
 // Delete mocks base method
-func (m *MockCertificatesService) Delete(cID string) error {
+func (m *MockCertificatesService) Delete(ctx context.Context, cID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", cID)
+	ret := m.ctrl.Call(m, "Delete", ctx, cID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockCertificatesServiceMockRecorder) Delete(cID interface{}) *gomock.Call {
+func (mr *MockCertificatesServiceMockRecorder) Delete(ctx, cID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCertificatesService)(nil).Delete), cID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCertificatesService)(nil).Delete), ctx, cID)
 }
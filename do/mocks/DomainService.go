@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,134 +36,134 @@ func (m *MockDomainsService) EXPECT() *MockDomainsServiceMockRecorder {
 }
 
 // List mocks base method
-func (m *MockDomainsService) List() (do.Domains, error) {
+func (m *MockDomainsService) List(arg0 context.Context) (do.Domains, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.Domains)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockDomainsServiceMockRecorder) List() *gomock.Call {
+func (mr *MockDomainsServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDomainsService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDomainsService)(nil).List), arg0)
 }
 
 // Get mocks base method
-func (m *MockDomainsService) Get(arg0 string) (*do.Domain, error) {
+func (m *MockDomainsService) Get(arg0 context.Context, arg1 string) (*do.Domain, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
 	ret0, _ := ret[0].(*do.Domain)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockDomainsServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
+func (mr *MockDomainsServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDomainsService)(nil).Get), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDomainsService)(nil).Get), arg0, arg1)
 }
 
 // Create mocks base method
-func (m *MockDomainsService) Create(arg0 *godo.DomainCreateRequest) (*do.Domain, error) {
+func (m *MockDomainsService) Create(arg0 context.Context, arg1 *godo.DomainCreateRequest) (*do.Domain, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(*do.Domain)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockDomainsServiceMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockDomainsServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDomainsService)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDomainsService)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method
-func (m *MockDomainsService) Delete(arg0 string) error {
+func (m *MockDomainsService) Delete(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockDomainsServiceMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+func (mr *MockDomainsServiceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDomainsService)(nil).Delete), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDomainsService)(nil).Delete), arg0, arg1)
 }
 
 // Records mocks base method
-func (m *MockDomainsService) Records(arg0 string) (do.DomainRecords, error) {
+func (m *MockDomainsService) Records(arg0 context.Context, arg1 string) (do.DomainRecords, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Records", arg0)
+	ret := m.ctrl.Call(m, "Records", arg0, arg1)
 	ret0, _ := ret[0].(do.DomainRecords)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Records indicates an expected call of Records
-func (mr *MockDomainsServiceMockRecorder) Records(arg0 interface{}) *gomock.Call {
+func (mr *MockDomainsServiceMockRecorder) Records(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Records", reflect.TypeOf((*MockDomainsService)(nil).Records), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Records", reflect.TypeOf((*MockDomainsService)(nil).Records), arg0, arg1)
 }
 
 // Record mocks base method
-func (m *MockDomainsService) Record(arg0 string, arg1 int) (*do.DomainRecord, error) {
+func (m *MockDomainsService) Record(arg0 context.Context, arg1 string, arg2 int) (*do.DomainRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Record", arg0, arg1)
+	ret := m.ctrl.Call(m, "Record", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*do.DomainRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Record indicates an expected call of Record
-func (mr *MockDomainsServiceMockRecorder) Record(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockDomainsServiceMockRecorder) Record(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockDomainsService)(nil).Record), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockDomainsService)(nil).Record), arg0, arg1, arg2)
 }
 
 // DeleteRecord mocks base method
-func (m *MockDomainsService) DeleteRecord(arg0 string, arg1 int) error {
+func (m *MockDomainsService) DeleteRecord(arg0 context.Context, arg1 string, arg2 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteRecord", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteRecord indicates an expected call of DeleteRecord
-func (mr *MockDomainsServiceMockRecorder) DeleteRecord(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockDomainsServiceMockRecorder) DeleteRecord(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockDomainsService)(nil).DeleteRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockDomainsService)(nil).DeleteRecord), arg0, arg1, arg2)
 }
 
 // EditRecord mocks base method
-func (m *MockDomainsService) EditRecord(arg0 string, arg1 int, arg2 *do.DomainRecordEditRequest) (*do.DomainRecord, error) {
+func (m *MockDomainsService) EditRecord(arg0 context.Context, arg1 string, arg2 int, arg3 *do.DomainRecordEditRequest) (*do.DomainRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EditRecord", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "EditRecord", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*do.DomainRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EditRecord indicates an expected call of EditRecord
-func (mr *MockDomainsServiceMockRecorder) EditRecord(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockDomainsServiceMockRecorder) EditRecord(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditRecord", reflect.TypeOf((*MockDomainsService)(nil).EditRecord), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditRecord", reflect.TypeOf((*MockDomainsService)(nil).EditRecord), arg0, arg1, arg2, arg3)
 }
 
 // CreateRecord mocks base method
-func (m *MockDomainsService) CreateRecord(arg0 string, arg1 *do.DomainRecordEditRequest) (*do.DomainRecord, error) {
+func (m *MockDomainsService) CreateRecord(arg0 context.Context, arg1 string, arg2 *do.DomainRecordEditRequest) (*do.DomainRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRecord", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateRecord", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*do.DomainRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateRecord indicates an expected call of CreateRecord
-func (mr *MockDomainsServiceMockRecorder) CreateRecord(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockDomainsServiceMockRecorder) CreateRecord(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockDomainsService)(nil).CreateRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockDomainsService)(nil).CreateRecord), arg0, arg1, arg2)
 }
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,98 +36,98 @@ func (m *MockFirewallsService) EXPECT() *MockFirewallsServiceMockRecorder {
 }
 
 // Get mocks base method
-func (m *MockFirewallsService) Get(fID string) (*do.Firewall, error) {
+func (m *MockFirewallsService) Get(ctx context.Context, fID string) (*do.Firewall, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", fID)
+	ret := m.ctrl.Call(m, "Get", ctx, fID)
 	ret0, _ := ret[0].(*do.Firewall)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockFirewallsServiceMockRecorder) Get(fID interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) Get(ctx, fID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFirewallsService)(nil).Get), fID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFirewallsService)(nil).Get), ctx, fID)
 }
 
 // Create mocks base method
-func (m *MockFirewallsService) Create(fr *godo.FirewallRequest) (*do.Firewall, error) {
+func (m *MockFirewallsService) Create(ctx context.Context, fr *godo.FirewallRequest) (*do.Firewall, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", fr)
+	ret := m.ctrl.Call(m, "Create", ctx, fr)
 	ret0, _ := ret[0].(*do.Firewall)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockFirewallsServiceMockRecorder) Create(fr interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) Create(ctx, fr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFirewallsService)(nil).Create), fr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFirewallsService)(nil).Create), ctx, fr)
 }
 
 // Update mocks base method
-func (m *MockFirewallsService) Update(fID string, fr *godo.FirewallRequest) (*do.Firewall, error) {
+func (m *MockFirewallsService) Update(ctx context.Context, fID string, fr *godo.FirewallRequest) (*do.Firewall, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", fID, fr)
+	ret := m.ctrl.Call(m, "Update", ctx, fID, fr)
 	ret0, _ := ret[0].(*do.Firewall)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update
-func (mr *MockFirewallsServiceMockRecorder) Update(fID, fr interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) Update(ctx, fID, fr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFirewallsService)(nil).Update), fID, fr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFirewallsService)(nil).Update), ctx, fID, fr)
 }
 
 // List mocks base method
-func (m *MockFirewallsService) List() (do.Firewalls, error) {
+func (m *MockFirewallsService) List(arg0 context.Context) (do.Firewalls, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.Firewalls)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockFirewallsServiceMockRecorder) List() *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFirewallsService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFirewallsService)(nil).List), arg0)
 }
 
 // ListByDroplet mocks base method
-func (m *MockFirewallsService) ListByDroplet(dID int) (do.Firewalls, error) {
+func (m *MockFirewallsService) ListByDroplet(ctx context.Context, dID int) (do.Firewalls, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByDroplet", dID)
+	ret := m.ctrl.Call(m, "ListByDroplet", ctx, dID)
 	ret0, _ := ret[0].(do.Firewalls)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByDroplet indicates an expected call of ListByDroplet
-func (mr *MockFirewallsServiceMockRecorder) ListByDroplet(dID interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) ListByDroplet(ctx, dID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDroplet", reflect.TypeOf((*MockFirewallsService)(nil).ListByDroplet), dID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDroplet", reflect.TypeOf((*MockFirewallsService)(nil).ListByDroplet), ctx, dID)
 }
 
 // Delete mocks base method
-func (m *MockFirewallsService) Delete(fID string) error {
+func (m *MockFirewallsService) Delete(ctx context.Context, fID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", fID)
+	ret := m.ctrl.Call(m, "Delete", ctx, fID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockFirewallsServiceMockRecorder) Delete(fID interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) Delete(ctx, fID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFirewallsService)(nil).Delete), fID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFirewallsService)(nil).Delete), ctx, fID)
 }
 
 // AddDroplets mocks base method
-func (m *MockFirewallsService) AddDroplets(fID string, dIDs ...int) error {
+func (m *MockFirewallsService) AddDroplets(ctx context.Context, fID string, dIDs ...int) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{fID}
+	varargs := []interface{}{ctx, fID}
 	for _, a := range dIDs {
 		varargs = append(varargs, a)
 	}
@@ -136,16 +137,16 @@ func (m *MockFirewallsService) AddDroplets(fID string, dIDs ...int) error {
 }
 
 // AddDroplets indicates an expected call of AddDroplets
-func (mr *MockFirewallsServiceMockRecorder) AddDroplets(fID interface{}, dIDs ...interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) AddDroplets(ctx, fID interface{}, dIDs ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{fID}, dIDs...)
+	varargs := append([]interface{}{ctx, fID}, dIDs...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDroplets", reflect.TypeOf((*MockFirewallsService)(nil).AddDroplets), varargs...)
 }
 
 // RemoveDroplets mocks base method
-func (m *MockFirewallsService) RemoveDroplets(fID string, dIDs ...int) error {
+func (m *MockFirewallsService) RemoveDroplets(ctx context.Context, fID string, dIDs ...int) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{fID}
+	varargs := []interface{}{ctx, fID}
 	for _, a := range dIDs {
 		varargs = append(varargs, a)
 	}
@@ -155,16 +156,16 @@ func (m *MockFirewallsService) RemoveDroplets(fID string, dIDs ...int) error {
 }
 
 // RemoveDroplets indicates an expected call of RemoveDroplets
-func (mr *MockFirewallsServiceMockRecorder) RemoveDroplets(fID interface{}, dIDs ...interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) RemoveDroplets(ctx, fID interface{}, dIDs ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{fID}, dIDs...)
+	varargs := append([]interface{}{ctx, fID}, dIDs...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDroplets", reflect.TypeOf((*MockFirewallsService)(nil).RemoveDroplets), varargs...)
 }
 
 // AddTags mocks base method
-func (m *MockFirewallsService) AddTags(fID string, tags ...string) error {
+func (m *MockFirewallsService) AddTags(ctx context.Context, fID string, tags ...string) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{fID}
+	varargs := []interface{}{ctx, fID}
 	for _, a := range tags {
 		varargs = append(varargs, a)
 	}
@@ -174,16 +175,16 @@ func (m *MockFirewallsService) AddTags(fID string, tags ...string) error {
 }
 
 // AddTags indicates an expected call of AddTags
-func (mr *MockFirewallsServiceMockRecorder) AddTags(fID interface{}, tags ...interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) AddTags(ctx, fID interface{}, tags ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{fID}, tags...)
+	varargs := append([]interface{}{ctx, fID}, tags...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockFirewallsService)(nil).AddTags), varargs...)
 }
 
 // RemoveTags mocks base method
-func (m *MockFirewallsService) RemoveTags(fID string, tags ...string) error {
+func (m *MockFirewallsService) RemoveTags(ctx context.Context, fID string, tags ...string) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{fID}
+	varargs := []interface{}{ctx, fID}
 	for _, a := range tags {
 		varargs = append(varargs, a)
 	}
@@ -193,36 +194,36 @@ func (m *MockFirewallsService) RemoveTags(fID string, tags ...string) error {
 }
 
 // RemoveTags indicates an expected call of RemoveTags
-func (mr *MockFirewallsServiceMockRecorder) RemoveTags(fID interface{}, tags ...interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) RemoveTags(ctx, fID interface{}, tags ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{fID}, tags...)
+	varargs := append([]interface{}{ctx, fID}, tags...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockFirewallsService)(nil).RemoveTags), varargs...)
 }
 
 // AddRules mocks base method
-func (m *MockFirewallsService) AddRules(fID string, rr *godo.FirewallRulesRequest) error {
+func (m *MockFirewallsService) AddRules(ctx context.Context, fID string, rr *godo.FirewallRulesRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddRules", fID, rr)
+	ret := m.ctrl.Call(m, "AddRules", ctx, fID, rr)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddRules indicates an expected call of AddRules
-func (mr *MockFirewallsServiceMockRecorder) AddRules(fID, rr interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) AddRules(ctx, fID, rr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRules", reflect.TypeOf((*MockFirewallsService)(nil).AddRules), fID, rr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRules", reflect.TypeOf((*MockFirewallsService)(nil).AddRules), ctx, fID, rr)
 }
 
 // RemoveRules mocks base method
-func (m *MockFirewallsService) RemoveRules(fID string, rr *godo.FirewallRulesRequest) error {
+func (m *MockFirewallsService) RemoveRules(ctx context.Context, fID string, rr *godo.FirewallRulesRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveRules", fID, rr)
+	ret := m.ctrl.Call(m, "RemoveRules", ctx, fID, rr)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveRules indicates an expected call of RemoveRules
-func (mr *MockFirewallsServiceMockRecorder) RemoveRules(fID, rr interface{}) *gomock.Call {
+func (mr *MockFirewallsServiceMockRecorder) RemoveRules(ctx, fID, rr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRules", reflect.TypeOf((*MockFirewallsService)(nil).RemoveRules), fID, rr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRules", reflect.TypeOf((*MockFirewallsService)(nil).RemoveRules), ctx, fID, rr)
 }
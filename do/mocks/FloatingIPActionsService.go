@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,61 +36,61 @@ func (m *MockFloatingIPActionsService) EXPECT() *MockFloatingIPActionsServiceMoc
 }
 
 // Assign mocks base method
-func (m *MockFloatingIPActionsService) Assign(ip string, dropletID int) (*do.Action, error) {
+func (m *MockFloatingIPActionsService) Assign(ctx context.Context, ip string, dropletID int) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Assign", ip, dropletID)
+	ret := m.ctrl.Call(m, "Assign", ctx, ip, dropletID)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Assign indicates an expected call of Assign
-func (mr *MockFloatingIPActionsServiceMockRecorder) Assign(ip, dropletID interface{}) *gomock.Call {
+func (mr *MockFloatingIPActionsServiceMockRecorder) Assign(ctx, ip, dropletID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockFloatingIPActionsService)(nil).Assign), ip, dropletID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockFloatingIPActionsService)(nil).Assign), ctx, ip, dropletID)
 }
 
 // Unassign mocks base method
-func (m *MockFloatingIPActionsService) Unassign(ip string) (*do.Action, error) {
+func (m *MockFloatingIPActionsService) Unassign(ctx context.Context, ip string) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Unassign", ip)
+	ret := m.ctrl.Call(m, "Unassign", ctx, ip)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Unassign indicates an expected call of Unassign
-func (mr *MockFloatingIPActionsServiceMockRecorder) Unassign(ip interface{}) *gomock.Call {
+func (mr *MockFloatingIPActionsServiceMockRecorder) Unassign(ctx, ip interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockFloatingIPActionsService)(nil).Unassign), ip)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockFloatingIPActionsService)(nil).Unassign), ctx, ip)
 }
 
 // Get mocks base method
-func (m *MockFloatingIPActionsService) Get(ip string, actionID int) (*do.Action, error) {
+func (m *MockFloatingIPActionsService) Get(ctx context.Context, ip string, actionID int) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ip, actionID)
+	ret := m.ctrl.Call(m, "Get", ctx, ip, actionID)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockFloatingIPActionsServiceMockRecorder) Get(ip, actionID interface{}) *gomock.Call {
+func (mr *MockFloatingIPActionsServiceMockRecorder) Get(ctx, ip, actionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFloatingIPActionsService)(nil).Get), ip, actionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFloatingIPActionsService)(nil).Get), ctx, ip, actionID)
 }
 
 // List mocks base method
-func (m *MockFloatingIPActionsService) List(ip string, opt *godo.ListOptions) ([]do.Action, error) {
+func (m *MockFloatingIPActionsService) List(ctx context.Context, ip string, opt *godo.ListOptions) ([]do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ip, opt)
+	ret := m.ctrl.Call(m, "List", ctx, ip, opt)
 	ret0, _ := ret[0].([]do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockFloatingIPActionsServiceMockRecorder) List(ip, opt interface{}) *gomock.Call {
+func (mr *MockFloatingIPActionsServiceMockRecorder) List(ctx, ip, opt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFloatingIPActionsService)(nil).List), ip, opt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFloatingIPActionsService)(nil).List), ctx, ip, opt)
 }
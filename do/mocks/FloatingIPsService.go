@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,60 +36,60 @@ func (m *MockFloatingIPsService) EXPECT() *MockFloatingIPsServiceMockRecorder {
 }
 
 // List mocks base method
-func (m *MockFloatingIPsService) List() (do.FloatingIPs, error) {
+func (m *MockFloatingIPsService) List(arg0 context.Context) (do.FloatingIPs, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.FloatingIPs)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockFloatingIPsServiceMockRecorder) List() *gomock.Call {
+func (mr *MockFloatingIPsServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFloatingIPsService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFloatingIPsService)(nil).List), arg0)
 }
 
 // Get mocks base method
-func (m *MockFloatingIPsService) Get(ip string) (*do.FloatingIP, error) {
+func (m *MockFloatingIPsService) Get(ctx context.Context, ip string) (*do.FloatingIP, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ip)
+	ret := m.ctrl.Call(m, "Get", ctx, ip)
 	ret0, _ := ret[0].(*do.FloatingIP)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockFloatingIPsServiceMockRecorder) Get(ip interface{}) *gomock.Call {
+func (mr *MockFloatingIPsServiceMockRecorder) Get(ctx, ip interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFloatingIPsService)(nil).Get), ip)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFloatingIPsService)(nil).Get), ctx, ip)
 }
 
 // Create mocks base method
-func (m *MockFloatingIPsService) Create(ficr *godo.FloatingIPCreateRequest) (*do.FloatingIP, error) {
+func (m *MockFloatingIPsService) Create(ctx context.Context, ficr *godo.FloatingIPCreateRequest) (*do.FloatingIP, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ficr)
+	ret := m.ctrl.Call(m, "Create", ctx, ficr)
 	ret0, _ := ret[0].(*do.FloatingIP)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockFloatingIPsServiceMockRecorder) Create(ficr interface{}) *gomock.Call {
+func (mr *MockFloatingIPsServiceMockRecorder) Create(ctx, ficr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFloatingIPsService)(nil).Create), ficr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFloatingIPsService)(nil).Create), ctx, ficr)
 }
 
 // Delete mocks base method
-func (m *MockFloatingIPsService) Delete(ip string) error {
+func (m *MockFloatingIPsService) Delete(ctx context.Context, ip string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ip)
+	ret := m.ctrl.Call(m, "Delete", ctx, ip)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockFloatingIPsServiceMockRecorder) Delete(ip interface{}) *gomock.Call {
+func (mr *MockFloatingIPsServiceMockRecorder) Delete(ctx, ip interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFloatingIPsService)(nil).Delete), ip)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFloatingIPsService)(nil).Delete), ctx, ip)
 }
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,46 +36,46 @@ func (m *MockImageActionsService) EXPECT() *MockImageActionsServiceMockRecorder
 }
 
 // Get mocks base method
-func (m *MockImageActionsService) Get(arg0, arg1 int) (*do.Action, error) {
+func (m *MockImageActionsService) Get(arg0 context.Context, arg1, arg2 int) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockImageActionsServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockImageActionsServiceMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockImageActionsService)(nil).Get), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockImageActionsService)(nil).Get), arg0, arg1, arg2)
 }
 
 // Convert mocks base method
-func (m *MockImageActionsService) Convert(arg0 int) (*do.Action, error) {
+func (m *MockImageActionsService) Convert(arg0 context.Context, arg1 int) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Convert", arg0)
+	ret := m.ctrl.Call(m, "Convert", arg0, arg1)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Convert indicates an expected call of Convert
-func (mr *MockImageActionsServiceMockRecorder) Convert(arg0 interface{}) *gomock.Call {
+func (mr *MockImageActionsServiceMockRecorder) Convert(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Convert", reflect.TypeOf((*MockImageActionsService)(nil).Convert), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Convert", reflect.TypeOf((*MockImageActionsService)(nil).Convert), arg0, arg1)
 }
 
 // Transfer mocks base method
-func (m *MockImageActionsService) Transfer(arg0 int, arg1 *godo.ActionRequest) (*do.Action, error) {
+func (m *MockImageActionsService) Transfer(arg0 context.Context, arg1 int, arg2 *godo.ActionRequest) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Transfer", arg0, arg1)
+	ret := m.ctrl.Call(m, "Transfer", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Transfer indicates an expected call of Transfer
-func (mr *MockImageActionsServiceMockRecorder) Transfer(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockImageActionsServiceMockRecorder) Transfer(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockImageActionsService)(nil).Transfer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockImageActionsService)(nil).Transfer), arg0, arg1, arg2)
 }
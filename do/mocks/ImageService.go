@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,135 +36,149 @@ func (m *MockImagesService) EXPECT() *MockImagesServiceMockRecorder {
 }
 
 // List mocks base method
-func (m *MockImagesService) List(public bool) (do.Images, error) {
+func (m *MockImagesService) List(ctx context.Context, public bool) (do.Images, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", public)
+	ret := m.ctrl.Call(m, "List", ctx, public)
 	ret0, _ := ret[0].(do.Images)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockImagesServiceMockRecorder) List(public interface{}) *gomock.Call {
+func (mr *MockImagesServiceMockRecorder) List(ctx, public interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockImagesService)(nil).List), public)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockImagesService)(nil).List), ctx, public)
+}
+
+// ListPages mocks base method
+func (m *MockImagesService) ListPages(ctx context.Context, public bool, fn func(do.Images) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPages", ctx, public, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListPages indicates an expected call of ListPages
+func (mr *MockImagesServiceMockRecorder) ListPages(ctx, public, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPages", reflect.TypeOf((*MockImagesService)(nil).ListPages), ctx, public, fn)
 }
 
 // ListDistribution mocks base method
-func (m *MockImagesService) ListDistribution(public bool) (do.Images, error) {
+func (m *MockImagesService) ListDistribution(ctx context.Context, public bool) (do.Images, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDistribution", public)
+	ret := m.ctrl.Call(m, "ListDistribution", ctx, public)
 	ret0, _ := ret[0].(do.Images)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDistribution indicates an expected call of ListDistribution
-func (mr *MockImagesServiceMockRecorder) ListDistribution(public interface{}) *gomock.Call {
+func (mr *MockImagesServiceMockRecorder) ListDistribution(ctx, public interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDistribution", reflect.TypeOf((*MockImagesService)(nil).ListDistribution), public)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDistribution", reflect.TypeOf((*MockImagesService)(nil).ListDistribution), ctx, public)
 }
 
 // ListApplication mocks base method
-func (m *MockImagesService) ListApplication(public bool) (do.Images, error) {
+func (m *MockImagesService) ListApplication(ctx context.Context, public bool) (do.Images, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListApplication", public)
+	ret := m.ctrl.Call(m, "ListApplication", ctx, public)
 	ret0, _ := ret[0].(do.Images)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListApplication indicates an expected call of ListApplication
-func (mr *MockImagesServiceMockRecorder) ListApplication(public interface{}) *gomock.Call {
+func (mr *MockImagesServiceMockRecorder) ListApplication(ctx, public interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplication", reflect.TypeOf((*MockImagesService)(nil).ListApplication), public)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplication", reflect.TypeOf((*MockImagesService)(nil).ListApplication), ctx, public)
 }
 
 // ListUser mocks base method
-func (m *MockImagesService) ListUser(public bool) (do.Images, error) {
+func (m *MockImagesService) ListUser(ctx context.Context, public bool) (do.Images, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUser", public)
+	ret := m.ctrl.Call(m, "ListUser", ctx, public)
 	ret0, _ := ret[0].(do.Images)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUser indicates an expected call of ListUser
-func (mr *MockImagesServiceMockRecorder) ListUser(public interface{}) *gomock.Call {
+func (mr *MockImagesServiceMockRecorder) ListUser(ctx, public interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUser", reflect.TypeOf((*MockImagesService)(nil).ListUser), public)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUser", reflect.TypeOf((*MockImagesService)(nil).ListUser), ctx, public)
 }
 
 // GetByID mocks base method
-func (m *MockImagesService) GetByID(id int) (*do.Image, error) {
+func (m *MockImagesService) GetByID(ctx context.Context, id int) (*do.Image, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
 	ret0, _ := ret[0].(*do.Image)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID
-func (mr *MockImagesServiceMockRecorder) GetByID(id interface{}) *gomock.Call {
+func (mr *MockImagesServiceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockImagesService)(nil).GetByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockImagesService)(nil).GetByID), ctx, id)
 }
 
 // GetBySlug mocks base method
-func (m *MockImagesService) GetBySlug(slug string) (*do.Image, error) {
+func (m *MockImagesService) GetBySlug(ctx context.Context, slug string) (*do.Image, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBySlug", slug)
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
 	ret0, _ := ret[0].(*do.Image)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBySlug indicates an expected call of GetBySlug
-func (mr *MockImagesServiceMockRecorder) GetBySlug(slug interface{}) *gomock.Call {
+func (mr *MockImagesServiceMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockImagesService)(nil).GetBySlug), slug)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockImagesService)(nil).GetBySlug), ctx, slug)
 }
 
 // Update mocks base method
-func (m *MockImagesService) Update(id int, iur *godo.ImageUpdateRequest) (*do.Image, error) {
+func (m *MockImagesService) Update(ctx context.Context, id int, iur *godo.ImageUpdateRequest) (*do.Image, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", id, iur)
+	ret := m.ctrl.Call(m, "Update", ctx, id, iur)
 	ret0, _ := ret[0].(*do.Image)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update
-func (mr *MockImagesServiceMockRecorder) Update(id, iur interface{}) *gomock.Call {
+func (mr *MockImagesServiceMockRecorder) Update(ctx, id, iur interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockImagesService)(nil).Update), id, iur)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockImagesService)(nil).Update), ctx, id, iur)
 }
 
 // Delete mocks base method
-func (m *MockImagesService) Delete(id int) error {
+func (m *MockImagesService) Delete(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockImagesServiceMockRecorder) Delete(id interface{}) *gomock.Call {
+func (mr *MockImagesServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockImagesService)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockImagesService)(nil).Delete), ctx, id)
 }
 
 // Create mocks base method
-func (m *MockImagesService) Create(icr *godo.CustomImageCreateRequest) (*do.Image, error) {
+func (m *MockImagesService) Create(ctx context.Context, icr *godo.CustomImageCreateRequest) (*do.Image, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", icr)
+	ret := m.ctrl.Call(m, "Create", ctx, icr)
 	ret0, _ := ret[0].(*do.Image)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockImagesServiceMockRecorder) Create(icr interface{}) *gomock.Call {
+func (mr *MockImagesServiceMockRecorder) Create(ctx, icr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockImagesService)(nil).Create), icr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockImagesService)(nil).Create), ctx, icr)
 }
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,75 +36,75 @@ func (m *MockKeysService) EXPECT() *MockKeysServiceMockRecorder {
 }
 
 // List mocks base method
-func (m *MockKeysService) List() (do.SSHKeys, error) {
+func (m *MockKeysService) List(arg0 context.Context) (do.SSHKeys, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.SSHKeys)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockKeysServiceMockRecorder) List() *gomock.Call {
+func (mr *MockKeysServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeysService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeysService)(nil).List), arg0)
 }
 
 // Get mocks base method
-func (m *MockKeysService) Get(id string) (*do.SSHKey, error) {
+func (m *MockKeysService) Get(ctx context.Context, id string) (*do.SSHKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", id)
+	ret := m.ctrl.Call(m, "Get", ctx, id)
 	ret0, _ := ret[0].(*do.SSHKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockKeysServiceMockRecorder) Get(id interface{}) *gomock.Call {
+func (mr *MockKeysServiceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeysService)(nil).Get), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeysService)(nil).Get), ctx, id)
 }
 
 // Create mocks base method
-func (m *MockKeysService) Create(kcr *godo.KeyCreateRequest) (*do.SSHKey, error) {
+func (m *MockKeysService) Create(ctx context.Context, kcr *godo.KeyCreateRequest) (*do.SSHKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", kcr)
+	ret := m.ctrl.Call(m, "Create", ctx, kcr)
 	ret0, _ := ret[0].(*do.SSHKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockKeysServiceMockRecorder) Create(kcr interface{}) *gomock.Call {
+func (mr *MockKeysServiceMockRecorder) Create(ctx, kcr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKeysService)(nil).Create), kcr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKeysService)(nil).Create), ctx, kcr)
 }
 
 // Update mocks base method
-func (m *MockKeysService) Update(id string, kur *godo.KeyUpdateRequest) (*do.SSHKey, error) {
+func (m *MockKeysService) Update(ctx context.Context, id string, kur *godo.KeyUpdateRequest) (*do.SSHKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", id, kur)
+	ret := m.ctrl.Call(m, "Update", ctx, id, kur)
 	ret0, _ := ret[0].(*do.SSHKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update
-func (mr *MockKeysServiceMockRecorder) Update(id, kur interface{}) *gomock.Call {
+func (mr *MockKeysServiceMockRecorder) Update(ctx, id, kur interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeysService)(nil).Update), id, kur)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeysService)(nil).Update), ctx, id, kur)
 }
 
 // Delete mocks base method
-func (m *MockKeysService) Delete(id string) error {
+func (m *MockKeysService) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockKeysServiceMockRecorder) Delete(id interface{}) *gomock.Call {
+func (mr *MockKeysServiceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeysService)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeysService)(nil).Delete), ctx, id)
 }
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,281 +36,281 @@ func (m *MockKubernetesService) EXPECT() *MockKubernetesServiceMockRecorder {
 }
 
 // Get mocks base method
-func (m *MockKubernetesService) Get(clusterID string) (*do.KubernetesCluster, error) {
+func (m *MockKubernetesService) Get(ctx context.Context, clusterID string) (*do.KubernetesCluster, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", clusterID)
+	ret := m.ctrl.Call(m, "Get", ctx, clusterID)
 	ret0, _ := ret[0].(*do.KubernetesCluster)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockKubernetesServiceMockRecorder) Get(clusterID interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) Get(ctx, clusterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKubernetesService)(nil).Get), clusterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKubernetesService)(nil).Get), ctx, clusterID)
 }
 
 // GetKubeConfig mocks base method
-func (m *MockKubernetesService) GetKubeConfig(clusterID string) ([]byte, error) {
+func (m *MockKubernetesService) GetKubeConfig(ctx context.Context, clusterID string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKubeConfig", clusterID)
+	ret := m.ctrl.Call(m, "GetKubeConfig", ctx, clusterID)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetKubeConfig indicates an expected call of GetKubeConfig
-func (mr *MockKubernetesServiceMockRecorder) GetKubeConfig(clusterID interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) GetKubeConfig(ctx, clusterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeConfig", reflect.TypeOf((*MockKubernetesService)(nil).GetKubeConfig), clusterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeConfig", reflect.TypeOf((*MockKubernetesService)(nil).GetKubeConfig), ctx, clusterID)
 }
 
 // GetCredentials mocks base method
-func (m *MockKubernetesService) GetCredentials(clusterID string) (*do.KubernetesClusterCredentials, error) {
+func (m *MockKubernetesService) GetCredentials(ctx context.Context, clusterID string) (*do.KubernetesClusterCredentials, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCredentials", clusterID)
+	ret := m.ctrl.Call(m, "GetCredentials", ctx, clusterID)
 	ret0, _ := ret[0].(*do.KubernetesClusterCredentials)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCredentials indicates an expected call of GetCredentials
-func (mr *MockKubernetesServiceMockRecorder) GetCredentials(clusterID interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) GetCredentials(ctx, clusterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentials", reflect.TypeOf((*MockKubernetesService)(nil).GetCredentials), clusterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentials", reflect.TypeOf((*MockKubernetesService)(nil).GetCredentials), ctx, clusterID)
 }
 
 // GetUpgrades mocks base method
-func (m *MockKubernetesService) GetUpgrades(clusterID string) (do.KubernetesVersions, error) {
+func (m *MockKubernetesService) GetUpgrades(ctx context.Context, clusterID string) (do.KubernetesVersions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUpgrades", clusterID)
+	ret := m.ctrl.Call(m, "GetUpgrades", ctx, clusterID)
 	ret0, _ := ret[0].(do.KubernetesVersions)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUpgrades indicates an expected call of GetUpgrades
-func (mr *MockKubernetesServiceMockRecorder) GetUpgrades(clusterID interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) GetUpgrades(ctx, clusterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpgrades", reflect.TypeOf((*MockKubernetesService)(nil).GetUpgrades), clusterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpgrades", reflect.TypeOf((*MockKubernetesService)(nil).GetUpgrades), ctx, clusterID)
 }
 
 // List mocks base method
-func (m *MockKubernetesService) List() (do.KubernetesClusters, error) {
+func (m *MockKubernetesService) List(arg0 context.Context) (do.KubernetesClusters, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.KubernetesClusters)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockKubernetesServiceMockRecorder) List() *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKubernetesService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKubernetesService)(nil).List), arg0)
 }
 
 // Create mocks base method
-func (m *MockKubernetesService) Create(create *godo.KubernetesClusterCreateRequest) (*do.KubernetesCluster, error) {
+func (m *MockKubernetesService) Create(ctx context.Context, create *godo.KubernetesClusterCreateRequest) (*do.KubernetesCluster, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", create)
+	ret := m.ctrl.Call(m, "Create", ctx, create)
 	ret0, _ := ret[0].(*do.KubernetesCluster)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockKubernetesServiceMockRecorder) Create(create interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) Create(ctx, create interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKubernetesService)(nil).Create), create)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKubernetesService)(nil).Create), ctx, create)
 }
 
 // Update mocks base method
-func (m *MockKubernetesService) Update(clusterID string, update *godo.KubernetesClusterUpdateRequest) (*do.KubernetesCluster, error) {
+func (m *MockKubernetesService) Update(ctx context.Context, clusterID string, update *godo.KubernetesClusterUpdateRequest) (*do.KubernetesCluster, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", clusterID, update)
+	ret := m.ctrl.Call(m, "Update", ctx, clusterID, update)
 	ret0, _ := ret[0].(*do.KubernetesCluster)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update
-func (mr *MockKubernetesServiceMockRecorder) Update(clusterID, update interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) Update(ctx, clusterID, update interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKubernetesService)(nil).Update), clusterID, update)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKubernetesService)(nil).Update), ctx, clusterID, update)
 }
 
 // Upgrade mocks base method
-func (m *MockKubernetesService) Upgrade(clusterID, versionSlug string) error {
+func (m *MockKubernetesService) Upgrade(ctx context.Context, clusterID, versionSlug string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upgrade", clusterID, versionSlug)
+	ret := m.ctrl.Call(m, "Upgrade", ctx, clusterID, versionSlug)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Upgrade indicates an expected call of Upgrade
-func (mr *MockKubernetesServiceMockRecorder) Upgrade(clusterID, versionSlug interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) Upgrade(ctx, clusterID, versionSlug interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockKubernetesService)(nil).Upgrade), clusterID, versionSlug)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockKubernetesService)(nil).Upgrade), ctx, clusterID, versionSlug)
 }
 
 // Delete mocks base method
-func (m *MockKubernetesService) Delete(clusterID string) error {
+func (m *MockKubernetesService) Delete(ctx context.Context, clusterID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", clusterID)
+	ret := m.ctrl.Call(m, "Delete", ctx, clusterID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockKubernetesServiceMockRecorder) Delete(clusterID interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) Delete(ctx, clusterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKubernetesService)(nil).Delete), clusterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKubernetesService)(nil).Delete), ctx, clusterID)
 }
 
 // CreateNodePool mocks base method
-func (m *MockKubernetesService) CreateNodePool(clusterID string, req *godo.KubernetesNodePoolCreateRequest) (*do.KubernetesNodePool, error) {
+func (m *MockKubernetesService) CreateNodePool(ctx context.Context, clusterID string, req *godo.KubernetesNodePoolCreateRequest) (*do.KubernetesNodePool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNodePool", clusterID, req)
+	ret := m.ctrl.Call(m, "CreateNodePool", ctx, clusterID, req)
 	ret0, _ := ret[0].(*do.KubernetesNodePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNodePool indicates an expected call of CreateNodePool
-func (mr *MockKubernetesServiceMockRecorder) CreateNodePool(clusterID, req interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) CreateNodePool(ctx, clusterID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).CreateNodePool), clusterID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).CreateNodePool), ctx, clusterID, req)
 }
 
 // GetNodePool mocks base method
-func (m *MockKubernetesService) GetNodePool(clusterID, poolID string) (*do.KubernetesNodePool, error) {
+func (m *MockKubernetesService) GetNodePool(ctx context.Context, clusterID, poolID string) (*do.KubernetesNodePool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNodePool", clusterID, poolID)
+	ret := m.ctrl.Call(m, "GetNodePool", ctx, clusterID, poolID)
 	ret0, _ := ret[0].(*do.KubernetesNodePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNodePool indicates an expected call of GetNodePool
-func (mr *MockKubernetesServiceMockRecorder) GetNodePool(clusterID, poolID interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) GetNodePool(ctx, clusterID, poolID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePool", reflect.TypeOf((*MockKubernetesService)(nil).GetNodePool), clusterID, poolID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePool", reflect.TypeOf((*MockKubernetesService)(nil).GetNodePool), ctx, clusterID, poolID)
 }
 
 // ListNodePools mocks base method
-func (m *MockKubernetesService) ListNodePools(clusterID string) (do.KubernetesNodePools, error) {
+func (m *MockKubernetesService) ListNodePools(ctx context.Context, clusterID string) (do.KubernetesNodePools, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNodePools", clusterID)
+	ret := m.ctrl.Call(m, "ListNodePools", ctx, clusterID)
 	ret0, _ := ret[0].(do.KubernetesNodePools)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNodePools indicates an expected call of ListNodePools
-func (mr *MockKubernetesServiceMockRecorder) ListNodePools(clusterID interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) ListNodePools(ctx, clusterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodePools", reflect.TypeOf((*MockKubernetesService)(nil).ListNodePools), clusterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodePools", reflect.TypeOf((*MockKubernetesService)(nil).ListNodePools), ctx, clusterID)
 }
 
 // UpdateNodePool mocks base method
-func (m *MockKubernetesService) UpdateNodePool(clusterID, poolID string, req *godo.KubernetesNodePoolUpdateRequest) (*do.KubernetesNodePool, error) {
+func (m *MockKubernetesService) UpdateNodePool(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolUpdateRequest) (*do.KubernetesNodePool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateNodePool", clusterID, poolID, req)
+	ret := m.ctrl.Call(m, "UpdateNodePool", ctx, clusterID, poolID, req)
 	ret0, _ := ret[0].(*do.KubernetesNodePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateNodePool indicates an expected call of UpdateNodePool
-func (mr *MockKubernetesServiceMockRecorder) UpdateNodePool(clusterID, poolID, req interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) UpdateNodePool(ctx, clusterID, poolID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).UpdateNodePool), clusterID, poolID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).UpdateNodePool), ctx, clusterID, poolID, req)
 }
 
 // RecycleNodePoolNodes mocks base method
-func (m *MockKubernetesService) RecycleNodePoolNodes(clusterID, poolID string, req *godo.KubernetesNodePoolRecycleNodesRequest) error {
+func (m *MockKubernetesService) RecycleNodePoolNodes(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolRecycleNodesRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RecycleNodePoolNodes", clusterID, poolID, req)
+	ret := m.ctrl.Call(m, "RecycleNodePoolNodes", ctx, clusterID, poolID, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RecycleNodePoolNodes indicates an expected call of RecycleNodePoolNodes
-func (mr *MockKubernetesServiceMockRecorder) RecycleNodePoolNodes(clusterID, poolID, req interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) RecycleNodePoolNodes(ctx, clusterID, poolID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecycleNodePoolNodes", reflect.TypeOf((*MockKubernetesService)(nil).RecycleNodePoolNodes), clusterID, poolID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecycleNodePoolNodes", reflect.TypeOf((*MockKubernetesService)(nil).RecycleNodePoolNodes), ctx, clusterID, poolID, req)
 }
 
 // DeleteNodePool mocks base method
-func (m *MockKubernetesService) DeleteNodePool(clusterID, poolID string) error {
+func (m *MockKubernetesService) DeleteNodePool(ctx context.Context, clusterID, poolID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNodePool", clusterID, poolID)
+	ret := m.ctrl.Call(m, "DeleteNodePool", ctx, clusterID, poolID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteNodePool indicates an expected call of DeleteNodePool
-func (mr *MockKubernetesServiceMockRecorder) DeleteNodePool(clusterID, poolID interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) DeleteNodePool(ctx, clusterID, poolID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNodePool", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNodePool), clusterID, poolID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNodePool", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNodePool), ctx, clusterID, poolID)
 }
 
 // DeleteNode mocks base method
-func (m *MockKubernetesService) DeleteNode(clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) error {
+func (m *MockKubernetesService) DeleteNode(ctx context.Context, clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNode", clusterID, poolID, nodeID, req)
+	ret := m.ctrl.Call(m, "DeleteNode", ctx, clusterID, poolID, nodeID, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteNode indicates an expected call of DeleteNode
-func (mr *MockKubernetesServiceMockRecorder) DeleteNode(clusterID, poolID, nodeID, req interface{}) *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) DeleteNode(ctx, clusterID, poolID, nodeID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNode", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNode), clusterID, poolID, nodeID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNode", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNode), ctx, clusterID, poolID, nodeID, req)
 }
 
 // GetVersions mocks base method
-func (m *MockKubernetesService) GetVersions() (do.KubernetesVersions, error) {
+func (m *MockKubernetesService) GetVersions(arg0 context.Context) (do.KubernetesVersions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVersions")
+	ret := m.ctrl.Call(m, "GetVersions", arg0)
 	ret0, _ := ret[0].(do.KubernetesVersions)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetVersions indicates an expected call of GetVersions
-func (mr *MockKubernetesServiceMockRecorder) GetVersions() *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) GetVersions(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockKubernetesService)(nil).GetVersions))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockKubernetesService)(nil).GetVersions), arg0)
 }
 
 // GetRegions mocks base method
-func (m *MockKubernetesService) GetRegions() (do.KubernetesRegions, error) {
+func (m *MockKubernetesService) GetRegions(arg0 context.Context) (do.KubernetesRegions, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegions")
+	ret := m.ctrl.Call(m, "GetRegions", arg0)
 	ret0, _ := ret[0].(do.KubernetesRegions)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRegions indicates an expected call of GetRegions
-func (mr *MockKubernetesServiceMockRecorder) GetRegions() *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) GetRegions(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegions", reflect.TypeOf((*MockKubernetesService)(nil).GetRegions))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegions", reflect.TypeOf((*MockKubernetesService)(nil).GetRegions), arg0)
 }
 
 // GetNodeSizes mocks base method
-func (m *MockKubernetesService) GetNodeSizes() (do.KubernetesNodeSizes, error) {
+func (m *MockKubernetesService) GetNodeSizes(arg0 context.Context) (do.KubernetesNodeSizes, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNodeSizes")
+	ret := m.ctrl.Call(m, "GetNodeSizes", arg0)
 	ret0, _ := ret[0].(do.KubernetesNodeSizes)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNodeSizes indicates an expected call of GetNodeSizes
-func (mr *MockKubernetesServiceMockRecorder) GetNodeSizes() *gomock.Call {
+func (mr *MockKubernetesServiceMockRecorder) GetNodeSizes(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeSizes", reflect.TypeOf((*MockKubernetesService)(nil).GetNodeSizes))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeSizes", reflect.TypeOf((*MockKubernetesService)(nil).GetNodeSizes), arg0)
 }
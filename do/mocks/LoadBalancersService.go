@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,83 +36,83 @@ func (m *MockLoadBalancersService) EXPECT() *MockLoadBalancersServiceMockRecorde
 }
 
 // Get mocks base method
-func (m *MockLoadBalancersService) Get(lbID string) (*do.LoadBalancer, error) {
+func (m *MockLoadBalancersService) Get(ctx context.Context, lbID string) (*do.LoadBalancer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", lbID)
+	ret := m.ctrl.Call(m, "Get", ctx, lbID)
 	ret0, _ := ret[0].(*do.LoadBalancer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockLoadBalancersServiceMockRecorder) Get(lbID interface{}) *gomock.Call {
+func (mr *MockLoadBalancersServiceMockRecorder) Get(ctx, lbID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLoadBalancersService)(nil).Get), lbID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLoadBalancersService)(nil).Get), ctx, lbID)
 }
 
 // List mocks base method
-func (m *MockLoadBalancersService) List() (do.LoadBalancers, error) {
+func (m *MockLoadBalancersService) List(arg0 context.Context) (do.LoadBalancers, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.LoadBalancers)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockLoadBalancersServiceMockRecorder) List() *gomock.Call {
+func (mr *MockLoadBalancersServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoadBalancersService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoadBalancersService)(nil).List), arg0)
 }
 
 // Create mocks base method
-func (m *MockLoadBalancersService) Create(lbr *godo.LoadBalancerRequest) (*do.LoadBalancer, error) {
+func (m *MockLoadBalancersService) Create(ctx context.Context, lbr *godo.LoadBalancerRequest) (*do.LoadBalancer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", lbr)
+	ret := m.ctrl.Call(m, "Create", ctx, lbr)
 	ret0, _ := ret[0].(*do.LoadBalancer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockLoadBalancersServiceMockRecorder) Create(lbr interface{}) *gomock.Call {
+func (mr *MockLoadBalancersServiceMockRecorder) Create(ctx, lbr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLoadBalancersService)(nil).Create), lbr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLoadBalancersService)(nil).Create), ctx, lbr)
 }
 
 // Update mocks base method
-func (m *MockLoadBalancersService) Update(lbID string, lbr *godo.LoadBalancerRequest) (*do.LoadBalancer, error) {
+func (m *MockLoadBalancersService) Update(ctx context.Context, lbID string, lbr *godo.LoadBalancerRequest) (*do.LoadBalancer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", lbID, lbr)
+	ret := m.ctrl.Call(m, "Update", ctx, lbID, lbr)
 	ret0, _ := ret[0].(*do.LoadBalancer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update
-func (mr *MockLoadBalancersServiceMockRecorder) Update(lbID, lbr interface{}) *gomock.Call {
+func (mr *MockLoadBalancersServiceMockRecorder) Update(ctx, lbID, lbr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLoadBalancersService)(nil).Update), lbID, lbr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLoadBalancersService)(nil).Update), ctx, lbID, lbr)
 }
 
 // Delete mocks base method
-func (m *MockLoadBalancersService) Delete(lbID string) error {
+func (m *MockLoadBalancersService) Delete(ctx context.Context, lbID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", lbID)
+	ret := m.ctrl.Call(m, "Delete", ctx, lbID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockLoadBalancersServiceMockRecorder) Delete(lbID interface{}) *gomock.Call {
+func (mr *MockLoadBalancersServiceMockRecorder) Delete(ctx, lbID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoadBalancersService)(nil).Delete), lbID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoadBalancersService)(nil).Delete), ctx, lbID)
 }
 
 // AddDroplets mocks base method
-func (m *MockLoadBalancersService) AddDroplets(lbID string, dIDs ...int) error {
+func (m *MockLoadBalancersService) AddDroplets(ctx context.Context, lbID string, dIDs ...int) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{lbID}
+	varargs := []interface{}{ctx, lbID}
 	for _, a := range dIDs {
 		varargs = append(varargs, a)
 	}
@@ -121,16 +122,16 @@ func (m *MockLoadBalancersService) AddDroplets(lbID string, dIDs ...int) error {
 }
 
 // AddDroplets indicates an expected call of AddDroplets
-func (mr *MockLoadBalancersServiceMockRecorder) AddDroplets(lbID interface{}, dIDs ...interface{}) *gomock.Call {
+func (mr *MockLoadBalancersServiceMockRecorder) AddDroplets(ctx, lbID interface{}, dIDs ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{lbID}, dIDs...)
+	varargs := append([]interface{}{ctx, lbID}, dIDs...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDroplets", reflect.TypeOf((*MockLoadBalancersService)(nil).AddDroplets), varargs...)
 }
 
 // RemoveDroplets mocks base method
-func (m *MockLoadBalancersService) RemoveDroplets(lbID string, dIDs ...int) error {
+func (m *MockLoadBalancersService) RemoveDroplets(ctx context.Context, lbID string, dIDs ...int) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{lbID}
+	varargs := []interface{}{ctx, lbID}
 	for _, a := range dIDs {
 		varargs = append(varargs, a)
 	}
@@ -140,16 +141,16 @@ func (m *MockLoadBalancersService) RemoveDroplets(lbID string, dIDs ...int) erro
 }
 
 // RemoveDroplets indicates an expected call of RemoveDroplets
-func (mr *MockLoadBalancersServiceMockRecorder) RemoveDroplets(lbID interface{}, dIDs ...interface{}) *gomock.Call {
+func (mr *MockLoadBalancersServiceMockRecorder) RemoveDroplets(ctx, lbID interface{}, dIDs ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{lbID}, dIDs...)
+	varargs := append([]interface{}{ctx, lbID}, dIDs...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDroplets", reflect.TypeOf((*MockLoadBalancersService)(nil).RemoveDroplets), varargs...)
 }
 
 // AddForwardingRules mocks base method
-func (m *MockLoadBalancersService) AddForwardingRules(lbID string, rules ...godo.ForwardingRule) error {
+func (m *MockLoadBalancersService) AddForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{lbID}
+	varargs := []interface{}{ctx, lbID}
 	for _, a := range rules {
 		varargs = append(varargs, a)
 	}
@@ -159,16 +160,16 @@ func (m *MockLoadBalancersService) AddForwardingRules(lbID string, rules ...godo
 }
 
 // AddForwardingRules indicates an expected call of AddForwardingRules
-func (mr *MockLoadBalancersServiceMockRecorder) AddForwardingRules(lbID interface{}, rules ...interface{}) *gomock.Call {
+func (mr *MockLoadBalancersServiceMockRecorder) AddForwardingRules(ctx, lbID interface{}, rules ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{lbID}, rules...)
+	varargs := append([]interface{}{ctx, lbID}, rules...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddForwardingRules", reflect.TypeOf((*MockLoadBalancersService)(nil).AddForwardingRules), varargs...)
 }
 
 // RemoveForwardingRules mocks base method
-func (m *MockLoadBalancersService) RemoveForwardingRules(lbID string, rules ...godo.ForwardingRule) error {
+func (m *MockLoadBalancersService) RemoveForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{lbID}
+	varargs := []interface{}{ctx, lbID}
 	for _, a := range rules {
 		varargs = append(varargs, a)
 	}
@@ -178,8 +179,8 @@ func (m *MockLoadBalancersService) RemoveForwardingRules(lbID string, rules ...g
 }
 
 // RemoveForwardingRules indicates an expected call of RemoveForwardingRules
-func (mr *MockLoadBalancersServiceMockRecorder) RemoveForwardingRules(lbID interface{}, rules ...interface{}) *gomock.Call {
+func (mr *MockLoadBalancersServiceMockRecorder) RemoveForwardingRules(ctx, lbID interface{}, rules ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{lbID}, rules...)
+	varargs := append([]interface{}{ctx, lbID}, rules...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveForwardingRules", reflect.TypeOf((*MockLoadBalancersService)(nil).RemoveForwardingRules), varargs...)
 }
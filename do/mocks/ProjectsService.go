@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,120 +36,120 @@ func (m *MockProjectsService) EXPECT() *MockProjectsServiceMockRecorder {
 }
 
 // List mocks base method
-func (m *MockProjectsService) List() (do.Projects, error) {
+func (m *MockProjectsService) List(arg0 context.Context) (do.Projects, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.Projects)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockProjectsServiceMockRecorder) List() *gomock.Call {
+func (mr *MockProjectsServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectsService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectsService)(nil).List), arg0)
 }
 
 // GetDefault mocks base method
-func (m *MockProjectsService) GetDefault() (*do.Project, error) {
+func (m *MockProjectsService) GetDefault(arg0 context.Context) (*do.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDefault")
+	ret := m.ctrl.Call(m, "GetDefault", arg0)
 	ret0, _ := ret[0].(*do.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDefault indicates an expected call of GetDefault
-func (mr *MockProjectsServiceMockRecorder) GetDefault() *gomock.Call {
+func (mr *MockProjectsServiceMockRecorder) GetDefault(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefault", reflect.TypeOf((*MockProjectsService)(nil).GetDefault))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefault", reflect.TypeOf((*MockProjectsService)(nil).GetDefault), arg0)
 }
 
 // Get mocks base method
-func (m *MockProjectsService) Get(projectUUID string) (*do.Project, error) {
+func (m *MockProjectsService) Get(ctx context.Context, projectUUID string) (*do.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", projectUUID)
+	ret := m.ctrl.Call(m, "Get", ctx, projectUUID)
 	ret0, _ := ret[0].(*do.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockProjectsServiceMockRecorder) Get(projectUUID interface{}) *gomock.Call {
+func (mr *MockProjectsServiceMockRecorder) Get(ctx, projectUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectsService)(nil).Get), projectUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectsService)(nil).Get), ctx, projectUUID)
 }
 
 // Create mocks base method
-func (m *MockProjectsService) Create(arg0 *godo.CreateProjectRequest) (*do.Project, error) {
+func (m *MockProjectsService) Create(arg0 context.Context, arg1 *godo.CreateProjectRequest) (*do.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(*do.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockProjectsServiceMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockProjectsServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectsService)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectsService)(nil).Create), arg0, arg1)
 }
 
 // Update mocks base method
-func (m *MockProjectsService) Update(projectUUID string, req *godo.UpdateProjectRequest) (*do.Project, error) {
+func (m *MockProjectsService) Update(ctx context.Context, projectUUID string, req *godo.UpdateProjectRequest) (*do.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", projectUUID, req)
+	ret := m.ctrl.Call(m, "Update", ctx, projectUUID, req)
 	ret0, _ := ret[0].(*do.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update
-func (mr *MockProjectsServiceMockRecorder) Update(projectUUID, req interface{}) *gomock.Call {
+func (mr *MockProjectsServiceMockRecorder) Update(ctx, projectUUID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectsService)(nil).Update), projectUUID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectsService)(nil).Update), ctx, projectUUID, req)
 }
 
 // Delete mocks base method
-func (m *MockProjectsService) Delete(projectUUID string) error {
+func (m *MockProjectsService) Delete(ctx context.Context, projectUUID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", projectUUID)
+	ret := m.ctrl.Call(m, "Delete", ctx, projectUUID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockProjectsServiceMockRecorder) Delete(projectUUID interface{}) *gomock.Call {
+func (mr *MockProjectsServiceMockRecorder) Delete(ctx, projectUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectsService)(nil).Delete), projectUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectsService)(nil).Delete), ctx, projectUUID)
 }
 
 // ListResources mocks base method
-func (m *MockProjectsService) ListResources(projectUUID string) (do.ProjectResources, error) {
+func (m *MockProjectsService) ListResources(ctx context.Context, projectUUID string) (do.ProjectResources, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListResources", projectUUID)
+	ret := m.ctrl.Call(m, "ListResources", ctx, projectUUID)
 	ret0, _ := ret[0].(do.ProjectResources)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListResources indicates an expected call of ListResources
-func (mr *MockProjectsServiceMockRecorder) ListResources(projectUUID interface{}) *gomock.Call {
+func (mr *MockProjectsServiceMockRecorder) ListResources(ctx, projectUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockProjectsService)(nil).ListResources), projectUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockProjectsService)(nil).ListResources), ctx, projectUUID)
 }
 
 // AssignResources mocks base method
-func (m *MockProjectsService) AssignResources(projectUUID string, resources []string) (do.ProjectResources, error) {
+func (m *MockProjectsService) AssignResources(ctx context.Context, projectUUID string, resources []string) (do.ProjectResources, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AssignResources", projectUUID, resources)
+	ret := m.ctrl.Call(m, "AssignResources", ctx, projectUUID, resources)
 	ret0, _ := ret[0].(do.ProjectResources)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AssignResources indicates an expected call of AssignResources
-func (mr *MockProjectsServiceMockRecorder) AssignResources(projectUUID, resources interface{}) *gomock.Call {
+func (mr *MockProjectsServiceMockRecorder) AssignResources(ctx, projectUUID, resources interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignResources", reflect.TypeOf((*MockProjectsService)(nil).AssignResources), projectUUID, resources)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignResources", reflect.TypeOf((*MockProjectsService)(nil).AssignResources), ctx, projectUUID, resources)
 }
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,62 +36,62 @@ func (m *MockRegistryService) EXPECT() *MockRegistryServiceMockRecorder {
 }
 
 // Get mocks base method
-func (m *MockRegistryService) Get() (*do.Registry, error) {
+func (m *MockRegistryService) Get(arg0 context.Context) (*do.Registry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get")
+	ret := m.ctrl.Call(m, "Get", arg0)
 	ret0, _ := ret[0].(*do.Registry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockRegistryServiceMockRecorder) Get() *gomock.Call {
+func (mr *MockRegistryServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRegistryService)(nil).Get))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRegistryService)(nil).Get), arg0)
 }
 
 // Create mocks base method
-func (m *MockRegistryService) Create(arg0 *godo.RegistryCreateRequest) (*do.Registry, error) {
+func (m *MockRegistryService) Create(arg0 context.Context, arg1 *godo.RegistryCreateRequest) (*do.Registry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(*do.Registry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create
-func (mr *MockRegistryServiceMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockRegistryServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRegistryService)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRegistryService)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method
-func (m *MockRegistryService) Delete() error {
+func (m *MockRegistryService) Delete(arg0 context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete")
+	ret := m.ctrl.Call(m, "Delete", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockRegistryServiceMockRecorder) Delete() *gomock.Call {
+func (mr *MockRegistryServiceMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRegistryService)(nil).Delete))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRegistryService)(nil).Delete), arg0)
 }
 
 // DockerCredentials mocks base method
-func (m *MockRegistryService) DockerCredentials(arg0 *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, error) {
+func (m *MockRegistryService) DockerCredentials(arg0 context.Context, arg1 *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DockerCredentials", arg0)
+	ret := m.ctrl.Call(m, "DockerCredentials", arg0, arg1)
 	ret0, _ := ret[0].(*godo.DockerCredentials)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DockerCredentials indicates an expected call of DockerCredentials
-func (mr *MockRegistryServiceMockRecorder) DockerCredentials(arg0 interface{}) *gomock.Call {
+func (mr *MockRegistryServiceMockRecorder) DockerCredentials(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DockerCredentials", reflect.TypeOf((*MockRegistryService)(nil).DockerCredentials), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DockerCredentials", reflect.TypeOf((*MockRegistryService)(nil).DockerCredentials), arg0, arg1)
 }
 
 // Endpoint mocks base method
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -34,75 +35,75 @@ func (m *MockSnapshotsService) EXPECT() *MockSnapshotsServiceMockRecorder {
 }
 
 // List mocks base method
-func (m *MockSnapshotsService) List() (do.Snapshots, error) {
+func (m *MockSnapshotsService) List(arg0 context.Context) (do.Snapshots, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", arg0)
 	ret0, _ := ret[0].(do.Snapshots)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockSnapshotsServiceMockRecorder) List() *gomock.Call {
+func (mr *MockSnapshotsServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSnapshotsService)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSnapshotsService)(nil).List), arg0)
 }
 
 // ListVolume mocks base method
-func (m *MockSnapshotsService) ListVolume() (do.Snapshots, error) {
+func (m *MockSnapshotsService) ListVolume(arg0 context.Context) (do.Snapshots, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVolume")
+	ret := m.ctrl.Call(m, "ListVolume", arg0)
 	ret0, _ := ret[0].(do.Snapshots)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListVolume indicates an expected call of ListVolume
-func (mr *MockSnapshotsServiceMockRecorder) ListVolume() *gomock.Call {
+func (mr *MockSnapshotsServiceMockRecorder) ListVolume(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolume", reflect.TypeOf((*MockSnapshotsService)(nil).ListVolume))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolume", reflect.TypeOf((*MockSnapshotsService)(nil).ListVolume), arg0)
 }
 
 // ListDroplet mocks base method
-func (m *MockSnapshotsService) ListDroplet() (do.Snapshots, error) {
+func (m *MockSnapshotsService) ListDroplet(arg0 context.Context) (do.Snapshots, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDroplet")
+	ret := m.ctrl.Call(m, "ListDroplet", arg0)
 	ret0, _ := ret[0].(do.Snapshots)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDroplet indicates an expected call of ListDroplet
-func (mr *MockSnapshotsServiceMockRecorder) ListDroplet() *gomock.Call {
+func (mr *MockSnapshotsServiceMockRecorder) ListDroplet(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDroplet", reflect.TypeOf((*MockSnapshotsService)(nil).ListDroplet))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDroplet", reflect.TypeOf((*MockSnapshotsService)(nil).ListDroplet), arg0)
 }
 
 // Get mocks base method
-func (m *MockSnapshotsService) Get(arg0 string) (*do.Snapshot, error) {
+func (m *MockSnapshotsService) Get(arg0 context.Context, arg1 string) (*do.Snapshot, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
 	ret0, _ := ret[0].(*do.Snapshot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockSnapshotsServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
+func (mr *MockSnapshotsServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSnapshotsService)(nil).Get), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSnapshotsService)(nil).Get), arg0, arg1)
 }
 
 // Delete mocks base method
-func (m *MockSnapshotsService) Delete(arg0 string) error {
+func (m *MockSnapshotsService) Delete(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockSnapshotsServiceMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+func (mr *MockSnapshotsServiceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSnapshotsService)(nil).Delete), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSnapshotsService)(nil).Delete), arg0, arg1)
 }
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
 	gomock "github.com/golang/mock/gomock"
@@ -35,76 +36,76 @@ func (m *MockVolumeActionsService) EXPECT() *MockVolumeActionsServiceMockRecorde
 }
 
 // Attach mocks base method
-func (m *MockVolumeActionsService) Attach(arg0 string, arg1 int) (*do.Action, error) {
+func (m *MockVolumeActionsService) Attach(arg0 context.Context, arg1 string, arg2 int) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Attach", arg0, arg1)
+	ret := m.ctrl.Call(m, "Attach", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Attach indicates an expected call of Attach
-func (mr *MockVolumeActionsServiceMockRecorder) Attach(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockVolumeActionsServiceMockRecorder) Attach(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockVolumeActionsService)(nil).Attach), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockVolumeActionsService)(nil).Attach), arg0, arg1, arg2)
 }
 
 // Detach mocks base method
-func (m *MockVolumeActionsService) Detach(arg0 string, arg1 int) (*do.Action, error) {
+func (m *MockVolumeActionsService) Detach(arg0 context.Context, arg1 string, arg2 int) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Detach", arg0, arg1)
+	ret := m.ctrl.Call(m, "Detach", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Detach indicates an expected call of Detach
-func (mr *MockVolumeActionsServiceMockRecorder) Detach(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockVolumeActionsServiceMockRecorder) Detach(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockVolumeActionsService)(nil).Detach), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockVolumeActionsService)(nil).Detach), arg0, arg1, arg2)
 }
 
 // Get mocks base method
-func (m *MockVolumeActionsService) Get(arg0 string, arg1 int) (*do.Action, error) {
+func (m *MockVolumeActionsService) Get(arg0 context.Context, arg1 string, arg2 int) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get
-func (mr *MockVolumeActionsServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockVolumeActionsServiceMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVolumeActionsService)(nil).Get), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVolumeActionsService)(nil).Get), arg0, arg1, arg2)
 }
 
 // List mocks base method
-func (m *MockVolumeActionsService) List(arg0 string, arg1 *godo.ListOptions) ([]do.Action, error) {
+func (m *MockVolumeActionsService) List(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List
-func (mr *MockVolumeActionsServiceMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockVolumeActionsServiceMockRecorder) List(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVolumeActionsService)(nil).List), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVolumeActionsService)(nil).List), arg0, arg1, arg2)
 }
 
 // Resize mocks base method
-func (m *MockVolumeActionsService) Resize(arg0 string, arg1 int, arg2 string) (*do.Action, error) {
+func (m *MockVolumeActionsService) Resize(arg0 context.Context, arg1 string, arg2 int, arg3 string) (*do.Action, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Resize", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Resize", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*do.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Resize indicates an expected call of Resize
-func (mr *MockVolumeActionsServiceMockRecorder) Resize(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockVolumeActionsServiceMockRecorder) Resize(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockVolumeActionsService)(nil).Resize), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockVolumeActionsService)(nil).Resize), arg0, arg1, arg2, arg3)
 }
@@ -14,85 +14,218 @@ limitations under the License.
 package do
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
-	"sync"
 
 	"github.com/digitalocean/godo"
 )
 
 const maxFetchPages = 5
 
+// perPage is how many items list operations fetch per request by default,
+// and the most the API returns.
 var perPage = 200
 
 var fetchFn = fetchPage
 
-type paginatedList struct {
-	list []interface{}
-	mu   sync.Mutex
+type listLimitKey struct{}
+
+// WithListLimit returns a copy of ctx that caps the number of items list
+// operations return. Pagination stops as soon as the limit is reached.
+func WithListLimit(ctx context.Context, limit int) context.Context {
+	return context.WithValue(ctx, listLimitKey{}, limit)
+}
+
+func listLimit(ctx context.Context) int {
+	limit, _ := ctx.Value(listLimitKey{}).(int)
+	if limit < 0 {
+		return 0
+	}
+	return limit
 }
 
-func (pl *paginatedList) append(items ...interface{}) {
-	pl.mu.Lock()
-	defer pl.mu.Unlock()
+type pageSizeKey struct{}
+
+// WithPageSize returns a copy of ctx setting how many items list operations
+// fetch per request, up to 200.
+func WithPageSize(ctx context.Context, size int) context.Context {
+	return context.WithValue(ctx, pageSizeKey{}, size)
+}
+
+func pageSize(ctx context.Context) int {
+	size, _ := ctx.Value(pageSizeKey{}).(int)
+	if size <= 0 || size > perPage {
+		return perPage
+	}
+	return size
+}
+
+// wholeList returns a copy of ctx listing whole lists, whatever the list
+// limit and page size of ctx, as cached lists must be.
+func wholeList(ctx context.Context) context.Context {
+	return WithPageSize(WithListLimit(ctx, 0), 0)
+}
 
-	pl.list = append(pl.list, items...)
+// limitedLen returns the length a list of n items is cut to by the list
+// limit of ctx.
+func limitedLen(ctx context.Context, n int) int {
+	if limit := listLimit(ctx); limit > 0 && limit < n {
+		return limit
+	}
+	return n
 }
 
 // Generator is a function that generates the list to be paginated.
 type Generator func(*godo.ListOptions) ([]interface{}, *godo.Response, error)
 
-// PaginateResp paginates a Response.
-func PaginateResp(gen Generator) ([]interface{}, error) {
-	opt := &godo.ListOptions{Page: 1, PerPage: perPage}
+// Page is a single page of a paginated list. If Err is set, the page could
+// not be fetched and no further pages follow it.
+type Page struct {
+	Number int
+	Items  []interface{}
+	Err    error
+}
 
-	l := paginatedList{}
+// PaginateResp paginates a Response. Pages are returned in order, and an
+// error fetching any page fails the whole list.
+func PaginateResp(ctx context.Context, gen Generator) ([]interface{}, error) {
+	var list []interface{}
+	err := PaginateEach(ctx, gen, func(items []interface{}) error {
+		list = append(list, items...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	fetchChan := make(chan int, maxFetchPages)
+	return list, nil
+}
 
-	var wg sync.WaitGroup
-	for i := 0; i < maxFetchPages-1; i++ {
-		wg.Add(1)
-		go func() {
-			for page := range fetchChan {
-				items, err := fetchFn(gen, page)
-				if err == nil {
-					l.append(items...)
-				}
+// PaginateEach calls fn with the items of each page of a Response, in page
+// order as they arrive. It stops at the first error fetching a page or
+// returned by fn.
+func PaginateEach(ctx context.Context, gen Generator, fn func([]interface{}) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for page := range PaginateStream(ctx, gen) {
+		if page.Err != nil {
+			return page.Err
+		}
+		if err := fn(page.Items); err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// PaginateStream sends the pages of a Response on the returned channel in
+// page order as they arrive. Pages after the first are fetched concurrently,
+// a few ahead of the reader. The channel is closed after the last page, after
+// a page that failed, or once the list limit set on ctx is reached. A caller
+// that stops reading early must cancel ctx.
+func PaginateStream(ctx context.Context, gen Generator) <-chan Page {
+	out := make(chan Page)
+	go paginate(ctx, gen, out)
+	return out
+}
+
+func paginate(ctx context.Context, gen Generator, out chan<- Page) {
+	defer close(out)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limit := listLimit(ctx)
+	pageSize := pageSize(ctx)
+	if limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+
+	remaining := limit
+	// send hands a page to the reader, trimmed to the list limit. It reports
+	// whether more pages should follow.
+	send := func(p Page) bool {
+		if p.Err == nil && limit > 0 {
+			if len(p.Items) > remaining {
+				p.Items = p.Items[:remaining]
 			}
-			wg.Done()
-		}()
+			remaining -= len(p.Items)
+		}
+
+		select {
+		case out <- p:
+		case <-ctx.Done():
+			return false
+		}
+
+		return p.Err == nil && (limit == 0 || remaining > 0)
 	}
 
 	// fetch first page to get page count (x)
-	items, resp, err := gen(opt)
+	items, resp, err := gen(&godo.ListOptions{Page: 1, PerPage: pageSize})
 	if err != nil {
-		return nil, err
+		send(Page{Number: 1, Err: err})
+		return
 	}
 
-	l.append(items...)
-
 	// find last page
 	lp, err := lastPage(resp)
 	if err != nil {
-		return nil, err
+		send(Page{Number: 1, Err: err})
+		return
 	}
 
-	// start with second page
-	opt.Page++
-	for ; opt.Page <= lp; opt.Page++ {
-		fetchChan <- opt.Page
+	if !send(Page{Number: 1, Items: items}) {
+		return
 	}
-	close(fetchChan)
 
-	wg.Wait()
+	pending := make([]chan Page, lp+1)
+	for page := 2; page <= lp; page++ {
+		pending[page] = make(chan Page, 1)
+	}
+
+	// slots bounds how many pages are fetched ahead of the reader.
+	slots := make(chan struct{}, maxFetchPages-1)
+	go func() {
+		for page := 2; page <= lp; page++ {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			go func(page int) {
+				items, err := fetchFn(gen, page, pageSize)
+				if err != nil {
+					err = fmt.Errorf("unable to fetch page %d of %d: %w", page, lp, err)
+				}
+				pending[page] <- Page{Number: page, Items: items, Err: err}
+			}(page)
+		}
+	}()
 
-	return l.list, nil
+	// start with second page
+	for page := 2; page <= lp; page++ {
+		var p Page
+		select {
+		case p = <-pending[page]:
+		case <-ctx.Done():
+			return
+		}
+		<-slots
+
+		if !send(p) {
+			return
+		}
+	}
 }
 
-func fetchPage(gen Generator, page int) ([]interface{}, error) {
-	opt := &godo.ListOptions{Page: page, PerPage: 200}
+func fetchPage(gen Generator, page, pageSize int) ([]interface{}, error) {
+	opt := &godo.ListOptions{Page: page, PerPage: pageSize}
 	items, _, err := gen(opt)
 	return items, err
 }
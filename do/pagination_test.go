@@ -14,8 +14,11 @@ limitations under the License.
 package do
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
@@ -33,23 +36,137 @@ func Test_PaginateResp(t *testing.T) {
 		return []interface{}{currentPage}, resp, nil
 	}
 
-	list, err := PaginateResp(gen)
+	list, err := PaginateResp(context.Background(), gen)
 	assert.NoError(t, err)
 
 	assert.Len(t, list, 5)
 }
 
+func Test_PaginateResp_order(t *testing.T) {
+	resp := &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Last: "http://example.com/?page=12"}}}
+
+	gen := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		// later pages answer faster than earlier ones
+		time.Sleep(time.Duration(12-opt.Page) * time.Millisecond)
+		return []interface{}{opt.Page}, resp, nil
+	}
+
+	list, err := PaginateResp(context.Background(), gen)
+	assert.NoError(t, err)
+
+	expected := []interface{}{}
+	for i := 1; i <= 12; i++ {
+		expected = append(expected, i)
+	}
+	assert.Equal(t, expected, list)
+}
+
+func Test_PaginateResp_pageError(t *testing.T) {
+	resp := &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Last: "http://example.com/?page=5"}}}
+
+	gen := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		if opt.Page == 3 {
+			return nil, nil, errors.New("boom")
+		}
+		return []interface{}{opt.Page}, resp, nil
+	}
+
+	list, err := PaginateResp(context.Background(), gen)
+	assert.EqualError(t, err, "unable to fetch page 3 of 5: boom")
+	assert.Nil(t, list)
+}
+
+func Test_PaginateResp_limit(t *testing.T) {
+	var mu sync.Mutex
+	fetched := map[int]bool{}
+	resp := &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Last: "http://example.com/?page=100"}}}
+
+	gen := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		mu.Lock()
+		fetched[opt.Page] = true
+		mu.Unlock()
+
+		items := []interface{}{}
+		for i := 0; i < opt.PerPage; i++ {
+			items = append(items, (opt.Page-1)*opt.PerPage+i)
+		}
+		return items, resp, nil
+	}
+
+	ctx := WithListLimit(context.Background(), 3)
+	list, err := PaginateResp(ctx, gen)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{0, 1, 2}, list)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[int]bool{1: true}, fetched)
+}
+
+func Test_PaginateResp_pageSize(t *testing.T) {
+	var mu sync.Mutex
+	var sizes []int
+	gen := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		mu.Lock()
+		sizes = append(sizes, opt.PerPage)
+		mu.Unlock()
+		return []interface{}{opt.Page}, &godo.Response{}, nil
+	}
+
+	for _, size := range []int{0, 50, 500} {
+		_, err := PaginateResp(WithPageSize(context.Background(), size), gen)
+		assert.NoError(t, err)
+	}
+	_, err := PaginateResp(WithListLimit(WithPageSize(context.Background(), 50), 10), gen)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []int{200, 50, 200, 10}, sizes)
+}
+
+func Test_PaginateResp_cancelled(t *testing.T) {
+	resp := &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Last: "http://example.com/?page=5"}}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	gen := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		cancel()
+		return []interface{}{opt.Page}, resp, nil
+	}
+
+	_, err := PaginateResp(ctx, gen)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func Test_PaginateStream(t *testing.T) {
+	resp := &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Last: "http://example.com/?page=3"}}}
+
+	gen := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		return []interface{}{opt.Page * 10, opt.Page*10 + 1}, resp, nil
+	}
+
+	var pages []int
+	var items []interface{}
+	for page := range PaginateStream(context.Background(), gen) {
+		assert.NoError(t, page.Err)
+		pages = append(pages, page.Number)
+		items = append(items, page.Items...)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, pages)
+	assert.Equal(t, []interface{}{10, 11, 20, 21, 30, 31}, items)
+}
+
 func Test_Pagination_fetchPage(t *testing.T) {
 	gen := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		items := []interface{}{}
 		resp := &godo.Response{}
 
 		assert.Equal(t, 10, opt.Page)
+		assert.Equal(t, 25, opt.PerPage)
 
 		return items, resp, nil
 	}
 
-	fetchPage(gen, 10)
+	fetchPage(gen, 10, 25)
 }
 
 func Test_Pagination_lastPage(t *testing.T) {
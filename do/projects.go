@@ -38,20 +38,19 @@ type ProjectResources []ProjectResource
 
 // ProjectsService is the godo ProjectsService interface.
 type ProjectsService interface {
-	List() (Projects, error)
-	GetDefault() (*Project, error)
-	Get(projectUUID string) (*Project, error)
-	Create(*godo.CreateProjectRequest) (*Project, error)
-	Update(projectUUID string, req *godo.UpdateProjectRequest) (*Project, error)
-	Delete(projectUUID string) error
-
-	ListResources(projectUUID string) (ProjectResources, error)
-	AssignResources(projectUUID string, resources []string) (ProjectResources, error)
+	List(context.Context) (Projects, error)
+	GetDefault(context.Context) (*Project, error)
+	Get(ctx context.Context, projectUUID string) (*Project, error)
+	Create(context.Context, *godo.CreateProjectRequest) (*Project, error)
+	Update(ctx context.Context, projectUUID string, req *godo.UpdateProjectRequest) (*Project, error)
+	Delete(ctx context.Context, projectUUID string) error
+
+	ListResources(ctx context.Context, projectUUID string) (ProjectResources, error)
+	AssignResources(ctx context.Context, projectUUID string, resources []string) (ProjectResources, error)
 }
 
 type projectsService struct {
 	client *godo.Client
-	ctx    context.Context
 }
 
 var _ ProjectsService = &projectsService{}
@@ -60,14 +59,13 @@ var _ ProjectsService = &projectsService{}
 func NewProjectsService(client *godo.Client) ProjectsService {
 	return &projectsService{
 		client: client,
-		ctx:    context.Background(),
 	}
 }
 
 // List projects.
-func (ps *projectsService) List() (Projects, error) {
+func (ps *projectsService) List(ctx context.Context) (Projects, error) {
 	listFn := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ps.client.Projects.List(ps.ctx, opt)
+		list, resp, err := ps.client.Projects.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -80,11 +78,11 @@ func (ps *projectsService) List() (Projects, error) {
 		return si, resp, err
 	}
 
-	return projectsPaginatedListHelper(listFn)
+	return projectsPaginatedListHelper(ctx, listFn)
 }
 
-func (ps *projectsService) GetDefault() (*Project, error) {
-	f, _, err := ps.client.Projects.GetDefault(ps.ctx)
+func (ps *projectsService) GetDefault(ctx context.Context) (*Project, error) {
+	f, _, err := ps.client.Projects.GetDefault(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -92,8 +90,8 @@ func (ps *projectsService) GetDefault() (*Project, error) {
 	return &Project{Project: f}, nil
 }
 
-func (ps *projectsService) Get(projectUUID string) (*Project, error) {
-	f, _, err := ps.client.Projects.Get(ps.ctx, projectUUID)
+func (ps *projectsService) Get(ctx context.Context, projectUUID string) (*Project, error) {
+	f, _, err := ps.client.Projects.Get(ctx, projectUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +99,8 @@ func (ps *projectsService) Get(projectUUID string) (*Project, error) {
 	return &Project{Project: f}, nil
 }
 
-func (ps *projectsService) Create(cr *godo.CreateProjectRequest) (*Project, error) {
-	f, _, err := ps.client.Projects.Create(ps.ctx, cr)
+func (ps *projectsService) Create(ctx context.Context, cr *godo.CreateProjectRequest) (*Project, error) {
+	f, _, err := ps.client.Projects.Create(ctx, cr)
 	if err != nil {
 		return nil, err
 	}
@@ -110,8 +108,8 @@ func (ps *projectsService) Create(cr *godo.CreateProjectRequest) (*Project, erro
 	return &Project{Project: f}, nil
 }
 
-func (ps *projectsService) Update(projectUUID string, ur *godo.UpdateProjectRequest) (*Project, error) {
-	p, _, err := ps.client.Projects.Update(ps.ctx, projectUUID, ur)
+func (ps *projectsService) Update(ctx context.Context, projectUUID string, ur *godo.UpdateProjectRequest) (*Project, error) {
+	p, _, err := ps.client.Projects.Update(ctx, projectUUID, ur)
 	if err != nil {
 		return nil, err
 	}
@@ -119,14 +117,14 @@ func (ps *projectsService) Update(projectUUID string, ur *godo.UpdateProjectRequ
 	return &Project{Project: p}, nil
 }
 
-func (ps *projectsService) Delete(projectUUID string) error {
-	_, err := ps.client.Projects.Delete(ps.ctx, projectUUID)
+func (ps *projectsService) Delete(ctx context.Context, projectUUID string) error {
+	_, err := ps.client.Projects.Delete(ctx, projectUUID)
 	return err
 }
 
-func (ps *projectsService) ListResources(projectUUID string) (ProjectResources, error) {
+func (ps *projectsService) ListResources(ctx context.Context, projectUUID string) (ProjectResources, error) {
 	listFn := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ps.client.Projects.ListResources(ps.ctx, projectUUID, opt)
+		list, resp, err := ps.client.Projects.ListResources(ctx, projectUUID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -139,16 +137,16 @@ func (ps *projectsService) ListResources(projectUUID string) (ProjectResources,
 		return si, resp, err
 	}
 
-	return projectResourcesPaginatedListHelper(listFn)
+	return projectResourcesPaginatedListHelper(ctx, listFn)
 }
 
-func (ps *projectsService) AssignResources(projectUUID string, resources []string) (ProjectResources, error) {
+func (ps *projectsService) AssignResources(ctx context.Context, projectUUID string, resources []string) (ProjectResources, error) {
 	assignableResources := make([]interface{}, len(resources))
 	for i, resource := range resources {
 		assignableResources[i] = resource
 	}
 
-	assignedResources, _, err := ps.client.Projects.AssignResources(ps.ctx, projectUUID, assignableResources...)
+	assignedResources, _, err := ps.client.Projects.AssignResources(ctx, projectUUID, assignableResources...)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +159,8 @@ func (ps *projectsService) AssignResources(projectUUID string, resources []strin
 	return prs, err
 }
 
-func projectsPaginatedListHelper(listFn func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error)) (Projects, error) {
-	si, err := PaginateResp(listFn)
+func projectsPaginatedListHelper(ctx context.Context, listFn func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error)) (Projects, error) {
+	si, err := PaginateResp(ctx, listFn)
 	if err != nil {
 		return nil, err
 	}
@@ -180,8 +178,8 @@ func projectsPaginatedListHelper(listFn func(opt *godo.ListOptions) ([]interface
 	return list, nil
 }
 
-func projectResourcesPaginatedListHelper(listFn func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error)) (ProjectResources, error) {
-	si, err := PaginateResp(listFn)
+func projectResourcesPaginatedListHelper(ctx context.Context, listFn func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error)) (ProjectResources, error) {
+	si, err := PaginateResp(ctx, listFn)
 	if err != nil {
 		return nil, err
 	}
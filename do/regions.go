@@ -29,7 +29,7 @@ type Regions []Region
 
 // RegionsService is the godo RegionsService interface.
 type RegionsService interface {
-	List() (Regions, error)
+	List(context.Context) (Regions, error)
 }
 
 type regionsService struct {
@@ -45,9 +45,9 @@ func NewRegionsService(client *godo.Client) RegionsService {
 	}
 }
 
-func (rs *regionsService) List() (Regions, error) {
+func (rs *regionsService) List(ctx context.Context) (Regions, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := rs.client.Regions.List(context.TODO(), opt)
+		list, resp, err := rs.client.Regions.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -60,7 +60,7 @@ func (rs *regionsService) List() (Regions, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -73,3 +73,26 @@ func (rs *regionsService) List() (Regions, error) {
 
 	return list, nil
 }
+
+type cachedRegionsService struct {
+	RegionsService
+	cache *Cache
+}
+
+// NewCachedRegionsService builds a RegionsService listing the regions of rs
+// through cache.
+func NewCachedRegionsService(rs RegionsService, cache *Cache) RegionsService {
+	return &cachedRegionsService{RegionsService: rs, cache: cache}
+}
+
+func (rs *cachedRegionsService) List(ctx context.Context) (Regions, error) {
+	var list Regions
+	err := rs.cache.Fetch("regions", &list, func() (err error) {
+		list, err = rs.RegionsService.List(wholeList(ctx))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list[:limitedLen(ctx, len(list))], nil
+}
@@ -35,16 +35,15 @@ func (r *Registry) Endpoint() string {
 
 // RegistryService is the godo RegistryService interface.
 type RegistryService interface {
-	Get() (*Registry, error)
-	Create(*godo.RegistryCreateRequest) (*Registry, error)
-	Delete() error
-	DockerCredentials(*godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, error)
+	Get(context.Context) (*Registry, error)
+	Create(context.Context, *godo.RegistryCreateRequest) (*Registry, error)
+	Delete(context.Context) error
+	DockerCredentials(context.Context, *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, error)
 	Endpoint() string
 }
 
 type registryService struct {
 	client *godo.Client
-	ctx    context.Context
 }
 
 var _ RegistryService = &registryService{}
@@ -53,12 +52,11 @@ var _ RegistryService = &registryService{}
 func NewRegistryService(client *godo.Client) RegistryService {
 	return &registryService{
 		client: client,
-		ctx:    context.Background(),
 	}
 }
 
-func (rs *registryService) Get() (*Registry, error) {
-	r, _, err := rs.client.Registry.Get(rs.ctx)
+func (rs *registryService) Get(ctx context.Context) (*Registry, error) {
+	r, _, err := rs.client.Registry.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +64,8 @@ func (rs *registryService) Get() (*Registry, error) {
 	return &Registry{Registry: r}, nil
 }
 
-func (rs *registryService) Create(cr *godo.RegistryCreateRequest) (*Registry, error) {
-	r, _, err := rs.client.Registry.Create(rs.ctx, cr)
+func (rs *registryService) Create(ctx context.Context, cr *godo.RegistryCreateRequest) (*Registry, error) {
+	r, _, err := rs.client.Registry.Create(ctx, cr)
 	if err != nil {
 		return nil, err
 	}
@@ -75,13 +73,13 @@ func (rs *registryService) Create(cr *godo.RegistryCreateRequest) (*Registry, er
 	return &Registry{Registry: r}, nil
 }
 
-func (rs *registryService) Delete() error {
-	_, err := rs.client.Registry.Delete(rs.ctx)
+func (rs *registryService) Delete(ctx context.Context) error {
+	_, err := rs.client.Registry.Delete(ctx)
 	return err
 }
 
-func (rs *registryService) DockerCredentials(request *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, error) {
-	dockerConfig, _, err := rs.client.Registry.DockerCredentials(rs.ctx, request)
+func (rs *registryService) DockerCredentials(ctx context.Context, request *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, error) {
+	dockerConfig, _, err := rs.client.Registry.DockerCredentials(ctx, request)
 	if err != nil {
 		return nil, err
 	}
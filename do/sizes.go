@@ -29,7 +29,7 @@ type Sizes []Size
 
 // SizesService is the godo SizesService interface.
 type SizesService interface {
-	List() (Sizes, error)
+	List(context.Context) (Sizes, error)
 }
 
 type sizesService struct {
@@ -45,9 +45,9 @@ func NewSizesService(client *godo.Client) SizesService {
 	}
 }
 
-func (rs *sizesService) List() (Sizes, error) {
+func (rs *sizesService) List(ctx context.Context) (Sizes, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := rs.client.Sizes.List(context.TODO(), opt)
+		list, resp, err := rs.client.Sizes.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -60,7 +60,7 @@ func (rs *sizesService) List() (Sizes, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -73,3 +73,26 @@ func (rs *sizesService) List() (Sizes, error) {
 
 	return list, nil
 }
+
+type cachedSizesService struct {
+	SizesService
+	cache *Cache
+}
+
+// NewCachedSizesService builds a SizesService listing the sizes of ss
+// through cache.
+func NewCachedSizesService(ss SizesService, cache *Cache) SizesService {
+	return &cachedSizesService{SizesService: ss, cache: cache}
+}
+
+func (ss *cachedSizesService) List(ctx context.Context) (Sizes, error) {
+	var list Sizes
+	err := ss.cache.Fetch("sizes", &list, func() (err error) {
+		list, err = ss.SizesService.List(wholeList(ctx))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list[:limitedLen(ctx, len(list))], nil
+}
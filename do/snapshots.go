@@ -29,11 +29,11 @@ type Snapshots []Snapshot
 
 // SnapshotsService is an interface for interacting with DigitalOcean's snapshot api.
 type SnapshotsService interface {
-	List() (Snapshots, error)
-	ListVolume() (Snapshots, error)
-	ListDroplet() (Snapshots, error)
-	Get(string) (*Snapshot, error)
-	Delete(string) error
+	List(context.Context) (Snapshots, error)
+	ListVolume(context.Context) (Snapshots, error)
+	ListDroplet(context.Context) (Snapshots, error)
+	Get(context.Context, string) (*Snapshot, error)
+	Delete(context.Context, string) error
 }
 
 type snapshotsService struct {
@@ -49,9 +49,9 @@ func NewSnapshotsService(client *godo.Client) SnapshotsService {
 	}
 }
 
-func (ss *snapshotsService) List() (Snapshots, error) {
+func (ss *snapshotsService) List(ctx context.Context) (Snapshots, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ss.client.Snapshots.List(context.TODO(), opt)
+		list, resp, err := ss.client.Snapshots.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -64,7 +64,7 @@ func (ss *snapshotsService) List() (Snapshots, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -78,9 +78,9 @@ func (ss *snapshotsService) List() (Snapshots, error) {
 	return list, nil
 }
 
-func (ss *snapshotsService) ListVolume() (Snapshots, error) {
+func (ss *snapshotsService) ListVolume(ctx context.Context) (Snapshots, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ss.client.Snapshots.ListVolume(context.TODO(), opt)
+		list, resp, err := ss.client.Snapshots.ListVolume(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -93,7 +93,7 @@ func (ss *snapshotsService) ListVolume() (Snapshots, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -107,9 +107,9 @@ func (ss *snapshotsService) ListVolume() (Snapshots, error) {
 	return list, nil
 }
 
-func (ss *snapshotsService) ListDroplet() (Snapshots, error) {
+func (ss *snapshotsService) ListDroplet(ctx context.Context) (Snapshots, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ss.client.Snapshots.ListDroplet(context.TODO(), opt)
+		list, resp, err := ss.client.Snapshots.ListDroplet(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -122,7 +122,7 @@ func (ss *snapshotsService) ListDroplet() (Snapshots, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -136,8 +136,8 @@ func (ss *snapshotsService) ListDroplet() (Snapshots, error) {
 	return list, nil
 }
 
-func (ss *snapshotsService) Get(snapshotID string) (*Snapshot, error) {
-	s, _, err := ss.client.Snapshots.Get(context.TODO(), snapshotID)
+func (ss *snapshotsService) Get(ctx context.Context, snapshotID string) (*Snapshot, error) {
+	s, _, err := ss.client.Snapshots.Get(ctx, snapshotID)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +145,7 @@ func (ss *snapshotsService) Get(snapshotID string) (*Snapshot, error) {
 	return &Snapshot{Snapshot: s}, nil
 }
 
-func (ss *snapshotsService) Delete(snapshotID string) error {
-	_, err := ss.client.Snapshots.Delete(context.TODO(), snapshotID)
+func (ss *snapshotsService) Delete(ctx context.Context, snapshotID string) error {
+	_, err := ss.client.Snapshots.Delete(ctx, snapshotID)
 	return err
 }
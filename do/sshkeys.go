@@ -31,11 +31,11 @@ type SSHKeys []SSHKey
 
 // KeysService is the godo KeysService interface.
 type KeysService interface {
-	List() (SSHKeys, error)
-	Get(id string) (*SSHKey, error)
-	Create(kcr *godo.KeyCreateRequest) (*SSHKey, error)
-	Update(id string, kur *godo.KeyUpdateRequest) (*SSHKey, error)
-	Delete(id string) error
+	List(context.Context) (SSHKeys, error)
+	Get(ctx context.Context, id string) (*SSHKey, error)
+	Create(ctx context.Context, kcr *godo.KeyCreateRequest) (*SSHKey, error)
+	Update(ctx context.Context, id string, kur *godo.KeyUpdateRequest) (*SSHKey, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type keysService struct {
@@ -51,9 +51,9 @@ func NewKeysService(client *godo.Client) KeysService {
 	}
 }
 
-func (ks *keysService) List() (SSHKeys, error) {
+func (ks *keysService) List(ctx context.Context) (SSHKeys, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ks.client.Keys.List(context.TODO(), opt)
+		list, resp, err := ks.client.Keys.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -66,7 +66,7 @@ func (ks *keysService) List() (SSHKeys, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -80,15 +80,15 @@ func (ks *keysService) List() (SSHKeys, error) {
 	return list, nil
 }
 
-func (ks *keysService) Get(id string) (*SSHKey, error) {
+func (ks *keysService) Get(ctx context.Context, id string) (*SSHKey, error) {
 	var err error
 	var k *godo.Key
 
 	if i, aerr := strconv.Atoi(id); aerr == nil {
-		k, _, err = ks.client.Keys.GetByID(context.TODO(), i)
+		k, _, err = ks.client.Keys.GetByID(ctx, i)
 	} else {
 		if len(id) > 0 {
-			k, _, err = ks.client.Keys.GetByFingerprint(context.TODO(), id)
+			k, _, err = ks.client.Keys.GetByFingerprint(ctx, id)
 		} else {
 			err = fmt.Errorf("missing key id or fingerprint")
 		}
@@ -101,8 +101,8 @@ func (ks *keysService) Get(id string) (*SSHKey, error) {
 	return &SSHKey{Key: k}, nil
 }
 
-func (ks *keysService) Create(kcr *godo.KeyCreateRequest) (*SSHKey, error) {
-	k, _, err := ks.client.Keys.Create(context.TODO(), kcr)
+func (ks *keysService) Create(ctx context.Context, kcr *godo.KeyCreateRequest) (*SSHKey, error) {
+	k, _, err := ks.client.Keys.Create(ctx, kcr)
 	if err != nil {
 		return nil, err
 	}
@@ -110,13 +110,13 @@ func (ks *keysService) Create(kcr *godo.KeyCreateRequest) (*SSHKey, error) {
 	return &SSHKey{Key: k}, nil
 }
 
-func (ks *keysService) Update(id string, kur *godo.KeyUpdateRequest) (*SSHKey, error) {
+func (ks *keysService) Update(ctx context.Context, id string, kur *godo.KeyUpdateRequest) (*SSHKey, error) {
 	var k *godo.Key
 	var err error
 	if i, aerr := strconv.Atoi(id); aerr == nil {
-		k, _, err = ks.client.Keys.UpdateByID(context.TODO(), i, kur)
+		k, _, err = ks.client.Keys.UpdateByID(ctx, i, kur)
 	} else {
-		k, _, err = ks.client.Keys.UpdateByFingerprint(context.TODO(), id, kur)
+		k, _, err = ks.client.Keys.UpdateByFingerprint(ctx, id, kur)
 	}
 
 	if err != nil {
@@ -126,13 +126,13 @@ func (ks *keysService) Update(id string, kur *godo.KeyUpdateRequest) (*SSHKey, e
 	return &SSHKey{Key: k}, nil
 }
 
-func (ks *keysService) Delete(id string) error {
+func (ks *keysService) Delete(ctx context.Context, id string) error {
 	var err error
 
 	if i, aerr := strconv.Atoi(id); aerr == nil {
-		_, err = ks.client.Keys.DeleteByID(context.TODO(), i)
+		_, err = ks.client.Keys.DeleteByID(ctx, i)
 	} else {
-		_, err = ks.client.Keys.DeleteByFingerprint(context.TODO(), id)
+		_, err = ks.client.Keys.DeleteByFingerprint(ctx, id)
 	}
 
 	return err
@@ -29,12 +29,12 @@ type Tags []Tag
 
 // TagsService is an interface for interacting with DigitalOcean's tags api.
 type TagsService interface {
-	List() (Tags, error)
-	Get(string) (*Tag, error)
-	Create(*godo.TagCreateRequest) (*Tag, error)
-	Delete(string) error
-	TagResources(string, *godo.TagResourcesRequest) error
-	UntagResources(string, *godo.UntagResourcesRequest) error
+	List(context.Context) (Tags, error)
+	Get(context.Context, string) (*Tag, error)
+	Create(context.Context, *godo.TagCreateRequest) (*Tag, error)
+	Delete(context.Context, string) error
+	TagResources(context.Context, string, *godo.TagResourcesRequest) error
+	UntagResources(context.Context, string, *godo.UntagResourcesRequest) error
 }
 
 type tagsService struct {
@@ -50,9 +50,9 @@ func NewTagsService(godoClient *godo.Client) TagsService {
 	}
 }
 
-func (ts *tagsService) List() (Tags, error) {
+func (ts *tagsService) List(ctx context.Context) (Tags, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ts.client.Tags.List(context.TODO(), opt)
+		list, resp, err := ts.client.Tags.List(ctx, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -65,7 +65,7 @@ func (ts *tagsService) List() (Tags, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +79,8 @@ func (ts *tagsService) List() (Tags, error) {
 	return list, nil
 }
 
-func (ts *tagsService) Get(name string) (*Tag, error) {
-	t, _, err := ts.client.Tags.Get(context.TODO(), name)
+func (ts *tagsService) Get(ctx context.Context, name string) (*Tag, error) {
+	t, _, err := ts.client.Tags.Get(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -88,8 +88,8 @@ func (ts *tagsService) Get(name string) (*Tag, error) {
 	return &Tag{Tag: t}, nil
 }
 
-func (ts *tagsService) Create(tcr *godo.TagCreateRequest) (*Tag, error) {
-	t, _, err := ts.client.Tags.Create(context.TODO(), tcr)
+func (ts *tagsService) Create(ctx context.Context, tcr *godo.TagCreateRequest) (*Tag, error) {
+	t, _, err := ts.client.Tags.Create(ctx, tcr)
 	if err != nil {
 		return nil, err
 	}
@@ -97,17 +97,17 @@ func (ts *tagsService) Create(tcr *godo.TagCreateRequest) (*Tag, error) {
 	return &Tag{Tag: t}, nil
 }
 
-func (ts *tagsService) Delete(name string) error {
-	_, err := ts.client.Tags.Delete(context.TODO(), name)
+func (ts *tagsService) Delete(ctx context.Context, name string) error {
+	_, err := ts.client.Tags.Delete(ctx, name)
 	return err
 }
 
-func (ts *tagsService) TagResources(name string, trr *godo.TagResourcesRequest) error {
-	_, err := ts.client.Tags.TagResources(context.TODO(), name, trr)
+func (ts *tagsService) TagResources(ctx context.Context, name string, trr *godo.TagResourcesRequest) error {
+	_, err := ts.client.Tags.TagResources(ctx, name, trr)
 	return err
 }
 
-func (ts *tagsService) UntagResources(name string, urr *godo.UntagResourcesRequest) error {
-	_, err := ts.client.Tags.UntagResources(context.TODO(), name, urr)
+func (ts *tagsService) UntagResources(ctx context.Context, name string, urr *godo.UntagResourcesRequest) error {
+	_, err := ts.client.Tags.UntagResources(ctx, name, urr)
 	return err
 }
@@ -8,11 +8,11 @@ import (
 
 // VolumeActionsService is an interface for interacting with DigitalOcean's volume-action api.
 type VolumeActionsService interface {
-	Attach(string, int) (*Action, error)
-	Detach(string, int) (*Action, error)
-	Get(string, int) (*Action, error)
-	List(string, *godo.ListOptions) ([]Action, error)
-	Resize(string, int, string) (*Action, error)
+	Attach(context.Context, string, int) (*Action, error)
+	Detach(context.Context, string, int) (*Action, error)
+	Get(context.Context, string, int) (*Action, error)
+	List(context.Context, string, *godo.ListOptions) ([]Action, error)
+	Resize(context.Context, string, int, string) (*Action, error)
 }
 
 type volumeActionsService struct {
@@ -37,26 +37,26 @@ func (vas *volumeActionsService) handleActionResponse(a *godo.Action, err error)
 	return &Action{Action: a}, nil
 }
 
-func (vas *volumeActionsService) Attach(volumeID string, dropletID int) (*Action, error) {
-	a, _, err := vas.client.StorageActions.Attach(context.TODO(), volumeID, dropletID)
+func (vas *volumeActionsService) Attach(ctx context.Context, volumeID string, dropletID int) (*Action, error) {
+	a, _, err := vas.client.StorageActions.Attach(ctx, volumeID, dropletID)
 	return vas.handleActionResponse(a, err)
 
 }
 
-func (vas *volumeActionsService) Detach(volumeID string, dropletID int) (*Action, error) {
-	a, _, err := vas.client.StorageActions.DetachByDropletID(context.TODO(), volumeID, dropletID)
+func (vas *volumeActionsService) Detach(ctx context.Context, volumeID string, dropletID int) (*Action, error) {
+	a, _, err := vas.client.StorageActions.DetachByDropletID(ctx, volumeID, dropletID)
 	return vas.handleActionResponse(a, err)
 
 }
 
-func (vas *volumeActionsService) Get(volumeID string, actionID int) (*Action, error) {
-	a, _, err := vas.client.StorageActions.Get(context.TODO(), volumeID, actionID)
+func (vas *volumeActionsService) Get(ctx context.Context, volumeID string, actionID int) (*Action, error) {
+	a, _, err := vas.client.StorageActions.Get(ctx, volumeID, actionID)
 	return vas.handleActionResponse(a, err)
 }
 
-func (vas *volumeActionsService) List(volumeID string, opt *godo.ListOptions) ([]Action, error) {
+func (vas *volumeActionsService) List(ctx context.Context, volumeID string, opt *godo.ListOptions) ([]Action, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := vas.client.StorageActions.List(context.TODO(), volumeID, opt)
+		list, resp, err := vas.client.StorageActions.List(ctx, volumeID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -69,7 +69,7 @@ func (vas *volumeActionsService) List(volumeID string, opt *godo.ListOptions) ([
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +83,7 @@ func (vas *volumeActionsService) List(volumeID string, opt *godo.ListOptions) ([
 	return list, nil
 }
 
-func (vas *volumeActionsService) Resize(volumeID string, sizeGigabytes int, regionSlug string) (*Action, error) {
-	a, _, err := vas.client.StorageActions.Resize(context.TODO(), volumeID, sizeGigabytes, regionSlug)
+func (vas *volumeActionsService) Resize(ctx context.Context, volumeID string, sizeGigabytes int, regionSlug string) (*Action, error) {
+	a, _, err := vas.client.StorageActions.Resize(ctx, volumeID, sizeGigabytes, regionSlug)
 	return vas.handleActionResponse(a, err)
 }
@@ -13,14 +13,14 @@ type Volume struct {
 
 // VolumesService is an interface for interacting with DigitalOcean's volume api.
 type VolumesService interface {
-	List() ([]Volume, error)
-	CreateVolume(*godo.VolumeCreateRequest) (*Volume, error)
-	DeleteVolume(string) error
-	Get(string) (*Volume, error)
-	CreateSnapshot(*godo.SnapshotCreateRequest) (*Snapshot, error)
-	GetSnapshot(string) (*Snapshot, error)
-	DeleteSnapshot(string) error
-	ListSnapshots(string, *godo.ListOptions) ([]Snapshot, error)
+	List(context.Context) ([]Volume, error)
+	CreateVolume(context.Context, *godo.VolumeCreateRequest) (*Volume, error)
+	DeleteVolume(context.Context, string) error
+	Get(context.Context, string) (*Volume, error)
+	CreateSnapshot(context.Context, *godo.SnapshotCreateRequest) (*Snapshot, error)
+	GetSnapshot(context.Context, string) (*Snapshot, error)
+	DeleteSnapshot(context.Context, string) error
+	ListSnapshots(context.Context, string, *godo.ListOptions) ([]Snapshot, error)
 }
 
 type volumesService struct {
@@ -36,10 +36,10 @@ func NewVolumesService(godoClient *godo.Client) VolumesService {
 	}
 }
 
-func (a *volumesService) List() ([]Volume, error) {
+func (a *volumesService) List(ctx context.Context) ([]Volume, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
 		params := &godo.ListVolumeParams{ListOptions: opt}
-		list, resp, err := a.client.Storage.ListVolumes(context.TODO(), params)
+		list, resp, err := a.client.Storage.ListVolumes(ctx, params)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -52,7 +52,7 @@ func (a *volumesService) List() ([]Volume, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -65,21 +65,21 @@ func (a *volumesService) List() ([]Volume, error) {
 	return list, nil
 }
 
-func (a *volumesService) CreateVolume(r *godo.VolumeCreateRequest) (*Volume, error) {
-	al, _, err := a.client.Storage.CreateVolume(context.TODO(), r)
+func (a *volumesService) CreateVolume(ctx context.Context, r *godo.VolumeCreateRequest) (*Volume, error) {
+	al, _, err := a.client.Storage.CreateVolume(ctx, r)
 	if err != nil {
 		return nil, err
 	}
 	return &Volume{Volume: al}, nil
 }
 
-func (a *volumesService) DeleteVolume(id string) error {
-	_, err := a.client.Storage.DeleteVolume(context.TODO(), id)
+func (a *volumesService) DeleteVolume(ctx context.Context, id string) error {
+	_, err := a.client.Storage.DeleteVolume(ctx, id)
 	return err
 }
 
-func (a *volumesService) Get(id string) (*Volume, error) {
-	d, _, err := a.client.Storage.GetVolume(context.TODO(), id)
+func (a *volumesService) Get(ctx context.Context, id string) (*Volume, error) {
+	d, _, err := a.client.Storage.GetVolume(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -87,8 +87,8 @@ func (a *volumesService) Get(id string) (*Volume, error) {
 	return &Volume{Volume: d}, nil
 }
 
-func (a *volumesService) CreateSnapshot(createRequest *godo.SnapshotCreateRequest) (*Snapshot, error) {
-	s, _, err := a.client.Storage.CreateSnapshot(context.TODO(), createRequest)
+func (a *volumesService) CreateSnapshot(ctx context.Context, createRequest *godo.SnapshotCreateRequest) (*Snapshot, error) {
+	s, _, err := a.client.Storage.CreateSnapshot(ctx, createRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -96,8 +96,8 @@ func (a *volumesService) CreateSnapshot(createRequest *godo.SnapshotCreateReques
 	return &Snapshot{Snapshot: s}, nil
 }
 
-func (a *volumesService) GetSnapshot(snapshotID string) (*Snapshot, error) {
-	s, _, err := a.client.Storage.GetSnapshot(context.TODO(), snapshotID)
+func (a *volumesService) GetSnapshot(ctx context.Context, snapshotID string) (*Snapshot, error) {
+	s, _, err := a.client.Storage.GetSnapshot(ctx, snapshotID)
 	if err != nil {
 		return nil, err
 	}
@@ -105,14 +105,14 @@ func (a *volumesService) GetSnapshot(snapshotID string) (*Snapshot, error) {
 	return &Snapshot{Snapshot: s}, nil
 }
 
-func (a *volumesService) DeleteSnapshot(snapshotID string) error {
-	_, err := a.client.Storage.DeleteSnapshot(context.TODO(), snapshotID)
+func (a *volumesService) DeleteSnapshot(ctx context.Context, snapshotID string) error {
+	_, err := a.client.Storage.DeleteSnapshot(ctx, snapshotID)
 	return err
 }
 
-func (a *volumesService) ListSnapshots(volumeID string, opt *godo.ListOptions) ([]Snapshot, error) {
+func (a *volumesService) ListSnapshots(ctx context.Context, volumeID string, opt *godo.ListOptions) ([]Snapshot, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := a.client.Storage.ListSnapshots(context.TODO(), volumeID, opt)
+		list, resp, err := a.client.Storage.ListSnapshots(ctx, volumeID, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -125,7 +125,7 @@ func (a *volumesService) ListSnapshots(volumeID string, opt *godo.ListOptions) (
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
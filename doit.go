@@ -19,7 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"io"
 	"net/http"
 	"os"
 	"regexp"
@@ -166,40 +166,88 @@ type Config interface {
 
 // LiveConfig is an implementation of Config for live values.
 type LiveConfig struct {
+	// Viper holds the configuration values. The global viper instance is
+	// used when it is nil.
+	Viper *viper.Viper
+	// Args are the command line arguments IsSet looks for flags in. os.Args
+	// is used when they are nil.
+	Args []string
+
 	cliArgs map[string]bool
 }
 
 var _ Config = &LiveConfig{}
 
+func (c *LiveConfig) settings() *viper.Viper {
+	if c.Viper != nil {
+		return c.Viper
+	}
+	return viper.GetViper()
+}
+
 // GetGodoClient returns a GodoClient.
 func (c *LiveConfig) GetGodoClient(trace bool, accessToken string) (*godo.Client, error) {
+	recordPath, replayPath := c.settings().GetString(ArgRecord), c.settings().GetString(ArgReplay)
+	if recordPath != "" && replayPath != "" {
+		return nil, fmt.Errorf("--%s and --%s cannot be used together", ArgRecord, ArgReplay)
+	}
+
 	if accessToken == "" {
-		return nil, fmt.Errorf("access token is required. (hint: run 'doctl auth init')")
+		if replayPath == "" {
+			return nil, fmt.Errorf("access token is required. (hint: run 'doctl auth init')")
+		}
+
+		// Replayed requests never reach the API, so no real token is needed.
+		accessToken = "replay"
 	}
 
 	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
 	oauthClient := oauth2.NewClient(context.Background(), tokenSource)
 
-	if trace {
-		r := newRecorder(oauthClient.Transport)
-
-		go func() {
-			for {
-				select {
-				case msg := <-r.req:
-					log.Println("->", strconv.Quote(msg))
-				case msg := <-r.resp:
-					log.Println("<-", strconv.Quote(msg))
-				}
-			}
-		}()
+	// Recording, replaying and tracing happen below the oauth2 transport, so
+	// they see the (redacted) Authorization header.
+	var base http.RoundTripper = http.DefaultTransport
+	switch {
+	case recordPath != "":
+		base = newCassetteRecorder(base, recordPath, accessToken)
+	case replayPath != "":
+		player, err := newCassettePlayer(replayPath)
+		if err != nil {
+			return nil, err
+		}
+		base = player
+	}
+
+	if c.settings().GetBool(ArgDryRun) {
+		base = newDryRunTransport(base, os.Stdout, c.settings().GetString("output"))
+	}
+
+	if harPath := c.settings().GetString(ArgTraceFile); trace || harPath != "" {
+		var log io.Writer
+		if trace {
+			log = os.Stderr
+		}
+
+		base = newTracer(base, log, harPath, accessToken)
+	}
+
+	if base != http.DefaultTransport {
+		oauthClient.Transport = &oauth2.Transport{Source: tokenSource, Base: base}
+	}
 
-		oauthClient.Transport = r
+	maxRetries := DefaultHTTPRetryMax
+	if c.settings().IsSet(ArgHTTPRetryMax) {
+		maxRetries = c.settings().GetInt(ArgHTTPRetryMax)
+	}
+	if maxRetries > 0 {
+		oauthClient.Transport = newRetryTransport(oauthClient.Transport, maxRetries)
 	}
 
+	oauthClient.Transport = &errorBodyTransport{wrap: oauthClient.Transport}
+
 	args := []godo.ClientOpt{godo.SetUserAgent(userAgent())}
 
-	apiURL := viper.GetString("api-url")
+	apiURL := c.settings().GetString("api-url")
 	if apiURL != "" {
 		args = append(args, godo.SetBaseURL(apiURL))
 	}
@@ -225,11 +273,18 @@ func (c *LiveConfig) SSH(user, host, keyPath string, port int, opts ssh.Options)
 
 // Set sets a config key.
 func (c *LiveConfig) Set(ns, key string, val interface{}) {
-	viper.Set(nskey(ns, key), val)
+	c.settings().Set(nskey(ns, key), val)
+}
+
+func (c *LiveConfig) args() []string {
+	if c.Args != nil {
+		return c.Args
+	}
+	return os.Args
 }
 
 func (c *LiveConfig) IsSet(key string) bool {
-	matches := regexp.MustCompile("\b*--([a-z-_]+)").FindAllStringSubmatch(strings.Join(os.Args, " "), -1)
+	matches := regexp.MustCompile("\b*--([a-z-_]+)").FindAllStringSubmatch(strings.Join(c.args(), " "), -1)
 	if len(matches) == 0 {
 		return false
 	}
@@ -247,9 +302,9 @@ func (c *LiveConfig) IsSet(key string) bool {
 // GetString returns a config value as a string.
 func (c *LiveConfig) GetString(ns, key string) (string, error) {
 	nskey := nskey(ns, key)
-	str := viper.GetString(nskey)
+	str := c.settings().GetString(nskey)
 
-	if isRequired(nskey) && strings.TrimSpace(str) == "" {
+	if c.isRequired(nskey) && strings.TrimSpace(str) == "" {
 		return "", NewMissingArgsErr(nskey)
 	}
 	return str, nil
@@ -257,7 +312,7 @@ func (c *LiveConfig) GetString(ns, key string) (string, error) {
 
 // GetBool returns a config value as a bool.
 func (c *LiveConfig) GetBool(ns, key string) (bool, error) {
-	return viper.GetBool(nskey(ns, key)), nil
+	return c.settings().GetBool(nskey(ns, key)), nil
 }
 
 // GetBoolPtr returns a config value as a bool pointer.
@@ -265,16 +320,16 @@ func (c *LiveConfig) GetBoolPtr(ns, key string) (*bool, error) {
 	if !c.IsSet(key) {
 		return nil, nil
 	}
-	val := viper.GetBool(nskey(ns, key))
+	val := c.settings().GetBool(nskey(ns, key))
 	return &val, nil
 }
 
 // GetInt returns a config value as an int.
 func (c *LiveConfig) GetInt(ns, key string) (int, error) {
 	nskey := nskey(ns, key)
-	val := viper.GetInt(nskey)
+	val := c.settings().GetInt(nskey)
 
-	if isRequired(nskey) && val == 0 {
+	if c.isRequired(nskey) && val == 0 {
 		return 0, NewMissingArgsErr(nskey)
 	}
 	return val, nil
@@ -285,26 +340,26 @@ func (c *LiveConfig) GetIntPtr(ns, key string) (*int, error) {
 	nskey := nskey(ns, key)
 
 	if !c.IsSet(key) {
-		if isRequired(nskey) {
+		if c.isRequired(nskey) {
 			return nil, NewMissingArgsErr(nskey)
 		}
 		return nil, nil
 	}
-	val := viper.GetInt(nskey)
+	val := c.settings().GetInt(nskey)
 	return &val, nil
 }
 
 // GetStringSlice returns a config value as a string slice.
 func (c *LiveConfig) GetStringSlice(ns, key string) ([]string, error) {
 	nskey := nskey(ns, key)
-	val := viper.GetStringSlice(nskey)
+	val := c.settings().GetStringSlice(nskey)
 
-	if isRequired(nskey) && emptyStringSlice(val) {
+	if c.isRequired(nskey) && emptyStringSlice(val) {
 		return nil, NewMissingArgsErr(nskey)
 	}
 
 	out := []string{}
-	for _, item := range viper.GetStringSlice(nskey) {
+	for _, item := range c.settings().GetStringSlice(nskey) {
 		item = strings.TrimPrefix(item, "[")
 		item = strings.TrimSuffix(item, "]")
 
@@ -323,8 +378,8 @@ func nskey(ns, key string) string {
 	return fmt.Sprintf("%s.%s", ns, key)
 }
 
-func isRequired(key string) bool {
-	return viper.GetBool(fmt.Sprintf("required.%s", key))
+func (c *LiveConfig) isRequired(key string) bool {
+	return c.settings().GetBool(fmt.Sprintf("required.%s", key))
 }
 
 // TestConfig is an implementation of Config for testing.
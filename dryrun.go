@@ -0,0 +1,219 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// dryRunBody is returned for every intercepted request. It holds an empty
+// object for each resource the API returns from a mutation, so the
+// response decodes whichever resource the client expects. Statuses are the
+// final ones, so waiting on a dry run resource ends immediately.
+var dryRunBody = []byte(`{
+  "action": {"id": 0, "status": "completed", "type": "dry_run", "resource_type": "dry_run"},
+  "actions": [],
+  "certificate": {"state": "verified"},
+  "database": {"status": "online"},
+  "db": {},
+  "domain": {},
+  "domain_record": {},
+  "droplet": {"status": "active"},
+  "droplets": [],
+  "endpoint": {},
+  "firewall": {"status": "succeeded"},
+  "floating_ip": {},
+  "image": {"status": "available"},
+  "kubernetes_cluster": {"status": {"state": "running"}},
+  "load_balancer": {"status": "active"},
+  "node_pool": {},
+  "pool": {},
+  "project": {},
+  "registry": {},
+  "replica": {"status": "online"},
+  "resources": [],
+  "snapshot": {},
+  "ssh_key": {},
+  "tag": {},
+  "user": {},
+  "volume": {},
+  "links": {},
+  "meta": {"total": 0}
+}`)
+
+type dryRunRecordKey struct{}
+
+// dryRunRecord records the requests a dry run intercepted, which are printed
+// to out unless it is nil.
+type dryRunRecord struct {
+	intercepted int32
+	out         io.Writer
+}
+
+// WithDryRunRecord returns a copy of ctx recording whether a dry run
+// intercepted any of the requests made with it. Intercepted requests are
+// printed to out, when not nil, so that they go where the output of the
+// command making them goes.
+func WithDryRunRecord(ctx context.Context, out io.Writer) context.Context {
+	return context.WithValue(ctx, dryRunRecordKey{}, &dryRunRecord{out: out})
+}
+
+// DryRunIntercepted reports whether a dry run intercepted a request made
+// with ctx, set up by WithDryRunRecord. Responses to intercepted requests are
+// synthetic.
+func DryRunIntercepted(ctx context.Context) bool {
+	r, ok := ctx.Value(dryRunRecordKey{}).(*dryRunRecord)
+	return ok && atomic.LoadInt32(&r.intercepted) > 0
+}
+
+func recordDryRun(ctx context.Context) {
+	if r, ok := ctx.Value(dryRunRecordKey{}).(*dryRunRecord); ok {
+		atomic.AddInt32(&r.intercepted, 1)
+	}
+}
+
+// dryRunRequest is an intercepted request, as written in JSON output.
+type dryRunRequest struct {
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// dryRunTransport sends read-only requests, so names still resolve, and
+// prints every other request instead of sending it, answering with a
+// synthetic success. Requests are printed to out, unless their context was
+// set up by WithDryRunRecord with another writer.
+type dryRunTransport struct {
+	wrap   http.RoundTripper
+	out    io.Writer
+	asJSON bool
+
+	mu sync.Mutex
+}
+
+func newDryRunTransport(transport http.RoundTripper, out io.Writer, output string) *dryRunTransport {
+	return &dryRunTransport{
+		wrap:   transport,
+		out:    out,
+		asJSON: output == "json",
+	}
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		if dryRunResource(req.URL.Path) {
+			recordDryRun(req.Context())
+			return dryRunResponse(req, http.StatusOK), nil
+		}
+
+		return t.wrap.RoundTrip(req)
+	}
+
+	body, err := readAndRestore(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request: %v", err)
+	}
+	if err := t.print(req, body); err != nil {
+		return nil, err
+	}
+	recordDryRun(req.Context())
+
+	if req.Method == http.MethodDelete {
+		return dryRunResponse(req, http.StatusNoContent), nil
+	}
+
+	return dryRunResponse(req, http.StatusOK), nil
+}
+
+// dryRunOutput returns where the requests intercepted with ctx are printed.
+func (t *dryRunTransport) dryRunOutput(ctx context.Context) io.Writer {
+	if r, ok := ctx.Value(dryRunRecordKey{}).(*dryRunRecord); ok && r.out != nil {
+		return r.out
+	}
+	return t.out
+}
+
+func (t *dryRunTransport) print(req *http.Request, body []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	body = bytes.TrimSpace(body)
+	if !json.Valid(body) {
+		body = nil
+	}
+	out := t.dryRunOutput(req.Context())
+
+	if t.asJSON {
+		b, err := json.Marshal(dryRunRequest{Method: req.Method, URL: req.URL.String(), Body: body})
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out, "%s\n", b)
+		return err
+	}
+
+	fmt.Fprintf(out, "%s %s\n", req.Method, req.URL)
+	if len(body) > 0 {
+		var indented bytes.Buffer
+		json.Indent(&indented, body, "", "  ")
+		fmt.Fprintf(out, "%s\n", indented.Bytes())
+	}
+
+	return nil
+}
+
+// dryRunResource reports whether a path refers to a resource a dry run
+// pretended to create, which is the only way to end up with an empty ID in
+// a path.
+func dryRunResource(path string) bool {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for _, s := range segments[1:] {
+		if s == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func dryRunResponse(req *http.Request, status int) *http.Response {
+	resp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode: status,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		Request:    req,
+	}
+
+	if status != http.StatusNoContent {
+		resp.Header.Set("Content-Type", "application/json")
+		resp.Body = ioutil.NopCloser(bytes.NewReader(dryRunBody))
+		resp.ContentLength = int64(len(dryRunBody))
+	}
+
+	return resp
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunTransport(t *testing.T) {
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"droplets":[{"id":1,"name":"web-01"}],"meta":{"total":1}}`))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	client, err := godo.New(&http.Client{Transport: newDryRunTransport(http.DefaultTransport, &out, "text")}, godo.SetBaseURL(server.URL))
+	require.NoError(t, err)
+	ctx := WithDryRunRecord(context.Background(), nil)
+
+	droplets, _, err := client.Droplets.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, droplets, 1, "read-only requests should reach the API")
+	assert.False(t, DryRunIntercepted(ctx))
+
+	d, _, err := client.Droplets.Create(ctx, &godo.DropletCreateRequest{Name: "web-02"})
+	require.NoError(t, err)
+	assert.Equal(t, "active", d.Status)
+	assert.True(t, DryRunIntercepted(ctx))
+
+	action, _, err := client.DropletActions.PowerOff(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, godo.ActionCompleted, action.Status)
+
+	cluster, _, err := client.Kubernetes.Create(ctx, &godo.KubernetesClusterCreateRequest{Name: "prod"})
+	require.NoError(t, err)
+	cluster, _, err = client.Kubernetes.Get(ctx, cluster.ID)
+	require.NoError(t, err, "dry run resources should be answered without the API")
+	assert.Equal(t, godo.KubernetesClusterStatusRunning, cluster.Status.State)
+
+	_, err = client.Droplets.DeleteByTag(ctx, "web")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GET /v2/droplets"}, sent)
+	assert.Equal(t, `POST `+server.URL+`/v2/droplets
+{
+  "name": "web-02",
+  "region": "",
+  "size": "",
+  "image": 0,
+  "ssh_keys": null,
+  "backups": false,
+  "ipv6": false,
+  "private_networking": false,
+  "monitoring": false,
+  "tags": null
+}
+POST `+server.URL+`/v2/droplets/1/actions
+{
+  "type": "power_off"
+}
+POST `+server.URL+`/v2/kubernetes/clusters
+{
+  "name": "prod",
+  "maintenance_policy": null,
+  "auto_upgrade": false
+}
+DELETE `+server.URL+`/v2/droplets?tag_name=web
+`, out.String())
+}
+
+func TestDryRunTransportJSON(t *testing.T) {
+	var out bytes.Buffer
+	client, err := godo.New(&http.Client{Transport: newDryRunTransport(http.DefaultTransport, &out, "json")}, godo.SetBaseURL("http://api.invalid"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, _, err = client.Tags.Create(ctx, &godo.TagCreateRequest{Name: "web"})
+	require.NoError(t, err)
+	_, err = client.Tags.Delete(ctx, "web")
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"method":"POST","url":"http://api.invalid/v2/tags","body":{"name":"web"}}
+{"method":"DELETE","url":"http://api.invalid/v2/tags/web"}
+`, out.String())
+}
+
+func TestDryRunRecordOutput(t *testing.T) {
+	var out, cmdOut bytes.Buffer
+	client, err := godo.New(&http.Client{Transport: newDryRunTransport(http.DefaultTransport, &out, "text")}, godo.SetBaseURL("http://api.invalid"))
+	require.NoError(t, err)
+	ctx := WithDryRunRecord(context.Background(), &cmdOut)
+
+	_, err = client.Tags.Delete(ctx, "web")
+	require.NoError(t, err)
+	assert.True(t, DryRunIntercepted(ctx))
+
+	assert.Empty(t, out.String())
+	assert.Equal(t, "DELETE http://api.invalid/v2/tags/web\n", cmdOut.String())
+}
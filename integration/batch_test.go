@@ -0,0 +1,126 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl/pkg/fakeapi"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+const batchCommands = `# a web droplet
+compute tag create web
+doctl compute droplet create web-01 --region nyc3 --size s-1vcpu-1gb --image ubuntu-18-04-x64 --tag-name web
+
+compute droplet list --tag-name web --format Name --no-header
+compute droplet get 999
+compute tag list --format Name
+`
+
+var _ = suite("batch", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		api := fakeapi.New()
+		api.ActionDuration = 0
+		server = httptest.NewServer(api)
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	batch := func(stdin string, args ...string) (string, string, error) {
+		cmd := exec.Command(builtBinaryPath, append([]string{"-t", "some-magic-token", "-u", server.URL, "batch"}, args...)...)
+		cmd.Stdin = strings.NewReader(stdin)
+
+		var stdout, stderr strings.Builder
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		err := cmd.Run()
+
+		return stdout.String(), stderr.String(), err
+	}
+
+	it("stops at the first failing command", func() {
+		stdout, stderr, err := batch(batchCommands)
+		expect.Error(err)
+		expect.Equal(4, err.(*exec.ExitError).ExitCode())
+
+		expect.Regexp(`^Name\s+Droplet Count\nweb\s+0\nID\s+Name`, stdout)
+		expect.True(strings.HasSuffix(stdout, "\nweb-01\n"), stdout)
+
+		expect.Contains(stderr, "Notice: line 2: compute tag create web")
+		expect.Contains(stderr, "Error: line 6: compute droplet get 999: ")
+		expect.Contains(stderr, "Warning: line 7 skipped: compute tag list --format Name")
+		expect.Contains(stderr, "Error: 1 of 5 commands failed")
+	})
+
+	it("prints the results as JSON", func() {
+		stdout, _, err := batch(batchCommands, "--continue-on-error", "--parallel", "2", "--output", "json")
+		expect.Error(err)
+
+		var results []struct {
+			Line     int             `json:"line"`
+			Status   string          `json:"status"`
+			ExitCode int             `json:"exit_code"`
+			Output   json.RawMessage `json:"output"`
+			Error    string          `json:"error"`
+		}
+		expect.NoError(json.Unmarshal([]byte(stdout), &results), stdout)
+		expect.Len(results, 5)
+
+		for i, line := range []int{2, 3, 5, 6, 7} {
+			expect.Equal(line, results[i].Line)
+		}
+		expect.Equal("ok", results[0].Status)
+		expect.Equal("failed", results[3].Status)
+		expect.Equal(4, results[3].ExitCode)
+		expect.Contains(results[3].Error, "404")
+		expect.Equal("ok", results[4].Status)
+		expect.True(json.Valid(results[4].Output))
+	})
+
+	it("prints the requests of a dry run in the results", func() {
+		stdout, _, err := batch("compute tag create web\ncompute tag create db\n", "--dry-run", "--parallel", "2", "--output", "json")
+		expect.NoError(err)
+
+		var results []struct {
+			Status string `json:"status"`
+			Output struct {
+				Method string `json:"method"`
+				URL    string `json:"url"`
+				Body   struct {
+					Name string `json:"name"`
+				} `json:"body"`
+			} `json:"output"`
+		}
+		expect.NoError(json.Unmarshal([]byte(stdout), &results), stdout)
+		expect.Len(results, 2)
+
+		for i, name := range []string{"web", "db"} {
+			expect.Equal("ok", results[i].Status)
+			expect.Equal("POST", results[i].Output.Method)
+			expect.Equal(server.URL+"/v2/tags", results[i].Output.URL)
+			expect.Equal(name, results[i].Output.Body.Name)
+		}
+	})
+
+	it("runs nothing when a line is invalid", func() {
+		stdout, stderr, err := batch("compute tag create web\ncompute tag list -o json\n")
+		expect.Error(err)
+		expect.Equal(2, err.(*exec.ExitError).ExitCode())
+		expect.Empty(stdout)
+		expect.Contains(stderr, "Warning: line 1 skipped: compute tag create web")
+		expect.Contains(stderr, "Error: line 2: compute tag list -o json: global flags apply to the whole batch, pass --output to batch itself")
+	})
+})
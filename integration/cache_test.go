@@ -0,0 +1,143 @@
+package integration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("cache", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect   *require.Assertions
+		server   *httptest.Server
+		dir      string
+		requests int
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+		requests = 0
+
+		var err error
+		dir, err = ioutil.TempDir("", "doctl-cache")
+		expect.NoError(err)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/v2/regions":
+				auth := req.Header.Get("Authorization")
+				if auth != "Bearer some-magic-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				requests++
+				w.Write([]byte(regionListResponse))
+			default:
+				dump, err := httputil.DumpRequest(req, true)
+				if err != nil {
+					t.Fatal("failed to dump request")
+				}
+
+				t.Fatalf("received unknown request: %s", dump)
+			}
+		}))
+	})
+
+	it.After(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	doctl := func(env []string, args ...string) *exec.Cmd {
+		cmd := exec.Command(builtBinaryPath, append([]string{"-t", "some-magic-token", "-u", server.URL}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"HOME="+filepath.Join(dir, "home"),
+			"XDG_CONFIG_HOME="+filepath.Join(dir, "config"),
+			"DIGITALOCEAN_HTTP_RETRY_MAX=0",
+		)
+		cmd.Env = append(cmd.Env, env...)
+		return cmd
+	}
+
+	when("listing regions again", func() {
+		it("uses the cached regions", func() {
+			for i := 0; i < 2; i++ {
+				output, err := doctl(nil, "compute", "region", "list").CombinedOutput()
+				expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+				expect.Equal(strings.TrimSpace(regionListOutput), strings.TrimSpace(string(output)))
+			}
+			expect.Equal(1, requests)
+		})
+	})
+
+	when("listing regions with --limit", func() {
+		it("caches all of them", func() {
+			output, err := doctl(nil, "compute", "region", "list", "--limit", "1").CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(regionListLimitOutput), strings.TrimSpace(string(output)))
+
+			output, err = doctl(nil, "compute", "region", "list").CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(regionListOutput), strings.TrimSpace(string(output)))
+			expect.Equal(1, requests)
+		})
+	})
+
+	when("--no-cache is passed", func() {
+		it("lists the regions from the API", func() {
+			for i := 0; i < 2; i++ {
+				output, err := doctl(nil, "--no-cache", "compute", "region", "list").CombinedOutput()
+				expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+				expect.Equal(strings.TrimSpace(regionListOutput), strings.TrimSpace(string(output)))
+			}
+			expect.Equal(2, requests)
+		})
+	})
+
+	when("the cache is cleared", func() {
+		it("lists the regions from the API", func() {
+			output, err := doctl(nil, "compute", "region", "list").CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+
+			output, err = doctl(nil, "cache", "clear").CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+
+			output, err = doctl(nil, "compute", "region", "list").CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(2, requests)
+		})
+	})
+
+	when("the API can't be reached", func() {
+		it("uses the stale regions with a warning", func() {
+			output, err := doctl(nil, "compute", "region", "list").CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+
+			server.Close()
+
+			cmd := doctl([]string{"DIGITALOCEAN_CACHE_TTL=1ns"}, "compute", "region", "list")
+			var stdout, stderr strings.Builder
+			cmd.Stdout, cmd.Stderr = &stdout, &stderr
+			expect.NoError(cmd.Run(), stderr.String())
+			expect.Equal(strings.TrimSpace(regionListOutput), strings.TrimSpace(stdout.String()))
+			expect.Contains(stderr.String(), "Warning: using regions cached ")
+			expect.Contains(stderr.String(), " ago, the API couldn't be reached")
+		})
+	})
+})
+
+const regionListLimitOutput = `
+Slug    Name          Available
+nyc1    New York 1    true
+`
@@ -0,0 +1,112 @@
+package integration
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("cassette", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+		dir    string
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		var err error
+		dir, err = ioutil.TempDir("", "doctl-cassette")
+		expect.NoError(err)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/v2/account":
+				w.Header().Add("content-type", "application/json")
+				w.Write([]byte(accountGetResponse))
+			default:
+				dump, err := httputil.DumpRequest(req, true)
+				if err != nil {
+					t.Fatal("failed to dump request")
+				}
+
+				t.Fatalf("received unknown request: %s", dump)
+			}
+		}))
+	})
+
+	it.After(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	it("replays a recorded session without the API", func() {
+		cassette := filepath.Join(dir, "cassette.yaml")
+
+		cmd := exec.Command(builtBinaryPath,
+			"-t", "some-magic-token",
+			"-u", server.URL,
+			"--record", cassette,
+			"account",
+			"get",
+		)
+
+		recorded, err := cmd.CombinedOutput()
+		expect.NoError(err, string(recorded))
+
+		b, err := ioutil.ReadFile(cassette)
+		expect.NoError(err)
+		expect.NotContains(string(b), "some-magic-token")
+
+		server.Close()
+
+		cmd = exec.Command(builtBinaryPath,
+			"-u", server.URL,
+			"--replay", cassette,
+			"account",
+			"get",
+		)
+
+		replayed, err := cmd.CombinedOutput()
+		expect.NoError(err, string(replayed))
+		expect.Equal(strings.TrimSpace(accountOutput), strings.TrimSpace(string(replayed)))
+	})
+
+	it("fails with a diff when a request was not recorded", func() {
+		cassette := filepath.Join(dir, "cassette.yaml")
+		expect.NoError(ioutil.WriteFile(cassette, []byte(cassetteMissing), 0600))
+
+		cmd := exec.Command(builtBinaryPath,
+			"-u", server.URL,
+			"--replay", cassette,
+			"compute",
+			"droplet",
+			"get",
+			"1",
+		)
+
+		output, err := cmd.CombinedOutput()
+		expect.Error(err)
+		expect.Contains(string(output), "no interaction in cassette "+cassette+" matches GET /v2/droplets/1")
+		expect.Contains(string(output), "- path: /v2/droplets/2\n+ path: /v2/droplets/1")
+	})
+})
+
+const cassetteMissing = `interactions:
+- request:
+    method: GET
+    path: /v2/droplets/2
+  response:
+    status: 404
+    body: '{"id":"not_found","message":"not found"}'
+`
@@ -23,7 +23,7 @@ var _ = suite("compute/cdn/delete", func(t *testing.T, when spec.G, it spec.S) {
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/cdn/endpoints/my-cdn-id":
+			case "/v2/cdn/endpoints/00001000-2000-4000-8000-000000000000":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -55,7 +55,7 @@ var _ = suite("compute/cdn/delete", func(t *testing.T, when spec.G, it spec.S) {
 				"compute",
 				"cdn",
 				"delete",
-				"my-cdn-id",
+				"00001000-2000-4000-8000-000000000000",
 				"--force",
 			)
 
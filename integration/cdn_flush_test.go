@@ -25,7 +25,7 @@ var _ = suite("compute/cdn/flush", func(t *testing.T, when spec.G, it spec.S) {
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/cdn/endpoints/some-cdn-id/cache":
+			case "/v2/cdn/endpoints/00001001-2001-4001-8001-000000000001/cache":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -68,7 +68,7 @@ var _ = suite("compute/cdn/flush", func(t *testing.T, when spec.G, it spec.S) {
 				"compute",
 				"cdn",
 				"flush",
-				"some-cdn-id",
+				"00001001-2001-4001-8001-000000000001",
 			)
 
 			output, err := cmd.CombinedOutput()
@@ -24,7 +24,7 @@ var _ = suite("compute/cdn/get", func(t *testing.T, when spec.G, it spec.S) {
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/cdn/endpoints/other-cdn-id":
+			case "/v2/cdn/endpoints/00001002-2002-4002-8002-000000000002":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -56,7 +56,7 @@ var _ = suite("compute/cdn/get", func(t *testing.T, when spec.G, it spec.S) {
 				"compute",
 				"cdn",
 				"get",
-				"other-cdn-id",
+				"00001002-2002-4002-8002-000000000002",
 			)
 
 			output, err := cmd.CombinedOutput()
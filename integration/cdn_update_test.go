@@ -26,7 +26,7 @@ var _ = suite("compute/cdn/update", func(t *testing.T, when spec.G, it spec.S) {
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/cdn/endpoints/magic-cdn-id":
+			case "/v2/cdn/endpoints/00001003-2003-4003-8003-000000000003":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -63,7 +63,7 @@ var _ = suite("compute/cdn/update", func(t *testing.T, when spec.G, it spec.S) {
 				"compute",
 				"cdn",
 				"update",
-				"magic-cdn-id",
+				"00001003-2003-4003-8003-000000000003",
 				"--certificate-id", "some-cert-id",
 				"--domain", "example.com",
 				"--ttl", "60",
@@ -16,7 +16,7 @@ var _ = suite("compute/certificate/delete", func(t *testing.T, when spec.G, it s
 	var (
 		expect   *require.Assertions
 		cmd      *exec.Cmd
-		baseArgs = []string{"some-cert-id", "--force"}
+		baseArgs = []string{"00001004-2004-4004-8004-000000000004", "--force"}
 	)
 
 	it.Before(func() {
@@ -24,7 +24,7 @@ var _ = suite("compute/certificate/delete", func(t *testing.T, when spec.G, it s
 
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/certificates/some-cert-id":
+			case "/v2/certificates/00001004-2004-4004-8004-000000000004":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
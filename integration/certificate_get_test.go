@@ -17,7 +17,7 @@ var _ = suite("compute/certificate/get", func(t *testing.T, when spec.G, it spec
 	var (
 		expect   *require.Assertions
 		cmd      *exec.Cmd
-		baseArgs = []string{"find-cert-id"}
+		baseArgs = []string{"00001005-2005-4005-8005-000000000005"}
 	)
 
 	it.Before(func() {
@@ -25,7 +25,7 @@ var _ = suite("compute/certificate/get", func(t *testing.T, when spec.G, it spec
 
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/certificates/find-cert-id":
+			case "/v2/certificates/00001005-2005-4005-8005-000000000005":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -0,0 +1,107 @@
+package integration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("completion", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect   *require.Assertions
+		server   *httptest.Server
+		dir      string
+		requests int
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+		requests = 0
+
+		var err error
+		dir, err = ioutil.TempDir("", "doctl-completion")
+		expect.NoError(err)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/v2/droplets":
+				auth := req.Header.Get("Authorization")
+				if auth != "Bearer some-magic-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				requests++
+				w.Write([]byte(`{"droplets": [{"id": 1111, "name": "some-droplet-name"}, {"id": 2222, "name": "another-droplet-name"}]}`))
+			default:
+				dump, err := httputil.DumpRequest(req, true)
+				if err != nil {
+					t.Fatal("failed to dump request")
+				}
+
+				t.Fatalf("received unknown request: %s", dump)
+			}
+		}))
+	})
+
+	it.After(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	doctl := func(args ...string) *exec.Cmd {
+		cmd := exec.Command(builtBinaryPath, args...)
+		cmd.Env = append(os.Environ(),
+			"HOME="+filepath.Join(dir, "home"),
+			"XDG_CACHE_HOME="+filepath.Join(dir, "cache"),
+			"XDG_CONFIG_HOME="+filepath.Join(dir, "config"),
+		)
+		return cmd
+	}
+
+	when("completing a droplet", func() {
+		it("lists the droplets once and caches them", func() {
+			for i := 0; i < 2; i++ {
+				output, err := doctl("__complete", "-t", "some-magic-token", "-u", server.URL, "compute", "ssh", "some").CombinedOutput()
+				expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+				expect.Equal("some-droplet-name\t1111\n:4\n", string(output))
+			}
+
+			expect.Equal(1, requests)
+		})
+	})
+
+	when("completing a flag", func() {
+		it("lists the flags of the command", func() {
+			output, err := doctl("__complete", "compute", "droplet", "list", "--tag").CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal("--tag-name\tTag name\n:4\n", string(output))
+		})
+	})
+
+	when("generating completion code", func() {
+		it("asks doctl for completions from fish and PowerShell", func() {
+			for _, shell := range []string{"fish", "powershell"} {
+				output, err := doctl("completion", shell).CombinedOutput()
+				expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+				expect.Contains(string(output), "__complete")
+			}
+		})
+
+		it("asks doctl for completions from bash", func() {
+			output, err := doctl("completion", "bash").CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Contains(string(output), `"${words[0]}" __complete`)
+			expect.Contains(string(output), `flags_completion+=("__doctl_complete_dynamic")`)
+		})
+	})
+})
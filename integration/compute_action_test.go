@@ -70,6 +70,14 @@ var _ = suite("compute/action", func(t *testing.T, when spec.G, it spec.S) {
 				}
 
 				w.Write([]byte(computeActionGetResponse))
+			case "/v2/droplets/2222/actions/30303":
+				auth := req.Header.Get("Authorization")
+				if auth != "Bearer some-magic-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				w.Write([]byte(computeActionErroredResponse))
 			default:
 				dump, err := httputil.DumpRequest(req, true)
 				if err != nil {
@@ -131,6 +139,60 @@ var _ = suite("compute/action", func(t *testing.T, when spec.G, it spec.S) {
 			expect.Equal(strings.TrimSpace(computeActionWaitOutput), strings.TrimSpace(string(output)))
 		})
 	})
+
+	when("command is wait with many actions", func() {
+		it("fails when some of them errored", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"action",
+				"wait",
+				"20202",
+				server.URL+"/v2/droplets/2222/actions/30303",
+				"--format", "ID,Status",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Error(err)
+			expect.Equal(strings.TrimSpace(computeActionWaitManyOutput), strings.TrimSpace(string(output)))
+		})
+	})
+
+	when("command is wait with something else than an action", func() {
+		it("fails without waiting", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"action",
+				"wait",
+				"abc",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Error(err)
+			expect.Equal(`Error: "abc" is neither an action ID nor an action HREF`, strings.TrimSpace(string(output)))
+		})
+	})
+
+	when("command is wait in a dry run", func() {
+		it("waits for the action", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"--dry-run",
+				"compute",
+				"action",
+				"wait",
+				"20202",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(computeActionGetOutput), strings.TrimSpace(string(output)))
+		})
+	})
 })
 
 const (
@@ -211,9 +273,30 @@ ID      Status       Type      Started At                       Completed At
 }
 `
 	computeActionWaitOutput = `
+waiting for action 10101: in-progress
 ID       Status       Type      Started At                       Completed At                     Resource ID    Resource Type    Region
 20202    completed    create    2014-11-14 16:29:21 +0000 UTC    2014-11-14 16:30:06 +0000 UTC    2222           droplet          nyc3
 	`
+	computeActionWaitManyOutput = `
+ID       Status
+20202    completed
+30303    errored
+Error: 1 of 2 actions errored: [30303]
+`
+	computeActionErroredResponse = `
+{
+  "action": {
+    "id": 30303,
+    "status": "errored",
+    "type": "resize",
+    "started_at": "2014-11-14T16:29:21Z",
+    "completed_at": "2014-11-14T16:30:06Z",
+    "resource_id": 2222,
+    "resource_type": "droplet",
+    "region_slug": "nyc3"
+  }
+}
+`
 	computeActionWaitInProgressResponse = `
 {
   "action": {
@@ -0,0 +1,119 @@
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("compute/action/watch", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+		lists  int
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+		lists = 0
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/v2/actions":
+				auth := req.Header.Get("Authorization")
+				if auth != "Bearer some-magic-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				lists++
+				switch lists {
+				case 1:
+					w.Write([]byte(`{"actions": [` + computeActionWatchCompleted + `]}`))
+				case 2:
+					w.Write([]byte(`{"actions": [` + fmt.Sprintf(computeActionWatchResize, "in-progress") + `, ` + computeActionWatchCompleted + `]}`))
+				default:
+					w.Write([]byte(`{"actions": [` + fmt.Sprintf(computeActionWatchResize, "completed") + `, ` + computeActionWatchCompleted + `]}`))
+				}
+			default:
+				dump, err := httputil.DumpRequest(req, true)
+				if err != nil {
+					t.Fatal("failed to dump request")
+				}
+
+				t.Fatalf("received unknown request: %s", dump)
+			}
+		}))
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	it("streams actions as they start and complete", func() {
+		cmd := exec.Command(builtBinaryPath,
+			"-t", "some-magic-token",
+			"-u", server.URL,
+			"--timeout", "2500ms",
+			"compute",
+			"action",
+			"watch",
+			"--poll-timeout", "1",
+			"--format", "ID,Status,Type",
+		)
+
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+		expect.Equal(strings.TrimSpace(computeActionWatchOutput), strings.TrimSpace(string(output)))
+	})
+
+	it("filters actions by resource type", func() {
+		cmd := exec.Command(builtBinaryPath,
+			"-t", "some-magic-token",
+			"-u", server.URL,
+			"--timeout", "2500ms",
+			"compute",
+			"action",
+			"watch",
+			"--poll-timeout", "1",
+			"--resource-type", "volume",
+		)
+
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+		expect.Empty(strings.TrimSpace(string(output)))
+	})
+})
+
+const (
+	computeActionWatchCompleted = `
+{
+  "id": 4444,
+  "status": "completed",
+  "type": "create",
+  "resource_id": 3164444,
+  "resource_type": "droplet",
+  "region_slug": "nyc3"
+}`
+	computeActionWatchResize = `
+{
+  "id": 6666,
+  "status": "%s",
+  "type": "resize",
+  "resource_id": 3164444,
+  "resource_type": "droplet",
+  "region_slug": "nyc3"
+}`
+	computeActionWatchOutput = `
+ID      Status         Type
+6666    in-progress    resize
+6666    completed    resize
+`
+)
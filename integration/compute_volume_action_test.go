@@ -25,7 +25,7 @@ var _ = suite("compute/volume-action", func(t *testing.T, when spec.G, it spec.S
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/volumes/1/actions":
+			case "/v2/volumes/00000001-0000-4000-8000-000000000000/actions":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -43,7 +43,7 @@ var _ = suite("compute/volume-action", func(t *testing.T, when spec.G, it spec.S
 				expect.JSONEq(`{"region":"moonbase","size_gigabytes":100,"type":"resize"}`, string(reqBody))
 
 				w.Write([]byte(volumeActionResponse))
-			case "/v2/volumes/22/actions":
+			case "/v2/volumes/00000022-0000-4000-8000-000000000000/actions":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -61,7 +61,7 @@ var _ = suite("compute/volume-action", func(t *testing.T, when spec.G, it spec.S
 				expect.JSONEq(`{"droplet_id":11,"type":"attach"}`, string(reqBody))
 
 				w.Write([]byte(volumeActionResponse))
-			case "/v2/volumes/13/actions":
+			case "/v2/volumes/00000013-0000-4000-8000-000000000000/actions":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -98,7 +98,7 @@ var _ = suite("compute/volume-action", func(t *testing.T, when spec.G, it spec.S
 				"compute",
 				"volume-action",
 				"attach",
-				"22",
+				"00000022-0000-4000-8000-000000000000",
 				"11",
 			)
 
@@ -116,7 +116,7 @@ var _ = suite("compute/volume-action", func(t *testing.T, when spec.G, it spec.S
 				"compute",
 				"volume-action",
 				"detach",
-				"13",
+				"00000013-0000-4000-8000-000000000000",
 				"14",
 			)
 
@@ -134,7 +134,7 @@ var _ = suite("compute/volume-action", func(t *testing.T, when spec.G, it spec.S
 				"compute",
 				"volume-action",
 				"resize",
-				"1",
+				"00000001-0000-4000-8000-000000000000",
 				"--region", "moonbase",
 				"--size", "100",
 			)
@@ -27,7 +27,7 @@ var _ = suite("database/sql-mode/set", func(t *testing.T, when spec.G, it spec.S
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/databases/some-database-id/sql_mode":
+			case "/v2/databases/00001011-2011-4011-8011-000000000011/sql_mode":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusTeapot)
@@ -70,7 +70,7 @@ var _ = suite("database/sql-mode/set", func(t *testing.T, when spec.G, it spec.S
 				"database",
 				"sql-mode",
 				"set",
-				"some-database-id",
+				"00001011-2011-4011-8011-000000000011",
 				"some-sql-mode",
 			)
 
@@ -91,7 +91,7 @@ var _ = suite("database/sql-mode/get", func(t *testing.T, when spec.G, it spec.S
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/databases/some-database-id/sql_mode":
+			case "/v2/databases/00001011-2011-4011-8011-000000000011/sql_mode":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusTeapot)
@@ -134,7 +134,7 @@ var _ = suite("database/sql-mode/get", func(t *testing.T, when spec.G, it spec.S
 				"database",
 				"sql-mode",
 				"get",
-				"some-database-id",
+				"00001011-2011-4011-8011-000000000011",
 			)
 
 			output, err := cmd.CombinedOutput()
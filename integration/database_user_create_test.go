@@ -28,7 +28,7 @@ var _ = suite("database/user/create", func(t *testing.T, when spec.G, it spec.S)
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/databases/some-database-id/users":
+			case "/v2/databases/00001011-2011-4011-8011-000000000011/users":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusTeapot)
@@ -77,7 +77,7 @@ var _ = suite("database/user/create", func(t *testing.T, when spec.G, it spec.S)
 				"database",
 				"user",
 				"create",
-				"some-database-id",
+				"00001011-2011-4011-8011-000000000011",
 				"some-user-name",
 			)
 
@@ -95,7 +95,7 @@ var _ = suite("database/user/create", func(t *testing.T, when spec.G, it spec.S)
 				"database",
 				"user",
 				"create",
-				"some-database-id",
+				"00001011-2011-4011-8011-000000000011",
 				"some-user-name",
 				"--mysql-auth-plugin", "mysql_native_password",
 			)
@@ -24,7 +24,7 @@ var _ = suite("database/user/delete", func(t *testing.T, when spec.G, it spec.S)
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/databases/some-database-id/users/some-user-id":
+			case "/v2/databases/00001011-2011-4011-8011-000000000011/users/some-user-id":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusTeapot)
@@ -55,7 +55,7 @@ var _ = suite("database/user/delete", func(t *testing.T, when spec.G, it spec.S)
 				"database",
 				"user",
 				"delete",
-				"some-database-id",
+				"00001011-2011-4011-8011-000000000011",
 				"some-user-id",
 				"-f",
 			)
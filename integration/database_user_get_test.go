@@ -24,7 +24,7 @@ var _ = suite("database/user/get", func(t *testing.T, when spec.G, it spec.S) {
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/databases/some-database-id/users/some-user-id":
+			case "/v2/databases/00001011-2011-4011-8011-000000000011/users/some-user-id":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusTeapot)
@@ -55,7 +55,7 @@ var _ = suite("database/user/get", func(t *testing.T, when spec.G, it spec.S) {
 				"database",
 				"user",
 				"get",
-				"some-database-id",
+				"00001011-2011-4011-8011-000000000011",
 				"some-user-id",
 			)
 
@@ -73,7 +73,7 @@ var _ = suite("database/user/get", func(t *testing.T, when spec.G, it spec.S) {
 				"database",
 				"user",
 				"get",
-				"some-database-id",
+				"00001011-2011-4011-8011-000000000011",
 				"some-user-id",
 				"--no-header",
 			)
@@ -92,7 +92,7 @@ var _ = suite("database/user/get", func(t *testing.T, when spec.G, it spec.S) {
 				"database",
 				"user",
 				"get",
-				"some-database-id",
+				"00001011-2011-4011-8011-000000000011",
 				"some-user-id",
 				"--format", "Name",
 			)
@@ -112,7 +112,7 @@ var _ = suite("database/user/get", func(t *testing.T, when spec.G, it spec.S) {
 				"database",
 				"user",
 				"get",
-				"some-database-id",
+				"00001011-2011-4011-8011-000000000011",
 				"some-user-id",
 			)
 
@@ -203,7 +203,7 @@ const (
 {"droplet": {"id": 777}, "links": {"actions": [{"id":1, "rel":"create", "href":"poll-for-droplet"}]}}
 `
 	actionCompletedResponse = `
-{"action": "id": 1, "status": "completed"}
+{"action": {"id": 1, "status": "completed"}}
 `
 	dropletCreateOutput = `
 ID      Name                 Public IPv4    Private IPv4    Public IPv6    Memory    VCPUs    Disk    Region              Image                          Status    Tags    Features    Volumes
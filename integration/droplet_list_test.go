@@ -37,6 +37,21 @@ var _ = suite("compute/droplet/list", func(t *testing.T, when spec.G, it spec.S)
 				}
 
 				q := req.URL.Query()
+				if q.Get("per_page") == "1" {
+					if q.Get("page") != "1" {
+						t.Fatalf("fetched page %s beyond the limit", q.Get("page"))
+					}
+
+					w.Write([]byte(dropletListPagedResponse))
+					return
+				}
+
+				if q.Get("per_page") == "2" {
+					page := q.Get("page")
+					w.Write([]byte(fmt.Sprintf(dropletListPageResponse, page, page)))
+					return
+				}
+
 				tag := q.Get("tag_name")
 				if tag == "some-tag" {
 					w.Write([]byte(`{}`))
@@ -94,6 +109,197 @@ var _ = suite("compute/droplet/list", func(t *testing.T, when spec.G, it spec.S)
 		})
 	})
 
+	when("yaml output is requested", func() {
+		it("lists droplets as yaml", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"-o", "yaml",
+				"compute",
+				"droplet",
+				"list",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(dropletListYAMLOutput), strings.TrimSpace(string(output)))
+		})
+
+		it("lists an empty list of droplets as an empty yaml list", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"-o", "yaml",
+				"compute",
+				"droplet",
+				"list",
+				"--tag-name", "some-tag",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal("[]", strings.TrimSpace(string(output)))
+		})
+	})
+
+	when("csv output is requested", func() {
+		it("lists the selected columns as csv", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"-o", "csv",
+				"compute",
+				"droplet",
+				"list",
+				"--format", "ID,Name,Image,Tags",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(dropletListCSVOutput), strings.TrimSpace(string(output)))
+		})
+	})
+
+	when("tsv output is requested without headers", func() {
+		it("lists droplets as tab separated values", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"-o", "tsv",
+				"compute",
+				"droplet",
+				"list",
+				"--format", "ID,Region,Status",
+				"--no-header",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal("1111\tsome-region-slug\tactive", strings.TrimSpace(string(output)))
+		})
+	})
+
+	when("a template is provided", func() {
+		it("renders the template for each droplet", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"--template", `{{.ID}} {{upper .Name}} {{join ";" .Tags}} {{first "public" . | default "none"}}`,
+				"compute",
+				"droplet",
+				"list",
+				"--tag-name", "regions",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal("1111  yes none\n1440  yes none", strings.TrimSpace(string(output)))
+		})
+	})
+
+	when("a filter and sort order are provided", func() {
+		it("filters and sorts the droplets client side", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"droplet",
+				"list",
+				"--tag-name", "regions",
+				"--filter", "status=active,region~*region*",
+				"--sort-by", "-id",
+				"--format", "ID,Region",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(dropletListFilterOutput), strings.TrimSpace(string(output)))
+		})
+
+		it("rejects unknown keys", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"droplet",
+				"list",
+				"--filter", "colour=red",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Error(err)
+			expect.Contains(string(output), `unknown key "colour"`)
+		})
+	})
+
+	when("a query is provided", func() {
+		it("prints the matching values raw", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"--query", "[].region.slug",
+				"compute",
+				"droplet",
+				"list",
+				"--tag-name", "regions",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal("not-regions\nmy-region", strings.TrimSpace(string(output)))
+		})
+
+		it("reports invalid expressions", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"--query", "[].region.",
+				"compute",
+				"droplet",
+				"list",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Error(err)
+			expect.Contains(string(output), "Error: invalid query: SyntaxError")
+		})
+	})
+
+	when("a limit is provided", func() {
+		it("stops fetching once the limit is reached", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"droplet",
+				"list",
+				"--limit", "1",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(dropletListOutput), strings.TrimSpace(string(output)))
+		})
+	})
+
+	when("a page size is provided", func() {
+		it("fetches pages of that size", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"droplet",
+				"list",
+				"--per-page", "2",
+				"--format", "ID,Name",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(dropletListPagesOutput), strings.TrimSpace(string(output)))
+		})
+	})
+
 	when("there are no droplets", func() {
 		it("lists only headers", func() {
 			cmd := exec.Command(builtBinaryPath,
@@ -132,6 +338,52 @@ const (
   }]
 }`
 
+	dropletListPagedResponse = `
+{
+  "droplets": [{
+    "id": 1111,
+    "name": "some-droplet-name",
+    "image": {
+      "distribution": "some-distro",
+      "name": "some-image-name"
+    },
+    "region": {
+      "slug": "some-region-slug"
+    },
+    "status": "active",
+    "tags": ["yes"],
+    "features": ["remotes"],
+    "volume_ids": ["some-volume-id"]
+  }],
+  "links": {
+    "pages": {
+      "next": "https://api.digitalocean.com/v2/droplets?page=2&per_page=1",
+      "last": "https://api.digitalocean.com/v2/droplets?page=3&per_page=1"
+    }
+  },
+  "meta": {
+    "total": 3
+  }
+}`
+
+	dropletListPageResponse = `
+{
+  "droplets": [{
+    "id": %s,
+    "name": "droplet-%s",
+    "image": {},
+    "region": {}
+  }],
+  "links": {
+    "pages": {
+      "last": "https://api.digitalocean.com/v2/droplets?page=2&per_page=2"
+    }
+  },
+  "meta": {
+    "total": 2
+  }
+}`
+
 	dropletListRegionResponse = `
 {
   "droplets": [{
@@ -166,11 +418,45 @@ const (
 	dropletListOutput = `
 ID      Name                 Public IPv4    Private IPv4    Public IPv6    Memory    VCPUs    Disk    Region              Image                          Status    Tags    Features    Volumes
 1111    some-droplet-name                                                  0         0        0       some-region-slug    some-distro some-image-name    active    yes     remotes     some-volume-id
+`
+
+	dropletListYAMLOutput = `
+- id: 1111
+  name: some-droplet-name
+  region:
+    slug: some-region-slug
+  image:
+    name: some-image-name
+    distribution: some-distro
+  features:
+  - remotes
+  status: active
+  tags:
+  - "yes"
+  volume_ids:
+  - some-volume-id
+`
+
+	dropletListCSVOutput = `
+ID,Name,Image,Tags
+1111,some-droplet-name,some-distro some-image-name,yes
+`
+
+	dropletListFilterOutput = `
+ID      Region
+1440    my-region
+1111    not-regions
 `
 
 	dropletListRegionOutput = `
 ID      Name    Public IPv4    Private IPv4    Public IPv6    Memory    VCPUs    Disk    Region       Image                          Status    Tags    Features    Volumes
 1440                                                          0         0        0       my-region    some-distro some-image-name    active    yes     remotes     some-volume-id
+`
+
+	dropletListPagesOutput = `
+ID    Name
+1     droplet-1
+2     droplet-2
 `
 
 	dropletListEmptyOutput = `
@@ -131,12 +131,12 @@ var _ = suite("compute/droplet/tag", func(t *testing.T, when spec.G, it spec.S)
 			{
 				desc: "when tagging and droplet name is missing",
 				args: append(base, []string{"untag", "bad-droplet-name", "--tag-name", "my-tag"}...),
-				err:  `^Error:.*\".*\" could not be found`,
+				err:  `^Error: no droplet goes by the name "bad-droplet-name"`,
 			},
 			{
 				desc: "when untagging and droplet name is missing",
 				args: append(base, []string{"untag", "bad-droplet-name", "--tag-name", "my-tag"}...),
-				err:  `^Error:.*\".*\" could not be found`,
+				err:  `^Error: no droplet goes by the name "bad-droplet-name"`,
 			},
 		}
 
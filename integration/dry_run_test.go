@@ -0,0 +1,80 @@
+package integration
+
+import (
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl/pkg/fakeapi"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("dry-run", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		api := fakeapi.New()
+		api.ActionDuration = 0
+		server = httptest.NewServer(api)
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	doctl := func(args ...string) string {
+		cmd := exec.Command(builtBinaryPath, append([]string{"-t", "some-magic-token", "-u", server.URL}, args...)...)
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err, string(output))
+		return string(output)
+	}
+
+	it("prints mutating requests instead of sending them", func() {
+		doctl("compute", "tag", "create", "web")
+		doctl(
+			"compute", "droplet", "create", "web-01",
+			"--region", "nyc3",
+			"--size", "s-1vcpu-1gb",
+			"--image", "ubuntu-18-04-x64",
+			"--tag-name", "web",
+		)
+
+		output := doctl("compute", "droplet", "delete", "--tag-name", "web", "--dry-run")
+		expect.Equal("DELETE "+server.URL+"/v2/droplets?tag_name=web", strings.TrimSpace(output))
+
+		output = doctl("compute", "droplet", "list", "--format", "Name", "--no-header")
+		expect.Equal("web-01", strings.TrimSpace(output))
+	})
+
+	it("shows what read-only commands return", func() {
+		doctl("compute", "tag", "create", "web")
+
+		output := doctl("compute", "tag", "list", "--format", "Name", "--no-header", "--dry-run")
+		expect.Equal("web", strings.TrimSpace(output))
+	})
+
+	it("resolves names with real requests", func() {
+		doctl("kubernetes", "cluster", "create", "prod", "--region", "nyc1", "--wait=false", "--update-kubeconfig=false")
+
+		output := doctl("kubernetes", "cluster", "delete", "prod", "--dry-run")
+		expect.Regexp(`^DELETE `+server.URL+`/v2/kubernetes/clusters/[0-9a-f-]{36}$`, strings.TrimSpace(output))
+
+		output = doctl("kubernetes", "cluster", "list", "--format", "Name", "--no-header")
+		expect.Equal("prod", strings.TrimSpace(output))
+	})
+
+	it("prints requests as JSON", func() {
+		output := doctl("compute", "tag", "create", "web", "--dry-run", "--output", "json")
+		expect.Equal(`{"method":"POST","url":"`+server.URL+`/v2/tags","body":{"name":"web"}}`, strings.TrimSpace(output))
+
+		output = doctl("compute", "tag", "list", "--format", "Name", "--no-header")
+		expect.Equal("", strings.TrimSpace(output))
+	})
+})
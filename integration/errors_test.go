@@ -0,0 +1,152 @@
+package integration
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("errors", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Add("content-type", "application/json")
+
+			switch req.URL.Path {
+			case "/v2/droplets/1111":
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(errorsNotFoundResponse))
+			case "/v2/droplets/2222":
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"id":"unauthorized","message":"Unable to authenticate you."}`))
+			case "/v2/droplets/3333":
+				w.Header().Set("Retry-After", "3600")
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"id":"too_many_requests","message":"API Rate limit exceeded."}`))
+			default:
+				dump, err := httputil.DumpRequest(req, true)
+				if err != nil {
+					t.Fatal("failed to dump request")
+				}
+
+				t.Fatalf("received unknown request: %s", dump)
+			}
+		}))
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	exitCode := func(err error) int {
+		exitErr, ok := err.(*exec.ExitError)
+		expect.True(ok, "expected an exit error, got %v", err)
+		return exitErr.ExitCode()
+	}
+
+	when("a resource is not found", func() {
+		it("prints the status, error id and request id", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"droplet",
+				"get",
+				"1111",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Equal(4, exitCode(err))
+			expect.Equal(
+				`Error: GET `+server.URL+`/v2/droplets/1111: 404 (request "some-request-id") The resource you were accessing could not be found. (id: not_found)`,
+				strings.TrimSpace(string(output)),
+			)
+		})
+
+		it("includes the details in json errors", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"-o", "json",
+				"compute",
+				"droplet",
+				"get",
+				"1111",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Equal(4, exitCode(err))
+			expect.Equal(
+				`{"errors":[{"detail":"GET `+server.URL+`/v2/droplets/1111: 404 (request \"some-request-id\") The resource you were accessing could not be found.","status":404,"id":"not_found","request_id":"some-request-id"}]}`,
+				strings.TrimSpace(string(output)),
+			)
+		})
+	})
+
+	when("the token is rejected", func() {
+		it("exits with the auth exit code", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"droplet",
+				"get",
+				"2222",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Equal(3, exitCode(err))
+			expect.Contains(string(output), "Hint: the access token was rejected; run doctl auth init --context default to set a valid token")
+		})
+	})
+
+	when("the rate limit is exceeded", func() {
+		it("exits with the rate limit exit code", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"droplet",
+				"get",
+				"3333",
+			)
+
+			_, err := cmd.CombinedOutput()
+			expect.Equal(7, exitCode(err))
+		})
+	})
+
+	when("arguments are missing", func() {
+		it("exits with the usage exit code", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"droplet",
+				"get",
+			)
+
+			_, err := cmd.CombinedOutput()
+			expect.Equal(2, exitCode(err))
+		})
+	})
+})
+
+const errorsNotFoundResponse = `
+{
+  "id": "not_found",
+  "message": "The resource you were accessing could not be found.",
+  "request_id": "some-request-id"
+}`
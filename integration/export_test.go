@@ -0,0 +1,100 @@
+package integration
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl/pkg/fakeapi"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("export", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+		dir    string
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		api := fakeapi.New()
+		api.ActionDuration = 0
+		server = httptest.NewServer(api)
+
+		var err error
+		dir, err = ioutil.TempDir("", "doctl-export")
+		expect.NoError(err)
+	})
+
+	it.After(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	doctl := func(args ...string) string {
+		cmd := exec.Command(builtBinaryPath, append([]string{"-t", "some-magic-token", "-u", server.URL}, args...)...)
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err, string(output))
+		return string(output)
+	}
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		expect.NoError(ioutil.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	it.Before(func() {
+		doctl("apply", "-f", writeFile("stack.yaml", stackSpec), "--force")
+		doctl(
+			"compute", "droplet", "create", "other",
+			"--region", "nyc1",
+			"--size", "s-1vcpu-1gb",
+			"--image", "ubuntu-18-04-x64",
+		)
+	})
+
+	it("exports a stack spec that plans no changes", func() {
+		exported := doctl("export")
+		expect.Contains(exported, "- name: other\n")
+		expect.Contains(exported, "load_balancer: web\n")
+
+		output := doctl("plan", "-f", writeFile("exported.yaml", exported), "--no-header")
+		expect.Equal("", strings.TrimSpace(output))
+
+		exported = doctl("export", "--tag", "web")
+		expect.NotContains(exported, "- name: other\n")
+
+		output = doctl("plan", "-f", writeFile("web.yaml", exported), "--prune", "--no-header")
+		expect.Equal("", strings.TrimSpace(output))
+	})
+
+	it("exports the resources of a project", func() {
+		project := strings.TrimSpace(doctl("projects", "create", "--name", "web", "--purpose", "Web Application", "--format", "ID", "--no-header"))
+		doctl("projects", "resources", "assign", project, "--resource", "do:domain:example.com")
+
+		exported := doctl("export", "--project", "web")
+		expect.Equal("domains:\n- name: example.com\n", exported[:len("domains:\n- name: example.com\n")])
+		expect.NotContains(exported, "droplets:")
+	})
+
+	it("exports Terraform configuration and import commands", func() {
+		script := filepath.Join(dir, "import.sh")
+		config := doctl("export", "--format", "hcl", "--import-script", script)
+		expect.Contains(config, `resource "digitalocean_droplet" "web_01" {`)
+		expect.Contains(config, "  value  = digitalocean_loadbalancer.web.ip\n")
+		expect.NotContains(config, "terraform import")
+
+		imports, err := ioutil.ReadFile(script)
+		expect.NoError(err)
+		expect.Regexp(`(?m)^terraform import digitalocean_droplet\.web_01 \d+$`, string(imports))
+		expect.Regexp(`(?m)^terraform import digitalocean_record\.example_com_a_www example\.com,\d+$`, string(imports))
+	})
+})
@@ -0,0 +1,65 @@
+package integration
+
+import (
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl/pkg/fakeapi"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("fake-api", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		api := fakeapi.New()
+		api.ActionDuration = 0
+		server = httptest.NewServer(api)
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	doctl := func(args ...string) string {
+		cmd := exec.Command(builtBinaryPath, append([]string{"-t", "some-magic-token", "-u", server.URL}, args...)...)
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err, string(output))
+		return string(output)
+	}
+
+	it("keeps state across commands", func() {
+		doctl("compute", "tag", "create", "web")
+		doctl(
+			"compute", "droplet", "create", "web-01", "web-02",
+			"--region", "nyc3",
+			"--size", "s-1vcpu-1gb",
+			"--image", "ubuntu-18-04-x64",
+			"--tag-name", "web",
+			"--wait",
+		)
+
+		output := doctl("compute", "droplet", "list", "--tag-name", "web", "--format", "Name,Status", "--no-header")
+		expect.ElementsMatch([]string{"web-01    active", "web-02    active"}, strings.Split(strings.TrimSpace(output), "\n"))
+
+		doctl("compute", "droplet", "delete", "web-01", "--force")
+
+		output = doctl("compute", "droplet", "list", "--format", "Name", "--no-header")
+		expect.Equal("web-02", strings.TrimSpace(output))
+	})
+
+	it("reports missing resources like the API", func() {
+		cmd := exec.Command(builtBinaryPath, "-t", "some-magic-token", "-u", server.URL, "compute", "volume", "get", "00000000-0000-4000-8000-000000000000")
+		output, err := cmd.CombinedOutput()
+		expect.Error(err)
+		expect.Contains(string(output), "404")
+	})
+})
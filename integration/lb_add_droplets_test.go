@@ -24,7 +24,7 @@ var _ = suite("compute/load-balancer/add-droplets", func(t *testing.T, when spec
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/load_balancers/my-lb-id/droplets":
+			case "/v2/load_balancers/00001006-2006-4006-8006-000000000006/droplets":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -61,7 +61,7 @@ var _ = suite("compute/load-balancer/add-droplets", func(t *testing.T, when spec
 				"compute",
 				"load-balancer",
 				"add-droplets",
-				"my-lb-id",
+				"00001006-2006-4006-8006-000000000006",
 				"--droplet-ids", "111,222,444",
 			)
 
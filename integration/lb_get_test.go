@@ -26,7 +26,7 @@ var _ = suite("compute/load-balancer/get", func(t *testing.T, when spec.G, it sp
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/load_balancers/find-lb-id":
+			case "/v2/load_balancers/00001008-2008-4008-8008-000000000008":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -57,7 +57,7 @@ var _ = suite("compute/load-balancer/get", func(t *testing.T, when spec.G, it sp
 		)
 
 		baseArgs = []string{
-			"find-lb-id",
+			"00001008-2008-4008-8008-000000000008",
 		}
 	})
 
@@ -27,7 +27,7 @@ var _ = suite("compute/load-balancer/update", func(t *testing.T, when spec.G, it
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/load_balancers/updated-lb-id":
+			case "/v2/load_balancers/0000100a-200a-400a-800a-00000000000a":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -63,7 +63,7 @@ var _ = suite("compute/load-balancer/update", func(t *testing.T, when spec.G, it
 		)
 
 		baseArgs = []string{
-			"updated-lb-id",
+			"0000100a-200a-400a-800a-00000000000a",
 			"--droplet-ids", "1,2,3,4",
 			"--name", "hello",
 			"--region", "the-best-region",
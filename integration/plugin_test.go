@@ -0,0 +1,153 @@
+package integration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+const helloPlugin = `#!/bin/sh
+echo "args: $*"
+echo "context: $DIGITALOCEAN_CONTEXT"
+echo "token: $DIGITALOCEAN_ACCESS_TOKEN"
+echo "api: $DIGITALOCEAN_API_URL"
+echo "output: $DIGITALOCEAN_OUTPUT"
+exit ${HELLO_EXIT:-0}
+`
+
+var _ = suite("plugin", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		dir    string
+	)
+
+	it.Before(func() {
+		if runtime.GOOS == "windows" {
+			t.Skip("plugins are shell scripts")
+		}
+
+		expect = require.New(t)
+
+		var err error
+		dir, err = ioutil.TempDir("", "doctl-plugins")
+		expect.NoError(err)
+		expect.NoError(ioutil.WriteFile(filepath.Join(dir, "doctl-hello"), []byte(helloPlugin), 0755))
+		expect.NoError(ioutil.WriteFile(filepath.Join(dir, "doctl-compute"), []byte(helloPlugin), 0755))
+	})
+
+	it.After(func() {
+		os.RemoveAll(dir)
+	})
+
+	doctl := func(env []string, args ...string) *exec.Cmd {
+		cmd := exec.Command(builtBinaryPath, args...)
+		cmd.Env = append(os.Environ(),
+			"PATH="+dir+string(os.PathListSeparator)+os.Getenv("PATH"),
+			"HOME="+filepath.Join(dir, "home"),
+			"XDG_CONFIG_HOME="+filepath.Join(dir, "config"),
+		)
+		cmd.Env = append(cmd.Env, env...)
+		return cmd
+	}
+
+	it("lists plugins as commands", func() {
+		output, err := doctl(nil, "--help").CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.Contains(string(output), "hello       run the doctl-hello plugin")
+	})
+
+	it("runs a plugin with the resolved settings", func() {
+		output, err := doctl(nil, "-t", "some-magic-token", "-u", "http://127.0.0.1:1", "-o", "json", "hello", "--verbose", "-o", "x").CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.Equal(`args: --verbose -o x
+context: default
+token: some-magic-token
+api: http://127.0.0.1:1
+output: json
+`, string(output))
+	})
+
+	it("exits with the plugin's exit code", func() {
+		cmd := doctl([]string{"HELLO_EXIT=3"}, "hello")
+		output, err := cmd.CombinedOutput()
+		expect.Error(err)
+		expect.Equal(3, err.(*exec.ExitError).ExitCode())
+		expect.NotContains(string(output), "Error")
+	})
+
+	it("shows where plugins come from", func() {
+		output, err := doctl(nil, "plugin", "list", "--format", "Name,Path,Source,ShadowedBy", "--no-header").CombinedOutput()
+		expect.NoError(err, string(output))
+
+		lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+		expect.Len(lines, 2)
+		expect.Regexp(`^compute\s+`+filepath.Join(dir, "doctl-compute")+`\s+PATH\s+built-in command$`, lines[0])
+		expect.Regexp(`^hello\s+`+filepath.Join(dir, "doctl-hello")+`\s+PATH$`, lines[1])
+	})
+
+	it("installs, upgrades and removes plugins from an index", func() {
+		versions := []string{"1.0.0"}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/index.yaml" {
+				fmt.Fprintf(w, "#!/bin/sh\necho greetings from %s\n", strings.TrimPrefix(r.URL.Path, "/"))
+				return
+			}
+
+			fmt.Fprint(w, "plugins:\n  - name: greet\n    versions:\n")
+			for _, v := range versions {
+				sum := sha256.Sum256([]byte(fmt.Sprintf("#!/bin/sh\necho greetings from %s\n", v)))
+				fmt.Fprintf(w, "      - version: %s\n        artifacts:\n          - {os: %s, arch: %s, url: %s, sha256: %s}\n",
+					v, runtime.GOOS, runtime.GOARCH, v, hex.EncodeToString(sum[:]))
+			}
+		}))
+		defer server.Close()
+		index := server.URL + "/index.yaml"
+
+		output, err := doctl(nil, "plugin", "install", "greet", "--index", index).CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.Contains(string(output), "Notice: installed plugin greet 1.0.0 to "+filepath.Join(dir, "config", "doctl", "plugins", "doctl-greet"))
+
+		output, err = doctl(nil, "greet").CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.Equal("greetings from 1.0.0\n", string(output))
+
+		output, err = doctl(nil, "plugin", "list", "--format", "Name,Version,Source", "--no-header").CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.Regexp(`(?m)^greet\s+1.0.0\s+index$`, string(output))
+
+		versions = append(versions, "1.1.0")
+		output, err = doctl(nil, "plugin", "upgrade").CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.Contains(string(output), "Notice: upgraded plugin greet from 1.0.0 to 1.1.0")
+
+		output, err = doctl(nil, "greet").CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.Equal("greetings from 1.1.0\n", string(output))
+
+		output, err = doctl(nil, "plugin", "remove", "greet").CombinedOutput()
+		expect.NoError(err, string(output))
+
+		output, err = doctl(nil, "plugin", "list", "--format", "Name", "--no-header").CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.NotContains(string(output), "greet")
+	})
+
+	it("refuses plugins not matching their checksum", func() {
+		path := filepath.Join(dir, "doctl-hello")
+		output, err := doctl(nil, "plugin", "install", path, "--sha256", "0000").CombinedOutput()
+		expect.Error(err)
+		expect.Contains(string(output), "Error: checksum mismatch for "+path)
+	})
+})
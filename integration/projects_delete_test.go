@@ -6,7 +6,6 @@ import (
 	"net/http/httptest"
 	"net/http/httputil"
 	"os/exec"
-	"strings"
 	"testing"
 
 	"github.com/sclevine/spec"
@@ -24,9 +23,9 @@ var _ = suite("projects/delete", func(t *testing.T, when spec.G, it spec.S) {
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/projects/test-project-1":
+			case "/v2/projects/0000100d-200d-400d-800d-00000000000d":
 				fallthrough
-			case "/v2/projects/test-project-2":
+			case "/v2/projects/0000100e-200e-400e-800e-00000000000e":
 				if req.Method != http.MethodDelete {
 					w.WriteHeader(http.StatusMethodNotAllowed)
 					return
@@ -52,51 +51,18 @@ var _ = suite("projects/delete", func(t *testing.T, when spec.G, it spec.S) {
 				"--trace",
 				"projects",
 				"delete",
-				"test-project-1",
-				"test-project-2",
+				"0000100d-200d-400d-800d-00000000000d",
+				"0000100e-200e-400e-800e-00000000000e",
 				"-f",
 			)
 
 			output, err := cmd.CombinedOutput()
 			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
 
-			shared := []func(string){
-				func(s string) { expect.Equal("doctl:", s) },
-				func(s string) { expect.Regexp(`\d{4}/\d{2}/\d{2}`, s) },
-				func(s string) { expect.Regexp(`\d{2}:\d{2}:\d{2}`, s) },
-			}
-
-			lines := strings.Split(string(output), "\n")
-
-			line := lines[0]
-			for i, content := range strings.SplitN(line, " ", 5) {
-				matchers := append(shared,
-					func(s string) { expect.Equal("->", s) },
-					func(s string) { expect.Contains(s, "DELETE /v2/projects/test-project-1 HTTP/1.1") },
-				)
-
-				matchers[i](content)
-			}
-
-			line = lines[1]
-			for i, content := range strings.SplitN(line, " ", 5) {
-				matchers := append(shared,
-					func(s string) { expect.Equal("<-", s) },
-					func(s string) { expect.Contains(s, `HTTP/1.1 204 No Content\r\nDate:`) },
-				)
-
-				matchers[i](content)
-			}
-
-			line = lines[2]
-			for i, content := range strings.SplitN(line, " ", 5) {
-				matchers := append(shared,
-					func(s string) { expect.Equal("->", s) },
-					func(s string) { expect.Contains(s, "DELETE /v2/projects/test-project-2 HTTP/1.1") },
-				)
-
-				matchers[i](content)
-			}
+			expect.Contains(string(output), "-> DELETE "+server.URL+"/v2/projects/0000100d-200d-400d-800d-00000000000d\n")
+			expect.Contains(string(output), "<- 204 No Content (")
+			expect.Contains(string(output), "-> DELETE "+server.URL+"/v2/projects/0000100e-200e-400e-800e-00000000000e\n")
+			expect.NotContains(string(output), "some-magic-token")
 		})
 	})
 })
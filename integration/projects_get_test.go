@@ -24,7 +24,7 @@ var _ = suite("projects/get", func(t *testing.T, when spec.G, it spec.S) {
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/projects/test-project-1":
+			case "/v2/projects/0000100d-200d-400d-800d-00000000000d":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -55,7 +55,7 @@ var _ = suite("projects/get", func(t *testing.T, when spec.G, it spec.S) {
 				"-u", server.URL,
 				"projects",
 				"get",
-				"test-project-1",
+				"0000100d-200d-400d-800d-00000000000d",
 			)
 
 			output, err := cmd.CombinedOutput()
@@ -71,7 +71,7 @@ var _ = suite("projects/get", func(t *testing.T, when spec.G, it spec.S) {
 				"-u", server.URL,
 				"projects",
 				"get",
-				"test-project-1",
+				"0000100d-200d-400d-800d-00000000000d",
 				"--format", "Description",
 			)
 
@@ -26,7 +26,7 @@ var _ = suite("projects/resources/assign", func(t *testing.T, when spec.G, it sp
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/projects/some-project-id/resources":
+			case "/v2/projects/0000100f-200f-400f-800f-00000000000f/resources":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -70,7 +70,7 @@ var _ = suite("projects/resources/assign", func(t *testing.T, when spec.G, it sp
 				"projects",
 				"resources",
 				"assign",
-				"some-project-id",
+				"0000100f-200f-400f-800f-00000000000f",
 				"--resource", "some-urn-1",
 				"--resource", "some-urn-2",
 			)
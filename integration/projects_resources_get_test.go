@@ -50,7 +50,7 @@ var _ = suite("projects/resources/get", func(t *testing.T, when spec.G, it spec.
 				}
 
 				w.Write([]byte(projectsResourcesGetFloatingIPResponse))
-			case "/v2/load_balancers/1111":
+			case "/v2/load_balancers/00001111-0000-4000-8000-000000000000":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -76,7 +76,7 @@ var _ = suite("projects/resources/get", func(t *testing.T, when spec.G, it spec.
 				}
 
 				w.Write([]byte(projectsResourcesGetDomainResponse))
-			case "/v2/volumes/1111":
+			case "/v2/volumes/00001111-0000-4000-8000-000000000000":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -142,7 +142,7 @@ var _ = suite("projects/resources/get", func(t *testing.T, when spec.G, it spec.
 				"projects",
 				"resources",
 				"get",
-				"do:loadbalancer:1111",
+				"do:loadbalancer:00001111-0000-4000-8000-000000000000",
 			)
 
 			output, err := cmd.CombinedOutput()
@@ -176,7 +176,7 @@ var _ = suite("projects/resources/get", func(t *testing.T, when spec.G, it spec.
 				"projects",
 				"resources",
 				"get",
-				"do:volume:1111",
+				"do:volume:00001111-0000-4000-8000-000000000000",
 			)
 
 			output, err := cmd.CombinedOutput()
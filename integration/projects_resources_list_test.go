@@ -24,7 +24,7 @@ var _ = suite("projects/resources/list", func(t *testing.T, when spec.G, it spec
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/projects/some-project-id/resources":
+			case "/v2/projects/0000100f-200f-400f-800f-00000000000f/resources":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -56,7 +56,7 @@ var _ = suite("projects/resources/list", func(t *testing.T, when spec.G, it spec
 				"projects",
 				"resources",
 				"list",
-				"some-project-id",
+				"0000100f-200f-400f-800f-00000000000f",
 			)
 
 			output, err := cmd.CombinedOutput()
@@ -73,7 +73,7 @@ var _ = suite("projects/resources/list", func(t *testing.T, when spec.G, it spec
 				"projects",
 				"resources",
 				"list",
-				"some-project-id",
+				"0000100f-200f-400f-800f-00000000000f",
 				"--no-header",
 			)
 
@@ -91,7 +91,7 @@ var _ = suite("projects/resources/list", func(t *testing.T, when spec.G, it spec
 				"projects",
 				"resources",
 				"list",
-				"some-project-id",
+				"0000100f-200f-400f-800f-00000000000f",
 				"--format", "URN,Status",
 			)
 
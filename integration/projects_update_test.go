@@ -28,7 +28,7 @@ var _ = suite("projects/update", func(t *testing.T, when spec.G, it spec.S) {
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/projects/some-project-to-update":
+			case "/v2/projects/00001010-2010-4010-8010-000000000010":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -81,7 +81,7 @@ var _ = suite("projects/update", func(t *testing.T, when spec.G, it spec.S) {
 				"-u", server.URL,
 				"projects",
 				"update",
-				"some-project-to-update",
+				"00001010-2010-4010-8010-000000000010",
 				"--description", "yes",
 				"--name", "some-name",
 				"--purpose", "some-purpose",
@@ -102,7 +102,7 @@ var _ = suite("projects/update", func(t *testing.T, when spec.G, it spec.S) {
 				"-u", server.URL,
 				"projects",
 				"update",
-				"some-project-to-update",
+				"00001010-2010-4010-8010-000000000010",
 				"--description", "yes",
 				"--name", "some-name",
 				"--purpose", "some-purpose",
@@ -0,0 +1,57 @@
+package integration
+
+import (
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl/pkg/fakeapi"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("shell", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		api := fakeapi.New()
+		api.ActionDuration = 0
+		server = httptest.NewServer(api)
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	it("runs the commands read from stdin", func() {
+		cmd := exec.Command(builtBinaryPath, "-t", "some-magic-token", "-u", server.URL, "shell")
+		cmd.Stdin = strings.NewReader(`compute tag create web
+compute tag list --format Name --no-header
+compute tag list --output json
+set output json
+set query [].name
+compute tag list
+use context nope
+exit
+compute tag delete web --force
+`)
+
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.Equal(`Name    Droplet Count
+web     0
+web
+Error: global flags can't be set in the shell, use set or use context instead of --output
+[
+  "web"
+]
+{"errors":[{"detail":"context \"nope\" doesn't exist, see doctl auth list"}]}
+`, string(output))
+	})
+})
@@ -0,0 +1,136 @@
+package integration
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/doctl/pkg/fakeapi"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+const stackSpec = `
+tags: [web]
+droplets:
+  - name: web-01
+    region: nyc3
+    size: s-1vcpu-1gb
+    image: ubuntu-18-04-x64
+    tags: [web]
+    volumes: [web-data]
+volumes:
+  - name: web-data
+    region: nyc3
+    size: 10
+    tags: [web]
+firewalls:
+  - name: web
+    tags: [web]
+    inbound_rules:
+      - protocol: tcp
+        ports: "80"
+        load_balancers: [web]
+load_balancers:
+  - name: web
+    region: nyc3
+    tag: web
+    forwarding_rules:
+      - entry_protocol: http
+        entry_port: 80
+        target_protocol: http
+        target_port: 80
+domains:
+  - name: example.com
+    records:
+      - type: A
+        name: www
+        load_balancer: web
+`
+
+var _ = suite("stack", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect   *require.Assertions
+		server   *httptest.Server
+		specPath string
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		api := fakeapi.New()
+		api.ActionDuration = 0
+		server = httptest.NewServer(api)
+
+		dir, err := ioutil.TempDir("", "doctl-stack")
+		expect.NoError(err)
+		specPath = filepath.Join(dir, "stack.yaml")
+		expect.NoError(ioutil.WriteFile(specPath, []byte(stackSpec), 0600))
+	})
+
+	it.After(func() {
+		server.Close()
+		os.RemoveAll(filepath.Dir(specPath))
+	})
+
+	doctl := func(args ...string) string {
+		cmd := exec.Command(builtBinaryPath, append([]string{"-t", "some-magic-token", "-u", server.URL}, args...)...)
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err, string(output))
+		return string(output)
+	}
+
+	it("plans and applies a stack", func() {
+		output := doctl("plan", "-f", specPath, "--format", "Action,Type,Name", "--no-header")
+		expect.Equal(strings.TrimSpace(stackPlanOutput), strings.TrimSpace(output))
+
+		output = doctl("apply", "-f", specPath, "--force", "--format", "Action,Type,Name", "--no-header")
+		expect.Contains(output, "Notice: Created record A www.example.com")
+
+		output = doctl("plan", "-f", specPath, "--no-header")
+		expect.Equal("", strings.TrimSpace(output))
+
+		output = doctl("compute", "volume", "list", "--format", "Name,DropletIDs", "--no-header")
+		expect.Regexp(`^web-data\s+\[\d+\]$`, strings.TrimSpace(output))
+
+		output = doctl("compute", "domain", "records", "list", "example.com", "--format", "Type,Name", "--no-header")
+		expect.Regexp(`(?m)^A\s+www$`, output)
+	})
+
+	it("only deletes undeclared resources with --prune", func() {
+		doctl("apply", "-f", specPath, "--force")
+		doctl(
+			"compute", "droplet", "create", "web-02",
+			"--region", "nyc3",
+			"--size", "s-1vcpu-1gb",
+			"--image", "ubuntu-18-04-x64",
+			"--tag-name", "web",
+		)
+
+		output := doctl("plan", "-f", specPath, "--no-header")
+		expect.Equal("", strings.TrimSpace(output))
+
+		output = doctl("apply", "-f", specPath, "--prune", "--force", "--format", "Action,Type,Name", "--no-header")
+		expect.Contains(output, "delete    droplet    web-02")
+		expect.Contains(output, "Notice: Deleted droplet web-02")
+
+		output = doctl("compute", "droplet", "list", "--format", "Name", "--no-header")
+		expect.Equal("web-01", strings.TrimSpace(output))
+	})
+})
+
+const stackPlanOutput = `
+create    tag              web
+create    droplet          web-01
+create    volume           web-data
+update    volume           web-data
+create    firewall         web
+create    load balancer    web
+update    firewall         web
+create    domain           example.com
+create    record           A www.example.com
+`
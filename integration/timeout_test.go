@@ -0,0 +1,69 @@
+package integration
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("timeout", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/v2/account":
+				select {
+				case <-req.Context().Done():
+				case <-time.After(5 * time.Second):
+				}
+
+				w.Header().Add("content-type", "application/json")
+				w.Write([]byte(accountGetResponse))
+			default:
+				dump, err := httputil.DumpRequest(req, true)
+				if err != nil {
+					t.Fatal("failed to dump request")
+				}
+
+				t.Fatalf("received unknown request: %s", dump)
+			}
+		}))
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	when("the API does not respond before the timeout", func() {
+		it("exits non-zero with a timeout error", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"--timeout", "200ms",
+				"account",
+				"get",
+			)
+
+			start := time.Now()
+			output, err := cmd.CombinedOutput()
+			expect.Error(err)
+			expect.True(time.Since(start) < 5*time.Second)
+			expect.Equal(8, err.(*exec.ExitError).ExitCode())
+
+			expect.Equal("Error: command timed out after 200ms", strings.TrimSpace(string(output)))
+		})
+	})
+})
@@ -0,0 +1,110 @@
+package integration
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("trace-file", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+		dir    string
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+
+		var err error
+		dir, err = ioutil.TempDir("", "doctl-trace-file")
+		expect.NoError(err)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/v2/account":
+				w.Header().Add("content-type", "application/json")
+				w.Write([]byte(accountGetResponse))
+			default:
+				dump, err := httputil.DumpRequest(req, true)
+				if err != nil {
+					t.Fatal("failed to dump request")
+				}
+
+				t.Fatalf("received unknown request: %s", dump)
+			}
+		}))
+	})
+
+	it.After(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	it("writes a redacted HAR document", func() {
+		harPath := filepath.Join(dir, "trace.har")
+
+		cmd := exec.Command(builtBinaryPath,
+			"-t", "some-magic-token",
+			"-u", server.URL,
+			"--trace-file", harPath,
+			"account",
+			"get",
+		)
+
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err, string(output))
+
+		b, err := ioutil.ReadFile(harPath)
+		expect.NoError(err)
+		expect.NotContains(string(b), "some-magic-token")
+
+		var har struct {
+			Log struct {
+				Version string `json:"version"`
+				Entries []struct {
+					Request struct {
+						Method string `json:"method"`
+						URL    string `json:"url"`
+					} `json:"request"`
+					Response struct {
+						Status int `json:"status"`
+					} `json:"response"`
+				} `json:"entries"`
+			} `json:"log"`
+		}
+		expect.NoError(json.Unmarshal(b, &har))
+		expect.Equal("1.2", har.Log.Version)
+		expect.Len(har.Log.Entries, 1)
+		expect.Equal("GET", har.Log.Entries[0].Request.Method)
+		expect.True(strings.HasSuffix(har.Log.Entries[0].Request.URL, "/v2/account"))
+		expect.Equal(http.StatusOK, har.Log.Entries[0].Response.Status)
+	})
+
+	it("dumps readable requests with --trace", func() {
+		cmd := exec.Command(builtBinaryPath,
+			"-t", "some-magic-token",
+			"-u", server.URL,
+			"--trace",
+			"account",
+			"get",
+		)
+
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err, string(output))
+		expect.NotContains(string(output), "some-magic-token")
+		expect.Contains(string(output), "-> GET "+server.URL+"/v2/account")
+		expect.Contains(string(output), "Authorization: Bearer [REDACTED]")
+		expect.Contains(string(output), "<- 200 OK")
+	})
+})
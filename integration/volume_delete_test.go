@@ -24,7 +24,7 @@ var _ = suite("compute/volume/delete", func(t *testing.T, when spec.G, it spec.S
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/volumes/my-volume-id":
+			case "/v2/volumes/0000100b-200b-400b-800b-00000000000b":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -59,7 +59,7 @@ var _ = suite("compute/volume/delete", func(t *testing.T, when spec.G, it spec.S
 					"compute",
 					"volume",
 					alias,
-					"my-volume-id",
+					"0000100b-200b-400b-800b-00000000000b",
 					"--force",
 				)
 				output, err := cmd.CombinedOutput()
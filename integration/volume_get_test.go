@@ -25,7 +25,7 @@ var _ = suite("compute/volume/get", func(t *testing.T, when spec.G, it spec.S) {
 
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/volumes/some-volume-id":
+			case "/v2/volumes/0000100c-200c-400c-800c-00000000000c":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -60,7 +60,7 @@ var _ = suite("compute/volume/get", func(t *testing.T, when spec.G, it spec.S) {
 					"compute",
 					"volume",
 					alias,
-					"some-volume-id",
+					"0000100c-200c-400c-800c-00000000000c",
 				)
 
 				output, err := cmd.CombinedOutput()
@@ -18,7 +18,7 @@ var _ = suite("compute/volume/snapshot", func(t *testing.T, when spec.G, it spec
 		expect   *require.Assertions
 		cmd      *exec.Cmd
 		baseArgs = []string{
-			"my-volume-id",
+			"0000100b-200b-400b-800b-00000000000b",
 			"--snapshot-desc", "some magical description",
 			"--snapshot-name", "my-snapshot-name",
 			"--tag", "hey",
@@ -30,7 +30,7 @@ var _ = suite("compute/volume/snapshot", func(t *testing.T, when spec.G, it spec
 
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			switch req.URL.Path {
-			case "/v2/volumes/my-volume-id/snapshots":
+			case "/v2/volumes/0000100b-200b-400b-800b-00000000000b/snapshots":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
 					w.WriteHeader(http.StatusUnauthorized)
@@ -108,7 +108,7 @@ const (
   }
 }`
 	volumeSnapshotRequest = `{
-  "volume_id":"my-volume-id",
+  "volume_id":"0000100b-200b-400b-800b-00000000000b",
   "name":"my-snapshot-name",
   "description":"some magical description",
   "tags":["hey"]
@@ -0,0 +1,197 @@
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = suite("wait", func(t *testing.T, when spec.G, it spec.S) {
+	var (
+		expect *require.Assertions
+		server *httptest.Server
+		polls  int
+	)
+
+	it.Before(func() {
+		expect = require.New(t)
+		polls = 0
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			auth := req.Header.Get("Authorization")
+			if auth != "Bearer some-magic-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			switch req.URL.Path {
+			case "/v2/load_balancers":
+				if req.Method != http.MethodPost {
+					w.WriteHeader(http.StatusMethodNotAllowed)
+					return
+				}
+
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(fmt.Sprintf(waitLoadBalancerResponse, "new")))
+			case "/v2/load_balancers/4de7ac8b-495b-4884-9a69-1050c6793cd6":
+				polls++
+				status := "new"
+				if polls > 1 {
+					status = "active"
+				}
+				w.Write([]byte(fmt.Sprintf(waitLoadBalancerResponse, status)))
+			case "/v2/databases":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(waitDatabaseResponse))
+			case "/v2/databases/9cc10173-e9ea-4176-9dbc-a4cee4c4ff30":
+				polls++
+				w.Write([]byte(waitDatabaseResponse))
+			case "/v2/kubernetes/options":
+				w.Write([]byte(waitKubernetesOptionsResponse))
+			case "/v2/kubernetes/clusters":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(waitKubernetesClusterResponse))
+			case "/v2/kubernetes/clusters/5cf22b31-9c96-4a4d-8a27-63bd81f3f2a8":
+				polls++
+				w.Write([]byte(waitKubernetesClusterResponse))
+			default:
+				dump, err := httputil.DumpRequest(req, true)
+				if err != nil {
+					t.Fatal("failed to dump request")
+				}
+
+				t.Fatalf("received unknown request: %s", dump)
+			}
+		}))
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	when("waiting for a load balancer", func() {
+		it("displays it once active, showing its progress", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute", "load-balancer", "create",
+				"--name", "web", "--region", "nyc1", "--wait",
+				"--template", "{{.ID}} {{.Status}}",
+			)
+			var stdout, stderr strings.Builder
+			cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+			expect.NoError(cmd.Run(), stderr.String())
+			expect.Equal(strings.TrimSpace(waitLoadBalancerOutput), strings.TrimSpace(stdout.String()))
+			expect.Equal("waiting for load balancer web: new\n", stderr.String())
+			expect.Equal(2, polls)
+		})
+
+		it("shows no progress when quiet", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"--quiet",
+				"compute", "load-balancer", "create",
+				"--name", "web", "--region", "nyc1", "--wait",
+				"--template", "{{.ID}} {{.Status}}",
+			)
+			var stdout, stderr strings.Builder
+			cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+			expect.NoError(cmd.Run(), stderr.String())
+			expect.Equal(strings.TrimSpace(waitLoadBalancerOutput), strings.TrimSpace(stdout.String()))
+			expect.Empty(stderr.String())
+		})
+	})
+
+	when("waiting takes longer than --wait-timeout", func() {
+		it("fails", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"--wait-timeout", "1500ms",
+				"databases", "create", "pg", "--wait",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Error(err)
+			expect.Contains(string(output), "Error: timed out after 1.5s waiting for database cluster pg")
+			expect.True(polls >= 1)
+		})
+
+		it("fails when creating a Kubernetes cluster", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"--wait-timeout", "1500ms",
+				"kubernetes", "cluster", "create", "kc1",
+				"--region", "nyc1", "--version", "1.14.1-do.4",
+				"--update-kubeconfig=false", "--wait",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Error(err)
+			expect.Contains(string(output), "Error: timed out after 1.5s waiting for cluster 5cf22b31-9c96-4a4d-8a27-63bd81f3f2a8")
+			expect.NotContains(string(output), "provisioning    ")
+			expect.True(polls >= 1)
+		})
+	})
+})
+
+const (
+	waitLoadBalancerResponse = `
+{
+  "load_balancer": {
+    "id": "4de7ac8b-495b-4884-9a69-1050c6793cd6",
+    "name": "web",
+    "status": "%s",
+    "region": {"slug": "nyc1"},
+    "sticky_sessions": {},
+    "health_check": {}
+  }
+}
+`
+	waitLoadBalancerOutput        = `4de7ac8b-495b-4884-9a69-1050c6793cd6 active`
+	waitKubernetesOptionsResponse = `
+{
+  "options": {
+    "versions": [{"slug": "1.14.1-do.4", "kubernetes_version": "1.14.1"}],
+    "regions": [{"name": "New York 1", "slug": "nyc1"}],
+    "sizes": [{"name": "s-1vcpu-2gb", "slug": "s-1vcpu-2gb"}]
+  }
+}
+`
+	waitKubernetesClusterResponse = `
+{
+  "kubernetes_cluster": {
+    "id": "5cf22b31-9c96-4a4d-8a27-63bd81f3f2a8",
+    "name": "kc1",
+    "region": "nyc1",
+    "version": "1.14.1-do.4",
+    "node_pools": [],
+    "status": {"state": "provisioning"}
+  }
+}
+`
+	waitDatabaseResponse = `
+{
+  "database": {
+    "id": "9cc10173-e9ea-4176-9dbc-a4cee4c4ff30",
+    "name": "pg",
+    "engine": "pg",
+    "status": "creating",
+    "region": "nyc1",
+    "num_nodes": 1,
+    "size": "db-s-1vcpu-1gb"
+  }
+}
+`
+)
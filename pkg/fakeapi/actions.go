@@ -0,0 +1,118 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+func (s *Server) actionRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/actions", s.listActions),
+		newRoute(http.MethodGet, "/v2/actions/{id}", s.getAction),
+	}
+}
+
+// startAction records a new in-progress action on a resource. The action
+// completes, and onComplete runs, once the ActionDuration has passed.
+func (s *Server) startAction(actionType, resourceType string, resourceID int, region *godo.Region, onComplete func()) *godo.Action {
+	a := &godo.Action{
+		ID:           s.id(),
+		Status:       godo.ActionInProgress,
+		Type:         actionType,
+		StartedAt:    s.timestamp(),
+		ResourceID:   resourceID,
+		ResourceType: resourceType,
+		Region:       region,
+	}
+	if region != nil {
+		a.RegionSlug = region.Slug
+	}
+	s.actions = append(s.actions, a)
+
+	s.later(func() {
+		a.Status = godo.ActionCompleted
+		a.CompletedAt = s.timestamp()
+		if onComplete != nil {
+			onComplete()
+		}
+	})
+
+	return a
+}
+
+func (s *Server) findAction(id int) *godo.Action {
+	for _, a := range s.actions {
+		if a.ID == id {
+			return a
+		}
+	}
+
+	return nil
+}
+
+// listActions lists actions, newest first, as the API does.
+func (s *Server) listActions(r *request) {
+	actions := make([]*godo.Action, 0, len(s.actions))
+	for i := len(s.actions) - 1; i >= 0; i-- {
+		actions = append(actions, s.actions[i])
+	}
+
+	r.writePage("actions", actions)
+}
+
+func (s *Server) getAction(r *request) {
+	id, ok := r.intParam("id")
+	if !ok {
+		return
+	}
+
+	a := s.findAction(id)
+	if a == nil {
+		r.notFound()
+		return
+	}
+
+	r.write(http.StatusOK, map[string]interface{}{"action": a})
+}
+
+// writeResourceActions lists the actions on one resource.
+func (s *Server) writeResourceActions(r *request, resourceType string, resourceID int) {
+	actions := []*godo.Action{}
+	for _, a := range s.actions {
+		if a.ResourceType == resourceType && a.ResourceID == resourceID {
+			actions = append(actions, a)
+		}
+	}
+
+	r.writePage("actions", actions)
+}
+
+// writeResourceAction writes one action on a resource.
+func (s *Server) writeResourceAction(r *request, resourceType string, resourceID int) {
+	id, ok := r.intParam("action_id")
+	if !ok {
+		return
+	}
+
+	a := s.findAction(id)
+	if a == nil || a.ResourceType != resourceType || a.ResourceID != resourceID {
+		r.notFound()
+		return
+	}
+
+	r.write(http.StatusOK, map[string]interface{}{"action": a})
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+var (
+	regions = []godo.Region{
+		{Slug: "ams3", Name: "Amsterdam 3"},
+		{Slug: "fra1", Name: "Frankfurt 1"},
+		{Slug: "lon1", Name: "London 1"},
+		{Slug: "nyc1", Name: "New York 1"},
+		{Slug: "nyc3", Name: "New York 3"},
+		{Slug: "sfo2", Name: "San Francisco 2"},
+		{Slug: "sgp1", Name: "Singapore 1"},
+	}
+
+	sizes = []godo.Size{
+		{Slug: "s-1vcpu-1gb", Memory: 1024, Vcpus: 1, Disk: 25, Transfer: 1, PriceMonthly: 5, PriceHourly: 0.00744},
+		{Slug: "s-1vcpu-2gb", Memory: 2048, Vcpus: 1, Disk: 50, Transfer: 2, PriceMonthly: 10, PriceHourly: 0.01488},
+		{Slug: "s-2vcpu-2gb", Memory: 2048, Vcpus: 2, Disk: 60, Transfer: 3, PriceMonthly: 15, PriceHourly: 0.02232},
+		{Slug: "s-2vcpu-4gb", Memory: 4096, Vcpus: 2, Disk: 80, Transfer: 4, PriceMonthly: 20, PriceHourly: 0.02976},
+		{Slug: "s-4vcpu-8gb", Memory: 8192, Vcpus: 4, Disk: 160, Transfer: 5, PriceMonthly: 40, PriceHourly: 0.05952},
+	}
+
+	kubernetesVersions = []*godo.KubernetesVersion{
+		{Slug: "1.16.2-do.0", KubernetesVersion: "1.16.2"},
+		{Slug: "1.15.5-do.0", KubernetesVersion: "1.15.5"},
+	}
+)
+
+func init() {
+	for i := range regions {
+		regions[i].Available = true
+		regions[i].Features = []string{"private_networking", "backups", "ipv6", "metadata", "storage"}
+		for _, size := range sizes {
+			regions[i].Sizes = append(regions[i].Sizes, size.Slug)
+		}
+	}
+
+	for i := range sizes {
+		sizes[i].Available = true
+		for _, region := range regions {
+			sizes[i].Regions = append(sizes[i].Regions, region.Slug)
+		}
+	}
+}
+
+func findRegion(slug string) *godo.Region {
+	for _, r := range regions {
+		if r.Slug == slug {
+			r := r
+			return &r
+		}
+	}
+
+	return nil
+}
+
+func findSize(slug string) *godo.Size {
+	for _, s := range sizes {
+		if s.Slug == slug {
+			s := s
+			return &s
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) catalogRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/account", s.getAccount),
+		newRoute(http.MethodGet, "/v2/regions", func(r *request) { r.writePage("regions", regions) }),
+		newRoute(http.MethodGet, "/v2/sizes", func(r *request) { r.writePage("sizes", sizes) }),
+	}
+}
+
+func (s *Server) getAccount(r *request) {
+	r.write(http.StatusOK, map[string]interface{}{
+		"account": &godo.Account{
+			DropletLimit:    25,
+			FloatingIPLimit: 3,
+			VolumeLimit:     100,
+			Email:           "fake@example.com",
+			UUID:            "00000000-0000-4000-8000-000000000000",
+			EmailVerified:   true,
+			Status:          "active",
+		},
+	})
+}
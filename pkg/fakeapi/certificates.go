@@ -0,0 +1,116 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+func (s *Server) certificateRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/certificates", s.listCertificates),
+		newRoute(http.MethodPost, "/v2/certificates", s.createCertificate),
+		newRoute(http.MethodGet, "/v2/certificates/{id}", s.getCertificate),
+		newRoute(http.MethodDelete, "/v2/certificates/{id}", s.deleteCertificate),
+	}
+}
+
+func (s *Server) certificateParam(r *request) *godo.Certificate {
+	for _, c := range s.certificates {
+		if c.ID == r.param("id") {
+			return c
+		}
+	}
+
+	r.notFound()
+	return nil
+}
+
+func (s *Server) listCertificates(r *request) {
+	r.writePage("certificates", s.certificates)
+}
+
+func (s *Server) getCertificate(r *request) {
+	if c := s.certificateParam(r); c != nil {
+		r.write(http.StatusOK, map[string]interface{}{"certificate": c})
+	}
+}
+
+// createCertificate creates a certificate. Let's Encrypt certificates are
+// pending until the ActionDuration has passed, custom ones are verified
+// right away.
+func (s *Server) createCertificate(r *request) {
+	var req godo.CertificateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name == "" {
+		r.invalid("Name is required.")
+		return
+	}
+
+	c := &godo.Certificate{
+		ID:       s.uuid(),
+		Name:     req.Name,
+		DNSNames: req.DNSNames,
+		Created:  s.created(),
+		Type:     req.Type,
+		State:    "verified",
+		NotAfter: s.Now().UTC().AddDate(0, 3, 0).Format("2006-01-02T15:04:05Z"),
+	}
+
+	switch req.Type {
+	case "lets_encrypt":
+		if len(req.DNSNames) == 0 {
+			r.invalid("Let's Encrypt certificates need DNS names.")
+			return
+		}
+		c.State = "pending"
+		s.later(func() {
+			c.State = "verified"
+		})
+	case "", "custom":
+		if req.PrivateKey == "" || req.LeafCertificate == "" {
+			r.invalid("Custom certificates need a private key and a leaf certificate.")
+			return
+		}
+		c.Type = "custom"
+	default:
+		r.invalid("Type must be custom or lets_encrypt.")
+		return
+	}
+
+	s.certificates = append(s.certificates, c)
+	r.write(http.StatusCreated, map[string]interface{}{"certificate": c})
+}
+
+func (s *Server) deleteCertificate(r *request) {
+	c := s.certificateParam(r)
+	if c == nil {
+		return
+	}
+
+	certificates := s.certificates[:0]
+	for _, other := range s.certificates {
+		if other != c {
+			certificates = append(certificates, other)
+		}
+	}
+	s.certificates = certificates
+
+	r.noContent()
+}
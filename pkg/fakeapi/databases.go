@@ -0,0 +1,318 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+const databasePort = 25060
+
+// databaseEngines maps the supported engines to their default version and
+// connection URI scheme.
+var databaseEngines = map[string]struct{ version, scheme string }{
+	"pg":    {"11", "postgresql"},
+	"mysql": {"8", "mysql"},
+	"redis": {"5", "rediss"},
+}
+
+func (s *Server) databaseRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/databases", s.listDatabases),
+		newRoute(http.MethodPost, "/v2/databases", s.createDatabase),
+		newRoute(http.MethodGet, "/v2/databases/{id}", s.getDatabase),
+		newRoute(http.MethodDelete, "/v2/databases/{id}", s.deleteDatabase),
+		newRoute(http.MethodGet, "/v2/databases/{id}/users", s.listDatabaseUsers),
+		newRoute(http.MethodPost, "/v2/databases/{id}/users", s.createDatabaseUser),
+		newRoute(http.MethodGet, "/v2/databases/{id}/users/{name}", s.getDatabaseUser),
+		newRoute(http.MethodDelete, "/v2/databases/{id}/users/{name}", s.deleteDatabaseUser),
+		newRoute(http.MethodGet, "/v2/databases/{id}/dbs", s.listDatabaseDBs),
+		newRoute(http.MethodPost, "/v2/databases/{id}/dbs", s.createDatabaseDB),
+		newRoute(http.MethodGet, "/v2/databases/{id}/dbs/{name}", s.getDatabaseDB),
+		newRoute(http.MethodDelete, "/v2/databases/{id}/dbs/{name}", s.deleteDatabaseDB),
+	}
+}
+
+func (s *Server) findDatabase(id string) *godo.Database {
+	for _, db := range s.databases {
+		if db.ID == id {
+			return db
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) databaseParam(r *request) *godo.Database {
+	db := s.findDatabase(r.param("id"))
+	if db == nil {
+		r.notFound()
+	}
+
+	return db
+}
+
+func (s *Server) listDatabases(r *request) {
+	tag := r.URL.Query().Get("tag_name")
+
+	databases := []*godo.Database{}
+	for _, db := range s.databases {
+		if tag == "" || containsString(db.Tags, tag) {
+			databases = append(databases, db)
+		}
+	}
+
+	r.writePage("databases", databases)
+}
+
+func (s *Server) getDatabase(r *request) {
+	if db := s.databaseParam(r); db != nil {
+		r.write(http.StatusOK, map[string]interface{}{"database": db})
+	}
+}
+
+// createDatabase creates a database cluster, which comes online once the
+// ActionDuration has passed.
+func (s *Server) createDatabase(r *request) {
+	var req godo.DatabaseCreateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name == "" {
+		r.invalid("Name is required.")
+		return
+	}
+	engine, ok := databaseEngines[req.EngineSlug]
+	if !ok {
+		r.invalid("Engine %q is not supported.", req.EngineSlug)
+		return
+	}
+	if findRegion(req.Region) == nil {
+		r.invalid("Region is not available.")
+		return
+	}
+
+	version := req.Version
+	if version == "" {
+		version = engine.version
+	}
+	numNodes := req.NumNodes
+	if numNodes < 1 {
+		numNodes = 1
+	}
+
+	admin := godo.DatabaseUser{Name: "doadmin", Role: "primary", Password: s.password()}
+	host := fmt.Sprintf("%s-do-user-%d-0.db.ondigitalocean.com", req.Name, s.id())
+
+	db := &godo.Database{
+		ID:          s.uuid(),
+		Name:        req.Name,
+		EngineSlug:  req.EngineSlug,
+		VersionSlug: version,
+		Connection: &godo.DatabaseConnection{
+			URI:      fmt.Sprintf("%s://%s:%s@%s:%d/defaultdb?sslmode=require", engine.scheme, admin.Name, admin.Password, host, databasePort),
+			Database: "defaultdb",
+			Host:     host,
+			Port:     databasePort,
+			User:     admin.Name,
+			Password: admin.Password,
+			SSL:      true,
+		},
+		Users:              []godo.DatabaseUser{admin},
+		NumNodes:           numNodes,
+		SizeSlug:           req.SizeSlug,
+		DBNames:            []string{"defaultdb"},
+		RegionSlug:         req.Region,
+		Status:             "creating",
+		MaintenanceWindow:  &godo.DatabaseMaintenanceWindow{Day: "sunday", Hour: "00:00:00"},
+		CreatedAt:          s.Now().UTC(),
+		PrivateNetworkUUID: req.PrivateNetworkUUID,
+		Tags:               append([]string{}, req.Tags...),
+	}
+	for _, tag := range db.Tags {
+		s.ensureTag(tag)
+	}
+	s.databases = append(s.databases, db)
+
+	s.later(func() {
+		db.Status = "online"
+	})
+
+	r.write(http.StatusCreated, map[string]interface{}{"database": db})
+}
+
+// password returns a new, unique password.
+func (s *Server) password() string {
+	return fmt.Sprintf("fake-password-%x", s.id())
+}
+
+func (s *Server) deleteDatabase(r *request) {
+	db := s.databaseParam(r)
+	if db == nil {
+		return
+	}
+
+	databases := s.databases[:0]
+	for _, other := range s.databases {
+		if other != db {
+			databases = append(databases, other)
+		}
+	}
+	s.databases = databases
+
+	r.noContent()
+}
+
+func (s *Server) listDatabaseUsers(r *request) {
+	if db := s.databaseParam(r); db != nil {
+		r.writePage("users", db.Users)
+	}
+}
+
+func (s *Server) getDatabaseUser(r *request) {
+	db := s.databaseParam(r)
+	if db == nil {
+		return
+	}
+
+	for _, u := range db.Users {
+		if u.Name == r.param("name") {
+			r.write(http.StatusOK, map[string]interface{}{"user": u})
+			return
+		}
+	}
+
+	r.notFound()
+}
+
+func (s *Server) createDatabaseUser(r *request) {
+	db := s.databaseParam(r)
+	if db == nil {
+		return
+	}
+
+	var req godo.DatabaseCreateUserRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name == "" {
+		r.invalid("Name is required.")
+		return
+	}
+	for _, u := range db.Users {
+		if u.Name == req.Name {
+			r.invalid("User %s already exists.", req.Name)
+			return
+		}
+	}
+
+	u := godo.DatabaseUser{Name: req.Name, Role: "normal", Password: s.password(), MySQLSettings: req.MySQLSettings}
+	db.Users = append(db.Users, u)
+
+	r.write(http.StatusCreated, map[string]interface{}{"user": u})
+}
+
+func (s *Server) deleteDatabaseUser(r *request) {
+	db := s.databaseParam(r)
+	if db == nil {
+		return
+	}
+
+	for i, u := range db.Users {
+		if u.Name == r.param("name") {
+			if u.Role == "primary" {
+				r.invalid("The primary user cannot be deleted.")
+				return
+			}
+
+			db.Users = append(db.Users[:i], db.Users[i+1:]...)
+			r.noContent()
+			return
+		}
+	}
+
+	r.notFound()
+}
+
+func (s *Server) listDatabaseDBs(r *request) {
+	db := s.databaseParam(r)
+	if db == nil {
+		return
+	}
+
+	dbs := make([]godo.DatabaseDB, 0, len(db.DBNames))
+	for _, name := range db.DBNames {
+		dbs = append(dbs, godo.DatabaseDB{Name: name})
+	}
+
+	r.writePage("dbs", dbs)
+}
+
+func (s *Server) getDatabaseDB(r *request) {
+	db := s.databaseParam(r)
+	if db == nil {
+		return
+	}
+
+	if !containsString(db.DBNames, r.param("name")) {
+		r.notFound()
+		return
+	}
+
+	r.write(http.StatusOK, map[string]interface{}{"db": godo.DatabaseDB{Name: r.param("name")}})
+}
+
+func (s *Server) createDatabaseDB(r *request) {
+	db := s.databaseParam(r)
+	if db == nil {
+		return
+	}
+
+	var req godo.DatabaseCreateDBRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name == "" {
+		r.invalid("Name is required.")
+		return
+	}
+	if containsString(db.DBNames, req.Name) {
+		r.invalid("Database %s already exists.", req.Name)
+		return
+	}
+	db.DBNames = append(db.DBNames, req.Name)
+
+	r.write(http.StatusCreated, map[string]interface{}{"db": godo.DatabaseDB{Name: req.Name}})
+}
+
+func (s *Server) deleteDatabaseDB(r *request) {
+	db := s.databaseParam(r)
+	if db == nil {
+		return
+	}
+
+	if !containsString(db.DBNames, r.param("name")) {
+		r.notFound()
+		return
+	}
+	db.DBNames = removeString(db.DBNames, r.param("name"))
+
+	r.noContent()
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+const defaultTTL = 1800
+
+// domain is a domain and its records.
+type domain struct {
+	godo.Domain
+	records []*godo.DomainRecord
+}
+
+func (s *Server) domainRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/domains", s.listDomains),
+		newRoute(http.MethodPost, "/v2/domains", s.createDomain),
+		newRoute(http.MethodGet, "/v2/domains/{name}", s.getDomain),
+		newRoute(http.MethodDelete, "/v2/domains/{name}", s.deleteDomain),
+		newRoute(http.MethodGet, "/v2/domains/{name}/records", s.listDomainRecords),
+		newRoute(http.MethodPost, "/v2/domains/{name}/records", s.createDomainRecord),
+		newRoute(http.MethodGet, "/v2/domains/{name}/records/{id}", s.getDomainRecord),
+		newRoute(http.MethodPut, "/v2/domains/{name}/records/{id}", s.updateDomainRecord),
+		newRoute(http.MethodDelete, "/v2/domains/{name}/records/{id}", s.deleteDomainRecord),
+	}
+}
+
+func (s *Server) domainParam(r *request) *domain {
+	for _, d := range s.domains {
+		if d.Name == r.param("name") {
+			return d
+		}
+	}
+
+	r.notFound()
+	return nil
+}
+
+func (s *Server) listDomains(r *request) {
+	domains := make([]godo.Domain, 0, len(s.domains))
+	for _, d := range s.domains {
+		domains = append(domains, d.Domain)
+	}
+
+	r.writePage("domains", domains)
+}
+
+func (s *Server) getDomain(r *request) {
+	if d := s.domainParam(r); d != nil {
+		r.write(http.StatusOK, map[string]interface{}{"domain": d.Domain})
+	}
+}
+
+func (s *Server) createDomain(r *request) {
+	var req godo.DomainCreateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name == "" {
+		r.invalid("Name is required.")
+		return
+	}
+	for _, d := range s.domains {
+		if d.Name == req.Name {
+			r.invalid("Name already exists.")
+			return
+		}
+	}
+
+	d := &domain{Domain: godo.Domain{Name: req.Name, TTL: defaultTTL}}
+	for _, ns := range []string{"ns1.digitalocean.com", "ns2.digitalocean.com", "ns3.digitalocean.com"} {
+		d.records = append(d.records, &godo.DomainRecord{ID: s.id(), Type: "NS", Name: "@", Data: ns, TTL: defaultTTL})
+	}
+	if req.IPAddress != "" {
+		d.records = append(d.records, &godo.DomainRecord{ID: s.id(), Type: "A", Name: "@", Data: req.IPAddress, TTL: defaultTTL})
+	}
+	s.domains = append(s.domains, d)
+
+	r.write(http.StatusCreated, map[string]interface{}{"domain": d.Domain})
+}
+
+func (s *Server) deleteDomain(r *request) {
+	d := s.domainParam(r)
+	if d == nil {
+		return
+	}
+
+	domains := s.domains[:0]
+	for _, other := range s.domains {
+		if other != d {
+			domains = append(domains, other)
+		}
+	}
+	s.domains = domains
+
+	r.noContent()
+}
+
+func (s *Server) domainRecordParam(r *request, d *domain) *godo.DomainRecord {
+	id, ok := r.intParam("id")
+	if !ok {
+		return nil
+	}
+
+	for _, record := range d.records {
+		if record.ID == id {
+			return record
+		}
+	}
+
+	r.notFound()
+	return nil
+}
+
+func (s *Server) listDomainRecords(r *request) {
+	d := s.domainParam(r)
+	if d == nil {
+		return
+	}
+
+	q := r.URL.Query()
+	records := []*godo.DomainRecord{}
+	for _, record := range d.records {
+		if t := q.Get("type"); t != "" && record.Type != t {
+			continue
+		}
+		if name := q.Get("name"); name != "" && record.Name != name {
+			continue
+		}
+		records = append(records, record)
+	}
+
+	r.writePage("domain_records", records)
+}
+
+func (s *Server) getDomainRecord(r *request) {
+	if d := s.domainParam(r); d != nil {
+		if record := s.domainRecordParam(r, d); record != nil {
+			r.write(http.StatusOK, map[string]interface{}{"domain_record": record})
+		}
+	}
+}
+
+func (s *Server) createDomainRecord(r *request) {
+	d := s.domainParam(r)
+	if d == nil {
+		return
+	}
+
+	var req godo.DomainRecordEditRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Type == "" || req.Data == "" {
+		r.invalid("Type and data are required.")
+		return
+	}
+
+	record := &godo.DomainRecord{ID: s.id()}
+	applyRecordEdit(record, req)
+	d.records = append(d.records, record)
+
+	r.write(http.StatusCreated, map[string]interface{}{"domain_record": record})
+}
+
+func (s *Server) updateDomainRecord(r *request) {
+	d := s.domainParam(r)
+	if d == nil {
+		return
+	}
+
+	record := s.domainRecordParam(r, d)
+	if record == nil {
+		return
+	}
+
+	var req godo.DomainRecordEditRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	applyRecordEdit(record, req)
+	r.write(http.StatusOK, map[string]interface{}{"domain_record": record})
+}
+
+func (s *Server) deleteDomainRecord(r *request) {
+	d := s.domainParam(r)
+	if d == nil {
+		return
+	}
+
+	record := s.domainRecordParam(r, d)
+	if record == nil {
+		return
+	}
+
+	records := d.records[:0]
+	for _, other := range d.records {
+		if other != record {
+			records = append(records, other)
+		}
+	}
+	d.records = records
+
+	r.noContent()
+}
+
+// applyRecordEdit updates a record with the fields set in req.
+func applyRecordEdit(record *godo.DomainRecord, req godo.DomainRecordEditRequest) {
+	if req.Type != "" {
+		record.Type = req.Type
+	}
+	if req.Name != "" {
+		record.Name = req.Name
+	}
+	if req.Data != "" {
+		record.Data = req.Data
+	}
+	if req.Port != 0 {
+		record.Port = req.Port
+	}
+	if req.Tag != "" {
+		record.Tag = req.Tag
+	}
+	record.Priority = req.Priority
+	record.Weight = req.Weight
+	record.Flags = req.Flags
+
+	record.TTL = defaultTTL
+	if req.TTL != 0 {
+		record.TTL = req.TTL
+	}
+}
@@ -0,0 +1,398 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+)
+
+// dropletCreateRequest is a single or multiple droplet create request. The
+// image is a slug or an id.
+type dropletCreateRequest struct {
+	Name              string          `json:"name"`
+	Names             []string        `json:"names"`
+	Region            string          `json:"region"`
+	Size              string          `json:"size"`
+	Image             json.RawMessage `json:"image"`
+	Backups           bool            `json:"backups"`
+	IPv6              bool            `json:"ipv6"`
+	PrivateNetworking bool            `json:"private_networking"`
+	Monitoring        bool            `json:"monitoring"`
+	Tags              []string        `json:"tags"`
+	VPCUUID           string          `json:"vpc_uuid"`
+}
+
+func (s *Server) dropletRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/droplets", s.listDroplets),
+		newRoute(http.MethodPost, "/v2/droplets", s.createDroplets),
+		newRoute(http.MethodDelete, "/v2/droplets", s.deleteDropletsByTag),
+		newRoute(http.MethodPost, "/v2/droplets/actions", s.createDropletActionsByTag),
+		newRoute(http.MethodGet, "/v2/droplets/{id}", s.getDroplet),
+		newRoute(http.MethodDelete, "/v2/droplets/{id}", s.deleteDroplet),
+		newRoute(http.MethodGet, "/v2/droplets/{id}/actions", s.listDropletActions),
+		newRoute(http.MethodPost, "/v2/droplets/{id}/actions", s.createDropletAction),
+		newRoute(http.MethodGet, "/v2/droplets/{id}/actions/{action_id}", s.getDropletAction),
+		newRoute(http.MethodGet, "/v2/droplets/{id}/firewalls", s.listDropletFirewalls),
+	}
+}
+
+func (s *Server) findDroplet(id int) *godo.Droplet {
+	for _, d := range s.droplets {
+		if d.ID == id {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// dropletParam returns the droplet named by the id path parameter, writing
+// a not found error when there is none.
+func (s *Server) dropletParam(r *request) *godo.Droplet {
+	id, ok := r.intParam("id")
+	if !ok {
+		return nil
+	}
+
+	d := s.findDroplet(id)
+	if d == nil {
+		r.notFound()
+	}
+
+	return d
+}
+
+func (s *Server) dropletsTagged(tag string) []*godo.Droplet {
+	droplets := []*godo.Droplet{}
+	for _, d := range s.droplets {
+		if tag == "" || containsString(d.Tags, tag) {
+			droplets = append(droplets, d)
+		}
+	}
+
+	return droplets
+}
+
+func (s *Server) listDroplets(r *request) {
+	r.writePage("droplets", s.dropletsTagged(r.URL.Query().Get("tag_name")))
+}
+
+func (s *Server) getDroplet(r *request) {
+	if d := s.dropletParam(r); d != nil {
+		r.write(http.StatusOK, map[string]interface{}{"droplet": d})
+	}
+}
+
+func (s *Server) createDroplets(r *request) {
+	var req dropletCreateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	names := req.Names
+	if req.Name != "" {
+		names = []string{req.Name}
+	}
+	if len(names) == 0 {
+		r.invalid("Name is required.")
+		return
+	}
+
+	region := findRegion(req.Region)
+	if region == nil {
+		r.invalid("Region is not available.")
+		return
+	}
+
+	size := findSize(req.Size)
+	if size == nil {
+		r.invalid("Size is not available in this region.")
+		return
+	}
+
+	image, err := dropletImage(req.Image)
+	if err != nil {
+		r.invalid("%v", err)
+		return
+	}
+
+	var (
+		droplets []*godo.Droplet
+		actions  []*godo.Action
+	)
+	for _, name := range names {
+		d := s.newDroplet(name, region, size, image, req)
+		droplets = append(droplets, d)
+
+		actions = append(actions, s.startAction("create", "droplet", d.ID, region, func() {
+			d.Status = "active"
+		}))
+	}
+
+	links := r.actionLinks("create", actions...)
+	if req.Name != "" {
+		r.write(http.StatusAccepted, map[string]interface{}{"droplet": droplets[0], "links": links})
+		return
+	}
+
+	r.write(http.StatusAccepted, map[string]interface{}{"droplets": droplets, "links": links})
+}
+
+func dropletImage(raw json.RawMessage) (*godo.Image, error) {
+	var slug string
+	if err := json.Unmarshal(raw, &slug); err == nil && slug != "" {
+		return &godo.Image{Slug: slug, Name: slug, Distribution: "Fake", Public: true, Type: "snapshot"}, nil
+	}
+
+	var id int
+	if err := json.Unmarshal(raw, &id); err == nil && id != 0 {
+		return &godo.Image{ID: id, Name: strconv.Itoa(id), Distribution: "Fake", Type: "snapshot"}, nil
+	}
+
+	return nil, fmt.Errorf("You specified an invalid image for Droplet creation.")
+}
+
+func (s *Server) newDroplet(name string, region *godo.Region, size *godo.Size, image *godo.Image, req dropletCreateRequest) *godo.Droplet {
+	id := s.id()
+
+	d := &godo.Droplet{
+		ID:        id,
+		Name:      name,
+		Memory:    size.Memory,
+		Vcpus:     size.Vcpus,
+		Disk:      size.Disk,
+		Region:    region,
+		Image:     image,
+		Size:      size,
+		SizeSlug:  size.Slug,
+		Status:    "new",
+		Created:   s.created(),
+		Tags:      append([]string{}, req.Tags...),
+		VolumeIDs: []string{},
+		VPCUUID:   req.VPCUUID,
+		Networks: &godo.Networks{
+			V4: []godo.NetworkV4{
+				{IPAddress: fmt.Sprintf("192.0.2.%d", id%254+1), Netmask: "255.255.255.0", Gateway: "192.0.2.254", Type: "public"},
+			},
+		},
+	}
+
+	if req.PrivateNetworking {
+		d.Features = append(d.Features, "private_networking")
+		d.Networks.V4 = append(d.Networks.V4, godo.NetworkV4{
+			IPAddress: fmt.Sprintf("10.%d.%d.%d", id/65536%256, id/256%256, id%256),
+			Netmask:   "255.255.0.0",
+			Gateway:   "10.0.0.1",
+			Type:      "private",
+		})
+	}
+	if req.IPv6 {
+		d.Features = append(d.Features, "ipv6")
+		d.Networks.V6 = append(d.Networks.V6, godo.NetworkV6{
+			IPAddress: fmt.Sprintf("2001:db8::%x", id),
+			Netmask:   64,
+			Gateway:   "2001:db8::1",
+			Type:      "public",
+		})
+	}
+	if req.Backups {
+		d.Features = append(d.Features, "backups")
+	}
+	if req.Monitoring {
+		d.Features = append(d.Features, "monitoring")
+	}
+
+	for _, tag := range d.Tags {
+		s.ensureTag(tag)
+	}
+
+	s.droplets = append(s.droplets, d)
+	return d
+}
+
+func (s *Server) deleteDroplet(r *request) {
+	if d := s.dropletParam(r); d != nil {
+		s.removeDroplet(d)
+		r.noContent()
+	}
+}
+
+func (s *Server) deleteDropletsByTag(r *request) {
+	tag := r.URL.Query().Get("tag_name")
+	if tag == "" {
+		r.invalid("tag_name is required.")
+		return
+	}
+
+	for _, d := range s.dropletsTagged(tag) {
+		s.removeDroplet(d)
+	}
+	r.noContent()
+}
+
+// removeDroplet deletes a droplet and detaches it from everything that
+// refers to it.
+func (s *Server) removeDroplet(d *godo.Droplet) {
+	droplets := s.droplets[:0]
+	for _, other := range s.droplets {
+		if other != d {
+			droplets = append(droplets, other)
+		}
+	}
+	s.droplets = droplets
+
+	for _, v := range s.volumes {
+		v.DropletIDs = removeInt(v.DropletIDs, d.ID)
+	}
+	for _, fw := range s.firewalls {
+		fw.DropletIDs = removeInt(fw.DropletIDs, d.ID)
+	}
+	for _, lb := range s.loadBalancers {
+		lb.DropletIDs = removeInt(lb.DropletIDs, d.ID)
+	}
+}
+
+func (s *Server) listDropletActions(r *request) {
+	if d := s.dropletParam(r); d != nil {
+		s.writeResourceActions(r, "droplet", d.ID)
+	}
+}
+
+func (s *Server) getDropletAction(r *request) {
+	if d := s.dropletParam(r); d != nil {
+		s.writeResourceAction(r, "droplet", d.ID)
+	}
+}
+
+func (s *Server) createDropletAction(r *request) {
+	d := s.dropletParam(r)
+	if d == nil {
+		return
+	}
+
+	var req godo.ActionRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	a, err := s.dropletAction(d, req)
+	if err != nil {
+		r.invalid("%v", err)
+		return
+	}
+
+	r.write(http.StatusCreated, map[string]interface{}{"action": a})
+}
+
+func (s *Server) createDropletActionsByTag(r *request) {
+	tag := r.URL.Query().Get("tag_name")
+	if tag == "" {
+		r.invalid("tag_name is required.")
+		return
+	}
+
+	var req godo.ActionRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	actions := []*godo.Action{}
+	for _, d := range s.dropletsTagged(tag) {
+		a, err := s.dropletAction(d, req)
+		if err != nil {
+			r.invalid("%v", err)
+			return
+		}
+		actions = append(actions, a)
+	}
+
+	r.write(http.StatusCreated, map[string]interface{}{"actions": actions})
+}
+
+// dropletAction starts an action on a droplet. Its effect on the droplet
+// is applied when the action completes.
+func (s *Server) dropletAction(d *godo.Droplet, req godo.ActionRequest) (*godo.Action, error) {
+	actionType, _ := req["type"].(string)
+
+	var effect func()
+	switch actionType {
+	case "power_off", "shutdown":
+		effect = func() { d.Status = "off" }
+	case "power_on", "power_cycle", "reboot":
+		effect = func() { d.Status = "active" }
+	case "rename":
+		name, _ := req["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("Name is required.")
+		}
+		effect = func() { d.Name = name }
+	case "resize":
+		slug, _ := req["size"].(string)
+		size := findSize(slug)
+		if size == nil {
+			return nil, fmt.Errorf("Size is not available in this region.")
+		}
+		effect = func() {
+			d.Size, d.SizeSlug = size, size.Slug
+			d.Memory, d.Vcpus, d.Disk = size.Memory, size.Vcpus, size.Disk
+		}
+	case "enable_backups":
+		effect = func() {
+			if !containsString(d.Features, "backups") {
+				d.Features = append(d.Features, "backups")
+			}
+		}
+	case "disable_backups":
+		effect = func() { d.Features = removeString(d.Features, "backups") }
+	case "enable_private_networking":
+		effect = func() {
+			if !containsString(d.Features, "private_networking") {
+				d.Features = append(d.Features, "private_networking")
+			}
+		}
+	case "enable_ipv6":
+		effect = func() {
+			if !containsString(d.Features, "ipv6") {
+				d.Features = append(d.Features, "ipv6")
+			}
+		}
+	case "password_reset", "rebuild", "restore", "change_kernel", "snapshot":
+	default:
+		return nil, fmt.Errorf("Action type %q is not supported.", actionType)
+	}
+
+	return s.startAction(actionType, "droplet", d.ID, d.Region, effect), nil
+}
+
+func (s *Server) listDropletFirewalls(r *request) {
+	d := s.dropletParam(r)
+	if d == nil {
+		return
+	}
+
+	firewalls := []*godo.Firewall{}
+	for _, fw := range s.firewalls {
+		if s.firewallApplies(fw, d) {
+			firewalls = append(firewalls, fw)
+		}
+	}
+
+	r.writePage("firewalls", firewalls)
+}
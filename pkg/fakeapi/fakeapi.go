@@ -0,0 +1,403 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fakeapi is a stateful, in-memory fake of the DigitalOcean v2 API.
+// It serves droplets, actions, volumes, tags, domains, firewalls, load
+// balancers, Kubernetes clusters, databases, the container registry,
+// certificates and projects well enough to develop and test tooling without
+// an account:
+//
+//	server := httptest.NewServer(fakeapi.New())
+//	defer server.Close()
+//
+// Actions start in progress and complete once the server's ActionDuration has
+// passed, and resources being provisioned become ready at the same time.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+const (
+	defaultPerPage = 20
+	maxPerPage     = 200
+)
+
+// Server is a fake DigitalOcean API. Its zero value is not usable; create one
+// with New.
+type Server struct {
+	// ActionDuration is how long actions stay in progress, and how long
+	// resources take to provision.
+	ActionDuration time.Duration
+
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	mu      sync.Mutex
+	routes  []route
+	pending []pending
+	nextID  int
+
+	actions       []*godo.Action
+	droplets      []*godo.Droplet
+	volumes       []*godo.Volume
+	volumeActions map[string][]int
+	tags          []string
+	domains       []*domain
+	firewalls     []*godo.Firewall
+	loadBalancers []*godo.LoadBalancer
+	clusters      []*godo.KubernetesCluster
+	databases     []*godo.Database
+	registry      *godo.Registry
+	certificates  []*godo.Certificate
+	projects      []*godo.Project
+	// projectResources are the resources of each project, by project ID.
+	projectResources map[string][]godo.ProjectResource
+}
+
+// New returns a fake API with no resources whose actions complete after two
+// seconds.
+func New() *Server {
+	s := &Server{
+		ActionDuration: 2 * time.Second,
+		Now:            time.Now,
+		nextID:         1000,
+		volumeActions:  map[string][]int{},
+
+		projectResources: map[string][]godo.ProjectResource{},
+	}
+
+	s.routes = append(s.routes, s.catalogRoutes()...)
+	s.routes = append(s.routes, s.actionRoutes()...)
+	s.routes = append(s.routes, s.dropletRoutes()...)
+	s.routes = append(s.routes, s.volumeRoutes()...)
+	s.routes = append(s.routes, s.tagRoutes()...)
+	s.routes = append(s.routes, s.domainRoutes()...)
+	s.routes = append(s.routes, s.firewallRoutes()...)
+	s.routes = append(s.routes, s.loadBalancerRoutes()...)
+	s.routes = append(s.routes, s.kubernetesRoutes()...)
+	s.routes = append(s.routes, s.databaseRoutes()...)
+	s.routes = append(s.routes, s.registryRoutes()...)
+	s.routes = append(s.routes, s.certificateRoutes()...)
+	s.routes = append(s.routes, s.projectRoutes()...)
+
+	return s
+}
+
+// ServeHTTP serves a request to the fake API.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.settle()
+
+	segments := splitPath(r.URL.Path)
+	pathMatched := false
+	for _, rt := range s.routes {
+		params, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+
+		pathMatched = true
+		if rt.method != r.Method {
+			continue
+		}
+
+		rt.handler(&request{Request: r, w: w, params: params})
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s is not allowed on %s", r.Method, r.URL.Path))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "not_found", "The resource you were accessing could not be found.")
+}
+
+// route maps a method and a path pattern such as /v2/droplets/{id} to a
+// handler.
+type route struct {
+	method  string
+	pattern []string
+	handler func(*request)
+}
+
+func newRoute(method, pattern string, handler func(*request)) route {
+	return route{method: method, pattern: splitPath(pattern), handler: handler}
+}
+
+func (rt route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(rt.pattern) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, p := range rt.pattern {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			params[p[1:len(p)-1]] = segments[i]
+			continue
+		}
+		if p != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+func splitPath(p string) []string {
+	return strings.Split(strings.Trim(p, "/"), "/")
+}
+
+// request is a request being served, with its path parameters.
+type request struct {
+	*http.Request
+	w      http.ResponseWriter
+	params map[string]string
+}
+
+func (r *request) param(name string) string {
+	return r.params[name]
+}
+
+// intParam returns an integer path parameter, writing a not found error
+// when it is not a number.
+func (r *request) intParam(name string) (int, bool) {
+	i, err := strconv.Atoi(r.params[name])
+	if err != nil {
+		r.notFound()
+		return 0, false
+	}
+
+	return i, true
+}
+
+// decode decodes the JSON request body into v, writing an error when it is
+// invalid.
+func (r *request) decode(v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		r.invalid("request body is not valid JSON: %v", err)
+		return false
+	}
+
+	return true
+}
+
+func (r *request) write(status int, v interface{}) {
+	writeJSON(r.w, status, v)
+}
+
+func (r *request) noContent() {
+	r.w.WriteHeader(http.StatusNoContent)
+}
+
+func (r *request) notFound() {
+	writeError(r.w, http.StatusNotFound, "not_found", "The resource you were accessing could not be found.")
+}
+
+func (r *request) invalid(format string, a ...interface{}) {
+	writeError(r.w, http.StatusUnprocessableEntity, "unprocessable_entity", fmt.Sprintf(format, a...))
+}
+
+// baseURL returns the URL the fake API was reached at.
+func (r *request) baseURL() string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	return scheme + "://" + r.Host
+}
+
+// actionLinks returns the links pointing to actions started by a request.
+func (r *request) actionLinks(rel string, actions ...*godo.Action) *godo.Links {
+	links := &godo.Links{}
+	for _, a := range actions {
+		links.Actions = append(links.Actions, godo.LinkAction{
+			ID:   a.ID,
+			Rel:  rel,
+			HREF: fmt.Sprintf("%s/v2/actions/%d", r.baseURL(), a.ID),
+		})
+	}
+
+	return links
+}
+
+// writePage writes one page of items, a slice, under key along with the
+// pagination links and meta the API returns for lists.
+func (r *request) writePage(key string, items interface{}) {
+	v := reflect.ValueOf(items)
+	total := v.Len()
+
+	q := r.URL.Query()
+	page, err := strconv.Atoi(q.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(q.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+
+	lastPage := (total + perPage - 1) / perPage
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	lo, hi := (page-1)*perPage, page*perPage
+	if lo > total {
+		lo = total
+	}
+	if hi > total {
+		hi = total
+	}
+
+	pageURL := func(p int) string {
+		q := r.URL.Query()
+		q.Set("page", strconv.Itoa(p))
+		q.Set("per_page", strconv.Itoa(perPage))
+		return fmt.Sprintf("%s%s?%s", r.baseURL(), r.URL.Path, q.Encode())
+	}
+
+	pages := &godo.Pages{}
+	if page > 1 {
+		pages.First = pageURL(1)
+		pages.Prev = pageURL(page - 1)
+	}
+	if page < lastPage {
+		pages.Next = pageURL(page + 1)
+		pages.Last = pageURL(lastPage)
+	}
+
+	r.write(http.StatusOK, map[string]interface{}{
+		key:     v.Slice(lo, hi).Interface(),
+		"links": &godo.Links{Pages: pages},
+		"meta":  &godo.Meta{Total: total},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, id, message string) {
+	writeJSON(w, status, map[string]string{"id": id, "message": message})
+}
+
+// pending is a state change that happens once the ActionDuration has passed.
+type pending struct {
+	at time.Time
+	fn func()
+}
+
+// later schedules fn to run once the ActionDuration has passed.
+func (s *Server) later(fn func()) {
+	s.pending = append(s.pending, pending{at: s.Now().Add(s.ActionDuration), fn: fn})
+}
+
+// settle runs the state changes that are due, in the order they were
+// scheduled.
+func (s *Server) settle() {
+	now := s.Now()
+
+	var remaining []pending
+	for _, p := range s.pending {
+		if now.Before(p.at) {
+			remaining = append(remaining, p)
+			continue
+		}
+		p.fn()
+	}
+	s.pending = remaining
+}
+
+// id returns a new numeric id.
+func (s *Server) id() int {
+	s.nextID++
+	return s.nextID
+}
+
+// uuid returns a new id formatted like the API's UUIDs.
+func (s *Server) uuid() string {
+	n := s.id()
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", n, n)
+}
+
+func (s *Server) timestamp() *godo.Timestamp {
+	return &godo.Timestamp{Time: s.Now().UTC()}
+}
+
+func (s *Server) created() string {
+	return s.Now().UTC().Format(time.RFC3339)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeString(list []string, s string) []string {
+	out := list[:0]
+	for _, v := range list {
+		if v != s {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+func containsInt(list []int, i int) bool {
+	for _, v := range list {
+		if v == i {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeInt(list []int, i int) []int {
+	out := list[:0]
+	for _, v := range list {
+		if v != i {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
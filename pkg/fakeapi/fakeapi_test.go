@@ -0,0 +1,419 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer starts a fake API whose clock only moves when advance is
+// called.
+func testServer(t *testing.T) (client *godo.Client, advance func(), closer func()) {
+	now := time.Date(2019, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	api := New()
+	api.ActionDuration = time.Minute
+	api.Now = func() time.Time { return now }
+
+	server := httptest.NewServer(api)
+
+	client, err := godo.New(http.DefaultClient, godo.SetBaseURL(server.URL))
+	require.NoError(t, err)
+
+	return client, func() { now = now.Add(time.Minute) }, server.Close
+}
+
+func TestDropletLifecycle(t *testing.T) {
+	client, advance, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	d, resp, err := client.Droplets.Create(ctx, &godo.DropletCreateRequest{
+		Name:   "web-01",
+		Region: "nyc3",
+		Size:   "s-1vcpu-1gb",
+		Image:  godo.DropletCreateImage{Slug: "ubuntu-18-04-x64"},
+		Tags:   []string{"web"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "new", d.Status)
+	require.Len(t, resp.Links.Actions, 1)
+	assert.Equal(t, "create", resp.Links.Actions[0].Rel)
+
+	action, _, err := client.DropletActions.GetByURI(ctx, resp.Links.Actions[0].HREF)
+	require.NoError(t, err)
+	assert.Equal(t, godo.ActionInProgress, action.Status)
+
+	advance()
+
+	action, _, err = client.DropletActions.GetByURI(ctx, resp.Links.Actions[0].HREF)
+	require.NoError(t, err)
+	assert.Equal(t, godo.ActionCompleted, action.Status)
+
+	d, _, err = client.Droplets.Get(ctx, d.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "active", d.Status)
+	ip, err := d.PublicIPv4()
+	require.NoError(t, err)
+	assert.NotEmpty(t, ip)
+
+	action, _, err = client.DropletActions.PowerOff(ctx, d.ID)
+	require.NoError(t, err)
+	d, _, _ = client.Droplets.Get(ctx, d.ID)
+	assert.Equal(t, "active", d.Status, "the droplet should change once the action completes")
+
+	advance()
+
+	d, _, _ = client.Droplets.Get(ctx, d.ID)
+	assert.Equal(t, "off", d.Status)
+
+	tag, _, err := client.Tags.Get(ctx, "web")
+	require.NoError(t, err)
+	assert.Equal(t, 1, tag.Resources.Droplets.Count)
+
+	_, err = client.Droplets.Delete(ctx, d.ID)
+	require.NoError(t, err)
+
+	_, resp, err = client.Droplets.Get(ctx, d.ID)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestDropletCreateValidation(t *testing.T) {
+	client, _, closer := testServer(t)
+	defer closer()
+
+	_, resp, err := client.Droplets.Create(context.Background(), &godo.DropletCreateRequest{
+		Name:   "web-01",
+		Region: "nyc3",
+		Size:   "huge",
+		Image:  godo.DropletCreateImage{Slug: "ubuntu-18-04-x64"},
+	})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func TestPagination(t *testing.T) {
+	client, _, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		_, _, err := client.Tags.Create(ctx, &godo.TagCreateRequest{Name: name})
+		require.NoError(t, err)
+	}
+
+	var names []string
+	opt := &godo.ListOptions{Page: 1, PerPage: 2}
+	for {
+		tags, resp, err := client.Tags.List(ctx, opt)
+		require.NoError(t, err)
+		for _, tag := range tags {
+			names = append(names, tag.Name)
+		}
+
+		page, err := resp.Links.CurrentPage()
+		require.NoError(t, err)
+		assert.Equal(t, opt.Page, page)
+		assert.Equal(t, 5, resp.Meta.Total)
+
+		if resp.Links.IsLastPage() {
+			break
+		}
+		opt.Page++
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+	assert.Equal(t, 3, opt.Page)
+}
+
+func TestVolumeAttach(t *testing.T) {
+	client, advance, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	d, _, err := client.Droplets.Create(ctx, &godo.DropletCreateRequest{
+		Name: "db-01", Region: "nyc3", Size: "s-1vcpu-1gb", Image: godo.DropletCreateImage{Slug: "ubuntu-18-04-x64"},
+	})
+	require.NoError(t, err)
+
+	v, _, err := client.Storage.CreateVolume(ctx, &godo.VolumeCreateRequest{Name: "data", Region: "nyc3", SizeGigaBytes: 10})
+	require.NoError(t, err)
+
+	action, _, err := client.StorageActions.Attach(ctx, v.ID, d.ID)
+	require.NoError(t, err)
+	assert.Equal(t, godo.ActionInProgress, action.Status)
+
+	advance()
+
+	action, _, err = client.StorageActions.Get(ctx, v.ID, action.ID)
+	require.NoError(t, err)
+	assert.Equal(t, godo.ActionCompleted, action.Status)
+
+	v, _, err = client.Storage.GetVolume(ctx, v.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []int{d.ID}, v.DropletIDs)
+
+	d, _, err = client.Droplets.Get(ctx, d.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{v.ID}, d.VolumeIDs)
+
+	_, err = client.Storage.DeleteVolume(ctx, v.ID)
+	require.Error(t, err, "attached volumes cannot be deleted")
+}
+
+func TestDomainRecords(t *testing.T) {
+	client, _, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	_, _, err := client.Domains.Create(ctx, &godo.DomainCreateRequest{Name: "example.com", IPAddress: "192.0.2.1"})
+	require.NoError(t, err)
+
+	record, _, err := client.Domains.CreateRecord(ctx, "example.com", &godo.DomainRecordEditRequest{Type: "CNAME", Name: "www", Data: "@"})
+	require.NoError(t, err)
+
+	records, _, err := client.Domains.Records(ctx, "example.com", nil)
+	require.NoError(t, err)
+	assert.Len(t, records, 5)
+
+	record, _, err = client.Domains.EditRecord(ctx, "example.com", record.ID, &godo.DomainRecordEditRequest{Data: "example.com."})
+	require.NoError(t, err)
+	assert.Equal(t, "example.com.", record.Data)
+	assert.Equal(t, "CNAME", record.Type)
+
+	_, err = client.Domains.DeleteRecord(ctx, "example.com", record.ID)
+	require.NoError(t, err)
+	_, _, err = client.Domains.Record(ctx, "example.com", record.ID)
+	require.Error(t, err)
+}
+
+func TestFirewallsAndLoadBalancers(t *testing.T) {
+	client, advance, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	d, _, err := client.Droplets.Create(ctx, &godo.DropletCreateRequest{
+		Name: "web-01", Region: "nyc3", Size: "s-1vcpu-1gb", Image: godo.DropletCreateImage{Slug: "ubuntu-18-04-x64"},
+	})
+	require.NoError(t, err)
+
+	fw, _, err := client.Firewalls.Create(ctx, &godo.FirewallRequest{
+		Name:         "web",
+		InboundRules: []godo.InboundRule{{Protocol: "tcp", PortRange: "80", Sources: &godo.Sources{Addresses: []string{"0.0.0.0/0"}}}},
+	})
+	require.NoError(t, err)
+
+	_, err = client.Firewalls.AddDroplets(ctx, fw.ID, d.ID)
+	require.NoError(t, err)
+
+	firewalls, _, err := client.Firewalls.ListByDroplet(ctx, d.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, firewalls, 1)
+	assert.Equal(t, fw.ID, firewalls[0].ID)
+
+	lb, _, err := client.LoadBalancers.Create(ctx, &godo.LoadBalancerRequest{
+		Name:            "web",
+		Region:          "nyc3",
+		ForwardingRules: []godo.ForwardingRule{{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80}},
+		DropletIDs:      []int{d.ID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "new", lb.Status)
+
+	advance()
+
+	lb, _, err = client.LoadBalancers.Get(ctx, lb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "active", lb.Status)
+
+	_, err = client.Droplets.Delete(ctx, d.ID)
+	require.NoError(t, err)
+
+	lb, _, err = client.LoadBalancers.Get(ctx, lb.ID)
+	require.NoError(t, err)
+	assert.Empty(t, lb.DropletIDs)
+}
+
+func TestKubernetesCluster(t *testing.T) {
+	client, advance, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	c, _, err := client.Kubernetes.Create(ctx, &godo.KubernetesClusterCreateRequest{
+		Name:       "prod",
+		RegionSlug: "nyc1",
+		NodePools:  []*godo.KubernetesNodePoolCreateRequest{{Name: "pool", Size: "s-2vcpu-4gb", Count: 3}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, godo.KubernetesClusterStatusProvisioning, c.Status.State)
+	assert.Equal(t, kubernetesVersions[0].Slug, c.VersionSlug)
+	require.Len(t, c.NodePools, 1)
+	assert.Len(t, c.NodePools[0].Nodes, 3)
+
+	advance()
+
+	c, _, err = client.Kubernetes.Get(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, godo.KubernetesClusterStatusRunning, c.Status.State)
+
+	config, _, err := client.Kubernetes.GetKubeConfig(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Contains(t, string(config.KubeconfigYAML), "server: "+c.Endpoint)
+
+	count := 1
+	pool, _, err := client.Kubernetes.UpdateNodePool(ctx, c.ID, c.NodePools[0].ID, &godo.KubernetesNodePoolUpdateRequest{Count: &count})
+	require.NoError(t, err)
+	assert.Len(t, pool.Nodes, 1)
+}
+
+func TestDatabases(t *testing.T) {
+	client, advance, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	db, _, err := client.Databases.Create(ctx, &godo.DatabaseCreateRequest{
+		Name: "main", EngineSlug: "pg", Region: "nyc1", SizeSlug: "db-s-1vcpu-1gb", NumNodes: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "creating", db.Status)
+	assert.Contains(t, db.Connection.URI, "postgresql://doadmin:")
+
+	advance()
+
+	db, _, err = client.Databases.Get(ctx, db.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "online", db.Status)
+
+	user, _, err := client.Databases.CreateUser(ctx, db.ID, &godo.DatabaseCreateUserRequest{Name: "app"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, user.Password)
+
+	users, _, err := client.Databases.ListUsers(ctx, db.ID, nil)
+	require.NoError(t, err)
+	assert.Len(t, users, 2)
+
+	_, _, err = client.Databases.CreateDB(ctx, db.ID, &godo.DatabaseCreateDBRequest{Name: "app"})
+	require.NoError(t, err)
+	dbs, _, err := client.Databases.ListDBs(ctx, db.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []godo.DatabaseDB{{Name: "defaultdb"}, {Name: "app"}}, dbs)
+}
+
+func TestRegistry(t *testing.T) {
+	client, _, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	_, resp, err := client.Registry.Get(ctx)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	_, _, err = client.Registry.Create(ctx, &godo.RegistryCreateRequest{Name: "mine"})
+	require.NoError(t, err)
+
+	r, _, err := client.Registry.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "mine", r.Name)
+
+	creds, _, err := client.Registry.DockerCredentials(ctx, &godo.RegistryDockerCredentialsRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, string(creds.DockerConfigJSON), registryHost)
+
+	_, err = client.Registry.Delete(ctx)
+	require.NoError(t, err)
+}
+
+func TestCertificates(t *testing.T) {
+	client, advance, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	c, _, err := client.Certificates.Create(ctx, &godo.CertificateRequest{
+		Name:     "web",
+		Type:     "lets_encrypt",
+		DNSNames: []string{"example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "pending", c.State)
+
+	advance()
+	c, _, err = client.Certificates.Get(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "verified", c.State)
+
+	_, _, err = client.Certificates.Create(ctx, &godo.CertificateRequest{Name: "custom", Type: "custom"})
+	require.Error(t, err)
+
+	_, err = client.Certificates.Delete(ctx, c.ID)
+	require.NoError(t, err)
+
+	certs, _, err := client.Certificates.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, certs)
+}
+
+func TestProjects(t *testing.T) {
+	client, _, closer := testServer(t)
+	defer closer()
+	ctx := context.Background()
+
+	def, _, err := client.Projects.GetDefault(ctx)
+	require.NoError(t, err)
+	assert.True(t, def.IsDefault)
+
+	p, _, err := client.Projects.Create(ctx, &godo.CreateProjectRequest{Name: "web", Purpose: "Web Application"})
+	require.NoError(t, err)
+
+	_, _, err = client.Projects.AssignResources(ctx, def.ID, "do:droplet:1")
+	require.NoError(t, err)
+	_, _, err = client.Projects.AssignResources(ctx, p.ID, "do:droplet:1", "do:domain:example.com")
+	require.NoError(t, err)
+
+	resources, _, err := client.Projects.ListResources(ctx, p.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	assert.Equal(t, "do:droplet:1", resources[0].URN)
+
+	resources, _, err = client.Projects.ListResources(ctx, def.ID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+
+	_, err = client.Projects.Delete(ctx, p.ID)
+	require.Error(t, err)
+}
+
+func TestUnknownRoutes(t *testing.T) {
+	server := httptest.NewServer(New())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/v2/nothing")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	req, _ := http.NewRequest(http.MethodPatch, server.URL+"/v2/droplets", nil)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
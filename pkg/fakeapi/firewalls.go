@@ -0,0 +1,265 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+func (s *Server) firewallRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/firewalls", s.listFirewalls),
+		newRoute(http.MethodPost, "/v2/firewalls", s.createFirewall),
+		newRoute(http.MethodGet, "/v2/firewalls/{id}", s.getFirewall),
+		newRoute(http.MethodPut, "/v2/firewalls/{id}", s.updateFirewall),
+		newRoute(http.MethodDelete, "/v2/firewalls/{id}", s.deleteFirewall),
+		newRoute(http.MethodPost, "/v2/firewalls/{id}/droplets", s.firewallDroplets(true)),
+		newRoute(http.MethodDelete, "/v2/firewalls/{id}/droplets", s.firewallDroplets(false)),
+		newRoute(http.MethodPost, "/v2/firewalls/{id}/tags", s.firewallTags(true)),
+		newRoute(http.MethodDelete, "/v2/firewalls/{id}/tags", s.firewallTags(false)),
+		newRoute(http.MethodPost, "/v2/firewalls/{id}/rules", s.firewallRules(true)),
+		newRoute(http.MethodDelete, "/v2/firewalls/{id}/rules", s.firewallRules(false)),
+	}
+}
+
+func (s *Server) firewallParam(r *request) *godo.Firewall {
+	for _, fw := range s.firewalls {
+		if fw.ID == r.param("id") {
+			return fw
+		}
+	}
+
+	r.notFound()
+	return nil
+}
+
+// firewallApplies reports whether a firewall applies to a droplet, directly
+// or through one of its tags.
+func (s *Server) firewallApplies(fw *godo.Firewall, d *godo.Droplet) bool {
+	if containsInt(fw.DropletIDs, d.ID) {
+		return true
+	}
+
+	for _, tag := range fw.Tags {
+		if containsString(d.Tags, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) listFirewalls(r *request) {
+	r.writePage("firewalls", s.firewalls)
+}
+
+func (s *Server) getFirewall(r *request) {
+	if fw := s.firewallParam(r); fw != nil {
+		r.write(http.StatusOK, map[string]interface{}{"firewall": fw})
+	}
+}
+
+func (s *Server) createFirewall(r *request) {
+	var req godo.FirewallRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	fw := &godo.Firewall{
+		ID:             s.uuid(),
+		Status:         "succeeded",
+		Created:        s.created(),
+		PendingChanges: []godo.PendingChange{},
+	}
+	if !s.applyFirewallRequest(r, fw, req) {
+		return
+	}
+	s.firewalls = append(s.firewalls, fw)
+
+	r.write(http.StatusAccepted, map[string]interface{}{"firewall": fw})
+}
+
+func (s *Server) updateFirewall(r *request) {
+	fw := s.firewallParam(r)
+	if fw == nil {
+		return
+	}
+
+	var req godo.FirewallRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if s.applyFirewallRequest(r, fw, req) {
+		r.write(http.StatusOK, map[string]interface{}{"firewall": fw})
+	}
+}
+
+// applyFirewallRequest replaces a firewall's configuration, writing an
+// error when the request is invalid.
+func (s *Server) applyFirewallRequest(r *request, fw *godo.Firewall, req godo.FirewallRequest) bool {
+	if req.Name == "" {
+		r.invalid("Name is required.")
+		return false
+	}
+	for _, id := range req.DropletIDs {
+		if s.findDroplet(id) == nil {
+			r.invalid("Droplet %d could not be found.", id)
+			return false
+		}
+	}
+
+	fw.Name = req.Name
+	fw.InboundRules = append([]godo.InboundRule{}, req.InboundRules...)
+	fw.OutboundRules = append([]godo.OutboundRule{}, req.OutboundRules...)
+	fw.DropletIDs = append([]int{}, req.DropletIDs...)
+	fw.Tags = append([]string{}, req.Tags...)
+	for _, tag := range fw.Tags {
+		s.ensureTag(tag)
+	}
+
+	return true
+}
+
+func (s *Server) deleteFirewall(r *request) {
+	fw := s.firewallParam(r)
+	if fw == nil {
+		return
+	}
+
+	firewalls := s.firewalls[:0]
+	for _, other := range s.firewalls {
+		if other != fw {
+			firewalls = append(firewalls, other)
+		}
+	}
+	s.firewalls = firewalls
+
+	r.noContent()
+}
+
+func (s *Server) firewallDroplets(add bool) func(*request) {
+	return func(r *request) {
+		fw := s.firewallParam(r)
+		if fw == nil {
+			return
+		}
+
+		var req struct {
+			DropletIDs []int `json:"droplet_ids"`
+		}
+		if !r.decode(&req) {
+			return
+		}
+
+		for _, id := range req.DropletIDs {
+			if s.findDroplet(id) == nil {
+				r.invalid("Droplet %d could not be found.", id)
+				return
+			}
+		}
+
+		for _, id := range req.DropletIDs {
+			fw.DropletIDs = removeInt(fw.DropletIDs, id)
+			if add {
+				fw.DropletIDs = append(fw.DropletIDs, id)
+			}
+		}
+		r.noContent()
+	}
+}
+
+func (s *Server) firewallTags(add bool) func(*request) {
+	return func(r *request) {
+		fw := s.firewallParam(r)
+		if fw == nil {
+			return
+		}
+
+		var req struct {
+			Tags []string `json:"tags"`
+		}
+		if !r.decode(&req) {
+			return
+		}
+
+		for _, tag := range req.Tags {
+			fw.Tags = removeString(fw.Tags, tag)
+			if add {
+				s.ensureTag(tag)
+				fw.Tags = append(fw.Tags, tag)
+			}
+		}
+		r.noContent()
+	}
+}
+
+func (s *Server) firewallRules(add bool) func(*request) {
+	return func(r *request) {
+		fw := s.firewallParam(r)
+		if fw == nil {
+			return
+		}
+
+		var req godo.FirewallRulesRequest
+		if !r.decode(&req) {
+			return
+		}
+
+		if add {
+			fw.InboundRules = append(fw.InboundRules, req.InboundRules...)
+			fw.OutboundRules = append(fw.OutboundRules, req.OutboundRules...)
+		} else {
+			fw.InboundRules = removeInboundRules(fw.InboundRules, req.InboundRules)
+			fw.OutboundRules = removeOutboundRules(fw.OutboundRules, req.OutboundRules)
+		}
+		r.noContent()
+	}
+}
+
+func removeInboundRules(rules, remove []godo.InboundRule) []godo.InboundRule {
+	out := []godo.InboundRule{}
+	for _, rule := range rules {
+		keep := true
+		for _, rm := range remove {
+			if rule.Protocol == rm.Protocol && rule.PortRange == rm.PortRange {
+				keep = false
+			}
+		}
+		if keep {
+			out = append(out, rule)
+		}
+	}
+
+	return out
+}
+
+func removeOutboundRules(rules, remove []godo.OutboundRule) []godo.OutboundRule {
+	out := []godo.OutboundRule{}
+	for _, rule := range rules {
+		keep := true
+		for _, rm := range remove {
+			if rule.Protocol == rm.Protocol && rule.PortRange == rm.PortRange {
+				keep = false
+			}
+		}
+		if keep {
+			out = append(out, rule)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,428 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+const kubeconfigTemplate = `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: ZmFrZQ==
+    server: %[1]s
+  name: do-%[2]s-%[3]s
+contexts:
+- context:
+    cluster: do-%[2]s-%[3]s
+    user: do-%[2]s-%[3]s-admin
+  name: do-%[2]s-%[3]s
+current-context: do-%[2]s-%[3]s
+kind: Config
+preferences: {}
+users:
+- name: do-%[2]s-%[3]s-admin
+  user:
+    token: fake-token
+`
+
+func (s *Server) kubernetesRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/kubernetes/options", s.getKubernetesOptions),
+		newRoute(http.MethodGet, "/v2/kubernetes/clusters", s.listClusters),
+		newRoute(http.MethodPost, "/v2/kubernetes/clusters", s.createCluster),
+		newRoute(http.MethodGet, "/v2/kubernetes/clusters/{id}", s.getCluster),
+		newRoute(http.MethodPut, "/v2/kubernetes/clusters/{id}", s.updateCluster),
+		newRoute(http.MethodDelete, "/v2/kubernetes/clusters/{id}", s.deleteCluster),
+		newRoute(http.MethodGet, "/v2/kubernetes/clusters/{id}/kubeconfig", s.getKubeconfig),
+		newRoute(http.MethodGet, "/v2/kubernetes/clusters/{id}/credentials", s.getClusterCredentials),
+		newRoute(http.MethodGet, "/v2/kubernetes/clusters/{id}/upgrades", s.getClusterUpgrades),
+		newRoute(http.MethodGet, "/v2/kubernetes/clusters/{id}/node_pools", s.listNodePools),
+		newRoute(http.MethodPost, "/v2/kubernetes/clusters/{id}/node_pools", s.createNodePool),
+		newRoute(http.MethodGet, "/v2/kubernetes/clusters/{id}/node_pools/{pool_id}", s.getNodePool),
+		newRoute(http.MethodPut, "/v2/kubernetes/clusters/{id}/node_pools/{pool_id}", s.updateNodePool),
+		newRoute(http.MethodDelete, "/v2/kubernetes/clusters/{id}/node_pools/{pool_id}", s.deleteNodePool),
+	}
+}
+
+func (s *Server) getKubernetesOptions(r *request) {
+	options := &godo.KubernetesOptions{Versions: kubernetesVersions}
+	for _, region := range regions {
+		options.Regions = append(options.Regions, &godo.KubernetesRegion{Name: region.Name, Slug: region.Slug})
+	}
+	for _, size := range sizes {
+		options.Sizes = append(options.Sizes, &godo.KubernetesNodeSize{Name: size.Slug, Slug: size.Slug})
+	}
+
+	r.write(http.StatusOK, map[string]interface{}{"options": options})
+}
+
+func (s *Server) clusterParam(r *request) *godo.KubernetesCluster {
+	for _, c := range s.clusters {
+		if c.ID == r.param("id") {
+			return c
+		}
+	}
+
+	r.notFound()
+	return nil
+}
+
+func (s *Server) listClusters(r *request) {
+	r.writePage("kubernetes_clusters", s.clusters)
+}
+
+func (s *Server) getCluster(r *request) {
+	if c := s.clusterParam(r); c != nil {
+		r.write(http.StatusOK, map[string]interface{}{"kubernetes_cluster": c})
+	}
+}
+
+// createCluster creates a cluster, which is running once the
+// ActionDuration has passed.
+func (s *Server) createCluster(r *request) {
+	var req godo.KubernetesClusterCreateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name == "" {
+		r.invalid("Name is required.")
+		return
+	}
+	if findRegion(req.RegionSlug) == nil {
+		r.invalid("Region is not available.")
+		return
+	}
+	version := s.kubernetesVersion(req.VersionSlug)
+	if version == "" {
+		r.invalid("Version %q is not available.", req.VersionSlug)
+		return
+	}
+	if len(req.NodePools) == 0 {
+		r.invalid("At least one node pool is required.")
+		return
+	}
+
+	id := s.uuid()
+	c := &godo.KubernetesCluster{
+		ID:                id,
+		Name:              req.Name,
+		RegionSlug:        req.RegionSlug,
+		VersionSlug:       version,
+		ClusterSubnet:     "10.244.0.0/16",
+		ServiceSubnet:     "10.245.0.0/16",
+		Endpoint:          fmt.Sprintf("https://%s.k8s.ondigitalocean.com", id),
+		Tags:              append([]string{"k8s", "k8s:" + id}, req.Tags...),
+		VPCUUID:           req.VPCUUID,
+		MaintenancePolicy: req.MaintenancePolicy,
+		AutoUpgrade:       req.AutoUpgrade,
+		Status:            &godo.KubernetesClusterStatus{State: godo.KubernetesClusterStatusProvisioning},
+		CreatedAt:         s.Now().UTC(),
+		UpdatedAt:         s.Now().UTC(),
+	}
+	if c.MaintenancePolicy == nil {
+		c.MaintenancePolicy = &godo.KubernetesMaintenancePolicy{StartTime: "00:00", Duration: "4h0m0s"}
+	}
+
+	for _, poolReq := range req.NodePools {
+		pool, err := s.newNodePool(poolReq)
+		if err != nil {
+			r.invalid("%v", err)
+			return
+		}
+		c.NodePools = append(c.NodePools, pool)
+	}
+	s.clusters = append(s.clusters, c)
+
+	s.later(func() {
+		c.Status.State = godo.KubernetesClusterStatusRunning
+		c.IPv4 = fmt.Sprintf("198.51.100.%d", s.id()%254+1)
+		for _, pool := range c.NodePools {
+			for _, node := range pool.Nodes {
+				node.Status.State = "running"
+			}
+		}
+	})
+
+	r.write(http.StatusCreated, map[string]interface{}{"kubernetes_cluster": c})
+}
+
+// kubernetesVersion resolves a version slug, where "latest" or an empty slug
+// is the newest version. It returns an empty string for unknown versions.
+func (s *Server) kubernetesVersion(slug string) string {
+	if slug == "" || slug == "latest" {
+		return kubernetesVersions[0].Slug
+	}
+
+	for _, v := range kubernetesVersions {
+		if v.Slug == slug {
+			return slug
+		}
+	}
+
+	return ""
+}
+
+func (s *Server) newNodePool(req *godo.KubernetesNodePoolCreateRequest) (*godo.KubernetesNodePool, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("Node pool name is required.")
+	}
+	if findSize(req.Size) == nil {
+		return nil, fmt.Errorf("Node pool size %q is not available.", req.Size)
+	}
+	if req.Count < 1 {
+		return nil, fmt.Errorf("Node pool count must be at least 1.")
+	}
+
+	pool := &godo.KubernetesNodePool{
+		ID:        s.uuid(),
+		Name:      req.Name,
+		Size:      req.Size,
+		Count:     req.Count,
+		Tags:      req.Tags,
+		AutoScale: req.AutoScale,
+		MinNodes:  req.MinNodes,
+		MaxNodes:  req.MaxNodes,
+	}
+	s.resizeNodePool(pool, req.Count, "provisioning")
+
+	return pool, nil
+}
+
+// resizeNodePool adds or removes nodes until a pool has count nodes. New
+// nodes start in state.
+func (s *Server) resizeNodePool(pool *godo.KubernetesNodePool, count int, state string) {
+	for len(pool.Nodes) < count {
+		id := s.id()
+		pool.Nodes = append(pool.Nodes, &godo.KubernetesNode{
+			ID:        s.uuid(),
+			Name:      fmt.Sprintf("%s-%x", pool.Name, id),
+			Status:    &godo.KubernetesNodeStatus{State: state},
+			DropletID: fmt.Sprint(id),
+			CreatedAt: s.Now().UTC(),
+			UpdatedAt: s.Now().UTC(),
+		})
+	}
+	if len(pool.Nodes) > count {
+		pool.Nodes = pool.Nodes[:count]
+	}
+	pool.Count = count
+}
+
+func (s *Server) updateCluster(r *request) {
+	c := s.clusterParam(r)
+	if c == nil {
+		return
+	}
+
+	var req godo.KubernetesClusterUpdateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name != "" {
+		c.Name = req.Name
+	}
+	if req.Tags != nil {
+		c.Tags = append([]string{"k8s", "k8s:" + c.ID}, req.Tags...)
+	}
+	if req.MaintenancePolicy != nil {
+		c.MaintenancePolicy = req.MaintenancePolicy
+	}
+	if req.AutoUpgrade != nil {
+		c.AutoUpgrade = *req.AutoUpgrade
+	}
+	c.UpdatedAt = s.Now().UTC()
+
+	r.write(http.StatusAccepted, map[string]interface{}{"kubernetes_cluster": c})
+}
+
+func (s *Server) deleteCluster(r *request) {
+	c := s.clusterParam(r)
+	if c == nil {
+		return
+	}
+
+	clusters := s.clusters[:0]
+	for _, other := range s.clusters {
+		if other != c {
+			clusters = append(clusters, other)
+		}
+	}
+	s.clusters = clusters
+
+	r.noContent()
+}
+
+func (s *Server) getKubeconfig(r *request) {
+	c := s.clusterParam(r)
+	if c == nil {
+		return
+	}
+
+	r.w.Header().Set("Content-Type", "application/yaml")
+	fmt.Fprintf(r.w, kubeconfigTemplate, c.Endpoint, c.RegionSlug, c.Name)
+}
+
+func (s *Server) getClusterCredentials(r *request) {
+	c := s.clusterParam(r)
+	if c == nil {
+		return
+	}
+
+	r.write(http.StatusOK, &godo.KubernetesClusterCredentials{
+		Server:                   c.Endpoint,
+		CertificateAuthorityData: []byte("fake"),
+		Token:                    "fake-token",
+		ExpiresAt:                s.Now().UTC().Add(7 * 24 * time.Hour),
+	})
+}
+
+func (s *Server) getClusterUpgrades(r *request) {
+	c := s.clusterParam(r)
+	if c == nil {
+		return
+	}
+
+	upgrades := []*godo.KubernetesVersion{}
+	for _, v := range kubernetesVersions {
+		if v.Slug == c.VersionSlug {
+			break
+		}
+		upgrades = append(upgrades, v)
+	}
+
+	r.write(http.StatusOK, map[string]interface{}{"available_upgrade_versions": upgrades})
+}
+
+func (s *Server) nodePoolParam(r *request, c *godo.KubernetesCluster) *godo.KubernetesNodePool {
+	for _, pool := range c.NodePools {
+		if pool.ID == r.param("pool_id") {
+			return pool
+		}
+	}
+
+	r.notFound()
+	return nil
+}
+
+func (s *Server) listNodePools(r *request) {
+	if c := s.clusterParam(r); c != nil {
+		r.writePage("node_pools", c.NodePools)
+	}
+}
+
+func (s *Server) getNodePool(r *request) {
+	if c := s.clusterParam(r); c != nil {
+		if pool := s.nodePoolParam(r, c); pool != nil {
+			r.write(http.StatusOK, map[string]interface{}{"node_pool": pool})
+		}
+	}
+}
+
+func (s *Server) createNodePool(r *request) {
+	c := s.clusterParam(r)
+	if c == nil {
+		return
+	}
+
+	var req godo.KubernetesNodePoolCreateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	pool, err := s.newNodePool(&req)
+	if err != nil {
+		r.invalid("%v", err)
+		return
+	}
+	c.NodePools = append(c.NodePools, pool)
+
+	s.later(func() {
+		for _, node := range pool.Nodes {
+			node.Status.State = "running"
+		}
+	})
+
+	r.write(http.StatusCreated, map[string]interface{}{"node_pool": pool})
+}
+
+func (s *Server) updateNodePool(r *request) {
+	c := s.clusterParam(r)
+	if c == nil {
+		return
+	}
+
+	pool := s.nodePoolParam(r, c)
+	if pool == nil {
+		return
+	}
+
+	var req godo.KubernetesNodePoolUpdateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name != "" {
+		pool.Name = req.Name
+	}
+	if req.Tags != nil {
+		pool.Tags = req.Tags
+	}
+	if req.AutoScale != nil {
+		pool.AutoScale = *req.AutoScale
+	}
+	if req.MinNodes != nil {
+		pool.MinNodes = *req.MinNodes
+	}
+	if req.MaxNodes != nil {
+		pool.MaxNodes = *req.MaxNodes
+	}
+	if req.Count != nil {
+		if *req.Count < 1 {
+			r.invalid("Node pool count must be at least 1.")
+			return
+		}
+		s.resizeNodePool(pool, *req.Count, "running")
+	}
+
+	r.write(http.StatusAccepted, map[string]interface{}{"node_pool": pool})
+}
+
+func (s *Server) deleteNodePool(r *request) {
+	c := s.clusterParam(r)
+	if c == nil {
+		return
+	}
+
+	pool := s.nodePoolParam(r, c)
+	if pool == nil {
+		return
+	}
+
+	pools := c.NodePools[:0]
+	for _, other := range c.NodePools {
+		if other != pool {
+			pools = append(pools, other)
+		}
+	}
+	c.NodePools = pools
+
+	r.noContent()
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+func (s *Server) loadBalancerRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/load_balancers", s.listLoadBalancers),
+		newRoute(http.MethodPost, "/v2/load_balancers", s.createLoadBalancer),
+		newRoute(http.MethodGet, "/v2/load_balancers/{id}", s.getLoadBalancer),
+		newRoute(http.MethodPut, "/v2/load_balancers/{id}", s.updateLoadBalancer),
+		newRoute(http.MethodDelete, "/v2/load_balancers/{id}", s.deleteLoadBalancer),
+		newRoute(http.MethodPost, "/v2/load_balancers/{id}/droplets", s.loadBalancerDroplets(true)),
+		newRoute(http.MethodDelete, "/v2/load_balancers/{id}/droplets", s.loadBalancerDroplets(false)),
+		newRoute(http.MethodPost, "/v2/load_balancers/{id}/forwarding_rules", s.loadBalancerForwardingRules(true)),
+		newRoute(http.MethodDelete, "/v2/load_balancers/{id}/forwarding_rules", s.loadBalancerForwardingRules(false)),
+	}
+}
+
+func (s *Server) loadBalancerParam(r *request) *godo.LoadBalancer {
+	for _, lb := range s.loadBalancers {
+		if lb.ID == r.param("id") {
+			return lb
+		}
+	}
+
+	r.notFound()
+	return nil
+}
+
+func (s *Server) listLoadBalancers(r *request) {
+	r.writePage("load_balancers", s.loadBalancers)
+}
+
+func (s *Server) getLoadBalancer(r *request) {
+	if lb := s.loadBalancerParam(r); lb != nil {
+		r.write(http.StatusOK, map[string]interface{}{"load_balancer": lb})
+	}
+}
+
+// createLoadBalancer creates a load balancer, which becomes active once the
+// ActionDuration has passed.
+func (s *Server) createLoadBalancer(r *request) {
+	var req godo.LoadBalancerRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	region := findRegion(req.Region)
+	if region == nil {
+		r.invalid("Region is not available.")
+		return
+	}
+
+	n := s.id()
+	lb := &godo.LoadBalancer{
+		ID:      s.uuid(),
+		IP:      fmt.Sprintf("198.51.100.%d", n%254+1),
+		Status:  "new",
+		Created: s.created(),
+		Region:  region,
+	}
+	if !s.applyLoadBalancerRequest(r, lb, req) {
+		return
+	}
+	s.loadBalancers = append(s.loadBalancers, lb)
+
+	s.later(func() {
+		lb.Status = "active"
+	})
+
+	r.write(http.StatusAccepted, map[string]interface{}{"load_balancer": lb})
+}
+
+func (s *Server) updateLoadBalancer(r *request) {
+	lb := s.loadBalancerParam(r)
+	if lb == nil {
+		return
+	}
+
+	var req godo.LoadBalancerRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if s.applyLoadBalancerRequest(r, lb, req) {
+		r.write(http.StatusOK, map[string]interface{}{"load_balancer": lb})
+	}
+}
+
+// applyLoadBalancerRequest replaces a load balancer's configuration,
+// writing an error when the request is invalid.
+func (s *Server) applyLoadBalancerRequest(r *request, lb *godo.LoadBalancer, req godo.LoadBalancerRequest) bool {
+	if req.Name == "" {
+		r.invalid("Name is required.")
+		return false
+	}
+	if len(req.ForwardingRules) == 0 {
+		r.invalid("At least one forwarding rule is required.")
+		return false
+	}
+	if len(req.DropletIDs) > 0 && req.Tag != "" {
+		r.invalid("Specify droplet ids or a tag, not both.")
+		return false
+	}
+	for _, id := range req.DropletIDs {
+		d := s.findDroplet(id)
+		if d == nil {
+			r.invalid("Droplet %d could not be found.", id)
+			return false
+		}
+		if d.Region.Slug != lb.Region.Slug {
+			r.invalid("Droplet %d is not in region %s.", id, lb.Region.Slug)
+			return false
+		}
+	}
+
+	lb.Name = req.Name
+	lb.Algorithm = req.Algorithm
+	if lb.Algorithm == "" {
+		lb.Algorithm = "round_robin"
+	}
+	lb.ForwardingRules = append([]godo.ForwardingRule{}, req.ForwardingRules...)
+	lb.HealthCheck = req.HealthCheck
+	if lb.HealthCheck == nil {
+		lb.HealthCheck = &godo.HealthCheck{
+			Protocol:               "http",
+			Port:                   80,
+			Path:                   "/",
+			CheckIntervalSeconds:   10,
+			ResponseTimeoutSeconds: 5,
+			HealthyThreshold:       5,
+			UnhealthyThreshold:     3,
+		}
+	}
+	lb.StickySessions = req.StickySessions
+	if lb.StickySessions == nil {
+		lb.StickySessions = &godo.StickySessions{Type: "none"}
+	}
+	lb.DropletIDs = append([]int{}, req.DropletIDs...)
+	lb.Tag = req.Tag
+	lb.RedirectHttpToHttps = req.RedirectHttpToHttps
+	lb.EnableProxyProtocol = req.EnableProxyProtocol
+	lb.VPCUUID = req.VPCUUID
+
+	if lb.Tag != "" {
+		s.ensureTag(lb.Tag)
+	}
+
+	return true
+}
+
+func (s *Server) deleteLoadBalancer(r *request) {
+	lb := s.loadBalancerParam(r)
+	if lb == nil {
+		return
+	}
+
+	loadBalancers := s.loadBalancers[:0]
+	for _, other := range s.loadBalancers {
+		if other != lb {
+			loadBalancers = append(loadBalancers, other)
+		}
+	}
+	s.loadBalancers = loadBalancers
+
+	r.noContent()
+}
+
+func (s *Server) loadBalancerDroplets(add bool) func(*request) {
+	return func(r *request) {
+		lb := s.loadBalancerParam(r)
+		if lb == nil {
+			return
+		}
+
+		var req struct {
+			DropletIDs []int `json:"droplet_ids"`
+		}
+		if !r.decode(&req) {
+			return
+		}
+
+		if lb.Tag != "" {
+			r.invalid("Droplets of a load balancer using a tag cannot be changed.")
+			return
+		}
+		for _, id := range req.DropletIDs {
+			if s.findDroplet(id) == nil {
+				r.invalid("Droplet %d could not be found.", id)
+				return
+			}
+		}
+
+		for _, id := range req.DropletIDs {
+			lb.DropletIDs = removeInt(lb.DropletIDs, id)
+			if add {
+				lb.DropletIDs = append(lb.DropletIDs, id)
+			}
+		}
+		r.noContent()
+	}
+}
+
+func (s *Server) loadBalancerForwardingRules(add bool) func(*request) {
+	return func(r *request) {
+		lb := s.loadBalancerParam(r)
+		if lb == nil {
+			return
+		}
+
+		var req struct {
+			Rules []godo.ForwardingRule `json:"forwarding_rules"`
+		}
+		if !r.decode(&req) {
+			return
+		}
+
+		for _, rule := range req.Rules {
+			rules := []godo.ForwardingRule{}
+			for _, existing := range lb.ForwardingRules {
+				if existing != rule {
+					rules = append(rules, existing)
+				}
+			}
+			if add {
+				rules = append(rules, rule)
+			}
+			lb.ForwardingRules = rules
+		}
+		r.noContent()
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+func (s *Server) projectRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/projects", s.listProjects),
+		newRoute(http.MethodPost, "/v2/projects", s.createProject),
+		newRoute(http.MethodGet, "/v2/projects/{id}", s.getProject),
+		newRoute(http.MethodDelete, "/v2/projects/{id}", s.deleteProject),
+		newRoute(http.MethodGet, "/v2/projects/{id}/resources", s.listProjectResources),
+		newRoute(http.MethodPost, "/v2/projects/{id}/resources", s.assignProjectResources),
+	}
+}
+
+// defaultProject returns the default project, creating it on first use.
+func (s *Server) defaultProject() *godo.Project {
+	for _, p := range s.projects {
+		if p.IsDefault {
+			return p
+		}
+	}
+
+	p := &godo.Project{
+		ID:          s.uuid(),
+		Name:        "first-project",
+		Purpose:     "Just trying out DigitalOcean",
+		Environment: "Development",
+		IsDefault:   true,
+		CreatedAt:   s.created(),
+		UpdatedAt:   s.created(),
+	}
+	s.projects = append(s.projects, p)
+
+	return p
+}
+
+// projectParam returns the project whose ID is in the path, or the default
+// project for the ID default.
+func (s *Server) projectParam(r *request) *godo.Project {
+	if r.param("id") == godo.DefaultProject {
+		return s.defaultProject()
+	}
+
+	for _, p := range s.projects {
+		if p.ID == r.param("id") {
+			return p
+		}
+	}
+
+	r.notFound()
+	return nil
+}
+
+func (s *Server) listProjects(r *request) {
+	s.defaultProject()
+	r.writePage("projects", s.projects)
+}
+
+func (s *Server) getProject(r *request) {
+	if p := s.projectParam(r); p != nil {
+		r.write(http.StatusOK, map[string]interface{}{"project": p})
+	}
+}
+
+func (s *Server) createProject(r *request) {
+	var req godo.CreateProjectRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name == "" || req.Purpose == "" {
+		r.invalid("Name and purpose are required.")
+		return
+	}
+
+	s.defaultProject()
+	p := &godo.Project{
+		ID:          s.uuid(),
+		Name:        req.Name,
+		Description: req.Description,
+		Purpose:     req.Purpose,
+		Environment: req.Environment,
+		CreatedAt:   s.created(),
+		UpdatedAt:   s.created(),
+	}
+	s.projects = append(s.projects, p)
+
+	r.write(http.StatusCreated, map[string]interface{}{"project": p})
+}
+
+func (s *Server) deleteProject(r *request) {
+	p := s.projectParam(r)
+	if p == nil {
+		return
+	}
+
+	if p.IsDefault {
+		r.invalid("The default project can't be deleted.")
+		return
+	}
+	if len(s.projectResources[p.ID]) > 0 {
+		r.invalid("Projects with resources can't be deleted.")
+		return
+	}
+
+	projects := s.projects[:0]
+	for _, other := range s.projects {
+		if other != p {
+			projects = append(projects, other)
+		}
+	}
+	s.projects = projects
+
+	r.noContent()
+}
+
+func (s *Server) listProjectResources(r *request) {
+	if p := s.projectParam(r); p != nil {
+		resources := s.projectResources[p.ID]
+		if resources == nil {
+			resources = []godo.ProjectResource{}
+		}
+
+		r.writePage("resources", resources)
+	}
+}
+
+// assignProjectResources moves resources, given by URN, to a project. A
+// resource belongs to one project at most. Resources are not assigned to
+// the default project when they are created.
+func (s *Server) assignProjectResources(r *request) {
+	p := s.projectParam(r)
+	if p == nil {
+		return
+	}
+
+	var req struct {
+		Resources []string `json:"resources"`
+	}
+	if !r.decode(&req) {
+		return
+	}
+
+	var assigned []godo.ProjectResource
+	for _, urn := range req.Resources {
+		for id, resources := range s.projectResources {
+			kept := resources[:0]
+			for _, res := range resources {
+				if res.URN != urn {
+					kept = append(kept, res)
+				}
+			}
+			s.projectResources[id] = kept
+		}
+
+		res := godo.ProjectResource{
+			URN:        urn,
+			AssignedAt: s.created(),
+			Links:      &godo.ProjectResourceLinks{Self: fmt.Sprintf("%s/v2/projects/%s/resources", r.baseURL(), p.ID)},
+			Status:     "ok",
+		}
+		s.projectResources[p.ID] = append(s.projectResources[p.ID], res)
+		assigned = append(assigned, res)
+	}
+
+	r.write(http.StatusOK, map[string]interface{}{"resources": assigned})
+}
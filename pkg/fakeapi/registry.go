@@ -0,0 +1,87 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"encoding/base64"
+	"net/http"
+	"regexp"
+
+	"github.com/digitalocean/godo"
+)
+
+const registryHost = "registry.digitalocean.com"
+
+var registryNameRe = regexp.MustCompile(`^[a-z0-9-]{1,63}$`)
+
+func (s *Server) registryRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/registry", s.getRegistry),
+		newRoute(http.MethodPost, "/v2/registry", s.createRegistry),
+		newRoute(http.MethodDelete, "/v2/registry", s.deleteRegistry),
+		newRoute(http.MethodGet, "/v2/registry/docker-credentials", s.getDockerCredentials),
+	}
+}
+
+func (s *Server) getRegistry(r *request) {
+	if s.registry == nil {
+		r.notFound()
+		return
+	}
+
+	r.write(http.StatusOK, map[string]interface{}{"registry": s.registry})
+}
+
+func (s *Server) createRegistry(r *request) {
+	var req godo.RegistryCreateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if s.registry != nil {
+		writeError(r.w, http.StatusConflict, "conflict", "A registry already exists for this account.")
+		return
+	}
+	if !registryNameRe.MatchString(req.Name) {
+		r.invalid("Registry names may only contain lowercase letters, numbers and dashes.")
+		return
+	}
+
+	s.registry = &godo.Registry{Name: req.Name}
+	r.write(http.StatusCreated, map[string]interface{}{"registry": s.registry})
+}
+
+func (s *Server) deleteRegistry(r *request) {
+	if s.registry == nil {
+		r.notFound()
+		return
+	}
+
+	s.registry = nil
+	r.noContent()
+}
+
+func (s *Server) getDockerCredentials(r *request) {
+	if s.registry == nil {
+		r.notFound()
+		return
+	}
+
+	auth := base64.StdEncoding.EncodeToString([]byte("fake-token:fake-token"))
+	r.write(http.StatusOK, map[string]interface{}{
+		"auths": map[string]interface{}{
+			registryHost: map[string]string{"auth": auth},
+		},
+	})
+}
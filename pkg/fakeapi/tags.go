@@ -0,0 +1,209 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+)
+
+var tagNameRe = regexp.MustCompile(`^[a-zA-Z0-9_\-:]{1,255}$`)
+
+func (s *Server) tagRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/tags", s.listTags),
+		newRoute(http.MethodPost, "/v2/tags", s.createTag),
+		newRoute(http.MethodGet, "/v2/tags/{name}", s.getTag),
+		newRoute(http.MethodDelete, "/v2/tags/{name}", s.deleteTag),
+		newRoute(http.MethodPost, "/v2/tags/{name}/resources", s.tagResources),
+		newRoute(http.MethodDelete, "/v2/tags/{name}/resources", s.untagResources),
+	}
+}
+
+// ensureTag creates a tag if it does not exist yet, as tagging a resource
+// does.
+func (s *Server) ensureTag(name string) {
+	if !containsString(s.tags, name) {
+		s.tags = append(s.tags, name)
+	}
+}
+
+// tag describes a tag and the resources it is applied to.
+func (s *Server) tag(name string) *godo.Tag {
+	droplets := s.dropletsTagged(name)
+
+	volumes := 0
+	for _, v := range s.volumes {
+		if containsString(v.Tags, name) {
+			volumes++
+		}
+	}
+
+	databases := 0
+	for _, db := range s.databases {
+		if containsString(db.Tags, name) {
+			databases++
+		}
+	}
+
+	t := &godo.Tag{
+		Name: name,
+		Resources: &godo.TaggedResources{
+			Count:           len(droplets) + volumes + databases,
+			Droplets:        &godo.TaggedDropletsResources{Count: len(droplets)},
+			Images:          &godo.TaggedImagesResources{},
+			Volumes:         &godo.TaggedVolumesResources{Count: volumes},
+			VolumeSnapshots: &godo.TaggedVolumeSnapshotsResources{},
+			Databases:       &godo.TaggedDatabasesResources{Count: databases},
+		},
+	}
+	if len(droplets) > 0 {
+		t.Resources.Droplets.LastTagged = droplets[len(droplets)-1]
+	}
+
+	return t
+}
+
+func (s *Server) tagParam(r *request) (string, bool) {
+	name := r.param("name")
+	if !containsString(s.tags, name) {
+		r.notFound()
+		return "", false
+	}
+
+	return name, true
+}
+
+func (s *Server) listTags(r *request) {
+	tags := make([]*godo.Tag, 0, len(s.tags))
+	for _, name := range s.tags {
+		tags = append(tags, s.tag(name))
+	}
+
+	r.writePage("tags", tags)
+}
+
+func (s *Server) getTag(r *request) {
+	if name, ok := s.tagParam(r); ok {
+		r.write(http.StatusOK, map[string]interface{}{"tag": s.tag(name)})
+	}
+}
+
+func (s *Server) createTag(r *request) {
+	var req godo.TagCreateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if !tagNameRe.MatchString(req.Name) {
+		r.invalid("Tag names may only contain letters, numbers, colons, dashes and underscores.")
+		return
+	}
+
+	s.ensureTag(req.Name)
+	r.write(http.StatusCreated, map[string]interface{}{"tag": s.tag(req.Name)})
+}
+
+func (s *Server) deleteTag(r *request) {
+	name, ok := s.tagParam(r)
+	if !ok {
+		return
+	}
+
+	s.tags = removeString(s.tags, name)
+	for _, d := range s.droplets {
+		d.Tags = removeString(d.Tags, name)
+	}
+	for _, v := range s.volumes {
+		v.Tags = removeString(v.Tags, name)
+	}
+	for _, db := range s.databases {
+		db.Tags = removeString(db.Tags, name)
+	}
+	for _, fw := range s.firewalls {
+		fw.Tags = removeString(fw.Tags, name)
+	}
+
+	r.noContent()
+}
+
+func (s *Server) tagResources(r *request) {
+	s.changeTags(r, func(tags []string, name string) []string {
+		if containsString(tags, name) {
+			return tags
+		}
+		return append(tags, name)
+	})
+}
+
+func (s *Server) untagResources(r *request) {
+	s.changeTags(r, removeString)
+}
+
+// changeTags applies change to the tags of each resource in the request.
+func (s *Server) changeTags(r *request, change func(tags []string, name string) []string) {
+	name, ok := s.tagParam(r)
+	if !ok {
+		return
+	}
+
+	var req godo.TagResourcesRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	var updates []func()
+	for _, res := range req.Resources {
+		tags, err := s.resourceTags(res)
+		if err != nil {
+			r.invalid("%v", err)
+			return
+		}
+		updates = append(updates, func() { *tags = change(*tags, name) })
+	}
+
+	for _, update := range updates {
+		update()
+	}
+	r.noContent()
+}
+
+// resourceTags returns the tags of a taggable resource.
+func (s *Server) resourceTags(res godo.Resource) (*[]string, error) {
+	switch res.Type {
+	case godo.DropletResourceType:
+		id, err := strconv.Atoi(res.ID)
+		if err == nil {
+			if d := s.findDroplet(id); d != nil {
+				return &d.Tags, nil
+			}
+		}
+	case godo.VolumeResourceType:
+		if v := s.findVolume(res.ID); v != nil {
+			return &v.Tags, nil
+		}
+	case godo.DatabaseResourceType:
+		if db := s.findDatabase(res.ID); db != nil {
+			return &db.Tags, nil
+		}
+	default:
+		return nil, fmt.Errorf("Resource type %q cannot be tagged.", res.Type)
+	}
+
+	return nil, fmt.Errorf("%s %s could not be found.", res.Type, res.ID)
+}
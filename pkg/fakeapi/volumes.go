@@ -0,0 +1,235 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeapi
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+)
+
+// volumeActionRequest is a request to attach, detach or resize a volume.
+type volumeActionRequest struct {
+	Type          string `json:"type"`
+	DropletID     int    `json:"droplet_id"`
+	SizeGigabytes int64  `json:"size_gigabytes"`
+}
+
+func (s *Server) volumeRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/v2/volumes", s.listVolumes),
+		newRoute(http.MethodPost, "/v2/volumes", s.createVolume),
+		newRoute(http.MethodGet, "/v2/volumes/{id}", s.getVolume),
+		newRoute(http.MethodDelete, "/v2/volumes/{id}", s.deleteVolume),
+		newRoute(http.MethodGet, "/v2/volumes/{id}/actions", s.listVolumeActions),
+		newRoute(http.MethodPost, "/v2/volumes/{id}/actions", s.createVolumeAction),
+		newRoute(http.MethodGet, "/v2/volumes/{id}/actions/{action_id}", s.getVolumeAction),
+	}
+}
+
+func (s *Server) findVolume(id string) *godo.Volume {
+	for _, v := range s.volumes {
+		if v.ID == id {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) volumeParam(r *request) *godo.Volume {
+	v := s.findVolume(r.param("id"))
+	if v == nil {
+		r.notFound()
+	}
+
+	return v
+}
+
+func (s *Server) listVolumes(r *request) {
+	q := r.URL.Query()
+
+	volumes := []*godo.Volume{}
+	for _, v := range s.volumes {
+		if name := q.Get("name"); name != "" && v.Name != name {
+			continue
+		}
+		if region := q.Get("region"); region != "" && v.Region.Slug != region {
+			continue
+		}
+		volumes = append(volumes, v)
+	}
+
+	r.writePage("volumes", volumes)
+}
+
+func (s *Server) getVolume(r *request) {
+	if v := s.volumeParam(r); v != nil {
+		r.write(http.StatusOK, map[string]interface{}{"volume": v})
+	}
+}
+
+func (s *Server) createVolume(r *request) {
+	var req godo.VolumeCreateRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	if req.Name == "" {
+		r.invalid("Name is required.")
+		return
+	}
+	region := findRegion(req.Region)
+	if region == nil {
+		r.invalid("Region is not available.")
+		return
+	}
+	if req.SizeGigaBytes < 1 {
+		r.invalid("Size must be at least 1 GiB.")
+		return
+	}
+
+	v := &godo.Volume{
+		ID:              s.uuid(),
+		Region:          region,
+		Name:            req.Name,
+		SizeGigaBytes:   req.SizeGigaBytes,
+		Description:     req.Description,
+		DropletIDs:      []int{},
+		CreatedAt:       s.Now().UTC(),
+		FilesystemType:  req.FilesystemType,
+		FilesystemLabel: req.FilesystemLabel,
+		Tags:            append([]string{}, req.Tags...),
+	}
+	for _, tag := range v.Tags {
+		s.ensureTag(tag)
+	}
+	s.volumes = append(s.volumes, v)
+
+	r.write(http.StatusCreated, map[string]interface{}{"volume": v})
+}
+
+func (s *Server) deleteVolume(r *request) {
+	v := s.volumeParam(r)
+	if v == nil {
+		return
+	}
+
+	if len(v.DropletIDs) > 0 {
+		writeError(r.w, http.StatusConflict, "conflict", "Volume is attached to a droplet; detach it first.")
+		return
+	}
+
+	volumes := s.volumes[:0]
+	for _, other := range s.volumes {
+		if other != v {
+			volumes = append(volumes, other)
+		}
+	}
+	s.volumes = volumes
+
+	r.noContent()
+}
+
+func (s *Server) listVolumeActions(r *request) {
+	v := s.volumeParam(r)
+	if v == nil {
+		return
+	}
+
+	actions := []*godo.Action{}
+	for _, id := range s.volumeActions[v.ID] {
+		actions = append(actions, s.findAction(id))
+	}
+
+	r.writePage("actions", actions)
+}
+
+func (s *Server) getVolumeAction(r *request) {
+	v := s.volumeParam(r)
+	if v == nil {
+		return
+	}
+
+	for _, id := range s.volumeActions[v.ID] {
+		if strconv.Itoa(id) == r.param("action_id") {
+			r.write(http.StatusOK, map[string]interface{}{"action": s.findAction(id)})
+			return
+		}
+	}
+
+	r.notFound()
+}
+
+func (s *Server) createVolumeAction(r *request) {
+	v := s.volumeParam(r)
+	if v == nil {
+		return
+	}
+
+	var req volumeActionRequest
+	if !r.decode(&req) {
+		return
+	}
+
+	var effect func()
+	switch req.Type {
+	case "attach", "detach":
+		d := s.findDroplet(req.DropletID)
+		if d == nil {
+			r.invalid("Droplet %d could not be found.", req.DropletID)
+			return
+		}
+		if d.Region.Slug != v.Region.Slug {
+			r.invalid("Droplet and volume must be in the same region.")
+			return
+		}
+
+		attached := containsInt(v.DropletIDs, d.ID)
+		if req.Type == "attach" {
+			if attached {
+				r.invalid("Volume is already attached to this droplet.")
+				return
+			}
+			effect = func() {
+				v.DropletIDs = append(v.DropletIDs, d.ID)
+				d.VolumeIDs = append(d.VolumeIDs, v.ID)
+			}
+		} else {
+			if !attached {
+				r.invalid("Volume is not attached to this droplet.")
+				return
+			}
+			effect = func() {
+				v.DropletIDs = removeInt(v.DropletIDs, d.ID)
+				d.VolumeIDs = removeString(d.VolumeIDs, v.ID)
+			}
+		}
+	case "resize":
+		if req.SizeGigabytes <= v.SizeGigaBytes {
+			r.invalid("Volumes can only be resized to a larger size.")
+			return
+		}
+		effect = func() { v.SizeGigaBytes = req.SizeGigabytes }
+	default:
+		r.invalid("Action type %q is not supported.", req.Type)
+		return
+	}
+
+	a := s.startAction(req.Type+"_volume", "volume", 0, v.Region, effect)
+	s.volumeActions[v.ID] = append(s.volumeActions[v.ID], a.ID)
+
+	r.write(http.StatusAccepted, map[string]interface{}{"action": a})
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultHTTPRetryMax is the number of times a request is retried when
+	// http-retry-max is not configured.
+	DefaultHTTPRetryMax = 3
+
+	headerRetryAfter    = "Retry-After"
+	headerRateLimit     = "RateLimit-Limit"
+	headerRateRemaining = "RateLimit-Remaining"
+	headerRateReset     = "RateLimit-Reset"
+
+	// throttleFraction is the share of the rate limit below which requests
+	// are spread out over the time remaining until the limit resets.
+	throttleFraction = 0.05
+)
+
+// retryTransport retries requests that were rate limited or hit a transient
+// server error, and slows requests down as the account approaches its rate
+// limit.
+type retryTransport struct {
+	wrap       http.RoundTripper
+	maxRetries int
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	// maxWait is the longest a server-requested delay is honored for. Longer
+	// delays return the response instead of stalling the command.
+	maxWait time.Duration
+
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+
+	mu        sync.Mutex
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+func newRetryTransport(transport http.RoundTripper, maxRetries int) *retryTransport {
+	return &retryTransport{
+		wrap:       transport,
+		maxRetries: maxRetries,
+		minBackoff: 500 * time.Millisecond,
+		maxBackoff: 30 * time.Second,
+		maxWait:    2 * time.Minute,
+		now:        time.Now,
+		sleep:      sleepContext,
+		remaining:  -1,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if err := t.sleep(ctx, t.throttleDelay()); err != nil {
+			return nil, err
+		}
+
+		r := req
+		if attempt > 0 {
+			var err error
+			if r, err = rewindRequest(req); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.wrap.RoundTrip(r)
+		if err == nil {
+			t.observe(resp)
+		}
+
+		wait, ok := t.retryDelay(req, resp, err, attempt)
+		if !ok {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryDelay reports whether a request should be retried and how long to
+// wait before doing so.
+func (t *retryTransport) retryDelay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= t.maxRetries || req.Context().Err() != nil {
+		return 0, false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+
+	backoff := t.backoff(attempt)
+
+	switch {
+	case err != nil:
+		var miss *cassetteMissError
+		if errors.As(err, &miss) {
+			return 0, false
+		}
+		return backoff, isIdempotent(req.Method)
+	case resp.StatusCode == http.StatusTooManyRequests:
+		// A rate limited request was never processed, so it is safe to
+		// retry regardless of its method.
+		wait, ok := t.serverDelay(resp)
+		if !ok {
+			return backoff, true
+		}
+		return wait, wait <= t.maxWait
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		if !isIdempotent(req.Method) {
+			return 0, false
+		}
+		if wait, ok := t.serverDelay(resp); ok && wait > backoff {
+			if wait > t.maxWait {
+				return 0, false
+			}
+			return wait, true
+		}
+		return backoff, true
+	}
+
+	return 0, false
+}
+
+// backoff returns a jittered, exponentially increasing delay for the given
+// attempt. Half the delay is fixed and half is random so that concurrent
+// clients spread out their retries.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	d := t.minBackoff << uint(attempt)
+	if d <= 0 || d > t.maxBackoff {
+		d = t.maxBackoff
+	}
+
+	half := int64(d / 2)
+	if half <= 0 {
+		return d
+	}
+	return time.Duration(half + rand.Int63n(half))
+}
+
+// serverDelay returns how long the API asked the client to wait, taken from
+// Retry-After or, failing that, from when the rate limit resets.
+func (t *retryTransport) serverDelay(resp *http.Response) (time.Duration, bool) {
+	if v := resp.Header.Get(headerRetryAfter); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return time.Duration(secs) * time.Second, true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return nonNegative(at.Sub(t.now())), true
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if v := resp.Header.Get(headerRateReset); v != "" {
+			if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return nonNegative(time.Unix(secs, 0).Sub(t.now())), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// observe records the rate limit state reported by the API.
+func (t *retryTransport) observe(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get(headerRateRemaining))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get(headerRateReset), 10, 64)
+	if err != nil {
+		return
+	}
+	limit, _ := strconv.Atoi(resp.Header.Get(headerRateLimit))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.limit = limit
+	t.remaining = remaining
+	t.reset = time.Unix(reset, 0)
+}
+
+// throttleDelay returns how long to hold a request back so the remaining
+// rate limit budget is spread evenly until it resets.
+func (t *retryTransport) throttleDelay() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.remaining < 0 || t.limit <= 0 {
+		return 0
+	}
+	if float64(t.remaining) > float64(t.limit)*throttleFraction {
+		return 0
+	}
+
+	untilReset := t.reset.Sub(t.now())
+	if untilReset <= 0 {
+		return 0
+	}
+
+	d := untilReset / time.Duration(t.remaining+1)
+	if d > t.maxBackoff {
+		d = t.maxBackoff
+	}
+
+	return d
+}
+
+// rewindRequest returns a copy of req with a fresh body so it can be sent
+// again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+
+	return r, nil
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// sleepContext waits for d to elapse or ctx to be done, whichever is first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRetryClient returns a client using a retryTransport that records the
+// delays it would sleep for instead of sleeping.
+func testRetryClient(maxRetries int) (*http.Client, *retryTransport, *[]time.Duration) {
+	var (
+		mu     sync.Mutex
+		sleeps []time.Duration
+	)
+
+	rt := newRetryTransport(http.DefaultTransport, maxRetries)
+	rt.sleep = func(ctx context.Context, d time.Duration) error {
+		if d > 0 {
+			mu.Lock()
+			sleeps = append(sleeps, d)
+			mu.Unlock()
+		}
+		return ctx.Err()
+	}
+
+	return &http.Client{Transport: rt}, rt, &sleeps
+}
+
+func TestRetryTransport(t *testing.T) {
+	t.Run("retries 429s honoring Retry-After", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.Header().Set("Retry-After", "2")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		client, _, sleeps := testRetryClient(3)
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second}, *sleeps)
+	})
+
+	t.Run("waits for the rate limit to reset when Retry-After is missing", func(t *testing.T) {
+		calls := 0
+		now := time.Unix(1000, 0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("RateLimit-Limit", "5000")
+				w.Header().Set("RateLimit-Remaining", "0")
+				w.Header().Set("RateLimit-Reset", strconv.FormatInt(now.Add(7*time.Second).Unix(), 10))
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		client, rt, sleeps := testRetryClient(3)
+		rt.now = func() time.Time { return now }
+		rt.maxBackoff = time.Minute
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 2, calls)
+		// The first sleep is the 429 retry, the second is the throttle
+		// delay before the retried request is sent.
+		require.Len(t, *sleeps, 2)
+		assert.Equal(t, 7*time.Second, (*sleeps)[0])
+		assert.Equal(t, 7*time.Second, (*sleeps)[1])
+	})
+
+	t.Run("gives up after the maximum number of retries", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client, _, _ := testRetryClient(2)
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("does not honor excessively long Retry-After", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client, _, _ := testRetryClient(3)
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("retries transient errors for idempotent requests with their body", func(t *testing.T) {
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			if len(bodies) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		client, _, sleeps := testRetryClient(3)
+
+		req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"web-01"}`))
+		require.NoError(t, err)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []string{`{"name":"web-01"}`, `{"name":"web-01"}`}, bodies)
+		require.Len(t, *sleeps, 1)
+		assert.True(t, (*sleeps)[0] >= 250*time.Millisecond && (*sleeps)[0] <= 500*time.Millisecond)
+	})
+
+	t.Run("does not retry transient errors for non-idempotent requests", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client, _, _ := testRetryClient(3)
+
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _, _ := testRetryClient(3)
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("slows down as the rate limit runs low", func(t *testing.T) {
+		now := time.Unix(1000, 0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("RateLimit-Limit", "5000")
+			w.Header().Set("RateLimit-Remaining", "99")
+			w.Header().Set("RateLimit-Reset", strconv.FormatInt(now.Add(100*time.Second).Unix(), 10))
+			w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		client, rt, sleeps := testRetryClient(3)
+		rt.now = func() time.Time { return now }
+
+		for i := 0; i < 2; i++ {
+			resp, err := client.Get(server.URL)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}
+
+		assert.Equal(t, []time.Duration{time.Second}, *sleeps)
+	})
+
+	t.Run("stops waiting when the request is cancelled", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		rt := newRetryTransport(http.DefaultTransport, 3)
+		client := &http.Client{Transport: rt}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		start := time.Now()
+		_, err = client.Do(req.WithContext(ctx))
+		assert.Error(t, err)
+		assert.True(t, time.Since(start) < 5*time.Second)
+	})
+}
@@ -0,0 +1,466 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptrace"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tracer traces the HTTP requests made to the API. It writes readable dumps
+// of each request and response to log, and records them in a HAR 1.2
+// document at harPath. Tokens and secrets are redacted from both. Failing to
+// write the HAR document is reported to warnings once, and doesn't fail the
+// requests.
+type tracer struct {
+	wrap     http.RoundTripper
+	redactor *redactor
+	log      io.Writer
+	harPath  string
+	warnings io.Writer
+	now      func() time.Time
+
+	mu sync.Mutex
+	// har is the HAR document being written, harEnd the offset where its
+	// next entry goes and harTail what closes it.
+	har     *os.File
+	harEnd  int64
+	harTail []byte
+	entries int
+}
+
+func newTracer(transport http.RoundTripper, log io.Writer, harPath string, secrets ...string) *tracer {
+	return &tracer{
+		wrap:     transport,
+		redactor: newRedactor(secrets...),
+		log:      log,
+		harPath:  harPath,
+		warnings: os.Stderr,
+		now:      time.Now,
+	}
+}
+
+func (t *tracer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var ct connTimes
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), ct.clientTrace(t.now)))
+
+	reqBody, err := readAndRestore(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("tracing request: %v", err)
+	}
+
+	start := t.now()
+	resp, rerr := t.wrap.RoundTrip(req)
+	returned := t.now()
+
+	var respBody []byte
+	if rerr == nil {
+		if respBody, err = readAndRestore(&resp.Body); err != nil {
+			return nil, fmt.Errorf("tracing response: %v", err)
+		}
+	}
+	end := t.now()
+
+	entry := t.entry(req, reqBody, resp, respBody, rerr)
+	entry.StartedDateTime = start.Format(time.RFC3339Nano)
+	entry.Timings = ct.timings(start, returned, end)
+	entry.Time = msBetween(start, end)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.log != nil {
+		t.dump(entry)
+	}
+
+	if t.harPath != "" {
+		if err := t.writeHAR(entry); err != nil {
+			fmt.Fprintf(t.warnings, "Warning: unable to write the trace file, no more requests are recorded: %v\n", err)
+			t.harPath = ""
+		}
+	}
+
+	return resp, rerr
+}
+
+// entry builds the redacted HAR entry for a request and its response.
+func (t *tracer) entry(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, rerr error) harEntry {
+	e := harEntry{
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: httpVersion(req.ProtoMajor, req.ProtoMinor),
+			Cookies:     []harNameValue{},
+			Headers:     t.headers(req.Header),
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Cache: struct{}{},
+	}
+
+	q := req.URL.Query()
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range q[k] {
+			e.Request.QueryString = append(e.Request.QueryString, harNameValue{Name: k, Value: v})
+		}
+	}
+
+	if len(reqBody) > 0 {
+		e.Request.PostData = &harPostData{
+			MimeType: req.Header.Get("Content-Type"),
+			Text:     string(t.redactor.body(req.URL.Path, reqBody)),
+		}
+	}
+
+	e.Response = harResponse{
+		Cookies:     []harNameValue{},
+		Headers:     []harNameValue{},
+		HeadersSize: -1,
+		BodySize:    -1,
+	}
+
+	if rerr != nil {
+		e.Response.StatusText = rerr.Error()
+		e.Response.Content = harContent{MimeType: "x-unknown"}
+		return e
+	}
+
+	e.Response.Status = resp.StatusCode
+	e.Response.StatusText = strings.TrimSpace(strings.TrimPrefix(resp.Status, fmt.Sprint(resp.StatusCode)))
+	e.Response.HTTPVersion = httpVersion(resp.ProtoMajor, resp.ProtoMinor)
+	e.Response.Headers = t.headers(resp.Header)
+	e.Response.RedirectURL = resp.Header.Get("Location")
+	e.Response.BodySize = len(respBody)
+	e.Response.Content = harContent{
+		Size:     len(respBody),
+		MimeType: resp.Header.Get("Content-Type"),
+		Text:     string(t.redactor.body(req.URL.Path, respBody)),
+	}
+
+	return e
+}
+
+func (t *tracer) headers(h http.Header) []harNameValue {
+	redacted := t.redactor.headers(h)
+
+	keys := make([]string, 0, len(redacted))
+	for k := range redacted {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := []harNameValue{}
+	for _, k := range keys {
+		for _, v := range redacted[k] {
+			out = append(out, harNameValue{Name: k, Value: v})
+		}
+	}
+
+	return out
+}
+
+// dump writes a readable version of an entry to the log.
+func (t *tracer) dump(e harEntry) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "-> %s %s\n", e.Request.Method, e.Request.URL)
+	for _, h := range e.Request.Headers {
+		fmt.Fprintf(&b, "   %s: %s\n", h.Name, h.Value)
+	}
+	if e.Request.PostData != nil {
+		writeIndented(&b, e.Request.PostData.Text)
+	}
+
+	if e.Response.Status == 0 {
+		fmt.Fprintf(&b, "<- error after %.0fms: %s\n", e.Time, e.Response.StatusText)
+	} else {
+		fmt.Fprintf(&b, "<- %d %s (%.0fms)\n", e.Response.Status, e.Response.StatusText, e.Time)
+		for _, h := range e.Response.Headers {
+			fmt.Fprintf(&b, "   %s: %s\n", h.Name, h.Value)
+		}
+		writeIndented(&b, e.Response.Content.Text)
+	}
+
+	io.WriteString(t.log, b.String())
+}
+
+// writeHAR adds an entry to the HAR document, creating it first if needed.
+// Only the entry and the end of the document are written, so the file is
+// complete after each request even if the command exits abruptly.
+func (t *tracer) writeHAR(e harEntry) error {
+	if t.har == nil {
+		f, err := os.OpenFile(t.harPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+
+		b, err := json.MarshalIndent(harDocument{Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "doctl", Version: DoitVersion.String()},
+			Entries: []harEntry{},
+		}}, "", "  ")
+		if err != nil {
+			f.Close()
+			return err
+		}
+
+		// The entries are last, their list is left open.
+		i := bytes.LastIndex(b, []byte("[]")) + 1
+		if _, err := f.Write(b[:i]); err != nil {
+			f.Close()
+			return err
+		}
+		t.har, t.harEnd, t.harTail = f, int64(i), b[i:]
+	}
+
+	b, err := json.MarshalIndent(e, "      ", "  ")
+	if err != nil {
+		return err
+	}
+
+	sep := ",\n      "
+	if t.entries == 0 {
+		sep = "\n      "
+	}
+	entry := append([]byte(sep), b...)
+
+	if _, err := t.har.WriteAt(append(append(entry, "\n    "...), t.harTail...), t.harEnd); err != nil {
+		return err
+	}
+	t.harEnd += int64(len(entry))
+	t.entries++
+
+	return nil
+}
+
+// writeIndented writes a body below a dump's headers, pretty printing JSON.
+func writeIndented(b *strings.Builder, body string) {
+	if body == "" {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(body), "   ", "  "); err == nil {
+		body = buf.String()
+	} else {
+		body = strings.Replace(strings.TrimRight(body, "\n"), "\n", "\n   ", -1)
+	}
+
+	fmt.Fprintf(b, "\n   %s\n", body)
+}
+
+// readAndRestore reads a body and replaces it with an in-memory copy.
+func readAndRestore(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+func httpVersion(major, minor int) string {
+	if major == 0 {
+		return "HTTP/1.1"
+	}
+
+	return fmt.Sprintf("HTTP/%d.%d", major, minor)
+}
+
+func msBetween(from, to time.Time) float64 {
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return -1
+	}
+
+	return float64(to.Sub(from)) / float64(time.Millisecond)
+}
+
+// connTimes records when the phases of a request happened.
+type connTimes struct {
+	mu sync.Mutex
+
+	dnsStart, dnsDone         time.Time
+	connectStart, connectDone time.Time
+	tlsStart, tlsDone         time.Time
+	gotConn                   time.Time
+	wroteRequest              time.Time
+	firstByte                 time.Time
+}
+
+func (ct *connTimes) clientTrace(now func() time.Time) *httptrace.ClientTrace {
+	set := func(t *time.Time) {
+		ct.mu.Lock()
+		defer ct.mu.Unlock()
+		*t = now()
+	}
+
+	return &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { set(&ct.dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { set(&ct.dnsDone) },
+		ConnectStart:         func(string, string) { set(&ct.connectStart) },
+		ConnectDone:          func(string, string, error) { set(&ct.connectDone) },
+		TLSHandshakeStart:    func() { set(&ct.tlsStart) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { set(&ct.tlsDone) },
+		GotConn:              func(httptrace.GotConnInfo) { set(&ct.gotConn) },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { set(&ct.wroteRequest) },
+		GotFirstResponseByte: func() { set(&ct.firstByte) },
+	}
+}
+
+// timings converts the recorded times to HAR timings. Phases that did not
+// happen, such as DNS lookups on reused connections, are reported as -1.
+func (ct *connTimes) timings(start, returned, end time.Time) harTimings {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	sendStart := ct.gotConn
+	if sendStart.IsZero() {
+		sendStart = start
+	}
+	wrote := ct.wroteRequest
+	if wrote.IsZero() {
+		wrote = sendStart
+	}
+	firstByte := ct.firstByte
+	if firstByte.IsZero() {
+		firstByte = returned
+	}
+
+	blocked := msBetween(start, sendStart)
+	for _, d := range []float64{msBetween(ct.dnsStart, ct.dnsDone), msBetween(ct.connectStart, ct.connectDone)} {
+		if d > 0 {
+			blocked -= d
+		}
+	}
+	if blocked < 0 {
+		blocked = -1
+	}
+
+	return harTimings{
+		Blocked: blocked,
+		DNS:     msBetween(ct.dnsStart, ct.dnsDone),
+		Connect: msBetween(ct.connectStart, ct.connectDone),
+		SSL:     msBetween(ct.tlsStart, ct.tlsDone),
+		Send:    nonNegativeMS(msBetween(sendStart, wrote)),
+		Wait:    nonNegativeMS(msBetween(wrote, firstByte)),
+		Receive: nonNegativeMS(msBetween(firstByte, end)),
+	}
+}
+
+func nonNegativeMS(ms float64) float64 {
+	if ms < 0 {
+		return 0
+	}
+	return ms
+}
+
+// The types below describe the parts of a HAR 1.2 document doctl writes.
+// See http://www.softwareishard.com/blog/har-12-spec/.
+
+type harDocument struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	SSL     float64 `json:"ssl"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"database":{"id":"db-1","connection":{"uri":"postgresql://doadmin:hunter2@db:25060/defaultdb","password":"hunter2"}}}`))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "doctl-trace")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	harPath := filepath.Join(dir, "trace.har")
+	var log bytes.Buffer
+	client := &http.Client{Transport: newTracer(http.DefaultTransport, &log, harPath, "secret-token")}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/databases?page=2", strings.NewReader(`{"name":"db"}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret-token")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Contains(t, string(body), "hunter2", "the caller should see the real response")
+
+	b, err := ioutil.ReadFile(harPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "secret-token")
+	assert.NotContains(t, string(b), "hunter2")
+
+	var har harDocument
+	require.NoError(t, json.Unmarshal(b, &har))
+	assert.Equal(t, "1.2", har.Log.Version)
+	assert.Equal(t, "doctl", har.Log.Creator.Name)
+	require.Len(t, har.Log.Entries, 1)
+
+	e := har.Log.Entries[0]
+	assert.Equal(t, http.MethodPost, e.Request.Method)
+	assert.Contains(t, e.Request.Headers, harNameValue{Name: "Authorization", Value: "Bearer [REDACTED]"})
+	assert.Equal(t, []harNameValue{{Name: "page", Value: "2"}}, e.Request.QueryString)
+	require.NotNil(t, e.Request.PostData)
+	assert.Equal(t, `{"name":"db"}`, e.Request.PostData.Text)
+	assert.Equal(t, http.StatusCreated, e.Response.Status)
+	assert.Equal(t, "Created", e.Response.StatusText)
+	assert.Contains(t, e.Response.Content.Text, "postgresql://doadmin:REDACTED@db:25060/defaultdb")
+	assert.True(t, e.Time >= 0)
+	assert.True(t, e.Timings.Send >= 0)
+	assert.True(t, e.Timings.Wait >= 0)
+	assert.True(t, e.Timings.Receive >= 0)
+
+	dump := log.String()
+	assert.NotContains(t, dump, "secret-token")
+	assert.NotContains(t, dump, "hunter2")
+	assert.Contains(t, dump, "-> POST "+server.URL+"/v2/databases?page=2\n")
+	assert.Contains(t, dump, "<- 201 Created (")
+	assert.Contains(t, dump, `"id": "db-1"`, "JSON bodies should be indented")
+}
+
+func TestTracerRequestError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	dir, err := ioutil.TempDir("", "doctl-trace")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	harPath := filepath.Join(dir, "trace.har")
+	client := &http.Client{Transport: newTracer(http.DefaultTransport, nil, harPath)}
+
+	_, err = client.Get(server.URL)
+	require.Error(t, err)
+
+	b, err := ioutil.ReadFile(harPath)
+	require.NoError(t, err)
+
+	var har harDocument
+	require.NoError(t, json.Unmarshal(b, &har))
+	require.Len(t, har.Log.Entries, 1)
+	assert.Equal(t, 0, har.Log.Entries[0].Response.Status)
+	assert.NotEmpty(t, har.Log.Entries[0].Response.StatusText)
+}
+
+func TestTracerEntries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "doctl-trace")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	harPath := filepath.Join(dir, "trace.har")
+	client := &http.Client{Transport: newTracer(http.DefaultTransport, nil, harPath)}
+
+	for i := 1; i <= 3; i++ {
+		resp, err := client.Get(fmt.Sprintf("%s/v2/droplets/%d", server.URL, i))
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		// The document is complete after each request.
+		b, err := ioutil.ReadFile(harPath)
+		require.NoError(t, err)
+
+		var har harDocument
+		require.NoError(t, json.Unmarshal(b, &har), string(b))
+		require.Len(t, har.Log.Entries, i)
+		assert.Equal(t, "1.2", har.Log.Version)
+		assert.Equal(t, fmt.Sprintf("%s/v2/droplets/%d", server.URL, i), har.Log.Entries[i-1].Request.URL)
+	}
+}
+
+func TestTracerWriteError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var warnings bytes.Buffer
+	tr := newTracer(http.DefaultTransport, nil, filepath.Join(os.TempDir(), "doctl-missing", "dir", "trace.har"))
+	tr.warnings = &warnings
+	client := &http.Client{Transport: tr}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err, "the request succeeded")
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, 1, strings.Count(warnings.String(), "Warning: unable to write the trace file"), warnings.String())
+}